@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/authcache"
+)
+
+// Auth caches selected by the auth-cache flag.
+const (
+	authCacheNone  = "none"
+	authCacheLRU   = "lru"
+	authCacheRedis = "redis"
+)
+
+var (
+	authCache = &cli.StringFlag{
+		Name: "auth-cache",
+		Usage: "cache of users of auth tokens: none, lru or redis, " +
+			"lru is invalidated only in its process, so it is intended for a single instance",
+		EnvVars: []string{"AUTH_CACHE"},
+		Value:   authCacheNone,
+	}
+
+	authCacheTTL = &cli.DurationFlag{
+		Name:    "auth-cache-ttl",
+		Usage:   "time of keeping users in the auth cache, it bounds staleness of racing updates",
+		EnvVars: []string{"AUTH_CACHE_TTL"},
+		Value:   time.Minute,
+	}
+
+	authCacheSize = &cli.IntFlag{
+		Name:    "auth-cache-size",
+		Usage:   "max number of tokens in the lru auth cache",
+		EnvVars: []string{"AUTH_CACHE_SIZE"},
+		Value:   10000,
+	}
+
+	redisURL = &cli.StringFlag{
+		Name:    "redis-url",
+		Usage:   "redis URL of the redis auth cache, e.g. redis://localhost:6379/0",
+		EnvVars: []string{"REDIS_URL"},
+	}
+)
+
+// newAuthCache returns the auth cache selected by auth cache flags, nil if the cache is disabled.
+func newAuthCache(c *cli.Context) (app.AuthCache, error) {
+	ttl := c.Duration(authCacheTTL.Name)
+
+	switch cache := c.String(authCache.Name); cache {
+	case "", authCacheNone:
+		return nil, nil
+	case authCacheLRU:
+		return authcache.NewLRU(c.Int(authCacheSize.Name), ttl), nil
+	case authCacheRedis:
+		opt, err := redis.ParseURL(c.String(redisURL.Name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", redisURL.Name, err)
+		}
+
+		return authcache.NewRedis(redis.NewClient(opt), ttl), nil
+	default:
+		return nil, fmt.Errorf("%s: unknown cache %q", authCache.Name, cache)
+	}
+}
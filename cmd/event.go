@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/zergslaw/boilerplate/internal/app"
+	"go.uber.org/zap"
+)
+
+// eventLog logs failed publishing of events in addition to collecting metrics.
+type eventLog struct {
+	app.EventMetrics
+	logger *zap.Logger
+}
+
+// PublishFailed for implemented app.EventMetrics.
+func (l eventLog) PublishFailed(events []app.Event, err error) {
+	l.EventMetrics.PublishFailed(events, err)
+
+	l.logger.Warn("failed to publish events",
+		zap.Int("first_event", events[0].ID), zap.Int("events", len(events)), zap.Error(err))
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/health"
+	"github.com/zergslaw/boilerplate/internal/repo"
+	"github.com/zergslaw/boilerplate/migrate"
+)
+
+var (
+	healthInterval = &cli.DurationFlag{
+		Name:    "health-interval",
+		Usage:   "interval of checking dependencies reported by /healthz, /readyz and the gRPC health service",
+		EnvVars: []string{"HEALTH_INTERVAL"},
+		Value:   10 * time.Second,
+	}
+	healthMaxBacklog = &cli.IntFlag{
+		Name:    "health-max-notification-backlog",
+		Usage:   "number of overdue notification tasks at which the service isn't ready, 0 disables the check",
+		EnvVars: []string{"HEALTH_MAX_NOTIFICATION_BACKLOG"},
+		Value:   1000,
+	}
+	shutdownDrain = &cli.DurationFlag{
+		Name:    "shutdown-drain",
+		Usage:   "time between failing the readiness and stopping servers at the shutdown, with finishing in-flight requests it must fit 9s before the forced exit",
+		EnvVars: []string{"SHUTDOWN_DRAIN"},
+		Value:   5 * time.Second,
+	}
+
+	errMigrationState      = errors.New("database schema differs from the binary")
+	errNotificationBacklog = errors.New("notification backlog exceeds the limit")
+)
+
+// Names of health checks.
+const (
+	checkDatabase              = "database"
+	checkMigrations            = "migrations"
+	checkNotificationBacklog   = "notification_backlog"
+	checkNotificationProviders = "notification_providers"
+)
+
+type (
+	// pinger is implemented by the storage connected to the database.
+	pinger interface {
+		Ping(ctx context.Context) error
+	}
+	// migrationRepo is implemented by the storage migrated by the migrate command.
+	migrationRepo interface {
+		MigrationStates(ctx context.Context, migrations []repo.Migration) ([]repo.MigrationState, error)
+	}
+	// backlogRepo is implemented by the storage keeping notification tasks.
+	backlogRepo interface {
+		NotificationBacklog(ctx context.Context) (int, error)
+	}
+	// providerAvailability is implemented by the notification sending emails by providers.
+	providerAvailability interface {
+		Available() error
+	}
+)
+
+// newHealthChecker returns the checker of dependencies supported by the storage and the notification.
+// Dependencies are checked only by the readiness probe, the restart of the process doesn't fix them.
+func newHealthChecker(c *cli.Context, r interface{}, n app.Notification) (*health.Checker, error) {
+	checker := health.New()
+
+	if db, ok := r.(pinger); ok {
+		checker.AddReadinessCheck(checkDatabase, db.Ping)
+	}
+
+	if db, ok := r.(migrationRepo); ok {
+		migrations, err := repo.LoadMigrations(migrate.FS)
+		if err != nil {
+			return nil, fmt.Errorf("load migrations: %w", err)
+		}
+
+		checker.AddReadinessCheck(checkMigrations, func(ctx context.Context) error {
+			return migrationsApplied(ctx, db, migrations)
+		})
+	}
+
+	if db, ok := r.(backlogRepo); ok && c.Int(healthMaxBacklog.Name) > 0 {
+		max := c.Int(healthMaxBacklog.Name)
+		checker.AddReadinessCheck(checkNotificationBacklog, func(ctx context.Context) error {
+			backlog, err := db.NotificationBacklog(ctx)
+			if err != nil {
+				return err
+			}
+			if backlog >= max {
+				return fmt.Errorf("%w: %d tasks", errNotificationBacklog, backlog)
+			}
+
+			return nil
+		})
+	}
+
+	if providers, ok := n.(providerAvailability); ok {
+		checker.AddReadinessCheck(checkNotificationProviders, func(context.Context) error {
+			return providers.Available()
+		})
+	}
+
+	return checker, nil
+}
+
+// migrationsApplied returns the error if any migration of the binary is pending or differs from the applied one.
+// Applied migrations unknown to the binary are fine, they are run by the newer version during the rolling update.
+func migrationsApplied(ctx context.Context, db migrationRepo, migrations []repo.Migration) error {
+	states, err := db.MigrationStates(ctx, migrations)
+	if err != nil {
+		return err
+	}
+
+	for _, state := range states {
+		if state.Status == repo.MigrationPending || state.Status == repo.MigrationDrift {
+			return fmt.Errorf("%w: migration %d is %s", errMigrationState, state.Version, state.Status)
+		}
+	}
+
+	return nil
+}
+
+// drainContext returns the context cancelled the drain period after ctx, it keeps values of ctx.
+// The checker reports the shutdown as soon as ctx is done, so load balancers stop routing
+// requests to the service before servers using the returned context stop accepting them.
+func drainContext(ctx context.Context, checker *health.Checker, drain time.Duration) context.Context {
+	drainCtx, cancel := context.WithCancel(valueContext{ctx})
+	go func() {
+		defer cancel()
+		<-ctx.Done()
+		checker.Shutdown()
+		time.Sleep(drain)
+	}()
+
+	return drainCtx
+}
+
+// valueContext keeps values of the context without its cancellation.
+type valueContext struct{ context.Context }
+
+// Deadline need for implements context.Context.
+func (valueContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+// Done need for implements context.Context.
+func (valueContext) Done() <-chan struct{} { return nil }
+
+// Err need for implements context.Context.
+func (valueContext) Err() error { return nil }
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/log"
+	"go.uber.org/zap"
+)
+
+// Elections of workers which must run on exactly one process.
+const (
+	schedulerElection     = "scheduler"
+	singletonJobsElection = "singleton_jobs"
+	eventRelayElection    = "event_relay"
+)
+
+// leaderLog logs changes of the leadership in addition to collecting metrics.
+type leaderLog struct {
+	app.LeaderMetrics
+	logger *zap.Logger
+}
+
+// Leader for implemented app.LeaderMetrics.
+func (l leaderLog) Leader(lease app.Lease, isLeader bool) {
+	l.LeaderMetrics.Leader(lease, isLeader)
+
+	fields := []zap.Field{zap.String(log.Election, lease.Election), zap.Int64(log.Term, lease.Term)}
+	if isLeader {
+		l.logger.Info("became leader", fields...)
+	} else {
+		l.logger.Info("lost leadership", fields...)
+	}
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/log"
+	"github.com/zergslaw/boilerplate/internal/repo"
+	"github.com/zergslaw/boilerplate/migrate"
+	"go.uber.org/zap"
+)
+
+var (
+	migrateOperation = &cli.StringFlag{
+		Name:    "operation",
+		Aliases: []string{"o"},
+		Usage:   "migration operation: up, up-to, up-one, down, down-to or reset",
+		Value:   string(repo.MigrateUp),
+	}
+	migrateTo = &cli.UintFlag{
+		Name:    "to",
+		Aliases: []string{"t"},
+		Usage:   "version of up-to and down-to operations",
+	}
+	migrateDryRun = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "prints migrations which would run with their SQL without running them",
+	}
+
+	errMigrationDrift = errors.New("applied migrations differ from the binary")
+
+	Migrate = &cli.Command{
+		Name:         "migrate",
+		Usage:        "migrates the database.",
+		UsageText:    "Runs migrations of the postgres storage embedded in the binary.",
+		BashComplete: cli.DefaultAppComplete,
+		Action:       migrateAction,
+		Flags:        append([]cli.Flag{migrateOperation, migrateTo, migrateDryRun}, dbFlags...),
+		Subcommands: []*cli.Command{
+			{
+				Name:   "status",
+				Usage:  "prints applied and pending migrations, fails if applied ones differ from the binary.",
+				Action: migrateStatusAction,
+				Flags:  dbFlags,
+			},
+		},
+	}
+)
+
+func migrateAction(c *cli.Context) error {
+	cfg := repo.MigrateConfig{
+		Operation: repo.MigrateOperation(c.String(migrateOperation.Name)),
+		To:        c.Uint(migrateTo.Name),
+		DryRun:    c.Bool(migrateDryRun.Name),
+	}
+
+	migrations, err := migrateDB(c, cfg)
+	if err != nil {
+		return err
+	}
+
+	action, query := "down", func(m repo.Migration) string { return m.Down }
+	if cfg.Operation.Up() {
+		action, query = "up", func(m repo.Migration) string { return m.Up }
+	}
+	if len(migrations) == 0 {
+		fmt.Println("no migrations to run")
+	}
+	for _, m := range migrations {
+		fmt.Println(action, m.Version, m.Name)
+		if cfg.DryRun {
+			fmt.Printf("%s\n\n", query(m))
+		}
+	}
+
+	return nil
+}
+
+func migrateStatusAction(c *cli.Context) error {
+	db, err := connectDB(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrations, err := repo.LoadMigrations(migrate.FS)
+	if err != nil {
+		return fmt.Errorf("load migrations: %w", err)
+	}
+
+	states, err := repo.MigrationStates(c.Context, db, migrations)
+	if err != nil {
+		return fmt.Errorf("migration states: %w", err)
+	}
+
+	drift := false
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED")
+	for _, state := range states {
+		name, applied := state.Name, "-"
+		if name == "" {
+			name = "-"
+		}
+		if !state.AppliedAt.IsZero() {
+			applied = state.AppliedAt.Format(time.RFC3339)
+		}
+		drift = drift || state.Status == repo.MigrationDrift || state.Status == repo.MigrationMissing
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", state.Version, name, state.Status, applied)
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return err
+	}
+	if drift {
+		return errMigrationDrift
+	}
+
+	return nil
+}
+
+// autoMigrateDB applies pending migrations before the service starts.
+func autoMigrateDB(c *cli.Context) error {
+	migrations, err := migrateDB(c, repo.MigrateConfig{Operation: repo.MigrateUp})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		log.FromContext(c.Context).Info("migration applied", zap.Uint("version", m.Version), zap.String("name", m.Name))
+	}
+
+	return nil
+}
+
+func migrateDB(c *cli.Context, cfg repo.MigrateConfig) ([]repo.Migration, error) {
+	db, err := connectDB(c)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	migrations, err := repo.LoadMigrations(migrate.FS)
+	if err != nil {
+		return nil, fmt.Errorf("load migrations: %w", err)
+	}
+
+	migrations, err = repo.Migrate(c.Context, db, migrations, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("migrate database: %w", err)
+	}
+
+	return migrations, nil
+}
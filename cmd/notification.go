@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var (
+	taskEmail = &cli.StringFlag{
+		Name:  "email",
+		Usage: "recipient email",
+	}
+
+	taskKind = &cli.StringFlag{
+		Name:  "kind",
+		Usage: "message kind, e.g. PassRecovery",
+	}
+
+	taskStatus = &cli.StringFlag{
+		Name:  "status",
+		Usage: "task status: pending, done or cancelled",
+	}
+
+	taskID = &cli.IntFlag{
+		Name:     "id",
+		Usage:    "task id",
+		Required: true,
+	}
+
+	taskLimit = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "max number of tasks to show",
+		Value: 20,
+	}
+
+	taskOffset = &cli.IntFlag{
+		Name:  "offset",
+		Usage: "number of tasks to skip",
+	}
+
+	Notification = &cli.Command{
+		Name:         "notification",
+		Usage:        "inspects and replays notification tasks.",
+		UsageText:    "Inspects and replays notification tasks.",
+		BashComplete: cli.DefaultAppComplete,
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "prints tasks, latest first.",
+				Action: notificationListAction,
+				Flags:  append(append([]cli.Flag{taskEmail, taskKind, taskStatus, taskLimit, taskOffset}, piiFlags...), dbFlags...),
+			},
+			{
+				Name:   "resend",
+				Usage:  "creates a new pending task with the same recipient, kind and payload.",
+				Action: notificationResendAction,
+				Flags:  append(append([]cli.Flag{taskID}, piiFlags...), dbFlags...),
+			},
+			{
+				Name:   "cancel",
+				Usage:  "cancels the pending task.",
+				Action: notificationCancelAction,
+				Flags:  append(append([]cli.Flag{taskID}, piiFlags...), dbFlags...),
+			},
+		},
+	}
+)
+
+func notificationAdminApp(c *cli.Context) (app.NotificationAdminApp, error) {
+	option, err := encryption(c)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := connectRepo(c, option)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.New(app.Config{Wal: r}), nil
+}
+
+func notificationListAction(c *cli.Context) error {
+	filter := app.TaskFilter{Email: c.String(taskEmail.Name)}
+
+	var err error
+	if c.String(taskKind.Name) != "" {
+		filter.Kind, err = app.ParseMessageKind(c.String(taskKind.Name))
+		if err != nil {
+			return err
+		}
+	}
+	if c.String(taskStatus.Name) != "" {
+		filter.Status, err = app.ParseTaskStatus(c.String(taskStatus.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	application, err := notificationAdminApp(c)
+	if err != nil {
+		return err
+	}
+
+	tasks, total, err := application.ListNotificationTasks(c.Context, filter, app.Page{
+		Limit:  c.Int(taskLimit.Name),
+		Offset: c.Int(taskOffset.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("list tasks: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tEMAIL\tKIND\tSTATUS\tCREATED\tRUN AT\tEXECUTED\tPROVIDER\tERROR")
+	for _, task := range tasks {
+		execTime := "-"
+		if !task.ExecTime.IsZero() {
+			execTime = task.ExecTime.Format(time.RFC3339)
+		}
+
+		provider := "-"
+		if task.Provider != "" {
+			provider = task.Provider
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			task.ID, task.Email, task.Kind, task.Status, task.CreatedAt.Format(time.RFC3339),
+			task.RunAt.Format(time.RFC3339), execTime, provider, task.Error)
+	}
+	fmt.Fprintf(w, "total: %d\n", total)
+
+	return w.Flush()
+}
+
+func notificationResendAction(c *cli.Context) error {
+	application, err := notificationAdminApp(c)
+	if err != nil {
+		return err
+	}
+
+	task, err := application.ResendNotificationTask(c.Context, c.Int(taskID.Name))
+	if err != nil {
+		return fmt.Errorf("resend task: %w", err)
+	}
+
+	fmt.Println("id:", task.ID)
+	return nil
+}
+
+func notificationCancelAction(c *cli.Context) error {
+	application, err := notificationAdminApp(c)
+	if err != nil {
+		return err
+	}
+
+	err = application.CancelNotificationTask(c.Context, c.Int(taskID.Name))
+	if err != nil {
+		return fmt.Errorf("cancel task: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/log"
+	"github.com/zergslaw/boilerplate/internal/pii"
+	"github.com/zergslaw/boilerplate/internal/repo"
+	"go.uber.org/zap"
+)
+
+var (
+	piiKeys = &cli.StringSliceFlag{
+		Name:    "pii-key",
+		Usage:   "key encrypting emails and phones like id:base64 of 32 bytes, the first key encrypts new data, the rest decrypt data until it's re-encrypted",
+		EnvVars: []string{"PII_KEYS"},
+	}
+	piiIndexKey = &cli.StringFlag{
+		Name:    "pii-index-key",
+		Usage:   "base64 of at least 32 bytes for blind indexes of emails and phones, it can't be changed without losing lookups",
+		EnvVars: []string{"PII_INDEX_KEY"},
+	}
+	piiBatchSize = &cli.IntFlag{
+		Name:  "batch-size",
+		Usage: "number of rows re-encrypted or decrypted in one transaction",
+		Value: 500,
+	}
+
+	piiFlags = []cli.Flag{piiKeys, piiIndexKey}
+
+	errPlaintextPII = errors.New("personal data is stored in plaintext, run pii reencrypt or serve with --auto-migrate")
+
+	PII = &cli.Command{
+		Name:         "pii",
+		Usage:        "manages encryption of personal data.",
+		UsageText:    "Manages encryption of personal data in the postgres storage.",
+		BashComplete: cli.DefaultAppComplete,
+		Subcommands: []*cli.Command{
+			{
+				Name:   "reencrypt",
+				Usage:  "encrypts plaintext personal data and one encrypted by previous keys with the first key.",
+				Action: piiReencryptAction,
+				Flags:  append(append([]cli.Flag{piiBatchSize}, piiFlags...), dbFlags...),
+			},
+			{
+				Name:   "decrypt",
+				Usage:  "stores personal data in plaintext, so migrations of the encryption can be reverted.",
+				Action: piiDecryptAction,
+				Flags:  append(append([]cli.Flag{piiBatchSize}, piiFlags...), dbFlags...),
+			},
+		},
+	}
+)
+
+// encryption returns the option of the postgres storage with the keyring set by pii flags,
+// personal data is stored in plaintext if no keys are set.
+func encryption(c *cli.Context) (repo.Option, error) {
+	if !encrypted(c) {
+		return repo.Encryption(nil), nil
+	}
+
+	indexKey, err := base64.StdEncoding.DecodeString(c.String(piiIndexKey.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", piiIndexKey.Name, err)
+	}
+
+	var keys []pii.Key
+	for _, s := range c.StringSlice(piiKeys.Name) {
+		key, err := pii.ParseKey(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", piiKeys.Name, err)
+		}
+		keys = append(keys, key)
+	}
+
+	keyring, err := pii.New(indexKey, keys...)
+	if err != nil {
+		return nil, fmt.Errorf("keyring: %w", err)
+	}
+
+	return repo.Encryption(keyring), nil
+}
+
+// encrypted reports whether keys of personal data are set.
+func encrypted(c *cli.Context) bool {
+	return len(c.StringSlice(piiKeys.Name)) > 0 || c.String(piiIndexKey.Name) != ""
+}
+
+// requireEncryptedPII refuses to start the service with keys while personal data is stored in plaintext,
+// it isn't found by blind indexes until it's encrypted. The data is encrypted instead if reencrypt is set.
+func requireEncryptedPII(c *cli.Context, r *repo.Repo, reencrypt bool) error {
+	if !encrypted(c) {
+		return nil
+	}
+
+	plaintext, err := r.PlaintextPII(c.Context)
+	if err != nil {
+		return err
+	}
+	if !plaintext {
+		return nil
+	}
+	if !reencrypt {
+		return errPlaintextPII
+	}
+
+	count, err := r.ReencryptPII(c.Context, piiBatchSize.Value)
+	if err != nil {
+		return fmt.Errorf("re-encrypt: %w", err)
+	}
+	log.FromContext(c.Context).Info("personal data encrypted", zap.Int("rows", count))
+
+	return nil
+}
+
+func piiReencryptAction(c *cli.Context) error {
+	option, err := encryption(c)
+	if err != nil {
+		return err
+	}
+
+	r, err := connectRepo(c, option)
+	if err != nil {
+		return err
+	}
+
+	count, err := r.ReencryptPII(c.Context, c.Int(piiBatchSize.Name))
+	fmt.Println("re-encrypted:", count)
+	if err != nil {
+		return fmt.Errorf("re-encrypt: %w", err)
+	}
+
+	return nil
+}
+
+func piiDecryptAction(c *cli.Context) error {
+	option, err := encryption(c)
+	if err != nil {
+		return err
+	}
+
+	r, err := connectRepo(c, option)
+	if err != nil {
+		return err
+	}
+
+	count, err := r.DecryptPII(c.Context, c.Int(piiBatchSize.Name))
+	fmt.Println("decrypted:", count)
+	if err != nil {
+		return fmt.Errorf("decrypt: %w", err)
+	}
+
+	return nil
+}
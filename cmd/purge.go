@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var (
+	purgeSchedule = &cli.StringFlag{
+		Name:    "purge-schedule",
+		Usage:   "cron expression of purging expired data, e.g. '0 3 * * *' or '@every 1h'",
+		EnvVars: []string{"PURGE_SCHEDULE"},
+		Value:   "0 3 * * *",
+	}
+
+	purgeBatchSize = &cli.IntFlag{
+		Name:    "purge-batch-size",
+		Usage:   "max number of rows removed by one query of purge jobs",
+		EnvVars: []string{"PURGE_BATCH_SIZE"},
+		Value:   1000,
+	}
+
+	sessionRetention = &cli.DurationFlag{
+		Name:    "session-retention",
+		Usage:   "time since the logout after which sessions are purged, 0 disables purging",
+		EnvVars: []string{"SESSION_RETENTION"},
+		Value:   30 * 24 * time.Hour,
+	}
+
+	recoveryCodeRetention = &cli.DurationFlag{
+		Name:    "recovery-code-retention",
+		Usage:   "age of recovery and phone verification codes to be purged, 0 disables purging",
+		EnvVars: []string{"RECOVERY_CODE_RETENTION"},
+		Value:   24 * time.Hour,
+	}
+
+	notificationRetention = &cli.DurationFlag{
+		Name:    "notification-retention",
+		Usage:   "age of completed notifications to be purged, must exceed periods of notification limits, 0 disables purging",
+		EnvVars: []string{"NOTIFICATION_RETENTION"},
+		Value:   30 * 24 * time.Hour,
+	}
+
+	jobRetention = &cli.DurationFlag{
+		Name:    "job-retention",
+		Usage:   "age of finished background jobs to be purged, 0 disables purging",
+		EnvVars: []string{"JOB_RETENTION"},
+		Value:   7 * 24 * time.Hour,
+	}
+)
+
+// purgeJobs returns periodic jobs purging expired data by purge flags.
+func purgeJobs(c *cli.Context) ([]app.PeriodicJob, error) {
+	schedule, err := cron.ParseStandard(c.String(purgeSchedule.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", purgeSchedule.Name, err)
+	}
+
+	batchSize := c.Int(purgeBatchSize.Name)
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("%s: must be positive", purgeBatchSize.Name)
+	}
+
+	retentions := []struct {
+		kind app.JobKind
+		flag *cli.DurationFlag
+	}{
+		{app.JobPurgeSessions, sessionRetention},
+		{app.JobPurgeRecoveryCodes, recoveryCodeRetention},
+		{app.JobPurgeNotifications, notificationRetention},
+		{app.JobPurgeJobs, jobRetention},
+	}
+
+	jobs := make([]app.PeriodicJob, 0, len(retentions))
+	for _, r := range retentions {
+		retention := c.Duration(r.flag.Name)
+		if retention <= 0 {
+			continue
+		}
+
+		jobs = append(jobs, app.PeriodicJob{
+			Job:      app.NewPurgeJob(r.kind, retention, batchSize),
+			Schedule: schedule,
+		})
+	}
+
+	return jobs, nil
+}
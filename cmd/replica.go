@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	zergrepo "github.com/ZergsLaw/zerg-repo"
+	dbFlag "github.com/ZergsLaw/zerg-repo/zergrepo/cmd"
+	"github.com/jmoiron/sqlx"
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/log"
+	"github.com/zergslaw/boilerplate/internal/repo"
+)
+
+var (
+	dbReplicas = &cli.StringSliceFlag{
+		Name:    "db-replica",
+		Usage:   "host:port of the read replica of the database, it shares name, user and password with the primary",
+		EnvVars: []string{"DB_REPLICAS"},
+	}
+
+	dbReplicaMaxLag = &cli.DurationFlag{
+		Name:    "db-replica-max-lag",
+		Usage:   "max replication lag at which the replica serves reads",
+		EnvVars: []string{"DB_REPLICA_MAX_LAG"},
+		Value:   time.Second,
+	}
+)
+
+// replicaCheckInterval is the interval of checking health and lag of replicas.
+const replicaCheckInterval = time.Second
+
+// replicaMonitor is implemented by the storage supporting read replicas.
+type replicaMonitor interface {
+	MonitorReplicas(ctx context.Context, interval time.Duration) error
+}
+
+// connectReplicas connects to the read replicas by db flags, returns the option of the repo.
+func connectReplicas(c *cli.Context) (repo.Option, error) {
+	addrs := c.StringSlice(dbReplicas.Name)
+	dbs := make([]*sqlx.DB, 0, len(addrs))
+	for _, addr := range addrs {
+		db, err := connectReplica(c, addr)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", dbReplicas.Name, addr, err)
+		}
+		dbs = append(dbs, db)
+	}
+
+	logger := log.FromContext(c.Context).Named("replica").Sugar()
+	replicas := repo.ConnectReplicas(dbs, log.FromContext(c.Context).Named("zergrepo").Sugar(), c.App.Name)
+
+	return repo.Replicas(logger, c.Duration(dbReplicaMaxLag.Name), replicas...), nil
+}
+
+func connectReplica(c *cli.Context, addr string) (*sqlx.DB, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("port: %w", err)
+	}
+
+	ctxConnect, cancelConnect := context.WithTimeout(c.Context, connectTimeout)
+	defer cancelConnect()
+
+	return zergrepo.ConnectByCfg(ctxConnect, "postgres", zergrepo.Config{
+		Host:     host,
+		Port:     portNum,
+		User:     c.String(dbFlag.User.Name),
+		Password: c.String(dbFlag.Pass.Name),
+		DBName:   c.String(dbFlag.Name.Name),
+		SSLMode:  zergrepo.DBSSLMode,
+	})
+}
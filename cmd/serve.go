@@ -2,26 +2,37 @@ package cmd
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	zergrepo "github.com/ZergsLaw/zerg-repo"
 	dbFlag "github.com/ZergsLaw/zerg-repo/zergrepo/cmd"
+	"github.com/jmoiron/sqlx"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli/v2"
 	"github.com/zergslaw/boilerplate/internal/api/rpc"
 	"github.com/zergslaw/boilerplate/internal/api/web"
 	"github.com/zergslaw/boilerplate/internal/app"
 	"github.com/zergslaw/boilerplate/internal/auth"
+	"github.com/zergslaw/boilerplate/internal/broker"
+	"github.com/zergslaw/boilerplate/internal/health"
+	"github.com/zergslaw/boilerplate/internal/inbox"
 	"github.com/zergslaw/boilerplate/internal/log"
+	"github.com/zergslaw/boilerplate/internal/metrics"
 	"github.com/zergslaw/boilerplate/internal/notification"
 	"github.com/zergslaw/boilerplate/internal/password"
 	"github.com/zergslaw/boilerplate/internal/recoverycode"
 	"github.com/zergslaw/boilerplate/internal/repo"
+	"github.com/zergslaw/boilerplate/internal/repo/memory"
+	"github.com/zergslaw/boilerplate/internal/repo/sqlite"
+	"github.com/zergslaw/boilerplate/internal/sms"
+	"github.com/zergslaw/boilerplate/internal/webhook"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -88,10 +99,129 @@ var (
 	}
 
 	emailAPIKey = &cli.StringFlag{
-		Name:     "email-api-key",
-		Usage:    "set api key for send email",
-		EnvVars:  []string{"EMAIL_API_KEY"},
-		Required: true,
+		Name:    "email-api-key",
+		Usage:   "set api key for send email by SendGrid",
+		EnvVars: []string{"EMAIL_API_KEY"},
+	}
+
+	emailProvider = &cli.StringSliceFlag{
+		Name:    "email-provider",
+		Usage:   "email providers in order of priority: sendgrid, smtp",
+		EnvVars: []string{"EMAIL_PROVIDERS"},
+		Value:   cli.NewStringSlice(notification.SendGrid),
+	}
+
+	smtpAddr = &cli.StringFlag{
+		Name:    "smtp-addr",
+		Usage:   "SMTP server address host:port for the smtp email provider",
+		EnvVars: []string{"SMTP_ADDR"},
+	}
+
+	smtpUser = &cli.StringFlag{
+		Name:    "smtp-user",
+		Usage:   "SMTP username, auth is not used if empty",
+		EnvVars: []string{"SMTP_USER"},
+	}
+
+	smtpPass = &cli.StringFlag{
+		Name:    "smtp-pass",
+		Usage:   "SMTP password",
+		EnvVars: []string{"SMTP_PASS"},
+	}
+
+	smsAccountSID = &cli.StringFlag{
+		Name:    "sms-account-sid",
+		Usage:   "account SID of the SMS provider, SMS are disabled if empty",
+		EnvVars: []string{"SMS_ACCOUNT_SID"},
+	}
+
+	smsAuthToken = &cli.StringFlag{
+		Name:    "sms-auth-token",
+		Usage:   "auth token of the SMS provider",
+		EnvVars: []string{"SMS_AUTH_TOKEN"},
+	}
+
+	smsFrom = &cli.StringFlag{
+		Name:    "sms-from",
+		Usage:   "phone or sender ID the SMS are sent from",
+		EnvVars: []string{"SMS_FROM"},
+	}
+
+	smsURL = &cli.StringFlag{
+		Name:    "sms-url",
+		Usage:   "address of the Twilio compatible SMS API",
+		EnvVars: []string{"SMS_URL"},
+		Value:   sms.DefaultURL,
+	}
+
+	unsubscribeURL = &cli.StringFlag{
+		Name:    "unsubscribe-url",
+		Usage:   "public address of the one-click unsubscribe endpoint",
+		EnvVars: []string{"UNSUBSCRIBE_URL"},
+		Value:   fmt.Sprintf("http://localhost:%d/api/v1/unsubscribe", WebServerPort),
+	}
+
+	orgInvitationURL = &cli.StringFlag{
+		Name:    "org-invitation-url",
+		Usage:   "public address of the page accepting invitations to organizations, the token is passed in the token query parameter",
+		EnvVars: []string{"ORG_INVITATION_URL"},
+	}
+
+	adminKey = &cli.StringFlag{
+		Name:    "admin-key",
+		Usage:   "key for admin endpoints passed in X-Admin-Key header, admin endpoints are disabled if empty",
+		EnvVars: []string{"ADMIN_KEY"},
+	}
+
+	emailEventKey = &cli.StringFlag{
+		Name:    "email-event-key",
+		Usage:   "base64 encoded public key verifying SendGrid signed event webhook, email events are rejected if empty",
+		EnvVars: []string{"EMAIL_EVENT_KEY"},
+	}
+
+	natsURL = &cli.StringFlag{
+		Name:    "nats-url",
+		Usage:   "NATS server address for publishing domain events to JetStream, events are not relayed if empty",
+		EnvVars: []string{"NATS_URL"},
+	}
+
+	natsSubjectPrefix = &cli.StringFlag{
+		Name:    "nats-subject-prefix",
+		Usage:   "prefix of NATS subjects, the event type is appended to it, a JetStream stream must capture <prefix>.>",
+		EnvVars: []string{"NATS_SUBJECT_PREFIX"},
+		Value:   "boilerplate",
+	}
+
+	jobWorker = &cli.BoolFlag{
+		Name:    "worker",
+		Usage:   "runs background jobs in the service, disable it to run them by the worker command",
+		EnvVars: []string{"WORKER"},
+		Value:   true,
+	}
+
+	notificationLimit = &cli.StringSliceFlag{
+		Name:    "notification-limit",
+		Usage:   "per-recipient rate limit of the notification kind in format Kind=count/period, e.g. PassRecovery=3/1h",
+		EnvVars: []string{"NOTIFICATION_LIMITS"},
+		Value:   cli.NewStringSlice("PassRecovery=3/1h", "PassRecoverySMS=3/1h", "PhoneVerification=3/1h"),
+	}
+
+	repoBackend = &cli.StringFlag{
+		Name:    "repo",
+		Usage:   "storage of the service: postgres, sqlite or memory, the memory storage loses data on exit and is intended for development",
+		EnvVars: []string{"REPO"},
+		Value:   repoPostgres,
+	}
+	sqlitePath = &cli.StringFlag{
+		Name:    "sqlite-path",
+		Usage:   "path to the database file of the sqlite storage, it is created and migrated at start",
+		EnvVars: []string{"SQLITE_PATH"},
+		Value:   "boilerplate.db",
+	}
+	autoMigrate = &cli.BoolFlag{
+		Name:    "auto-migrate",
+		Usage:   "runs pending migrations of the postgres storage and encrypts personal data stored in plaintext before starting servers",
+		EnvVars: []string{"AUTO_MIGRATE"},
 	}
 
 	Serve = &cli.Command{
@@ -102,24 +232,265 @@ var (
 		BashComplete: cli.DefaultAppComplete,
 		Action:       serverAction,
 		Flags: []cli.Flag{
+			repoBackend, sqlitePath, autoMigrate,
 			dbFlag.Name, dbFlag.User, dbFlag.Pass, dbFlag.Host, dbFlag.Port,
+			piiKeys, piiIndexKey,
+			dbReplicas, dbReplicaMaxLag,
 			jwtKey,
+			authCache, authCacheTTL, authCacheSize, redisURL,
 			webHost, restPort,
 			metricHost, metricPort,
+			healthInterval, healthMaxBacklog, shutdownDrain,
 			gRPCHost, gRPCPort,
-			emailFrom, emailAPIKey,
+			emailFrom, emailAPIKey, unsubscribeURL, emailEventKey,
+			emailProvider, smtpAddr, smtpUser, smtpPass,
+			smsAccountSID, smsAuthToken, smsFrom, smsURL,
+			notificationLimit,
+			orgInvitationURL,
+			natsURL, natsSubjectPrefix,
+			adminKey,
+			jobWorker,
+			purgeSchedule, purgeBatchSize,
+			sessionRetention, recoveryCodeRetention, notificationRetention, jobRetention,
 		},
 	}
 )
 
 const connectTimeout = time.Second * 5
 
+// Storages selected by the repo flag.
+const (
+	repoPostgres = "postgres"
+	repoSQLite   = "sqlite"
+	repoMemory   = "memory"
+)
+
+// repository is implemented by every storage of the service.
+type repository interface {
+	app.UserRepo
+	app.SessionRepo
+	app.CodeRepo
+	app.NotificationSettingsRepo
+	app.WebhookRepo
+	app.EventRepo
+	app.InboxRepo
+	app.DeliverabilityRepo
+	app.ProviderRepo
+	app.JobRepo
+	app.PurgeRepo
+	app.LeaderRepo
+	app.TenantRepo
+	app.OrgRepo
+	app.WAL
+}
+
 func serverAction(c *cli.Context) error {
 	hostName, err := os.Hostname()
 	if err != nil {
 		return fmt.Errorf("hostname: %w", err)
 	}
 
+	r, err := openRepo(c)
+	if err != nil {
+		return err
+	}
+
+	var eventBroker app.Broker
+	if c.String(natsURL.Name) != "" {
+		n, err := broker.NewNATS(c.String(natsURL.Name), c.String(natsSubjectPrefix.Name))
+		if err != nil {
+			return fmt.Errorf("nats: %w", err)
+		}
+		defer n.Close()
+		eventBroker = n
+	}
+
+	var eventKey *ecdsa.PublicKey
+	if c.String(emailEventKey.Name) != "" {
+		eventKey, err = web.ParseEmailEventKey(c.String(emailEventKey.Name))
+		if err != nil {
+			return fmt.Errorf("%s: %w", emailEventKey.Name, err)
+		}
+	}
+
+	n, err := newNotification(c, r)
+	if err != nil {
+		return err
+	}
+
+	application, err := newApplication(c, r, n, eventBroker)
+	if err != nil {
+		return err
+	}
+
+	checker, err := newHealthChecker(c, r, n)
+	if err != nil {
+		return err
+	}
+
+	webAPIHost := host(c.String(webHost.Name), hostName)
+	gRPCAPIHost := host(c.String(gRPCHost.Name), hostName)
+	metricAPIHost := host(c.String(metricHost.Name), hostName)
+
+	group, ctx := errgroup.WithContext(c.Context)
+	serveCtx := drainContext(ctx, checker, c.Duration(shutdownDrain.Name))
+	services := []func() error{
+		func() error {
+			return webAPI(serveCtx, application, webAPIHost, c.Int(restPort.Name), c.String(adminKey.Name), eventKey)
+		},
+		func() error { return metricAPI(serveCtx, checker, metricAPIHost, c.Int(metricPort.Name)) },
+		func() error { return grpcAPI(serveCtx, application, checker, gRPCAPIHost, c.Int(gRPCPort.Name)) },
+		func() error { return checker.Run(ctx, c.Duration(healthInterval.Name)) },
+	}
+	if c.Bool(jobWorker.Name) {
+		services = append(services,
+			func() error { return startJobs(ctx, application) },
+			func() error { return startSingleton(ctx, application, schedulerElection, application.StartScheduler) },
+			func() error {
+				return startSingleton(ctx, application, singletonJobsElection, application.StartSingletonJobs)
+			},
+		)
+	}
+	if monitor, ok := r.(replicaMonitor); ok {
+		services = append(services, func() error { return monitor.MonitorReplicas(ctx, replicaCheckInterval) })
+	}
+	if eventBroker != nil {
+		services = append(services, func() error {
+			return startSingleton(ctx, application, eventRelayElection, application.StartEventRelay)
+		})
+	}
+
+	for _, service := range services {
+		group.Go(service)
+	}
+
+	return group.Wait()
+}
+
+// newNotification builds the email notification by email flags.
+func newNotification(c *cli.Context, r app.ProviderRepo) (app.Notification, error) {
+	providers, err := emailProviders(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return notification.New(r, c.String(emailFrom.Name), c.String(unsubscribeURL.Name), providers...), nil
+}
+
+// newApplication builds the application by jwt, auth cache, SMS, notification limit and purge flags.
+func newApplication(c *cli.Context, r repository, n app.Notification, eventBroker app.Broker) (*app.Application, error) {
+	var smsSender app.Notification
+	if c.String(smsAccountSID.Name) != "" {
+		smsSender = sms.New(c.String(smsAccountSID.Name), c.String(smsAuthToken.Name), c.String(smsFrom.Name),
+			sms.SetURL(c.String(smsURL.Name)))
+	}
+
+	limits, err := notificationLimits(c.StringSlice(notificationLimit.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", notificationLimit.Name, err)
+	}
+
+	periodicJobs, err := purgeJobs(c)
+	if err != nil {
+		return nil, err
+	}
+
+	hostName, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("hostname: %w", err)
+	}
+
+	cache, err := newAuthCache(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.New(app.Config{
+		UserRepo: r, SessionRepo: r, CodeRepo: r, SettingsRepo: r, WebhookRepo: r, EventRepo: r, InboxRepo: r, DeliverRepo: r,
+		JobRepo: r, PurgeRepo: r, LeaderRepo: r, TenantRepo: r, OrgRepo: r, Wal: r,
+		Password:     password.New(),
+		Auth:         auth.New(c.String(jwtKey.Name)),
+		Notification: n,
+		Inbox:        inbox.New(r),
+		SMS:          smsSender,
+		Code:         recoverycode.New(),
+		Webhook:      webhook.New(),
+		Broker:       eventBroker,
+		EventMetrics: eventLog{
+			EventMetrics: metrics.Event{},
+			logger:       log.FromContext(c.Context).Named("event"),
+		},
+		Metrics:      metrics.Notification{},
+		PurgeMetrics: metrics.Purge{},
+		LeaderMetrics: leaderLog{
+			LeaderMetrics: metrics.Leader{},
+			logger:        log.FromContext(c.Context).Named("leader"),
+		},
+		AuthCache:        cache,
+		AuthCacheMetrics: metrics.AuthCache{},
+		PeriodicJobs:     periodicJobs,
+		Instance:         fmt.Sprintf("%s-%d", hostName, os.Getpid()),
+
+		NotificationLimits: limits,
+		OrgInvitationURL:   c.String(orgInvitationURL.Name),
+	}), nil
+}
+
+// openRepo returns the storage selected by the repo flag.
+func openRepo(c *cli.Context) (repository, error) {
+	switch backend := c.String(repoBackend.Name); backend {
+	case repoPostgres:
+		if c.Bool(autoMigrate.Name) {
+			err := autoMigrateDB(c)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		option, err := encryption(c)
+		if err != nil {
+			return nil, err
+		}
+
+		replicas, err := connectReplicas(c)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := connectRepo(c, option, replicas)
+		if err != nil {
+			return nil, err
+		}
+
+		// Personal data stored before keys were set is encrypted like pending migrations.
+		err = requireEncryptedPII(c, r, c.Bool(autoMigrate.Name))
+		if err != nil {
+			return nil, err
+		}
+
+		return r, nil
+	case repoSQLite:
+		return openSQLite(c)
+	case repoMemory:
+		log.FromContext(c.Context).Warn("data is kept in memory and will be lost on exit")
+		return memory.New(), nil
+	default:
+		return nil, fmt.Errorf("%s: unknown storage %q", repoBackend.Name, backend)
+	}
+}
+
+// connectRepo connects to the database by db flags.
+func connectRepo(c *cli.Context, options ...repo.Option) (*repo.Repo, error) {
+	dbConn, err := connectDB(c)
+	if err != nil {
+		return nil, err
+	}
+
+	zp := repo.Connect(dbConn, log.FromContext(c.Context).Named("zergrepo").Sugar(), c.App.Name)
+	return repo.New(zp, options...), nil
+}
+
+func connectDB(c *cli.Context) (*sqlx.DB, error) {
 	ctxConnect, cancelConnect := context.WithTimeout(c.Context, connectTimeout)
 	defer cancelConnect()
 
@@ -132,46 +503,85 @@ func serverAction(c *cli.Context) error {
 		SSLMode:  zergrepo.DBSSLMode,
 	})
 	if err != nil {
-		return fmt.Errorf("connect database: %w", err)
+		return nil, fmt.Errorf("connect database: %w", err)
 	}
 
-	zp := repo.Connect(dbConn, log.FromContext(c.Context).Named("zergrepo").Sugar(), c.App.Name)
-	r := repo.New(zp)
+	return dbConn, nil
+}
+
+func openSQLite(c *cli.Context) (*sqlite.Repo, error) {
+	ctxConnect, cancelConnect := context.WithTimeout(c.Context, connectTimeout)
+	defer cancelConnect()
 
-	emailClientConn, err := notification.Connect(c.String(emailAPIKey.Name))
+	db, err := sqlite.Open(ctxConnect, c.String(sqlitePath.Name))
 	if err != nil {
-		return fmt.Errorf("connect sendgrid: %w", err)
+		return nil, fmt.Errorf("open database: %w", err)
 	}
-	n := notification.New(emailClientConn, c.String(emailFrom.Name))
 
-	pass := password.New()
-	tokenizer := auth.New(c.String(jwtKey.Name))
-	rc := recoverycode.New()
-	application := app.New(app.Config{
-		UserRepo: r, SessionRepo: r, CodeRepo: r, Wal: r,
-		Password:     pass,
-		Auth:         tokenizer,
-		Notification: n,
-		Code:         rc,
-	})
+	err = sqlite.Migrate(ctxConnect, db)
+	if err != nil {
+		return nil, fmt.Errorf("migrate database: %w", err)
+	}
 
-	webAPIHost := host(c.String(webHost.Name), hostName)
-	gRPCAPIHost := host(c.String(gRPCHost.Name), hostName)
-	metricAPIHost := host(c.String(metricHost.Name), hostName)
+	zp := sqlite.Connect(db, log.FromContext(c.Context).Named("zergrepo").Sugar(), c.App.Name)
+	return sqlite.New(zp), nil
+}
 
-	group, ctx := errgroup.WithContext(c.Context)
-	services := []func() error{
-		func() error { return webAPI(ctx, application, webAPIHost, c.Int(restPort.Name)) },
-		func() error { return metricAPI(ctx, metricAPIHost, c.Int(metricPort.Name)) },
-		func() error { return grpcAPI(ctx, application, gRPCAPIHost, c.Int(gRPCPort.Name)) },
-		func() error { return startWAL(ctx, application) },
+func emailProviders(c *cli.Context) ([]notification.Provider, error) {
+	names := c.StringSlice(emailProvider.Name)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%s: empty", emailProvider.Name)
 	}
 
-	for _, service := range services {
-		group.Go(service)
+	providers := make([]notification.Provider, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case notification.SendGrid:
+			if c.String(emailAPIKey.Name) == "" {
+				return nil, fmt.Errorf("%s is required by %s", emailAPIKey.Name, name)
+			}
+
+			emailClientConn, err := notification.Connect(c.String(emailAPIKey.Name))
+			if err != nil {
+				return nil, fmt.Errorf("connect sendgrid: %w", err)
+			}
+			providers = append(providers, notification.NewSendGrid(emailClientConn))
+		case notification.SMTP:
+			if c.String(smtpAddr.Name) == "" {
+				return nil, fmt.Errorf("%s is required by %s", smtpAddr.Name, name)
+			}
+
+			providers = append(providers, notification.NewSMTP(c.String(smtpAddr.Name), c.String(smtpUser.Name), c.String(smtpPass.Name)))
+		default:
+			return nil, fmt.Errorf("%s: unknown provider %s", emailProvider.Name, name)
+		}
 	}
 
-	return group.Wait()
+	return providers, nil
+}
+
+func notificationLimits(values []string) (map[app.MessageKind]app.RateLimit, error) {
+	limits := make(map[app.MessageKind]app.RateLimit, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: %s", app.ErrInvalidRateLimit, value)
+		}
+
+		kind, err := app.ParseMessageKind(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		limit, err := app.ParseRateLimit(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		limits[kind] = limit
+	}
+
+	return limits, nil
 }
 
 func host(host, defHost string) string {
@@ -182,13 +592,15 @@ func host(host, defHost string) string {
 	return host
 }
 
-func webAPI(ctx context.Context, application app.App, host string, port int) error {
+func webAPI(ctx context.Context, application app.App, host string, port int, adminKey string, emailEventKey *ecdsa.PublicKey) error {
 	logger := log.FromContext(ctx).Named("web")
 
 	api, err := web.New(application,
 		logger,
 		web.SetHost(host),
 		web.SetPort(port),
+		web.SetAdminKey(adminKey),
+		web.SetEmailEventKey(emailEventKey),
 	)
 	if err != nil {
 		return fmt.Errorf("web new: %w", err)
@@ -211,10 +623,12 @@ func webAPI(ctx context.Context, application app.App, host string, port int) err
 	return nil
 }
 
-func metricAPI(ctx context.Context, host string, port int) error {
+func metricAPI(ctx context.Context, checker *health.Checker, host string, port int) error {
 	logger := log.FromContext(ctx).Named("prometheus")
 
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle("/healthz", checker.LivenessHandler())
+	http.Handle("/readyz", checker.ReadinessHandler())
 	metricSrv := &http.Server{
 		Addr: net.JoinHostPort(host, strconv.Itoa(port)),
 	}
@@ -237,10 +651,11 @@ func metricAPI(ctx context.Context, host string, port int) error {
 	return nil
 }
 
-func grpcAPI(ctx context.Context, application app.UserApp, host string, port int) error {
+func grpcAPI(ctx context.Context, application app.App, checker *health.Checker, host string, port int) error {
 	logger := log.FromContext(ctx).Named("gRPC")
 
 	api := rpc.New(application, logger)
+	checker.Register(api)
 	ln, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
 	if err != nil {
 		return fmt.Errorf("listen: %w", err)
@@ -264,6 +679,10 @@ func grpcAPI(ctx context.Context, application app.UserApp, host string, port int
 	return nil
 }
 
-func startWAL(ctx context.Context, application app.WALApplication) error {
-	return application.StartWALNotification(ctx)
+func startJobs(ctx context.Context, application app.JobApplication) error {
+	return application.StartJobs(ctx)
+}
+
+func startSingleton(ctx context.Context, application app.LeaderApplication, election string, start func(context.Context) error) error {
+	return application.RunAsLeader(ctx, election, start)
 }
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var (
+	tenantName = &cli.StringFlag{
+		Name:     "name",
+		Usage:    "tenant name, clients select the tenant by it in the X-Tenant header",
+		Required: true,
+	}
+
+	tenantHost = &cli.StringFlag{
+		Name:  "host",
+		Usage: "host bound to the tenant, requests without X-Tenant header are resolved by it",
+	}
+
+	Tenant = &cli.Command{
+		Name:         "tenant",
+		Usage:        "manages tenants.",
+		UsageText:    "Manages tenants, every tenant has its own pool of users.",
+		BashComplete: cli.DefaultAppComplete,
+		Subcommands: []*cli.Command{
+			{
+				Name:   "add",
+				Usage:  "registers a new tenant.",
+				Action: tenantAddAction,
+				Flags:  append([]cli.Flag{tenantName, tenantHost}, dbFlags...),
+			},
+			{
+				Name:   "list",
+				Usage:  "prints registered tenants.",
+				Action: tenantListAction,
+				Flags:  dbFlags,
+			},
+		},
+	}
+)
+
+func tenantApp(c *cli.Context) (app.TenantApp, error) {
+	r, err := connectRepo(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.New(app.Config{TenantRepo: r}), nil
+}
+
+func tenantAddAction(c *cli.Context) error {
+	application, err := tenantApp(c)
+	if err != nil {
+		return err
+	}
+
+	tenant, err := application.CreateTenant(c.Context, c.String(tenantName.Name), c.String(tenantHost.Name))
+	if err != nil {
+		return fmt.Errorf("create tenant: %w", err)
+	}
+
+	fmt.Println("id:", tenant.ID)
+	return nil
+}
+
+func tenantListAction(c *cli.Context) error {
+	application, err := tenantApp(c)
+	if err != nil {
+		return err
+	}
+
+	tenants, err := application.ListTenants(c.Context)
+	if err != nil {
+		return fmt.Errorf("list tenants: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tHOST\tCREATED")
+	for _, tenant := range tenants {
+		host := tenant.Host
+		if host == "" {
+			host = "-"
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", tenant.ID, tenant.Name, host, tenant.CreatedAt.Format(time.RFC3339))
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	dbFlag "github.com/ZergsLaw/zerg-repo/zergrepo/cmd"
+	"github.com/urfave/cli/v2"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var (
+	webhookURL = &cli.StringFlag{
+		Name:     "url",
+		Usage:    "endpoint address",
+		Required: true,
+	}
+
+	webhookSecret = &cli.StringFlag{
+		Name:  "secret",
+		Usage: "secret for signing requests, generated if empty",
+	}
+
+	webhookEvents = &cli.StringSliceFlag{
+		Name:     "event",
+		Usage:    "subscribed event type, can be repeated",
+		Required: true,
+	}
+
+	webhookID = &cli.IntFlag{
+		Name:     "id",
+		Usage:    "webhook id",
+		Required: true,
+	}
+
+	webhookLimit = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "max number of deliveries to show",
+		Value: 20,
+	}
+
+	webhookOffset = &cli.IntFlag{
+		Name:  "offset",
+		Usage: "number of deliveries to skip",
+	}
+
+	dbFlags = []cli.Flag{dbFlag.Name, dbFlag.User, dbFlag.Pass, dbFlag.Host, dbFlag.Port}
+
+	Webhook = &cli.Command{
+		Name:         "webhook",
+		Usage:        "manages outbound webhooks.",
+		UsageText:    "Manages endpoints subscribed to user lifecycle events.",
+		BashComplete: cli.DefaultAppComplete,
+		Subcommands: []*cli.Command{
+			{
+				Name:   "add",
+				Usage:  "registers a new endpoint.",
+				Action: webhookAddAction,
+				Flags:  append([]cli.Flag{webhookURL, webhookSecret, webhookEvents}, dbFlags...),
+			},
+			{
+				Name:   "list",
+				Usage:  "prints registered endpoints.",
+				Action: webhookListAction,
+				Flags:  dbFlags,
+			},
+			{
+				Name:   "remove",
+				Usage:  "removes endpoint and its delivery log.",
+				Action: webhookRemoveAction,
+				Flags:  append([]cli.Flag{webhookID}, dbFlags...),
+			},
+			{
+				Name:   "deliveries",
+				Usage:  "prints the delivery log of the endpoint.",
+				Action: webhookDeliveriesAction,
+				Flags:  append([]cli.Flag{webhookID, webhookLimit, webhookOffset}, dbFlags...),
+			},
+		},
+	}
+)
+
+func webhookApp(c *cli.Context) (app.WebhookApp, error) {
+	r, err := connectRepo(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.New(app.Config{WebhookRepo: r}), nil
+}
+
+func webhookAddAction(c *cli.Context) error {
+	application, err := webhookApp(c)
+	if err != nil {
+		return err
+	}
+
+	events := make([]app.EventType, len(c.StringSlice(webhookEvents.Name)))
+	for i, event := range c.StringSlice(webhookEvents.Name) {
+		events[i] = app.EventType(event)
+	}
+
+	webhook, err := application.CreateWebhook(c.Context, c.String(webhookURL.Name), c.String(webhookSecret.Name), events)
+	if err != nil {
+		return fmt.Errorf("create webhook: %w", err)
+	}
+
+	fmt.Println("id:", webhook.ID)
+	fmt.Println("secret:", webhook.Secret)
+	return nil
+}
+
+func webhookListAction(c *cli.Context) error {
+	application, err := webhookApp(c)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := application.ListWebhooks(c.Context)
+	if err != nil {
+		return fmt.Errorf("list webhooks: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tURL\tEVENTS\tCREATED")
+	for _, webhook := range webhooks {
+		events := make([]string, len(webhook.Events))
+		for i := range webhook.Events {
+			events[i] = string(webhook.Events[i])
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			webhook.ID, webhook.URL, strings.Join(events, ","), webhook.CreatedAt.Format(time.RFC3339))
+	}
+
+	return w.Flush()
+}
+
+func webhookRemoveAction(c *cli.Context) error {
+	application, err := webhookApp(c)
+	if err != nil {
+		return err
+	}
+
+	err = application.DeleteWebhook(c.Context, app.WebhookID(c.Int(webhookID.Name)))
+	if err != nil {
+		return fmt.Errorf("delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+func webhookDeliveriesAction(c *cli.Context) error {
+	application, err := webhookApp(c)
+	if err != nil {
+		return err
+	}
+
+	deliveries, total, err := application.WebhookDeliveries(c.Context, app.WebhookID(c.Int(webhookID.Name)), app.Page{
+		Limit:  c.Int(webhookLimit.Name),
+		Offset: c.Int(webhookOffset.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("webhook deliveries: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tEVENT\tSTATUS\tATTEMPTS\tCODE\tNEXT ATTEMPT\tERROR")
+	for _, d := range deliveries {
+		nextAttempt := "-"
+		if d.Status == app.DeliveryPending {
+			nextAttempt = d.NextAttemptAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			d.ID, d.Event.Type, d.Status, d.Attempts, strconv.Itoa(d.StatusCode), nextAttempt, d.Error)
+	}
+	fmt.Fprintf(w, "total: %d\n", total)
+
+	return w.Flush()
+}
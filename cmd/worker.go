@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	dbFlag "github.com/ZergsLaw/zerg-repo/zergrepo/cmd"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+// Worker runs background jobs without serving the API, so jobs can be scaled separately from the service.
+var Worker = &cli.Command{
+	Name:         "worker",
+	Aliases:      []string{"w"},
+	Usage:        "runs background jobs.",
+	UsageText:    "Runs background jobs: notifications, webhook deliveries and purges of expired data. Start the service with --worker=false to run jobs only here.",
+	BashComplete: cli.DefaultAppComplete,
+	Action:       workerAction,
+	Flags: []cli.Flag{
+		dbFlag.Name, dbFlag.User, dbFlag.Pass, dbFlag.Host, dbFlag.Port,
+		piiKeys, piiIndexKey,
+		jwtKey,
+		metricHost, metricPort,
+		healthInterval, healthMaxBacklog, shutdownDrain,
+		emailFrom, emailAPIKey, unsubscribeURL,
+		emailProvider, smtpAddr, smtpUser, smtpPass,
+		smsAccountSID, smsAuthToken, smsFrom, smsURL,
+		notificationLimit,
+		purgeSchedule, purgeBatchSize,
+		sessionRetention, recoveryCodeRetention, notificationRetention, jobRetention,
+	},
+}
+
+func workerAction(c *cli.Context) error {
+	hostName, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("hostname: %w", err)
+	}
+
+	option, err := encryption(c)
+	if err != nil {
+		return err
+	}
+
+	r, err := connectRepo(c, option)
+	if err != nil {
+		return err
+	}
+
+	err = requireEncryptedPII(c, r, false)
+	if err != nil {
+		return err
+	}
+
+	n, err := newNotification(c, r)
+	if err != nil {
+		return err
+	}
+
+	application, err := newApplication(c, r, n, nil)
+	if err != nil {
+		return err
+	}
+
+	checker, err := newHealthChecker(c, r, n)
+	if err != nil {
+		return err
+	}
+
+	metricAPIHost := host(c.String(metricHost.Name), hostName)
+
+	group, ctx := errgroup.WithContext(c.Context)
+	serveCtx := drainContext(ctx, checker, c.Duration(shutdownDrain.Name))
+	group.Go(func() error { return metricAPI(serveCtx, checker, metricAPIHost, c.Int(metricPort.Name)) })
+	group.Go(func() error { return checker.Run(ctx, c.Duration(healthInterval.Name)) })
+	group.Go(func() error { return startJobs(ctx, application) })
+	group.Go(func() error { return startSingleton(ctx, application, schedulerElection, application.StartScheduler) })
+	group.Go(func() error {
+		return startSingleton(ctx, application, singletonJobsElection, application.StartSingletonJobs)
+	})
+
+	return group.Wait()
+}
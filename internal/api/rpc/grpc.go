@@ -17,6 +17,14 @@ import (
 type users interface {
 	// UserByAuthToken is documented in app.App interface.
 	UserByAuthToken(ctx context.Context, token app.AuthToken) (*app.AuthUser, error)
+	// User is documented in app.App interface.
+	User(ctx context.Context, authUser app.AuthUser, publicID app.PublicID) (*app.User, error)
+	// Tenant is documented in app.App interface.
+	Tenant(ctx context.Context, name, host string) (*app.Tenant, error)
+	// WatchUserNotifications is documented in app.App interface.
+	WatchUserNotifications(ctx context.Context, authUser app.AuthUser, afterID int, fn func(app.UserNotification) error) error
+	// OrgMember is documented in app.App interface.
+	OrgMember(ctx context.Context, orgID app.OrgID, publicID app.PublicID) (*app.OrgMember, error)
 }
 
 type service struct {
@@ -39,6 +47,14 @@ func New(application users, logger *zap.Logger) *grpc.Server {
 			MakeUnaryServerLogger(logger),
 			UnaryServerRecover,
 			UnaryServerAccessLog,
+			UnaryServerTrackWrites,
+		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(
+			prometheus.StreamServerInterceptor,
+			MakeStreamServerLogger(logger),
+			StreamServerRecover,
+			StreamServerAccessLog,
+			StreamServerTrackWrites,
 		)),
 	)
 
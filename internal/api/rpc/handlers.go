@@ -3,30 +3,117 @@ package rpc
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
 
 	"github.com/zergslaw/boilerplate/internal/api/rpc/pb"
 	"github.com/zergslaw/boilerplate/internal/app"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// tenantMetadata selects the tenant by name, requests without it belong to the default tenant.
+const tenantMetadata = "x-tenant"
+
 func (s *service) GetUserByAuthToken(ctx context.Context, in *pb.AuthInfo) (*pb.User, error) {
-	info, err := s.app.UserByAuthToken(ctx, app.AuthToken(in.Token))
+	info, err := s.userByAuthToken(ctx, app.AuthToken(in.Token))
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	// The cached user has no email, so the user is read by the id.
+	user, err := s.app.User(ctx, *info, info.PublicID)
 	if err != nil {
 		return nil, apiError(err)
 	}
 
-	return apiUser(&info.User), nil
+	return apiUser(user), nil
+}
+
+func (s *service) StreamNotifications(in *pb.AuthInfo, stream pb.Users_StreamNotificationsServer) error {
+	ctx := stream.Context()
+
+	info, err := s.userByAuthToken(ctx, app.AuthToken(in.Token))
+	if err != nil {
+		return apiError(err)
+	}
+
+	err = s.app.WatchUserNotifications(ctx, *info, 0, func(notification app.UserNotification) error {
+		n, err := apiUserNotification(&notification)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(n)
+	})
+
+	return apiError(err)
+}
+
+func (s *service) IsOrgMember(ctx context.Context, in *pb.OrgMemberRequest) (*pb.OrgMembership, error) {
+	member, err := s.app.OrgMember(ctx, app.OrgID(in.OrgId), app.PublicID(in.UserId))
+	switch {
+	case errors.Is(err, app.ErrNotFound):
+		return &pb.OrgMembership{}, nil
+	case err != nil:
+		return nil, apiError(err)
+	}
+
+	return &pb.OrgMembership{
+		Member: true,
+		Role:   string(member.Role),
+	}, nil
+}
+
+// userByAuthToken returns the user of the token, tokens of users of other tenants aren't found.
+func (s *service) userByAuthToken(ctx context.Context, token app.AuthToken) (*app.AuthUser, error) {
+	info, err := s.app.UserByAuthToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	name := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(tenantMetadata)) > 0 {
+		name = md.Get(tenantMetadata)[0]
+	}
+
+	tenant, err := s.app.Tenant(ctx, name, "")
+	if err != nil {
+		return nil, err
+	}
+	if info.TenantID != tenant.ID {
+		return nil, app.ErrNotFound
+	}
+
+	return info, nil
 }
 
 func apiUser(user *app.User) *pb.User {
 	return &pb.User{
-		Id:       int32(user.ID),
+		Id:       string(user.PublicID),
 		Username: user.Name,
 		Email:    user.Email,
+		Version:  int32(user.Version),
 	}
 }
 
+func apiUserNotification(notification *app.UserNotification) (*pb.UserNotification, error) {
+	createdAt, err := ptypes.TimestampProto(notification.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert created at: %w", err)
+	}
+
+	return &pb.UserNotification{
+		Id:        int32(notification.ID),
+		Kind:      notification.Kind.String(),
+		Content:   notification.Content,
+		Read:      notification.Read,
+		CreatedAt: createdAt,
+	}, nil
+}
+
 func apiError(err error) error {
 	if err == nil {
 		return nil
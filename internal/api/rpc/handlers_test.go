@@ -3,12 +3,14 @@ package rpc_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/zergslaw/boilerplate/internal/api/rpc/pb"
 	"github.com/zergslaw/boilerplate/internal/app"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -23,36 +25,44 @@ func TestService_GetUserByAuthToken(t *testing.T) {
 	errCanceled := status.Error(codes.Canceled, context.Canceled.Error())
 	errInternal := status.Error(codes.Internal, errAny.Error())
 
+	// The cached user has no email.
+	cached := appUser
+	cached.Email = ""
+
 	testCases := []struct {
 		name    string
 		auth    *app.AuthUser
-		want    *pb.User
 		appErr  error
+		userErr error
 		wantErr error
 	}{
-		{"success", &appUser, &rpcUser, nil, nil},
-		{"not found", nil, nil, app.ErrNotFound, errNotFound},
-		{"deadline", nil, nil, context.DeadlineExceeded, errDeadline},
-		{"canceled", nil, nil, context.Canceled, errCanceled},
-		{"internal", nil, nil, errAny, errInternal},
+		{"success", &cached, nil, nil, nil},
+		{"not found", nil, app.ErrNotFound, nil, errNotFound},
+		{"deadline", nil, context.DeadlineExceeded, nil, errDeadline},
+		{"canceled", nil, context.Canceled, nil, errCanceled},
+		{"internal", nil, errAny, nil, errInternal},
+		{"err user", &cached, nil, errAny, errInternal},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			mockApp.EXPECT().UserByAuthToken(gomock.Any(), app.AuthToken(token)).Return(tc.auth, tc.appErr)
+			if tc.auth != nil {
+				mockApp.EXPECT().User(gomock.Any(), *tc.auth, tc.auth.PublicID).Return(&appUser.User, tc.userErr)
+			}
 
 			res, err := c.GetUserByAuthToken(ctx, &pb.AuthInfo{Token: token})
 			if tc.wantErr == nil {
 				assert.Nil(t, err)
 				assert.Equal(t, app.User{
-					ID:    app.UserID(res.Id),
-					Email: res.Email,
-					Name:  res.Username,
+					PublicID: app.PublicID(res.Id),
+					Email:    res.Email,
+					Name:     res.Username,
 				}, app.User{
-					ID:    tc.auth.ID,
-					Email: tc.auth.Email,
-					Name:  tc.auth.Name,
+					PublicID: appUser.PublicID,
+					Email:    appUser.Email,
+					Name:     appUser.Name,
 				})
 			} else {
 				assert.Nil(t, res)
@@ -61,3 +71,119 @@ func TestService_GetUserByAuthToken(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetUserByAuthTokenTenant(t *testing.T) {
+	t.Parallel()
+
+	c, mockApp, shutdown := testNew(t)
+	defer shutdown()
+
+	other := app.Tenant{ID: tenant.ID + 1, Name: "other"}
+	mockApp.EXPECT().Tenant(gomock.Any(), other.Name, "").Return(&other, nil)
+	mockApp.EXPECT().Tenant(gomock.Any(), "unknown", "").Return(nil, app.ErrNotFound)
+	mockApp.EXPECT().UserByAuthToken(gomock.Any(), app.AuthToken(token)).Return(&appUser, nil).Times(2)
+
+	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+
+	res, err := c.GetUserByAuthToken(metadata.AppendToOutgoingContext(ctx, "x-tenant", other.Name), &pb.AuthInfo{Token: token})
+	assert.Nil(t, res)
+	assert.Equal(t, errNotFound, err, "the token of other tenant")
+
+	res, err = c.GetUserByAuthToken(metadata.AppendToOutgoingContext(ctx, "x-tenant", "unknown"), &pb.AuthInfo{Token: token})
+	assert.Nil(t, res)
+	assert.Equal(t, errNotFound, err, "the unknown tenant")
+}
+
+func TestService_StreamNotifications(t *testing.T) {
+	t.Parallel()
+
+	c, mockApp, shutdown := testNew(t)
+	defer shutdown()
+
+	notification := app.UserNotification{
+		ID:        1,
+		Kind:      app.Welcome,
+		Content:   "Welcome",
+		CreatedAt: time.Unix(1600000000, 0),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockApp.EXPECT().UserByAuthToken(gomock.Any(), app.AuthToken(token)).Return(&appUser, nil)
+		mockApp.EXPECT().WatchUserNotifications(gomock.Any(), appUser, 0, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ app.AuthUser, _ int, fn func(app.UserNotification) error) error {
+				err := fn(notification)
+				if err != nil {
+					return err
+				}
+
+				<-ctx.Done()
+				return ctx.Err()
+			})
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := c.StreamNotifications(streamCtx, &pb.AuthInfo{Token: token})
+		assert.Nil(t, err)
+
+		res, err := stream.Recv()
+		assert.Nil(t, err)
+		assert.Equal(t, int32(notification.ID), res.Id)
+		assert.Equal(t, notification.Kind.String(), res.Kind)
+		assert.Equal(t, notification.Content, res.Content)
+		assert.False(t, res.Read)
+		assert.Equal(t, notification.CreatedAt.Unix(), res.CreatedAt.Seconds)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockApp.EXPECT().UserByAuthToken(gomock.Any(), app.AuthToken(token)).Return(nil, app.ErrNotFound)
+
+		stream, err := c.StreamNotifications(ctx, &pb.AuthInfo{Token: token})
+		assert.Nil(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, status.Error(codes.NotFound, app.ErrNotFound.Error()), err)
+	})
+}
+
+func TestService_IsOrgMember(t *testing.T) {
+	t.Parallel()
+
+	c, mockApp, shutdown := testNew(t)
+	defer shutdown()
+
+	const orgID app.OrgID = 3
+	member := &app.OrgMember{OrgID: orgID, UserID: appUser.ID, Role: app.OrgRoleAdmin}
+
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	testCases := []struct {
+		name       string
+		appRes     *app.OrgMember
+		appErr     error
+		wantMember bool
+		wantRole   string
+		wantErr    error
+	}{
+		{"member", member, nil, true, "admin", nil},
+		{"not member", nil, app.ErrNotFound, false, "", nil},
+		{"internal", nil, errAny, false, "", errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().OrgMember(gomock.Any(), orgID, appUser.PublicID).Return(tc.appRes, tc.appErr)
+
+			res, err := c.IsOrgMember(ctx, &pb.OrgMemberRequest{OrgId: int32(orgID), UserId: string(appUser.PublicID)})
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.wantMember, res.Member)
+				assert.Equal(t, tc.wantRole, res.Role)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -30,15 +30,23 @@ var (
 	ctx     = context.Background()
 	token   = "token"
 	rpcUser = pb.User{
-		Id:       1,
+		Id:       "9a3c1b4e-5f6d-4e7a-8b9c-0d1e2f3a4b5c",
 		Username: "username",
 		Email:    "email@email.com",
+		Version:  2,
+	}
+	tenant = app.Tenant{
+		ID:   app.DefaultTenant,
+		Name: "default",
 	}
 	appUser = app.AuthUser{
 		User: app.User{
-			ID:    app.UserID(rpcUser.Id),
-			Email: rpcUser.Email,
-			Name:  rpcUser.Username,
+			ID:       1,
+			PublicID: app.PublicID(rpcUser.Id),
+			TenantID: tenant.ID,
+			Email:    rpcUser.Email,
+			Name:     rpcUser.Username,
+			Version:  int(rpcUser.Version),
 		},
 	}
 )
@@ -51,6 +59,7 @@ func testNew(t *testing.T) (pb.UsersClient, *mock.MockApp, func()) {
 
 	ctrl := gomock.NewController(t)
 	mockApp := mock.NewMockApp(ctrl)
+	mockApp.EXPECT().Tenant(gomock.Any(), "", "").Return(&tenant, nil).AnyTimes()
 	server := rpc.New(mockApp, logger)
 
 	ln, err := net.Listen("tcp", "127.0.0.1:0")
@@ -4,6 +4,8 @@ import (
 	"context"
 	"path"
 
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/zergslaw/boilerplate/internal/consistency"
 	"github.com/zergslaw/boilerplate/internal/log"
 	"github.com/zergslaw/boilerplate/internal/metrics"
 	"go.uber.org/zap"
@@ -68,3 +70,62 @@ func logHandler(logger *zap.Logger, err error) {
 		logger.Error("failed to handle", zap.String(log.GRPCCode, code.String()), zap.String("msg", msg))
 	}
 }
+
+// UnaryServerTrackWrites returns a new unary server interceptor that makes reads following
+// a write of the request go to the primary database.
+func UnaryServerTrackWrites(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{}, err error) {
+	return handler(consistency.NewContext(ctx), req)
+}
+
+// MakeStreamServerLogger returns a new stream server interceptor that contains request logger.
+func MakeStreamServerLogger(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		remoteAddr := ""
+		if p, ok := peer.FromContext(stream.Context()); ok {
+			remoteAddr = p.Addr.String()
+		}
+
+		logger := logger.With(
+			zap.String(log.Remote, remoteAddr),
+			zap.String(log.Func, path.Base(info.FullMethod)),
+		)
+
+		wrapped := middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = log.SetContext(stream.Context(), logger)
+		return handler(srv, wrapped)
+	}
+}
+
+// StreamServerRecover returns a new stream server interceptor that recover and logs panic.
+func StreamServerRecover(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			metrics.PanicsTotal.Inc()
+			logger := log.FromContext(stream.Context())
+
+			err = status.Errorf(codes.Internal, "%v", p)
+			logger.Error("gRPC server panic",
+				zap.String(log.GRPCCode, codes.Internal.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return handler(srv, stream)
+}
+
+// StreamServerTrackWrites returns a new stream server interceptor that makes reads following
+// a write of the stream go to the primary database.
+func StreamServerTrackWrites(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	wrapped := middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = consistency.NewContext(stream.Context())
+	return handler(srv, wrapped)
+}
+
+// StreamServerAccessLog returns a new stream server interceptor that logs request status.
+func StreamServerAccessLog(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, stream)
+	logger := log.FromContext(stream.Context())
+	logHandler(logger, err)
+	return err
+}
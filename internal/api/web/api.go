@@ -3,12 +3,14 @@ package web
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"net"
 	"net/http"
 	"path"
 
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sebest/xff"
 	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi"
@@ -20,13 +22,26 @@ import (
 
 type (
 	service struct {
-		userApp app.UserApp
+		userApp     app.UserApp
+		settingsApp app.NotificationSettingsApp
+		adminApp    app.NotificationAdminApp
+		inboxApp    app.InboxApp
+		deliverApp  app.DeliverabilityApp
+		phoneApp    app.PhoneApp
+		tenantApp   app.TenantApp
+		orgApp      app.OrgApp
+		adminKey    string
+		// emailEventKey verifies events of the email provider, they are rejected if it is nil.
+		emailEventKey *ecdsa.PublicKey
 	}
 
 	config struct {
 		host     string
 		port     int
 		basePath string
+		adminKey string
+
+		emailEventKey *ecdsa.PublicKey
 	}
 	// Option for run server.
 	Option func(*config)
@@ -56,6 +71,22 @@ func SetHost(host string) Option {
 	}
 }
 
+// SetAdminKey sets the key for admin endpoints.
+// Default: empty, admin endpoints are disabled.
+func SetAdminKey(adminKey string) Option {
+	return func(c *config) {
+		c.adminKey = adminKey
+	}
+}
+
+// SetEmailEventKey sets the public key of the email provider for verifying signed events.
+// Default: nil, events are rejected.
+func SetEmailEventKey(key *ecdsa.PublicKey) Option {
+	return func(c *config) {
+		c.emailEventKey = key
+	}
+}
+
 func defaultConfig() *config {
 	return &config{
 		host:     "localhost",
@@ -66,13 +97,26 @@ func defaultConfig() *config {
 
 // New returns Swagger server configured to listen on the TCP network.
 func New(application app.App, logger *zap.Logger, options ...Option) (*restapi.Server, error) {
-	svc := &service{userApp: application}
 	cfg := defaultConfig()
 
 	for i := range options {
 		options[i](cfg)
 	}
 
+	svc := &service{
+		userApp:     application,
+		settingsApp: application,
+		adminApp:    application,
+		inboxApp:    application,
+		deliverApp:  application,
+		phoneApp:    application,
+		tenantApp:   application,
+		orgApp:      application,
+		adminKey:    cfg.adminKey,
+
+		emailEventKey: cfg.emailEventKey,
+	}
+
 	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
 	if err != nil {
 		return nil, fmt.Errorf("load embedded swagger spec: %w", err)
@@ -83,7 +127,11 @@ func New(application app.App, logger *zap.Logger, options ...Option) (*restapi.S
 	swaggerSpec.Spec().BasePath = cfg.basePath
 	api := operations.NewServiceBoilerplateAPI(swaggerSpec)
 	api.Logger = logger.Named("swagger").Sugar().Infof
+	api.TextEventStreamProducer = runtime.TextProducer()
+	api.APIKeyAuthenticator = svc.apiKeyAuthenticator
 	api.CookieKeyAuth = svc.cookieKeyAuth
+	api.AdminKeyAuth = svc.adminKeyAuth
+	api.APIAuthorizer = runtime.AuthorizerFunc(authorizeTenant)
 
 	api.VerificationEmailHandler = operations.VerificationEmailHandlerFunc(svc.verificationEmail)
 	api.VerificationUsernameHandler = operations.VerificationUsernameHandlerFunc(svc.verificationUsername)
@@ -98,6 +146,28 @@ func New(application app.App, logger *zap.Logger, options ...Option) (*restapi.S
 	api.GetUsersHandler = operations.GetUsersHandlerFunc(svc.getUsers)
 	api.CreateRecoveryCodeHandler = operations.CreateRecoveryCodeHandlerFunc(svc.createRecoveryCode)
 	api.RecoveryPasswordHandler = operations.RecoveryPasswordHandlerFunc(svc.recoveryPassword)
+	api.GetNotificationSettingsHandler = operations.GetNotificationSettingsHandlerFunc(svc.getNotificationSettings)
+	api.UpdateNotificationSettingHandler = operations.UpdateNotificationSettingHandlerFunc(svc.updateNotificationSetting)
+	api.UnsubscribeHandler = operations.UnsubscribeHandlerFunc(svc.unsubscribe)
+	api.UnsubscribeOneClickHandler = operations.UnsubscribeOneClickHandlerFunc(svc.unsubscribeOneClick)
+	api.ListNotificationTasksHandler = operations.ListNotificationTasksHandlerFunc(svc.listNotificationTasks)
+	api.ResendNotificationTaskHandler = operations.ResendNotificationTaskHandlerFunc(svc.resendNotificationTask)
+	api.CancelNotificationTaskHandler = operations.CancelNotificationTaskHandlerFunc(svc.cancelNotificationTask)
+	api.ListUserNotificationsHandler = operations.ListUserNotificationsHandlerFunc(svc.listUserNotifications)
+	api.MarkUserNotificationsReadHandler = operations.MarkUserNotificationsReadHandlerFunc(svc.markUserNotificationsRead)
+	api.GetUnreadNotificationCountHandler = operations.GetUnreadNotificationCountHandlerFunc(svc.getUnreadNotificationCount)
+	api.StreamUserNotificationsHandler = operations.StreamUserNotificationsHandlerFunc(svc.streamUserNotifications)
+	api.HandleEmailEventsHandler = operations.HandleEmailEventsHandlerFunc(svc.handleEmailEvents)
+	api.RequestPhoneVerificationHandler = operations.RequestPhoneVerificationHandlerFunc(svc.requestPhoneVerification)
+	api.VerifyPhoneHandler = operations.VerifyPhoneHandlerFunc(svc.verifyPhone)
+	api.DeletePhoneHandler = operations.DeletePhoneHandlerFunc(svc.deletePhone)
+	api.ListOrgsHandler = operations.ListOrgsHandlerFunc(svc.listOrgs)
+	api.CreateOrgHandler = operations.CreateOrgHandlerFunc(svc.createOrg)
+	api.AcceptOrgInvitationHandler = operations.AcceptOrgInvitationHandlerFunc(svc.acceptOrgInvitation)
+	api.ListOrgMembersHandler = operations.ListOrgMembersHandlerFunc(svc.listOrgMembers)
+	api.RemoveOrgMemberHandler = operations.RemoveOrgMemberHandlerFunc(svc.removeOrgMember)
+	api.InviteOrgMemberHandler = operations.InviteOrgMemberHandlerFunc(svc.inviteOrgMember)
+	api.TransferOrgOwnershipHandler = operations.TransferOrgOwnershipHandlerFunc(svc.transferOrgOwnership)
 
 	server := restapi.NewServer(api)
 	server.Host = cfg.host
@@ -108,15 +178,17 @@ func New(application app.App, logger *zap.Logger, options ...Option) (*restapi.S
 		xffmw, _ := xff.Default()
 		createLog := createLogger(cfg.basePath, logger)
 		accesslog := accessLog(cfg.basePath)
+		rawBody := keepRawBody(path.Join(cfg.basePath, "/email/events"))
+		tenant := resolveTenant(svc.tenantApp)
 		redocOpts := middleware.RedocOpts{
 			BasePath: cfg.basePath,
 			SpecURL:  path.Join(cfg.basePath, "/swagger.json"),
 		}
 
-		return xffmw.Handler(createLog(recovery(accesslog(
+		return xffmw.Handler(createLog(recovery(accesslog(trackWrites(rawBody(tenant(
 			middleware.Spec(cfg.basePath, restapi.FlatSwaggerJSON,
 				middleware.Redoc(redocOpts,
-					handler))))))
+					handler)))))))))
 	}
 
 	server.SetHandler(globalMiddlewares(api.Serve(nil)))
@@ -2,22 +2,48 @@ package web
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	unautnError "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/security"
 	"github.com/zergslaw/boilerplate/internal/app"
 )
 
 const (
+	cookieHeader    = "Cookie"
 	cookieTokenName = "authKey"
 	authTimeout     = 250 * time.Millisecond
 )
 
+// apiKeyAuthenticator authenticates cookies in the context of the request,
+// so the user of the token is read in the consistency scope of the request and may go to a replica.
+func (svc *service) apiKeyAuthenticator(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
+	if name != cookieHeader {
+		return security.APIKeyAuth(name, in, authenticate)
+	}
+
+	return security.APIKeyAuthCtx(name, in, func(ctx context.Context, raw string) (context.Context, interface{}, error) {
+		profile, err := svc.authUser(ctx, raw)
+		if err != nil {
+			return ctx, nil, err
+		}
+
+		return ctx, profile, nil
+	})
+}
+
+// cookieKeyAuth is required by the generated API, cookies are authenticated by apiKeyAuthenticator.
 func (svc *service) cookieKeyAuth(raw string) (*app.AuthUser, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), authTimeout)
+	return svc.authUser(context.Background(), raw)
+}
+
+func (svc *service) authUser(ctx context.Context, raw string) (*app.AuthUser, error) {
+	ctx, cancel := context.WithTimeout(ctx, authTimeout)
 	defer cancel()
 	profile, err := svc.userApp.UserByAuthToken(ctx, parseToken(raw))
 	switch {
@@ -30,6 +56,15 @@ func (svc *service) cookieKeyAuth(raw string) (*app.AuthUser, error) {
 	}
 }
 
+// adminKeyAuth checks the admin key, admin requests are not bound to any user.
+func (svc *service) adminKeyAuth(raw string) (*app.AuthUser, error) {
+	if svc.adminKey == "" || subtle.ConstantTimeCompare([]byte(raw), []byte(svc.adminKey)) != 1 {
+		return nil, unautnError.Unauthenticated("service")
+	}
+
+	return &app.AuthUser{}, nil
+}
+
 func parseToken(raw string) app.AuthToken {
 	header := http.Header{}
 	header.Add("Cookie", raw)
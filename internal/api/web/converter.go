@@ -1,6 +1,11 @@
 package web
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
 	"github.com/zergslaw/boilerplate/internal/app"
 )
@@ -19,8 +24,150 @@ func Users(u []app.User) []*models.User {
 // User conversion app.User => models.User.
 func User(u *app.User) *models.User {
 	return &models.User{
-		ID:       models.UserID(u.ID),
+		ID:       models.UserID(u.PublicID),
 		Username: models.Username(u.Name),
 		Email:    models.Email(u.Email),
+
+		EmailUndeliverable: u.EmailUndeliverable,
+		Phone:              u.Phone,
+	}
+}
+
+// ETag conversion app.User.Version => ETag header.
+func ETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Version conversion If-Match header => app.User.Version, the version is 0 if any version matches.
+// It returns false if the header can't match the user, e.g. the weak ETag.
+func Version(ifMatch *string) (int, bool) {
+	if ifMatch == nil || *ifMatch == "*" {
+		return 0, true
+	}
+
+	tag := strings.TrimSpace(*ifMatch)
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 1 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// RecoveryChannel conversion models.RecoveryChannel => app.Channel, email is used by default.
+func RecoveryChannel(channel models.RecoveryChannel) app.Channel {
+	if channel == models.RecoveryChannelSms {
+		return app.ChannelSMS
+	}
+
+	return app.ChannelEmail
+}
+
+// NotificationSettings conversion []app.NotificationSetting => []*models.NotificationSetting.
+func NotificationSettings(s []app.NotificationSetting) []*models.NotificationSetting {
+	settings := make([]*models.NotificationSetting, len(s))
+
+	for i := range settings {
+		settings[i] = &models.NotificationSetting{
+			Kind:      models.MessageKind(s[i].Kind.String()),
+			Enabled:   swag.Bool(s[i].Enabled),
+			Mandatory: swag.Bool(s[i].Kind.IsMandatory()),
+		}
+	}
+
+	return settings
+}
+
+// NotificationTasks conversion []app.TaskNotificationInfo => []*models.NotificationTask.
+func NotificationTasks(t []app.TaskNotificationInfo) []*models.NotificationTask {
+	tasks := make([]*models.NotificationTask, len(t))
+
+	for i := range tasks {
+		tasks[i] = NotificationTask(&t[i])
+	}
+
+	return tasks
+}
+
+// NotificationTask conversion app.TaskNotificationInfo => models.NotificationTask.
+func NotificationTask(t *app.TaskNotificationInfo) *models.NotificationTask {
+	task := &models.NotificationTask{
+		ID:        swag.Int32(int32(t.ID)),
+		Email:     models.Email(t.Email),
+		Kind:      models.MessageKind(t.Kind.String()),
+		Status:    models.TaskStatus(t.Status),
+		Error:     t.Error,
+		CreatedAt: (*strfmt.DateTime)(swag.Time(t.CreatedAt)),
+		RunAt:     (*strfmt.DateTime)(swag.Time(t.RunAt)),
+		Provider:  t.Provider,
+	}
+	if !t.ExecTime.IsZero() {
+		task.ExecTime = (*strfmt.DateTime)(swag.Time(t.ExecTime))
+	}
+
+	return task
+}
+
+// UserNotifications conversion []app.UserNotification => []*models.UserNotification.
+func UserNotifications(n []app.UserNotification) []*models.UserNotification {
+	notifications := make([]*models.UserNotification, len(n))
+
+	for i := range notifications {
+		notifications[i] = UserNotification(&n[i])
+	}
+
+	return notifications
+}
+
+// UserNotification conversion app.UserNotification => models.UserNotification.
+func UserNotification(n *app.UserNotification) *models.UserNotification {
+	return &models.UserNotification{
+		ID:        swag.Int32(int32(n.ID)),
+		Kind:      models.MessageKind(n.Kind.String()),
+		Content:   swag.String(n.Content),
+		Read:      swag.Bool(n.Read),
+		CreatedAt: (*strfmt.DateTime)(swag.Time(n.CreatedAt)),
 	}
 }
+
+// Orgs conversion []app.OrgMembership => []*models.Org.
+func Orgs(o []app.OrgMembership) []*models.Org {
+	orgs := make([]*models.Org, len(o))
+
+	for i := range orgs {
+		orgs[i] = Org(&o[i])
+	}
+
+	return orgs
+}
+
+// Org conversion app.OrgMembership => models.Org.
+func Org(o *app.OrgMembership) *models.Org {
+	return &models.Org{
+		ID:        models.OrgID(o.Org.ID),
+		Name:      models.OrgName(o.Org.Name),
+		Role:      models.OrgRole(o.Role),
+		CreatedAt: (*strfmt.DateTime)(swag.Time(o.Org.CreatedAt)),
+	}
+}
+
+// OrgMembers conversion []app.OrgMember => []*models.OrgMember.
+func OrgMembers(m []app.OrgMember) []*models.OrgMember {
+	members := make([]*models.OrgMember, len(m))
+
+	for i := range members {
+		members[i] = &models.OrgMember{
+			UserID:    models.UserID(m[i].UserPublicID),
+			Username:  models.Username(m[i].Username),
+			Email:     models.Email(m[i].Email),
+			Role:      models.OrgRole(m[i].Role),
+			CreatedAt: (*strfmt.DateTime)(swag.Time(m[i].CreatedAt)),
+		}
+	}
+
+	return members
+}
@@ -0,0 +1,180 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi/operations"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Event types of SendGrid Event Webhook.
+const (
+	providerEventBounce     = "bounce"
+	providerEventSpamReport = "spamreport"
+	providerBounceBlocked   = "blocked"
+)
+
+const (
+	// maxEmailEventsSize limits the body of the email provider request, SendGrid batches are far below it.
+	maxEmailEventsSize = 1 << 20
+	// emailEventsTolerance is the max difference between the signed timestamp and the local clock,
+	// captured requests can't be replayed after it.
+	emailEventsTolerance = 5 * time.Minute
+)
+
+var (
+	errInvalidSignature = errors.New("invalid signature")
+	errInvalidTimestamp = errors.New("invalid timestamp")
+)
+
+type rawBodyKey struct{}
+
+// ParseEmailEventKey parses the base64 encoded ECDSA public key used by the email provider to sign events.
+func ParseEmailEventKey(s string) (*ecdsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode key: %w", err)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse key: %w", err)
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: not ECDSA key", errInvalidSignature)
+	}
+
+	return ecdsaKey, nil
+}
+
+func (svc *service) handleEmailEvents(params operations.HandleEmailEventsParams) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
+
+	body, _ := ctx.Value(rawBodyKey{}).([]byte)
+	err := verifyEmailEvents(svc.emailEventKey, params.XTwilioEmailEventWebhookSignature, params.XTwilioEmailEventWebhookTimestamp, body, time.Now())
+	if err != nil {
+		return errHandleEmailEvents(log, err, http.StatusUnauthorized)
+	}
+
+	err = svc.deliverApp.HandleEmailEvents(ctx, EmailEvents(params.Events))
+	switch {
+	case err == nil:
+		return operations.NewHandleEmailEventsNoContent()
+	default:
+		return errHandleEmailEvents(log, err, http.StatusInternalServerError)
+	}
+}
+
+// EmailEvents conversion []*models.EmailProviderEvent => []app.EmailEvent,
+// only bounces and spam reports are kept.
+func EmailEvents(events []*models.EmailProviderEvent) []app.EmailEvent {
+	res := make([]app.EmailEvent, 0, len(events))
+
+	for _, event := range events {
+		var kind app.EmailEventKind
+		switch swag.StringValue(event.Event) {
+		case providerEventBounce:
+			if event.Type == providerBounceBlocked {
+				continue
+			}
+			kind = app.EmailBounce
+		case providerEventSpamReport:
+			kind = app.EmailComplaint
+		default:
+			continue
+		}
+
+		emailEvent := app.EmailEvent{
+			ExternalID: event.SgEventID,
+			Email:      swag.StringValue(event.Email),
+			Kind:       kind,
+			Reason:     event.Reason,
+		}
+		if event.Timestamp != 0 {
+			emailEvent.CreatedAt = time.Unix(event.Timestamp, 0).UTC()
+		}
+
+		res = append(res, emailEvent)
+	}
+
+	return res
+}
+
+// verifyEmailEvents checks the ECDSA signature of the timestamp followed by the body
+// and that the timestamp differs from now at most by emailEventsTolerance.
+func verifyEmailEvents(key *ecdsa.PublicKey, signature, timestamp string, body []byte, now time.Time) error {
+	if key == nil {
+		return errInvalidSignature
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errInvalidTimestamp
+	}
+	diff := now.Sub(time.Unix(unix, 0))
+	if diff > emailEventsTolerance || diff < -emailEventsTolerance {
+		return errInvalidTimestamp
+	}
+
+	der, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return errInvalidSignature
+	}
+
+	var sig struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return errInvalidSignature
+	}
+
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(timestamp))
+	_, _ = hash.Write(body)
+	if !ecdsa.Verify(key, hash.Sum(nil), sig.R, sig.S) {
+		return errInvalidSignature
+	}
+
+	return nil
+}
+
+// keepRawBody saves the body of requests to the path in the context,
+// the signature of the email provider must be verified over the exact bytes.
+func keepRawBody(path string) middlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != path {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxEmailEventsSize))
+			if err != nil {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			r = r.WithContext(context.WithValue(r.Context(), rawBodyKey{}, body))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package web_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/api/web"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestServiceHandleEmailEvents(t *testing.T) {
+	t.Parallel()
+
+	url, shutdown, mockApp, _ := testNewServer(t)
+	defer shutdown()
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	expired := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	body := []byte(`[
+		{"email":"Exist@email.com","event":"bounce","type":"bounce","reason":"550 User unknown","sg_event_id":"1","timestamp":1600000000},
+		{"email":"exist@email.com","event":"bounce","type":"blocked","sg_event_id":"2","timestamp":1600000000},
+		{"email":"exist@email.com","event":"spamreport","sg_event_id":"3"},
+		{"email":"exist@email.com","event":"delivered","sg_event_id":"4","timestamp":1600000000}
+	]`)
+	events := []app.EmailEvent{
+		{
+			ExternalID: "1",
+			Email:      "Exist@email.com",
+			Kind:       app.EmailBounce,
+			Reason:     "550 User unknown",
+			CreatedAt:  time.Unix(1600000000, 0).UTC(),
+		},
+		{
+			ExternalID: "3",
+			Email:      email,
+			Kind:       app.EmailComplaint,
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		timestamp string
+		signature string
+		appErr    error
+		want      *models.Error
+	}{
+		{"success", timestamp, signEmailEvents(t, timestamp, body), nil, nil},
+		{"invalid signature", timestamp, signEmailEvents(t, timestamp, []byte("[]")), nil, APIError("invalid signature")},
+		{"malformed signature", timestamp, "signature", nil, APIError("invalid signature")},
+		{"replayed request", expired, signEmailEvents(t, expired, body), nil, APIError("invalid timestamp")},
+		{"malformed timestamp", "timestamp", signEmailEvents(t, "timestamp", body), nil, APIError("invalid timestamp")},
+		{"internal error", timestamp, signEmailEvents(t, timestamp, body), errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.want == nil || tc.appErr != nil {
+				mockApp.EXPECT().HandleEmailEvents(gomock.Any(), events).Return(tc.appErr)
+			}
+
+			req, err := http.NewRequest(http.MethodPost,
+				fmt.Sprintf("http://%s%s/email/events", url, client.DefaultBasePath), bytes.NewReader(body))
+			assert.Nil(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Twilio-Email-Event-Webhook-Signature", tc.signature)
+			req.Header.Set("X-Twilio-Email-Event-Webhook-Timestamp", tc.timestamp)
+
+			res, err := http.DefaultClient.Do(req)
+			assert.Nil(t, err)
+			defer res.Body.Close()
+
+			if tc.want == nil {
+				assert.Equal(t, http.StatusNoContent, res.StatusCode)
+				return
+			}
+
+			apiErr := &models.Error{}
+			assert.Nil(t, json.NewDecoder(res.Body).Decode(apiErr))
+			assert.Equal(t, tc.want, apiErr)
+		})
+	}
+}
+
+func TestParseEmailEventKey(t *testing.T) {
+	t.Parallel()
+
+	der, err := x509.MarshalPKIXPublicKey(&emailEventKey.PublicKey)
+	assert.Nil(t, err)
+
+	key, err := web.ParseEmailEventKey(base64.StdEncoding.EncodeToString(der))
+	assert.Nil(t, err)
+	assert.Equal(t, &emailEventKey.PublicKey, key)
+
+	_, err = web.ParseEmailEventKey("not a key")
+	assert.NotNil(t, err)
+}
+
+func signEmailEvents(t *testing.T, timestamp string, body []byte) string {
+	t.Helper()
+
+	hash := sha256.Sum256(append([]byte(timestamp), body...))
+	r, s, err := ecdsa.Sign(rand.Reader, emailEventKey, hash[:])
+	assert.Nil(t, err)
+
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	assert.Nil(t, err)
+
+	return base64.StdEncoding.EncodeToString(der)
+}
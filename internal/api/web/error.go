@@ -11,7 +11,7 @@ import (
 	"go.uber.org/zap"
 )
 
-//go:generate genny -in=$GOFILE -out=gen-$GOFILE gen "CreateUser=Login,Logout,VerificationEmail,VerificationUsername,GetUser,DeleteUser,UpdatePassword,UpdateUsername,UpdateEmail,GetUsers,CreateRecoveryCode,RecoveryPassword"
+//go:generate genny -in=$GOFILE -out=gen-$GOFILE gen "CreateUser=Login,Logout,VerificationEmail,VerificationUsername,GetUser,DeleteUser,UpdatePassword,UpdateUsername,UpdateEmail,GetUsers,CreateRecoveryCode,RecoveryPassword,GetNotificationSettings,UpdateNotificationSetting,Unsubscribe,UnsubscribeOneClick,ListNotificationTasks,ResendNotificationTask,CancelNotificationTask,ListUserNotifications,MarkUserNotificationsRead,GetUnreadNotificationCount,HandleEmailEvents,RequestPhoneVerification,VerifyPhone,DeletePhone,ListOrgs,CreateOrg,AcceptOrgInvitation,ListOrgMembers,RemoveOrgMember,InviteOrgMember,TransferOrgOwnership"
 
 func errCreateUser(logger *zap.Logger, err error, code int) middleware.Responder {
 	if code < http.StatusInternalServerError {
@@ -194,3 +194,318 @@ func errRecoveryPassword(logger *zap.Logger, err error, code int) middleware.Res
 
 	return operations.NewRecoveryPasswordDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
 }
+
+func errGetNotificationSettings(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewGetNotificationSettingsDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errUpdateNotificationSetting(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewUpdateNotificationSettingDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errUnsubscribe(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewUnsubscribeDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errUnsubscribeOneClick(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewUnsubscribeOneClickDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errListNotificationTasks(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewListNotificationTasksDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errResendNotificationTask(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewResendNotificationTaskDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errCancelNotificationTask(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewCancelNotificationTaskDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errListUserNotifications(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewListUserNotificationsDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errMarkUserNotificationsRead(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewMarkUserNotificationsReadDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errGetUnreadNotificationCount(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewGetUnreadNotificationCountDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errHandleEmailEvents(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewHandleEmailEventsDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errRequestPhoneVerification(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewRequestPhoneVerificationDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errVerifyPhone(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewVerifyPhoneDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errDeletePhone(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewDeletePhoneDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errListOrgs(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewListOrgsDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errCreateOrg(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewCreateOrgDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errAcceptOrgInvitation(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewAcceptOrgInvitationDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errListOrgMembers(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewListOrgMembersDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errRemoveOrgMember(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewRemoveOrgMemberDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errInviteOrgMember(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewInviteOrgMemberDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
+
+func errTransferOrgOwnership(logger *zap.Logger, err error, code int) middleware.Responder {
+	if code < http.StatusInternalServerError {
+		logger.With(zap.String(log.Error, "client"), zap.Int(log.HTTPStatus, code)).Info(err.Error())
+	} else {
+		logger.With(zap.String(log.Error, "server"), zap.Int(log.HTTPStatus, code)).Warn(err.Error())
+	}
+
+	msg := err.Error()
+	if code == http.StatusInternalServerError { // Do no expose details about internal errors.
+		msg = http.StatusText(http.StatusInternalServerError)
+	}
+
+	return operations.NewTransferOrgOwnershipDefault(code).WithPayload(&models.Error{Message: swag.String(msg)})
+}
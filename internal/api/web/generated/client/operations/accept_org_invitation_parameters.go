@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAcceptOrgInvitationParams creates a new AcceptOrgInvitationParams object
+// with the default values initialized.
+func NewAcceptOrgInvitationParams() *AcceptOrgInvitationParams {
+	var ()
+	return &AcceptOrgInvitationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAcceptOrgInvitationParamsWithTimeout creates a new AcceptOrgInvitationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewAcceptOrgInvitationParamsWithTimeout(timeout time.Duration) *AcceptOrgInvitationParams {
+	var ()
+	return &AcceptOrgInvitationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewAcceptOrgInvitationParamsWithContext creates a new AcceptOrgInvitationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewAcceptOrgInvitationParamsWithContext(ctx context.Context) *AcceptOrgInvitationParams {
+	var ()
+	return &AcceptOrgInvitationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewAcceptOrgInvitationParamsWithHTTPClient creates a new AcceptOrgInvitationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewAcceptOrgInvitationParamsWithHTTPClient(client *http.Client) *AcceptOrgInvitationParams {
+	var ()
+	return &AcceptOrgInvitationParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AcceptOrgInvitationParams contains all the parameters to send to the API endpoint
+for the accept org invitation operation typically these are written to a http.Request
+*/
+type AcceptOrgInvitationParams struct {
+
+	/*Args*/
+	Args AcceptOrgInvitationBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the accept org invitation params
+func (o *AcceptOrgInvitationParams) WithTimeout(timeout time.Duration) *AcceptOrgInvitationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the accept org invitation params
+func (o *AcceptOrgInvitationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the accept org invitation params
+func (o *AcceptOrgInvitationParams) WithContext(ctx context.Context) *AcceptOrgInvitationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the accept org invitation params
+func (o *AcceptOrgInvitationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the accept org invitation params
+func (o *AcceptOrgInvitationParams) WithHTTPClient(client *http.Client) *AcceptOrgInvitationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the accept org invitation params
+func (o *AcceptOrgInvitationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the accept org invitation params
+func (o *AcceptOrgInvitationParams) WithArgs(args AcceptOrgInvitationBody) *AcceptOrgInvitationParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the accept org invitation params
+func (o *AcceptOrgInvitationParams) SetArgs(args AcceptOrgInvitationBody) {
+	o.Args = args
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AcceptOrgInvitationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// AcceptOrgInvitationReader is a Reader for the AcceptOrgInvitation structure.
+type AcceptOrgInvitationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AcceptOrgInvitationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAcceptOrgInvitationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewAcceptOrgInvitationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAcceptOrgInvitationOK creates a AcceptOrgInvitationOK with default headers values
+func NewAcceptOrgInvitationOK() *AcceptOrgInvitationOK {
+	return &AcceptOrgInvitationOK{}
+}
+
+/*
+AcceptOrgInvitationOK handles this case with default header values.
+
+OK
+*/
+type AcceptOrgInvitationOK struct {
+	Payload *models.Org
+}
+
+func (o *AcceptOrgInvitationOK) Error() string {
+	return fmt.Sprintf("[POST /orgs/invitations][%d] acceptOrgInvitationOK  %+v", 200, o.Payload)
+}
+
+func (o *AcceptOrgInvitationOK) GetPayload() *models.Org {
+	return o.Payload
+}
+
+func (o *AcceptOrgInvitationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Org)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAcceptOrgInvitationDefault creates a AcceptOrgInvitationDefault with default headers values
+func NewAcceptOrgInvitationDefault(code int) *AcceptOrgInvitationDefault {
+	return &AcceptOrgInvitationDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+AcceptOrgInvitationDefault handles this case with default header values.
+
+Generic error response.
+*/
+type AcceptOrgInvitationDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the accept org invitation default response
+func (o *AcceptOrgInvitationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AcceptOrgInvitationDefault) Error() string {
+	return fmt.Sprintf("[POST /orgs/invitations][%d] acceptOrgInvitation default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AcceptOrgInvitationDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AcceptOrgInvitationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+AcceptOrgInvitationBody accept org invitation body
+swagger:model AcceptOrgInvitationBody
+*/
+type AcceptOrgInvitationBody struct {
+
+	// token
+	// Required: true
+	// Min Length: 1
+	Token *string `json:"token"`
+}
+
+// Validate validates this accept org invitation body
+func (o *AcceptOrgInvitationBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AcceptOrgInvitationBody) validateToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("args"+"."+"token", "body", o.Token); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("args"+"."+"token", "body", string(*o.Token), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AcceptOrgInvitationBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AcceptOrgInvitationBody) UnmarshalBinary(b []byte) error {
+	var res AcceptOrgInvitationBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewCancelNotificationTaskParams creates a new CancelNotificationTaskParams object
+// with the default values initialized.
+func NewCancelNotificationTaskParams() *CancelNotificationTaskParams {
+	var ()
+	return &CancelNotificationTaskParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCancelNotificationTaskParamsWithTimeout creates a new CancelNotificationTaskParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCancelNotificationTaskParamsWithTimeout(timeout time.Duration) *CancelNotificationTaskParams {
+	var ()
+	return &CancelNotificationTaskParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCancelNotificationTaskParamsWithContext creates a new CancelNotificationTaskParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCancelNotificationTaskParamsWithContext(ctx context.Context) *CancelNotificationTaskParams {
+	var ()
+	return &CancelNotificationTaskParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCancelNotificationTaskParamsWithHTTPClient creates a new CancelNotificationTaskParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCancelNotificationTaskParamsWithHTTPClient(client *http.Client) *CancelNotificationTaskParams {
+	var ()
+	return &CancelNotificationTaskParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CancelNotificationTaskParams contains all the parameters to send to the API endpoint
+for the cancel notification task operation typically these are written to a http.Request
+*/
+type CancelNotificationTaskParams struct {
+
+	/*ID*/
+	ID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the cancel notification task params
+func (o *CancelNotificationTaskParams) WithTimeout(timeout time.Duration) *CancelNotificationTaskParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cancel notification task params
+func (o *CancelNotificationTaskParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cancel notification task params
+func (o *CancelNotificationTaskParams) WithContext(ctx context.Context) *CancelNotificationTaskParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cancel notification task params
+func (o *CancelNotificationTaskParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cancel notification task params
+func (o *CancelNotificationTaskParams) WithHTTPClient(client *http.Client) *CancelNotificationTaskParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cancel notification task params
+func (o *CancelNotificationTaskParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the cancel notification task params
+func (o *CancelNotificationTaskParams) WithID(id int32) *CancelNotificationTaskParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the cancel notification task params
+func (o *CancelNotificationTaskParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CancelNotificationTaskParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
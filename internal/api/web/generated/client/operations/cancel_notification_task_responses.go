@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// CancelNotificationTaskReader is a Reader for the CancelNotificationTask structure.
+type CancelNotificationTaskReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CancelNotificationTaskReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewCancelNotificationTaskNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCancelNotificationTaskDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCancelNotificationTaskNoContent creates a CancelNotificationTaskNoContent with default headers values
+func NewCancelNotificationTaskNoContent() *CancelNotificationTaskNoContent {
+	return &CancelNotificationTaskNoContent{}
+}
+
+/*
+CancelNotificationTaskNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type CancelNotificationTaskNoContent struct {
+}
+
+func (o *CancelNotificationTaskNoContent) Error() string {
+	return fmt.Sprintf("[POST /admin/notifications/{id}/cancel][%d] cancelNotificationTaskNoContent ", 204)
+}
+
+func (o *CancelNotificationTaskNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewCancelNotificationTaskDefault creates a CancelNotificationTaskDefault with default headers values
+func NewCancelNotificationTaskDefault(code int) *CancelNotificationTaskDefault {
+	return &CancelNotificationTaskDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CancelNotificationTaskDefault handles this case with default header values.
+
+Generic error response.
+*/
+type CancelNotificationTaskDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the cancel notification task default response
+func (o *CancelNotificationTaskDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CancelNotificationTaskDefault) Error() string {
+	return fmt.Sprintf("[POST /admin/notifications/{id}/cancel][%d] cancelNotificationTask default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CancelNotificationTaskDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CancelNotificationTaskDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
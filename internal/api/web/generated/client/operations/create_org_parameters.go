@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCreateOrgParams creates a new CreateOrgParams object
+// with the default values initialized.
+func NewCreateOrgParams() *CreateOrgParams {
+	var ()
+	return &CreateOrgParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateOrgParamsWithTimeout creates a new CreateOrgParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCreateOrgParamsWithTimeout(timeout time.Duration) *CreateOrgParams {
+	var ()
+	return &CreateOrgParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCreateOrgParamsWithContext creates a new CreateOrgParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCreateOrgParamsWithContext(ctx context.Context) *CreateOrgParams {
+	var ()
+	return &CreateOrgParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCreateOrgParamsWithHTTPClient creates a new CreateOrgParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCreateOrgParamsWithHTTPClient(client *http.Client) *CreateOrgParams {
+	var ()
+	return &CreateOrgParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateOrgParams contains all the parameters to send to the API endpoint
+for the create org operation typically these are written to a http.Request
+*/
+type CreateOrgParams struct {
+
+	/*Args*/
+	Args CreateOrgBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the create org params
+func (o *CreateOrgParams) WithTimeout(timeout time.Duration) *CreateOrgParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create org params
+func (o *CreateOrgParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create org params
+func (o *CreateOrgParams) WithContext(ctx context.Context) *CreateOrgParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create org params
+func (o *CreateOrgParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create org params
+func (o *CreateOrgParams) WithHTTPClient(client *http.Client) *CreateOrgParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create org params
+func (o *CreateOrgParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the create org params
+func (o *CreateOrgParams) WithArgs(args CreateOrgBody) *CreateOrgParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the create org params
+func (o *CreateOrgParams) SetArgs(args CreateOrgBody) {
+	o.Args = args
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateOrgParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// CreateOrgReader is a Reader for the CreateOrg structure.
+type CreateOrgReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateOrgReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateOrgOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateOrgDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateOrgOK creates a CreateOrgOK with default headers values
+func NewCreateOrgOK() *CreateOrgOK {
+	return &CreateOrgOK{}
+}
+
+/*
+CreateOrgOK handles this case with default header values.
+
+OK
+*/
+type CreateOrgOK struct {
+	Payload *models.Org
+}
+
+func (o *CreateOrgOK) Error() string {
+	return fmt.Sprintf("[POST /orgs][%d] createOrgOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateOrgOK) GetPayload() *models.Org {
+	return o.Payload
+}
+
+func (o *CreateOrgOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Org)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateOrgDefault creates a CreateOrgDefault with default headers values
+func NewCreateOrgDefault(code int) *CreateOrgDefault {
+	return &CreateOrgDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CreateOrgDefault handles this case with default header values.
+
+Generic error response.
+*/
+type CreateOrgDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the create org default response
+func (o *CreateOrgDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateOrgDefault) Error() string {
+	return fmt.Sprintf("[POST /orgs][%d] createOrg default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateOrgDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateOrgDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+CreateOrgBody create org body
+swagger:model CreateOrgBody
+*/
+type CreateOrgBody struct {
+
+	// name
+	// Required: true
+	Name models.OrgName `json:"name"`
+}
+
+// Validate validates this create org body
+func (o *CreateOrgBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateOrgBody) validateName(formats strfmt.Registry) error {
+
+	if err := o.Name.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateOrgBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateOrgBody) UnmarshalBinary(b []byte) error {
+	var res CreateOrgBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
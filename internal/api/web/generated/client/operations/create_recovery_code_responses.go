@@ -48,7 +48,8 @@ func NewCreateRecoveryCodeNoContent() *CreateRecoveryCodeNoContent {
 	return &CreateRecoveryCodeNoContent{}
 }
 
-/*CreateRecoveryCodeNoContent handles this case with default header values.
+/*
+CreateRecoveryCodeNoContent handles this case with default header values.
 
 The server successfully processed the request and is not returning any content.
 */
@@ -71,7 +72,8 @@ func NewCreateRecoveryCodeDefault(code int) *CreateRecoveryCodeDefault {
 	}
 }
 
-/*CreateRecoveryCodeDefault handles this case with default header values.
+/*
+CreateRecoveryCodeDefault handles this case with default header values.
 
 Generic error response.
 */
@@ -106,11 +108,15 @@ func (o *CreateRecoveryCodeDefault) readResponse(response runtime.ClientResponse
 	return nil
 }
 
-/*CreateRecoveryCodeBody create recovery code body
+/*
+CreateRecoveryCodeBody create recovery code body
 swagger:model CreateRecoveryCodeBody
 */
 type CreateRecoveryCodeBody struct {
 
+	// channel
+	Channel models.RecoveryChannel `json:"channel,omitempty"`
+
 	// email
 	// Required: true
 	// Format: email
@@ -121,6 +127,10 @@ type CreateRecoveryCodeBody struct {
 func (o *CreateRecoveryCodeBody) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.validateChannel(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateEmail(formats); err != nil {
 		res = append(res, err)
 	}
@@ -131,6 +141,22 @@ func (o *CreateRecoveryCodeBody) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *CreateRecoveryCodeBody) validateChannel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Channel) { // not required
+		return nil
+	}
+
+	if err := o.Channel.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "channel")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (o *CreateRecoveryCodeBody) validateEmail(formats strfmt.Registry) error {
 
 	if err := o.Email.Validate(formats); err != nil {
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeletePhoneParams creates a new DeletePhoneParams object
+// with the default values initialized.
+func NewDeletePhoneParams() *DeletePhoneParams {
+
+	return &DeletePhoneParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeletePhoneParamsWithTimeout creates a new DeletePhoneParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeletePhoneParamsWithTimeout(timeout time.Duration) *DeletePhoneParams {
+
+	return &DeletePhoneParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeletePhoneParamsWithContext creates a new DeletePhoneParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeletePhoneParamsWithContext(ctx context.Context) *DeletePhoneParams {
+
+	return &DeletePhoneParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeletePhoneParamsWithHTTPClient creates a new DeletePhoneParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeletePhoneParamsWithHTTPClient(client *http.Client) *DeletePhoneParams {
+
+	return &DeletePhoneParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeletePhoneParams contains all the parameters to send to the API endpoint
+for the delete phone operation typically these are written to a http.Request
+*/
+type DeletePhoneParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete phone params
+func (o *DeletePhoneParams) WithTimeout(timeout time.Duration) *DeletePhoneParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete phone params
+func (o *DeletePhoneParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete phone params
+func (o *DeletePhoneParams) WithContext(ctx context.Context) *DeletePhoneParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete phone params
+func (o *DeletePhoneParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete phone params
+func (o *DeletePhoneParams) WithHTTPClient(client *http.Client) *DeletePhoneParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete phone params
+func (o *DeletePhoneParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeletePhoneParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
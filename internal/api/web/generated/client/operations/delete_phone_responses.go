@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// DeletePhoneReader is a Reader for the DeletePhone structure.
+type DeletePhoneReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeletePhoneReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeletePhoneNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeletePhoneDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeletePhoneNoContent creates a DeletePhoneNoContent with default headers values
+func NewDeletePhoneNoContent() *DeletePhoneNoContent {
+	return &DeletePhoneNoContent{}
+}
+
+/*
+DeletePhoneNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type DeletePhoneNoContent struct {
+}
+
+func (o *DeletePhoneNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /user/phone][%d] deletePhoneNoContent ", 204)
+}
+
+func (o *DeletePhoneNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeletePhoneDefault creates a DeletePhoneDefault with default headers values
+func NewDeletePhoneDefault(code int) *DeletePhoneDefault {
+	return &DeletePhoneDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeletePhoneDefault handles this case with default header values.
+
+Generic error response.
+*/
+type DeletePhoneDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the delete phone default response
+func (o *DeletePhoneDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeletePhoneDefault) Error() string {
+	return fmt.Sprintf("[DELETE /user/phone][%d] deletePhone default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeletePhoneDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeletePhoneDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
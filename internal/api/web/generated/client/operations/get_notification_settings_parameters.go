@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetNotificationSettingsParams creates a new GetNotificationSettingsParams object
+// with the default values initialized.
+func NewGetNotificationSettingsParams() *GetNotificationSettingsParams {
+
+	return &GetNotificationSettingsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetNotificationSettingsParamsWithTimeout creates a new GetNotificationSettingsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetNotificationSettingsParamsWithTimeout(timeout time.Duration) *GetNotificationSettingsParams {
+
+	return &GetNotificationSettingsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetNotificationSettingsParamsWithContext creates a new GetNotificationSettingsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetNotificationSettingsParamsWithContext(ctx context.Context) *GetNotificationSettingsParams {
+
+	return &GetNotificationSettingsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetNotificationSettingsParamsWithHTTPClient creates a new GetNotificationSettingsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetNotificationSettingsParamsWithHTTPClient(client *http.Client) *GetNotificationSettingsParams {
+
+	return &GetNotificationSettingsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetNotificationSettingsParams contains all the parameters to send to the API endpoint
+for the get notification settings operation typically these are written to a http.Request
+*/
+type GetNotificationSettingsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get notification settings params
+func (o *GetNotificationSettingsParams) WithTimeout(timeout time.Duration) *GetNotificationSettingsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get notification settings params
+func (o *GetNotificationSettingsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get notification settings params
+func (o *GetNotificationSettingsParams) WithContext(ctx context.Context) *GetNotificationSettingsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get notification settings params
+func (o *GetNotificationSettingsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get notification settings params
+func (o *GetNotificationSettingsParams) WithHTTPClient(client *http.Client) *GetNotificationSettingsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get notification settings params
+func (o *GetNotificationSettingsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetNotificationSettingsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// GetNotificationSettingsReader is a Reader for the GetNotificationSettings structure.
+type GetNotificationSettingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetNotificationSettingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetNotificationSettingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetNotificationSettingsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetNotificationSettingsOK creates a GetNotificationSettingsOK with default headers values
+func NewGetNotificationSettingsOK() *GetNotificationSettingsOK {
+	return &GetNotificationSettingsOK{}
+}
+
+/*
+GetNotificationSettingsOK handles this case with default header values.
+
+OK
+*/
+type GetNotificationSettingsOK struct {
+	Payload []*models.NotificationSetting
+}
+
+func (o *GetNotificationSettingsOK) Error() string {
+	return fmt.Sprintf("[GET /user/notification-settings][%d] getNotificationSettingsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetNotificationSettingsOK) GetPayload() []*models.NotificationSetting {
+	return o.Payload
+}
+
+func (o *GetNotificationSettingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetNotificationSettingsDefault creates a GetNotificationSettingsDefault with default headers values
+func NewGetNotificationSettingsDefault(code int) *GetNotificationSettingsDefault {
+	return &GetNotificationSettingsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetNotificationSettingsDefault handles this case with default header values.
+
+Generic error response.
+*/
+type GetNotificationSettingsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get notification settings default response
+func (o *GetNotificationSettingsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetNotificationSettingsDefault) Error() string {
+	return fmt.Sprintf("[GET /user/notification-settings][%d] getNotificationSettings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetNotificationSettingsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetNotificationSettingsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
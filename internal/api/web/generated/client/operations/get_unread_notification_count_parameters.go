@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetUnreadNotificationCountParams creates a new GetUnreadNotificationCountParams object
+// with the default values initialized.
+func NewGetUnreadNotificationCountParams() *GetUnreadNotificationCountParams {
+
+	return &GetUnreadNotificationCountParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetUnreadNotificationCountParamsWithTimeout creates a new GetUnreadNotificationCountParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetUnreadNotificationCountParamsWithTimeout(timeout time.Duration) *GetUnreadNotificationCountParams {
+
+	return &GetUnreadNotificationCountParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetUnreadNotificationCountParamsWithContext creates a new GetUnreadNotificationCountParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetUnreadNotificationCountParamsWithContext(ctx context.Context) *GetUnreadNotificationCountParams {
+
+	return &GetUnreadNotificationCountParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetUnreadNotificationCountParamsWithHTTPClient creates a new GetUnreadNotificationCountParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetUnreadNotificationCountParamsWithHTTPClient(client *http.Client) *GetUnreadNotificationCountParams {
+
+	return &GetUnreadNotificationCountParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetUnreadNotificationCountParams contains all the parameters to send to the API endpoint
+for the get unread notification count operation typically these are written to a http.Request
+*/
+type GetUnreadNotificationCountParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get unread notification count params
+func (o *GetUnreadNotificationCountParams) WithTimeout(timeout time.Duration) *GetUnreadNotificationCountParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get unread notification count params
+func (o *GetUnreadNotificationCountParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get unread notification count params
+func (o *GetUnreadNotificationCountParams) WithContext(ctx context.Context) *GetUnreadNotificationCountParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get unread notification count params
+func (o *GetUnreadNotificationCountParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get unread notification count params
+func (o *GetUnreadNotificationCountParams) WithHTTPClient(client *http.Client) *GetUnreadNotificationCountParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get unread notification count params
+func (o *GetUnreadNotificationCountParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetUnreadNotificationCountParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
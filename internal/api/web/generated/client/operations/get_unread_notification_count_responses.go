@@ -0,0 +1,179 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// GetUnreadNotificationCountReader is a Reader for the GetUnreadNotificationCount structure.
+type GetUnreadNotificationCountReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetUnreadNotificationCountReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetUnreadNotificationCountOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetUnreadNotificationCountDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetUnreadNotificationCountOK creates a GetUnreadNotificationCountOK with default headers values
+func NewGetUnreadNotificationCountOK() *GetUnreadNotificationCountOK {
+	return &GetUnreadNotificationCountOK{}
+}
+
+/*
+GetUnreadNotificationCountOK handles this case with default header values.
+
+OK
+*/
+type GetUnreadNotificationCountOK struct {
+	Payload *GetUnreadNotificationCountOKBody
+}
+
+func (o *GetUnreadNotificationCountOK) Error() string {
+	return fmt.Sprintf("[GET /user/notifications/unread-count][%d] getUnreadNotificationCountOK  %+v", 200, o.Payload)
+}
+
+func (o *GetUnreadNotificationCountOK) GetPayload() *GetUnreadNotificationCountOKBody {
+	return o.Payload
+}
+
+func (o *GetUnreadNotificationCountOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(GetUnreadNotificationCountOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUnreadNotificationCountDefault creates a GetUnreadNotificationCountDefault with default headers values
+func NewGetUnreadNotificationCountDefault(code int) *GetUnreadNotificationCountDefault {
+	return &GetUnreadNotificationCountDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetUnreadNotificationCountDefault handles this case with default header values.
+
+Generic error response.
+*/
+type GetUnreadNotificationCountDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get unread notification count default response
+func (o *GetUnreadNotificationCountDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetUnreadNotificationCountDefault) Error() string {
+	return fmt.Sprintf("[GET /user/notifications/unread-count][%d] getUnreadNotificationCount default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetUnreadNotificationCountDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetUnreadNotificationCountDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+GetUnreadNotificationCountOKBody get unread notification count o k body
+swagger:model GetUnreadNotificationCountOKBody
+*/
+type GetUnreadNotificationCountOKBody struct {
+
+	// count
+	// Required: true
+	// Minimum: 0
+	Count *int32 `json:"count"`
+}
+
+// Validate validates this get unread notification count o k body
+func (o *GetUnreadNotificationCountOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetUnreadNotificationCountOKBody) validateCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("getUnreadNotificationCountOK"+"."+"count", "body", o.Count); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("getUnreadNotificationCountOK"+"."+"count", "body", int64(*o.Count), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetUnreadNotificationCountOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetUnreadNotificationCountOKBody) UnmarshalBinary(b []byte) error {
+	var res GetUnreadNotificationCountOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
 )
 
 // NewGetUserParams creates a new GetUserParams object
@@ -56,13 +55,14 @@ func NewGetUserParamsWithHTTPClient(client *http.Client) *GetUserParams {
 	}
 }
 
-/*GetUserParams contains all the parameters to send to the API endpoint
+/*
+GetUserParams contains all the parameters to send to the API endpoint
 for the get user operation typically these are written to a http.Request
 */
 type GetUserParams struct {
 
 	/*ID*/
-	ID *int32
+	ID *strfmt.UUID
 
 	timeout    time.Duration
 	Context    context.Context
@@ -103,13 +103,13 @@ func (o *GetUserParams) SetHTTPClient(client *http.Client) {
 }
 
 // WithID adds the id to the get user params
-func (o *GetUserParams) WithID(id *int32) *GetUserParams {
+func (o *GetUserParams) WithID(id *strfmt.UUID) *GetUserParams {
 	o.SetID(id)
 	return o
 }
 
 // SetID adds the id to the get user params
-func (o *GetUserParams) SetID(id *int32) {
+func (o *GetUserParams) SetID(id *strfmt.UUID) {
 	o.ID = id
 }
 
@@ -124,11 +124,11 @@ func (o *GetUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regis
 	if o.ID != nil {
 
 		// query param id
-		var qrID int32
+		var qrID strfmt.UUID
 		if o.ID != nil {
 			qrID = *o.ID
 		}
-		qID := swag.FormatInt32(qrID)
+		qID := qrID.String()
 		if qID != "" {
 			if err := r.SetQueryParam("id", qID); err != nil {
 				return err
@@ -46,11 +46,16 @@ func NewGetUserOK() *GetUserOK {
 	return &GetUserOK{}
 }
 
-/*GetUserOK handles this case with default header values.
+/*
+GetUserOK handles this case with default header values.
 
 OK
 */
 type GetUserOK struct {
+	/*Version of the user, it's sent in If-Match of updates.
+	 */
+	ETag string
+
 	Payload *models.User
 }
 
@@ -64,6 +69,9 @@ func (o *GetUserOK) GetPayload() *models.User {
 
 func (o *GetUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
 	o.Payload = new(models.User)
 
 	// response payload
@@ -81,7 +89,8 @@ func NewGetUserDefault(code int) *GetUserDefault {
 	}
 }
 
-/*GetUserDefault handles this case with default header values.
+/*
+GetUserDefault handles this case with default header values.
 
 Generic error response.
 */
@@ -0,0 +1,172 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// NewHandleEmailEventsParams creates a new HandleEmailEventsParams object
+// with the default values initialized.
+func NewHandleEmailEventsParams() *HandleEmailEventsParams {
+	var ()
+	return &HandleEmailEventsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewHandleEmailEventsParamsWithTimeout creates a new HandleEmailEventsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewHandleEmailEventsParamsWithTimeout(timeout time.Duration) *HandleEmailEventsParams {
+	var ()
+	return &HandleEmailEventsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewHandleEmailEventsParamsWithContext creates a new HandleEmailEventsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewHandleEmailEventsParamsWithContext(ctx context.Context) *HandleEmailEventsParams {
+	var ()
+	return &HandleEmailEventsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewHandleEmailEventsParamsWithHTTPClient creates a new HandleEmailEventsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewHandleEmailEventsParamsWithHTTPClient(client *http.Client) *HandleEmailEventsParams {
+	var ()
+	return &HandleEmailEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+HandleEmailEventsParams contains all the parameters to send to the API endpoint
+for the handle email events operation typically these are written to a http.Request
+*/
+type HandleEmailEventsParams struct {
+
+	/*XTwilioEmailEventWebhookSignature*/
+	XTwilioEmailEventWebhookSignature string
+	/*XTwilioEmailEventWebhookTimestamp*/
+	XTwilioEmailEventWebhookTimestamp string
+	/*Events*/
+	Events []*models.EmailProviderEvent
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the handle email events params
+func (o *HandleEmailEventsParams) WithTimeout(timeout time.Duration) *HandleEmailEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the handle email events params
+func (o *HandleEmailEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the handle email events params
+func (o *HandleEmailEventsParams) WithContext(ctx context.Context) *HandleEmailEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the handle email events params
+func (o *HandleEmailEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the handle email events params
+func (o *HandleEmailEventsParams) WithHTTPClient(client *http.Client) *HandleEmailEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the handle email events params
+func (o *HandleEmailEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithXTwilioEmailEventWebhookSignature adds the xTwilioEmailEventWebhookSignature to the handle email events params
+func (o *HandleEmailEventsParams) WithXTwilioEmailEventWebhookSignature(xTwilioEmailEventWebhookSignature string) *HandleEmailEventsParams {
+	o.SetXTwilioEmailEventWebhookSignature(xTwilioEmailEventWebhookSignature)
+	return o
+}
+
+// SetXTwilioEmailEventWebhookSignature adds the xTwilioEmailEventWebhookSignature to the handle email events params
+func (o *HandleEmailEventsParams) SetXTwilioEmailEventWebhookSignature(xTwilioEmailEventWebhookSignature string) {
+	o.XTwilioEmailEventWebhookSignature = xTwilioEmailEventWebhookSignature
+}
+
+// WithXTwilioEmailEventWebhookTimestamp adds the xTwilioEmailEventWebhookTimestamp to the handle email events params
+func (o *HandleEmailEventsParams) WithXTwilioEmailEventWebhookTimestamp(xTwilioEmailEventWebhookTimestamp string) *HandleEmailEventsParams {
+	o.SetXTwilioEmailEventWebhookTimestamp(xTwilioEmailEventWebhookTimestamp)
+	return o
+}
+
+// SetXTwilioEmailEventWebhookTimestamp adds the xTwilioEmailEventWebhookTimestamp to the handle email events params
+func (o *HandleEmailEventsParams) SetXTwilioEmailEventWebhookTimestamp(xTwilioEmailEventWebhookTimestamp string) {
+	o.XTwilioEmailEventWebhookTimestamp = xTwilioEmailEventWebhookTimestamp
+}
+
+// WithEvents adds the events to the handle email events params
+func (o *HandleEmailEventsParams) WithEvents(events []*models.EmailProviderEvent) *HandleEmailEventsParams {
+	o.SetEvents(events)
+	return o
+}
+
+// SetEvents adds the events to the handle email events params
+func (o *HandleEmailEventsParams) SetEvents(events []*models.EmailProviderEvent) {
+	o.Events = events
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *HandleEmailEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// header param X-Twilio-Email-Event-Webhook-Signature
+	if err := r.SetHeaderParam("X-Twilio-Email-Event-Webhook-Signature", o.XTwilioEmailEventWebhookSignature); err != nil {
+		return err
+	}
+
+	// header param X-Twilio-Email-Event-Webhook-Timestamp
+	if err := r.SetHeaderParam("X-Twilio-Email-Event-Webhook-Timestamp", o.XTwilioEmailEventWebhookTimestamp); err != nil {
+		return err
+	}
+
+	if o.Events != nil {
+		if err := r.SetBodyParam(o.Events); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// HandleEmailEventsReader is a Reader for the HandleEmailEvents structure.
+type HandleEmailEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *HandleEmailEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewHandleEmailEventsNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewHandleEmailEventsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewHandleEmailEventsNoContent creates a HandleEmailEventsNoContent with default headers values
+func NewHandleEmailEventsNoContent() *HandleEmailEventsNoContent {
+	return &HandleEmailEventsNoContent{}
+}
+
+/*
+HandleEmailEventsNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type HandleEmailEventsNoContent struct {
+}
+
+func (o *HandleEmailEventsNoContent) Error() string {
+	return fmt.Sprintf("[POST /email/events][%d] handleEmailEventsNoContent ", 204)
+}
+
+func (o *HandleEmailEventsNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewHandleEmailEventsDefault creates a HandleEmailEventsDefault with default headers values
+func NewHandleEmailEventsDefault(code int) *HandleEmailEventsDefault {
+	return &HandleEmailEventsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+HandleEmailEventsDefault handles this case with default header values.
+
+Generic error response.
+*/
+type HandleEmailEventsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the handle email events default response
+func (o *HandleEmailEventsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *HandleEmailEventsDefault) Error() string {
+	return fmt.Sprintf("[POST /email/events][%d] handleEmailEvents default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *HandleEmailEventsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *HandleEmailEventsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
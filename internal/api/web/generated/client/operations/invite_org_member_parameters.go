@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewInviteOrgMemberParams creates a new InviteOrgMemberParams object
+// with the default values initialized.
+func NewInviteOrgMemberParams() *InviteOrgMemberParams {
+	var ()
+	return &InviteOrgMemberParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewInviteOrgMemberParamsWithTimeout creates a new InviteOrgMemberParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewInviteOrgMemberParamsWithTimeout(timeout time.Duration) *InviteOrgMemberParams {
+	var ()
+	return &InviteOrgMemberParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewInviteOrgMemberParamsWithContext creates a new InviteOrgMemberParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewInviteOrgMemberParamsWithContext(ctx context.Context) *InviteOrgMemberParams {
+	var ()
+	return &InviteOrgMemberParams{
+
+		Context: ctx,
+	}
+}
+
+// NewInviteOrgMemberParamsWithHTTPClient creates a new InviteOrgMemberParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewInviteOrgMemberParamsWithHTTPClient(client *http.Client) *InviteOrgMemberParams {
+	var ()
+	return &InviteOrgMemberParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+InviteOrgMemberParams contains all the parameters to send to the API endpoint
+for the invite org member operation typically these are written to a http.Request
+*/
+type InviteOrgMemberParams struct {
+
+	/*Args*/
+	Args InviteOrgMemberBody
+	/*ID*/
+	ID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the invite org member params
+func (o *InviteOrgMemberParams) WithTimeout(timeout time.Duration) *InviteOrgMemberParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the invite org member params
+func (o *InviteOrgMemberParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the invite org member params
+func (o *InviteOrgMemberParams) WithContext(ctx context.Context) *InviteOrgMemberParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the invite org member params
+func (o *InviteOrgMemberParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the invite org member params
+func (o *InviteOrgMemberParams) WithHTTPClient(client *http.Client) *InviteOrgMemberParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the invite org member params
+func (o *InviteOrgMemberParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the invite org member params
+func (o *InviteOrgMemberParams) WithArgs(args InviteOrgMemberBody) *InviteOrgMemberParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the invite org member params
+func (o *InviteOrgMemberParams) SetArgs(args InviteOrgMemberBody) {
+	o.Args = args
+}
+
+// WithID adds the id to the invite org member params
+func (o *InviteOrgMemberParams) WithID(id int32) *InviteOrgMemberParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the invite org member params
+func (o *InviteOrgMemberParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *InviteOrgMemberParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// InviteOrgMemberReader is a Reader for the InviteOrgMember structure.
+type InviteOrgMemberReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *InviteOrgMemberReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewInviteOrgMemberNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewInviteOrgMemberDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewInviteOrgMemberNoContent creates a InviteOrgMemberNoContent with default headers values
+func NewInviteOrgMemberNoContent() *InviteOrgMemberNoContent {
+	return &InviteOrgMemberNoContent{}
+}
+
+/*
+InviteOrgMemberNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type InviteOrgMemberNoContent struct {
+}
+
+func (o *InviteOrgMemberNoContent) Error() string {
+	return fmt.Sprintf("[POST /orgs/{id}/invitations][%d] inviteOrgMemberNoContent ", 204)
+}
+
+func (o *InviteOrgMemberNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewInviteOrgMemberDefault creates a InviteOrgMemberDefault with default headers values
+func NewInviteOrgMemberDefault(code int) *InviteOrgMemberDefault {
+	return &InviteOrgMemberDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+InviteOrgMemberDefault handles this case with default header values.
+
+Generic error response.
+*/
+type InviteOrgMemberDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the invite org member default response
+func (o *InviteOrgMemberDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *InviteOrgMemberDefault) Error() string {
+	return fmt.Sprintf("[POST /orgs/{id}/invitations][%d] inviteOrgMember default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *InviteOrgMemberDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *InviteOrgMemberDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+InviteOrgMemberBody invite org member body
+swagger:model InviteOrgMemberBody
+*/
+type InviteOrgMemberBody struct {
+
+	// email
+	// Required: true
+	// Format: email
+	Email models.Email `json:"email"`
+
+	// role
+	// Required: true
+	// Enum: [admin member]
+	Role *string `json:"role"`
+}
+
+// Validate validates this invite org member body
+func (o *InviteOrgMemberBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *InviteOrgMemberBody) validateEmail(formats strfmt.Registry) error {
+
+	if err := o.Email.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "email")
+		}
+		return err
+	}
+
+	return nil
+}
+
+var inviteOrgMemberBodyTypeRolePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["admin","member"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		inviteOrgMemberBodyTypeRolePropEnum = append(inviteOrgMemberBodyTypeRolePropEnum, v)
+	}
+}
+
+const (
+
+	// InviteOrgMemberBodyRoleAdmin captures enum value "admin"
+	InviteOrgMemberBodyRoleAdmin string = "admin"
+
+	// InviteOrgMemberBodyRoleMember captures enum value "member"
+	InviteOrgMemberBodyRoleMember string = "member"
+)
+
+// prop value enum
+func (o *InviteOrgMemberBody) validateRoleEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, inviteOrgMemberBodyTypeRolePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *InviteOrgMemberBody) validateRole(formats strfmt.Registry) error {
+
+	if err := validate.Required("args"+"."+"role", "body", o.Role); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateRoleEnum("args"+"."+"role", "body", *o.Role); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *InviteOrgMemberBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *InviteOrgMemberBody) UnmarshalBinary(b []byte) error {
+	var res InviteOrgMemberBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,274 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListNotificationTasksParams creates a new ListNotificationTasksParams object
+// with the default values initialized.
+func NewListNotificationTasksParams() *ListNotificationTasksParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListNotificationTasksParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListNotificationTasksParamsWithTimeout creates a new ListNotificationTasksParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListNotificationTasksParamsWithTimeout(timeout time.Duration) *ListNotificationTasksParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListNotificationTasksParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		timeout: timeout,
+	}
+}
+
+// NewListNotificationTasksParamsWithContext creates a new ListNotificationTasksParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListNotificationTasksParamsWithContext(ctx context.Context) *ListNotificationTasksParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListNotificationTasksParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		Context: ctx,
+	}
+}
+
+// NewListNotificationTasksParamsWithHTTPClient creates a new ListNotificationTasksParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListNotificationTasksParamsWithHTTPClient(client *http.Client) *ListNotificationTasksParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListNotificationTasksParams{
+		Limit:      limitDefault,
+		Offset:     &offsetDefault,
+		HTTPClient: client,
+	}
+}
+
+/*
+ListNotificationTasksParams contains all the parameters to send to the API endpoint
+for the list notification tasks operation typically these are written to a http.Request
+*/
+type ListNotificationTasksParams struct {
+
+	/*Email*/
+	Email *string
+	/*Kind*/
+	Kind *string
+	/*Limit*/
+	Limit int32
+	/*Offset*/
+	Offset *int32
+	/*Status*/
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list notification tasks params
+func (o *ListNotificationTasksParams) WithTimeout(timeout time.Duration) *ListNotificationTasksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list notification tasks params
+func (o *ListNotificationTasksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list notification tasks params
+func (o *ListNotificationTasksParams) WithContext(ctx context.Context) *ListNotificationTasksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list notification tasks params
+func (o *ListNotificationTasksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list notification tasks params
+func (o *ListNotificationTasksParams) WithHTTPClient(client *http.Client) *ListNotificationTasksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list notification tasks params
+func (o *ListNotificationTasksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithEmail adds the email to the list notification tasks params
+func (o *ListNotificationTasksParams) WithEmail(email *string) *ListNotificationTasksParams {
+	o.SetEmail(email)
+	return o
+}
+
+// SetEmail adds the email to the list notification tasks params
+func (o *ListNotificationTasksParams) SetEmail(email *string) {
+	o.Email = email
+}
+
+// WithKind adds the kind to the list notification tasks params
+func (o *ListNotificationTasksParams) WithKind(kind *string) *ListNotificationTasksParams {
+	o.SetKind(kind)
+	return o
+}
+
+// SetKind adds the kind to the list notification tasks params
+func (o *ListNotificationTasksParams) SetKind(kind *string) {
+	o.Kind = kind
+}
+
+// WithLimit adds the limit to the list notification tasks params
+func (o *ListNotificationTasksParams) WithLimit(limit int32) *ListNotificationTasksParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list notification tasks params
+func (o *ListNotificationTasksParams) SetLimit(limit int32) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the list notification tasks params
+func (o *ListNotificationTasksParams) WithOffset(offset *int32) *ListNotificationTasksParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the list notification tasks params
+func (o *ListNotificationTasksParams) SetOffset(offset *int32) {
+	o.Offset = offset
+}
+
+// WithStatus adds the status to the list notification tasks params
+func (o *ListNotificationTasksParams) WithStatus(status *string) *ListNotificationTasksParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list notification tasks params
+func (o *ListNotificationTasksParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListNotificationTasksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Email != nil {
+
+		// query param email
+		var qrEmail string
+		if o.Email != nil {
+			qrEmail = *o.Email
+		}
+		qEmail := qrEmail
+		if qEmail != "" {
+			if err := r.SetQueryParam("email", qEmail); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Kind != nil {
+
+		// query param kind
+		var qrKind string
+		if o.Kind != nil {
+			qrKind = *o.Kind
+		}
+		qKind := qrKind
+		if qKind != "" {
+			if err := r.SetQueryParam("kind", qKind); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// query param limit
+	qrLimit := o.Limit
+	qLimit := swag.FormatInt32(qrLimit)
+	if qLimit != "" {
+		if err := r.SetQueryParam("limit", qLimit); err != nil {
+			return err
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int32
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt32(qrOffset)
+		if qOffset != "" {
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListNotificationTasksReader is a Reader for the ListNotificationTasks structure.
+type ListNotificationTasksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListNotificationTasksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListNotificationTasksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListNotificationTasksDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListNotificationTasksOK creates a ListNotificationTasksOK with default headers values
+func NewListNotificationTasksOK() *ListNotificationTasksOK {
+	return &ListNotificationTasksOK{}
+}
+
+/*
+ListNotificationTasksOK handles this case with default header values.
+
+OK
+*/
+type ListNotificationTasksOK struct {
+	Payload *ListNotificationTasksOKBody
+}
+
+func (o *ListNotificationTasksOK) Error() string {
+	return fmt.Sprintf("[GET /admin/notifications][%d] listNotificationTasksOK  %+v", 200, o.Payload)
+}
+
+func (o *ListNotificationTasksOK) GetPayload() *ListNotificationTasksOKBody {
+	return o.Payload
+}
+
+func (o *ListNotificationTasksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(ListNotificationTasksOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListNotificationTasksDefault creates a ListNotificationTasksDefault with default headers values
+func NewListNotificationTasksDefault(code int) *ListNotificationTasksDefault {
+	return &ListNotificationTasksDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListNotificationTasksDefault handles this case with default header values.
+
+Generic error response.
+*/
+type ListNotificationTasksDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list notification tasks default response
+func (o *ListNotificationTasksDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListNotificationTasksDefault) Error() string {
+	return fmt.Sprintf("[GET /admin/notifications][%d] listNotificationTasks default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListNotificationTasksDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListNotificationTasksDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+ListNotificationTasksOKBody list notification tasks o k body
+swagger:model ListNotificationTasksOKBody
+*/
+type ListNotificationTasksOKBody struct {
+
+	// tasks
+	// Max Items: 100
+	Tasks []*models.NotificationTask `json:"tasks"`
+
+	// total
+	// Minimum: 0
+	Total *int32 `json:"total,omitempty"`
+}
+
+// Validate validates this list notification tasks o k body
+func (o *ListNotificationTasksOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateTasks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListNotificationTasksOKBody) validateTasks(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Tasks) { // not required
+		return nil
+	}
+
+	iTasksSize := int64(len(o.Tasks))
+
+	if err := validate.MaxItems("listNotificationTasksOK"+"."+"tasks", "body", iTasksSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(o.Tasks); i++ {
+		if swag.IsZero(o.Tasks[i]) { // not required
+			continue
+		}
+
+		if o.Tasks[i] != nil {
+			if err := o.Tasks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("listNotificationTasksOK" + "." + "tasks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListNotificationTasksOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Total) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("listNotificationTasksOK"+"."+"total", "body", int64(*o.Total), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListNotificationTasksOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListNotificationTasksOKBody) UnmarshalBinary(b []byte) error {
+	var res ListNotificationTasksOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListOrgMembersParams creates a new ListOrgMembersParams object
+// with the default values initialized.
+func NewListOrgMembersParams() *ListOrgMembersParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListOrgMembersParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListOrgMembersParamsWithTimeout creates a new ListOrgMembersParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListOrgMembersParamsWithTimeout(timeout time.Duration) *ListOrgMembersParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListOrgMembersParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		timeout: timeout,
+	}
+}
+
+// NewListOrgMembersParamsWithContext creates a new ListOrgMembersParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListOrgMembersParamsWithContext(ctx context.Context) *ListOrgMembersParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListOrgMembersParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		Context: ctx,
+	}
+}
+
+// NewListOrgMembersParamsWithHTTPClient creates a new ListOrgMembersParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListOrgMembersParamsWithHTTPClient(client *http.Client) *ListOrgMembersParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListOrgMembersParams{
+		Limit:      limitDefault,
+		Offset:     &offsetDefault,
+		HTTPClient: client,
+	}
+}
+
+/*
+ListOrgMembersParams contains all the parameters to send to the API endpoint
+for the list org members operation typically these are written to a http.Request
+*/
+type ListOrgMembersParams struct {
+
+	/*ID*/
+	ID int32
+	/*Limit*/
+	Limit int32
+	/*Offset*/
+	Offset *int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list org members params
+func (o *ListOrgMembersParams) WithTimeout(timeout time.Duration) *ListOrgMembersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list org members params
+func (o *ListOrgMembersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list org members params
+func (o *ListOrgMembersParams) WithContext(ctx context.Context) *ListOrgMembersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list org members params
+func (o *ListOrgMembersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list org members params
+func (o *ListOrgMembersParams) WithHTTPClient(client *http.Client) *ListOrgMembersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list org members params
+func (o *ListOrgMembersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the list org members params
+func (o *ListOrgMembersParams) WithID(id int32) *ListOrgMembersParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the list org members params
+func (o *ListOrgMembersParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WithLimit adds the limit to the list org members params
+func (o *ListOrgMembersParams) WithLimit(limit int32) *ListOrgMembersParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list org members params
+func (o *ListOrgMembersParams) SetLimit(limit int32) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the list org members params
+func (o *ListOrgMembersParams) WithOffset(offset *int32) *ListOrgMembersParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the list org members params
+func (o *ListOrgMembersParams) SetOffset(offset *int32) {
+	o.Offset = offset
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListOrgMembersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	// query param limit
+	qrLimit := o.Limit
+	qLimit := swag.FormatInt32(qrLimit)
+	if qLimit != "" {
+		if err := r.SetQueryParam("limit", qLimit); err != nil {
+			return err
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int32
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt32(qrOffset)
+		if qOffset != "" {
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListOrgMembersReader is a Reader for the ListOrgMembers structure.
+type ListOrgMembersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListOrgMembersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListOrgMembersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListOrgMembersDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListOrgMembersOK creates a ListOrgMembersOK with default headers values
+func NewListOrgMembersOK() *ListOrgMembersOK {
+	return &ListOrgMembersOK{}
+}
+
+/*
+ListOrgMembersOK handles this case with default header values.
+
+OK
+*/
+type ListOrgMembersOK struct {
+	Payload *ListOrgMembersOKBody
+}
+
+func (o *ListOrgMembersOK) Error() string {
+	return fmt.Sprintf("[GET /orgs/{id}/members][%d] listOrgMembersOK  %+v", 200, o.Payload)
+}
+
+func (o *ListOrgMembersOK) GetPayload() *ListOrgMembersOKBody {
+	return o.Payload
+}
+
+func (o *ListOrgMembersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(ListOrgMembersOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListOrgMembersDefault creates a ListOrgMembersDefault with default headers values
+func NewListOrgMembersDefault(code int) *ListOrgMembersDefault {
+	return &ListOrgMembersDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListOrgMembersDefault handles this case with default header values.
+
+Generic error response.
+*/
+type ListOrgMembersDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list org members default response
+func (o *ListOrgMembersDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListOrgMembersDefault) Error() string {
+	return fmt.Sprintf("[GET /orgs/{id}/members][%d] listOrgMembers default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListOrgMembersDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListOrgMembersDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+ListOrgMembersOKBody list org members o k body
+swagger:model ListOrgMembersOKBody
+*/
+type ListOrgMembersOKBody struct {
+
+	// members
+	// Max Items: 100
+	Members []*models.OrgMember `json:"members"`
+
+	// total
+	// Minimum: 0
+	Total *int32 `json:"total,omitempty"`
+}
+
+// Validate validates this list org members o k body
+func (o *ListOrgMembersOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateMembers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListOrgMembersOKBody) validateMembers(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Members) { // not required
+		return nil
+	}
+
+	iMembersSize := int64(len(o.Members))
+
+	if err := validate.MaxItems("listOrgMembersOK"+"."+"members", "body", iMembersSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(o.Members); i++ {
+		if swag.IsZero(o.Members[i]) { // not required
+			continue
+		}
+
+		if o.Members[i] != nil {
+			if err := o.Members[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("listOrgMembersOK" + "." + "members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListOrgMembersOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Total) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("listOrgMembersOK"+"."+"total", "body", int64(*o.Total), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListOrgMembersOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListOrgMembersOKBody) UnmarshalBinary(b []byte) error {
+	var res ListOrgMembersOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
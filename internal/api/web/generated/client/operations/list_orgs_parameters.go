@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListOrgsParams creates a new ListOrgsParams object
+// with the default values initialized.
+func NewListOrgsParams() *ListOrgsParams {
+
+	return &ListOrgsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListOrgsParamsWithTimeout creates a new ListOrgsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListOrgsParamsWithTimeout(timeout time.Duration) *ListOrgsParams {
+
+	return &ListOrgsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListOrgsParamsWithContext creates a new ListOrgsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListOrgsParamsWithContext(ctx context.Context) *ListOrgsParams {
+
+	return &ListOrgsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListOrgsParamsWithHTTPClient creates a new ListOrgsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListOrgsParamsWithHTTPClient(client *http.Client) *ListOrgsParams {
+
+	return &ListOrgsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListOrgsParams contains all the parameters to send to the API endpoint
+for the list orgs operation typically these are written to a http.Request
+*/
+type ListOrgsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list orgs params
+func (o *ListOrgsParams) WithTimeout(timeout time.Duration) *ListOrgsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list orgs params
+func (o *ListOrgsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list orgs params
+func (o *ListOrgsParams) WithContext(ctx context.Context) *ListOrgsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list orgs params
+func (o *ListOrgsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list orgs params
+func (o *ListOrgsParams) WithHTTPClient(client *http.Client) *ListOrgsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list orgs params
+func (o *ListOrgsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListOrgsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListOrgsReader is a Reader for the ListOrgs structure.
+type ListOrgsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListOrgsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListOrgsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListOrgsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListOrgsOK creates a ListOrgsOK with default headers values
+func NewListOrgsOK() *ListOrgsOK {
+	return &ListOrgsOK{}
+}
+
+/*
+ListOrgsOK handles this case with default header values.
+
+OK
+*/
+type ListOrgsOK struct {
+	Payload []*models.Org
+}
+
+func (o *ListOrgsOK) Error() string {
+	return fmt.Sprintf("[GET /orgs][%d] listOrgsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListOrgsOK) GetPayload() []*models.Org {
+	return o.Payload
+}
+
+func (o *ListOrgsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListOrgsDefault creates a ListOrgsDefault with default headers values
+func NewListOrgsDefault(code int) *ListOrgsDefault {
+	return &ListOrgsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListOrgsDefault handles this case with default header values.
+
+Generic error response.
+*/
+type ListOrgsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list orgs default response
+func (o *ListOrgsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListOrgsDefault) Error() string {
+	return fmt.Sprintf("[GET /orgs][%d] listOrgs default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListOrgsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListOrgsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListUserNotificationsParams creates a new ListUserNotificationsParams object
+// with the default values initialized.
+func NewListUserNotificationsParams() *ListUserNotificationsParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListUserNotificationsParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListUserNotificationsParamsWithTimeout creates a new ListUserNotificationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListUserNotificationsParamsWithTimeout(timeout time.Duration) *ListUserNotificationsParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListUserNotificationsParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		timeout: timeout,
+	}
+}
+
+// NewListUserNotificationsParamsWithContext creates a new ListUserNotificationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListUserNotificationsParamsWithContext(ctx context.Context) *ListUserNotificationsParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListUserNotificationsParams{
+		Limit:  limitDefault,
+		Offset: &offsetDefault,
+
+		Context: ctx,
+	}
+}
+
+// NewListUserNotificationsParamsWithHTTPClient creates a new ListUserNotificationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListUserNotificationsParamsWithHTTPClient(client *http.Client) *ListUserNotificationsParams {
+	var (
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+	return &ListUserNotificationsParams{
+		Limit:      limitDefault,
+		Offset:     &offsetDefault,
+		HTTPClient: client,
+	}
+}
+
+/*
+ListUserNotificationsParams contains all the parameters to send to the API endpoint
+for the list user notifications operation typically these are written to a http.Request
+*/
+type ListUserNotificationsParams struct {
+
+	/*Limit*/
+	Limit int32
+	/*Offset*/
+	Offset *int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list user notifications params
+func (o *ListUserNotificationsParams) WithTimeout(timeout time.Duration) *ListUserNotificationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list user notifications params
+func (o *ListUserNotificationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list user notifications params
+func (o *ListUserNotificationsParams) WithContext(ctx context.Context) *ListUserNotificationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list user notifications params
+func (o *ListUserNotificationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list user notifications params
+func (o *ListUserNotificationsParams) WithHTTPClient(client *http.Client) *ListUserNotificationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list user notifications params
+func (o *ListUserNotificationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the list user notifications params
+func (o *ListUserNotificationsParams) WithLimit(limit int32) *ListUserNotificationsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list user notifications params
+func (o *ListUserNotificationsParams) SetLimit(limit int32) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the list user notifications params
+func (o *ListUserNotificationsParams) WithOffset(offset *int32) *ListUserNotificationsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the list user notifications params
+func (o *ListUserNotificationsParams) SetOffset(offset *int32) {
+	o.Offset = offset
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListUserNotificationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param limit
+	qrLimit := o.Limit
+	qLimit := swag.FormatInt32(qrLimit)
+	if qLimit != "" {
+		if err := r.SetQueryParam("limit", qLimit); err != nil {
+			return err
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int32
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt32(qrOffset)
+		if qOffset != "" {
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
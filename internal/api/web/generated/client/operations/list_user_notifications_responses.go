@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListUserNotificationsReader is a Reader for the ListUserNotifications structure.
+type ListUserNotificationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListUserNotificationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListUserNotificationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListUserNotificationsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListUserNotificationsOK creates a ListUserNotificationsOK with default headers values
+func NewListUserNotificationsOK() *ListUserNotificationsOK {
+	return &ListUserNotificationsOK{}
+}
+
+/*
+ListUserNotificationsOK handles this case with default header values.
+
+OK
+*/
+type ListUserNotificationsOK struct {
+	Payload *ListUserNotificationsOKBody
+}
+
+func (o *ListUserNotificationsOK) Error() string {
+	return fmt.Sprintf("[GET /user/notifications][%d] listUserNotificationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListUserNotificationsOK) GetPayload() *ListUserNotificationsOKBody {
+	return o.Payload
+}
+
+func (o *ListUserNotificationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(ListUserNotificationsOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUserNotificationsDefault creates a ListUserNotificationsDefault with default headers values
+func NewListUserNotificationsDefault(code int) *ListUserNotificationsDefault {
+	return &ListUserNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListUserNotificationsDefault handles this case with default header values.
+
+Generic error response.
+*/
+type ListUserNotificationsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list user notifications default response
+func (o *ListUserNotificationsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListUserNotificationsDefault) Error() string {
+	return fmt.Sprintf("[GET /user/notifications][%d] listUserNotifications default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListUserNotificationsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListUserNotificationsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+ListUserNotificationsOKBody list user notifications o k body
+swagger:model ListUserNotificationsOKBody
+*/
+type ListUserNotificationsOKBody struct {
+
+	// notifications
+	// Max Items: 100
+	Notifications []*models.UserNotification `json:"notifications"`
+
+	// total
+	// Minimum: 0
+	Total *int32 `json:"total,omitempty"`
+}
+
+// Validate validates this list user notifications o k body
+func (o *ListUserNotificationsOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateNotifications(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListUserNotificationsOKBody) validateNotifications(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Notifications) { // not required
+		return nil
+	}
+
+	iNotificationsSize := int64(len(o.Notifications))
+
+	if err := validate.MaxItems("listUserNotificationsOK"+"."+"notifications", "body", iNotificationsSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(o.Notifications); i++ {
+		if swag.IsZero(o.Notifications[i]) { // not required
+			continue
+		}
+
+		if o.Notifications[i] != nil {
+			if err := o.Notifications[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("listUserNotificationsOK" + "." + "notifications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListUserNotificationsOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Total) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("listUserNotificationsOK"+"."+"total", "body", int64(*o.Total), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListUserNotificationsOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListUserNotificationsOKBody) UnmarshalBinary(b []byte) error {
+	var res ListUserNotificationsOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
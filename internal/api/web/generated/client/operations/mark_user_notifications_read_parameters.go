@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewMarkUserNotificationsReadParams creates a new MarkUserNotificationsReadParams object
+// with the default values initialized.
+func NewMarkUserNotificationsReadParams() *MarkUserNotificationsReadParams {
+	var ()
+	return &MarkUserNotificationsReadParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewMarkUserNotificationsReadParamsWithTimeout creates a new MarkUserNotificationsReadParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewMarkUserNotificationsReadParamsWithTimeout(timeout time.Duration) *MarkUserNotificationsReadParams {
+	var ()
+	return &MarkUserNotificationsReadParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewMarkUserNotificationsReadParamsWithContext creates a new MarkUserNotificationsReadParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewMarkUserNotificationsReadParamsWithContext(ctx context.Context) *MarkUserNotificationsReadParams {
+	var ()
+	return &MarkUserNotificationsReadParams{
+
+		Context: ctx,
+	}
+}
+
+// NewMarkUserNotificationsReadParamsWithHTTPClient creates a new MarkUserNotificationsReadParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewMarkUserNotificationsReadParamsWithHTTPClient(client *http.Client) *MarkUserNotificationsReadParams {
+	var ()
+	return &MarkUserNotificationsReadParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+MarkUserNotificationsReadParams contains all the parameters to send to the API endpoint
+for the mark user notifications read operation typically these are written to a http.Request
+*/
+type MarkUserNotificationsReadParams struct {
+
+	/*Args*/
+	Args MarkUserNotificationsReadBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) WithTimeout(timeout time.Duration) *MarkUserNotificationsReadParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) WithContext(ctx context.Context) *MarkUserNotificationsReadParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) WithHTTPClient(client *http.Client) *MarkUserNotificationsReadParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) WithArgs(args MarkUserNotificationsReadBody) *MarkUserNotificationsReadParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the mark user notifications read params
+func (o *MarkUserNotificationsReadParams) SetArgs(args MarkUserNotificationsReadBody) {
+	o.Args = args
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *MarkUserNotificationsReadParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
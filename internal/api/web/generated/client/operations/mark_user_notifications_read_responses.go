@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// MarkUserNotificationsReadReader is a Reader for the MarkUserNotificationsRead structure.
+type MarkUserNotificationsReadReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *MarkUserNotificationsReadReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewMarkUserNotificationsReadNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewMarkUserNotificationsReadDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewMarkUserNotificationsReadNoContent creates a MarkUserNotificationsReadNoContent with default headers values
+func NewMarkUserNotificationsReadNoContent() *MarkUserNotificationsReadNoContent {
+	return &MarkUserNotificationsReadNoContent{}
+}
+
+/*
+MarkUserNotificationsReadNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type MarkUserNotificationsReadNoContent struct {
+}
+
+func (o *MarkUserNotificationsReadNoContent) Error() string {
+	return fmt.Sprintf("[POST /user/notifications/read][%d] markUserNotificationsReadNoContent ", 204)
+}
+
+func (o *MarkUserNotificationsReadNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewMarkUserNotificationsReadDefault creates a MarkUserNotificationsReadDefault with default headers values
+func NewMarkUserNotificationsReadDefault(code int) *MarkUserNotificationsReadDefault {
+	return &MarkUserNotificationsReadDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+MarkUserNotificationsReadDefault handles this case with default header values.
+
+Generic error response.
+*/
+type MarkUserNotificationsReadDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the mark user notifications read default response
+func (o *MarkUserNotificationsReadDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *MarkUserNotificationsReadDefault) Error() string {
+	return fmt.Sprintf("[POST /user/notifications/read][%d] markUserNotificationsRead default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *MarkUserNotificationsReadDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *MarkUserNotificationsReadDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+MarkUserNotificationsReadBody mark user notifications read body
+swagger:model MarkUserNotificationsReadBody
+*/
+type MarkUserNotificationsReadBody struct {
+
+	// ids
+	// Max Items: 100
+	Ids []int32 `json:"ids"`
+}
+
+// Validate validates this mark user notifications read body
+func (o *MarkUserNotificationsReadBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *MarkUserNotificationsReadBody) validateIds(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Ids) { // not required
+		return nil
+	}
+
+	iIdsSize := int64(len(o.Ids))
+
+	if err := validate.MaxItems("args"+"."+"ids", "body", iIdsSize, 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *MarkUserNotificationsReadBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *MarkUserNotificationsReadBody) UnmarshalBinary(b []byte) error {
+	var res MarkUserNotificationsReadBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
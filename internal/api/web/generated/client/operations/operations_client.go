@@ -25,24 +25,66 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AcceptOrgInvitation(params *AcceptOrgInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*AcceptOrgInvitationOK, error)
+
+	CancelNotificationTask(params *CancelNotificationTaskParams, authInfo runtime.ClientAuthInfoWriter) (*CancelNotificationTaskNoContent, error)
+
+	CreateOrg(params *CreateOrgParams, authInfo runtime.ClientAuthInfoWriter) (*CreateOrgOK, error)
+
 	CreateRecoveryCode(params *CreateRecoveryCodeParams) (*CreateRecoveryCodeNoContent, error)
 
 	CreateUser(params *CreateUserParams) (*CreateUserOK, error)
 
+	DeletePhone(params *DeletePhoneParams, authInfo runtime.ClientAuthInfoWriter) (*DeletePhoneNoContent, error)
+
 	DeleteUser(params *DeleteUserParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteUserNoContent, error)
 
+	GetNotificationSettings(params *GetNotificationSettingsParams, authInfo runtime.ClientAuthInfoWriter) (*GetNotificationSettingsOK, error)
+
+	GetUnreadNotificationCount(params *GetUnreadNotificationCountParams, authInfo runtime.ClientAuthInfoWriter) (*GetUnreadNotificationCountOK, error)
+
 	GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter) (*GetUserOK, error)
 
 	GetUsers(params *GetUsersParams, authInfo runtime.ClientAuthInfoWriter) (*GetUsersOK, error)
 
+	HandleEmailEvents(params *HandleEmailEventsParams) (*HandleEmailEventsNoContent, error)
+
+	InviteOrgMember(params *InviteOrgMemberParams, authInfo runtime.ClientAuthInfoWriter) (*InviteOrgMemberNoContent, error)
+
+	ListNotificationTasks(params *ListNotificationTasksParams, authInfo runtime.ClientAuthInfoWriter) (*ListNotificationTasksOK, error)
+
+	ListOrgMembers(params *ListOrgMembersParams, authInfo runtime.ClientAuthInfoWriter) (*ListOrgMembersOK, error)
+
+	ListOrgs(params *ListOrgsParams, authInfo runtime.ClientAuthInfoWriter) (*ListOrgsOK, error)
+
+	ListUserNotifications(params *ListUserNotificationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListUserNotificationsOK, error)
+
 	Login(params *LoginParams) (*LoginOK, error)
 
 	Logout(params *LogoutParams, authInfo runtime.ClientAuthInfoWriter) (*LogoutNoContent, error)
 
+	MarkUserNotificationsRead(params *MarkUserNotificationsReadParams, authInfo runtime.ClientAuthInfoWriter) (*MarkUserNotificationsReadNoContent, error)
+
 	RecoveryPassword(params *RecoveryPasswordParams) (*RecoveryPasswordNoContent, error)
 
+	RemoveOrgMember(params *RemoveOrgMemberParams, authInfo runtime.ClientAuthInfoWriter) (*RemoveOrgMemberNoContent, error)
+
+	RequestPhoneVerification(params *RequestPhoneVerificationParams, authInfo runtime.ClientAuthInfoWriter) (*RequestPhoneVerificationNoContent, error)
+
+	ResendNotificationTask(params *ResendNotificationTaskParams, authInfo runtime.ClientAuthInfoWriter) (*ResendNotificationTaskOK, error)
+
+	StreamUserNotifications(params *StreamUserNotificationsParams, authInfo runtime.ClientAuthInfoWriter) (*StreamUserNotificationsOK, error)
+
+	TransferOrgOwnership(params *TransferOrgOwnershipParams, authInfo runtime.ClientAuthInfoWriter) (*TransferOrgOwnershipNoContent, error)
+
+	Unsubscribe(params *UnsubscribeParams) (*UnsubscribeOK, error)
+
+	UnsubscribeOneClick(params *UnsubscribeOneClickParams) (*UnsubscribeOneClickNoContent, error)
+
 	UpdateEmail(params *UpdateEmailParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateEmailNoContent, error)
 
+	UpdateNotificationSetting(params *UpdateNotificationSettingParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateNotificationSettingNoContent, error)
+
 	UpdatePassword(params *UpdatePasswordParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePasswordNoContent, error)
 
 	UpdateUsername(params *UpdateUsernameParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateUsernameNoContent, error)
@@ -51,11 +93,115 @@ type ClientService interface {
 
 	VerificationUsername(params *VerificationUsernameParams) (*VerificationUsernameNoContent, error)
 
+	VerifyPhone(params *VerifyPhoneParams, authInfo runtime.ClientAuthInfoWriter) (*VerifyPhoneNoContent, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
 /*
-  CreateRecoveryCode Creates a password recovery token and sends it to the email.
+AcceptOrgInvitation Joins the organization by the token from the invitation sent to the user's email.
+*/
+func (a *Client) AcceptOrgInvitation(params *AcceptOrgInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*AcceptOrgInvitationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAcceptOrgInvitationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "acceptOrgInvitation",
+		Method:             "POST",
+		PathPattern:        "/orgs/invitations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AcceptOrgInvitationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AcceptOrgInvitationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AcceptOrgInvitationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CancelNotificationTask Cancels the pending task.
+*/
+func (a *Client) CancelNotificationTask(params *CancelNotificationTaskParams, authInfo runtime.ClientAuthInfoWriter) (*CancelNotificationTaskNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelNotificationTaskParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "cancelNotificationTask",
+		Method:             "POST",
+		PathPattern:        "/admin/notifications/{id}/cancel",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CancelNotificationTaskReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CancelNotificationTaskNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CancelNotificationTaskDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CreateOrg Creates the organization owned by the user.
+*/
+func (a *Client) CreateOrg(params *CreateOrgParams, authInfo runtime.ClientAuthInfoWriter) (*CreateOrgOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateOrgParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "createOrg",
+		Method:             "POST",
+		PathPattern:        "/orgs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateOrgReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateOrgOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateOrgDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CreateRecoveryCode Creates a password recovery token and sends it to the email or by SMS to the verified phone.
 */
 func (a *Client) CreateRecoveryCode(params *CreateRecoveryCodeParams) (*CreateRecoveryCodeNoContent, error) {
 	// TODO: Validate the params before sending
@@ -88,7 +234,7 @@ func (a *Client) CreateRecoveryCode(params *CreateRecoveryCodeParams) (*CreateRe
 }
 
 /*
-  CreateUser New user registration. If it is not sent to username, it will be the userID
+CreateUser New user registration. If it is not sent to username, it will be the userID
 */
 func (a *Client) CreateUser(params *CreateUserParams) (*CreateUserOK, error) {
 	// TODO: Validate the params before sending
@@ -121,7 +267,41 @@ func (a *Client) CreateUser(params *CreateUserParams) (*CreateUserOK, error) {
 }
 
 /*
-  DeleteUser Deletion of your account.
+DeletePhone Removes the phone.
+*/
+func (a *Client) DeletePhone(params *DeletePhoneParams, authInfo runtime.ClientAuthInfoWriter) (*DeletePhoneNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeletePhoneParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deletePhone",
+		Method:             "DELETE",
+		PathPattern:        "/user/phone",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeletePhoneReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeletePhoneNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeletePhoneDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteUser Deletion of your account.
 */
 func (a *Client) DeleteUser(params *DeleteUserParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteUserNoContent, error) {
 	// TODO: Validate the params before sending
@@ -155,7 +335,75 @@ func (a *Client) DeleteUser(params *DeleteUserParams, authInfo runtime.ClientAut
 }
 
 /*
-  GetUser Open user profile.
+GetNotificationSettings User preferences for every kind of notification.
+*/
+func (a *Client) GetNotificationSettings(params *GetNotificationSettingsParams, authInfo runtime.ClientAuthInfoWriter) (*GetNotificationSettingsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetNotificationSettingsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getNotificationSettings",
+		Method:             "GET",
+		PathPattern:        "/user/notification-settings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetNotificationSettingsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetNotificationSettingsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetNotificationSettingsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetUnreadNotificationCount Number of unread notifications in the user inbox.
+*/
+func (a *Client) GetUnreadNotificationCount(params *GetUnreadNotificationCountParams, authInfo runtime.ClientAuthInfoWriter) (*GetUnreadNotificationCountOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetUnreadNotificationCountParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getUnreadNotificationCount",
+		Method:             "GET",
+		PathPattern:        "/user/notifications/unread-count",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetUnreadNotificationCountReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetUnreadNotificationCountOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetUnreadNotificationCountDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetUser Open user profile, the profile of the user itself is returned if the id isn't sent.
 */
 func (a *Client) GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter) (*GetUserOK, error) {
 	// TODO: Validate the params before sending
@@ -189,7 +437,7 @@ func (a *Client) GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoW
 }
 
 /*
-  GetUsers User search.
+GetUsers User search.
 */
 func (a *Client) GetUsers(params *GetUsersParams, authInfo runtime.ClientAuthInfoWriter) (*GetUsersOK, error) {
 	// TODO: Validate the params before sending
@@ -223,56 +471,58 @@ func (a *Client) GetUsers(params *GetUsersParams, authInfo runtime.ClientAuthInf
 }
 
 /*
-  Login Login for user.
+HandleEmailEvents Receives bounces and complaints from the email provider, the request must be signed by the provider.
 */
-func (a *Client) Login(params *LoginParams) (*LoginOK, error) {
+func (a *Client) HandleEmailEvents(params *HandleEmailEventsParams) (*HandleEmailEventsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewLoginParams()
+		params = NewHandleEmailEventsParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "login",
+		ID:                 "handleEmailEvents",
 		Method:             "POST",
-		PathPattern:        "/login",
+		PathPattern:        "/email/events",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &LoginReader{formats: a.formats},
+		Reader:             &HandleEmailEventsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*LoginOK)
+	success, ok := result.(*HandleEmailEventsNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*LoginDefault)
+	unexpectedSuccess := result.(*HandleEmailEventsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  Logout Logout for user
+	InviteOrgMember Sends the invitation with the accept link to the email, which is accepted after signing up if the email has no user yet.
+
+Admins invite members, the owner invites admins too.
 */
-func (a *Client) Logout(params *LogoutParams, authInfo runtime.ClientAuthInfoWriter) (*LogoutNoContent, error) {
+func (a *Client) InviteOrgMember(params *InviteOrgMemberParams, authInfo runtime.ClientAuthInfoWriter) (*InviteOrgMemberNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewLogoutParams()
+		params = NewInviteOrgMemberParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "logout",
+		ID:                 "inviteOrgMember",
 		Method:             "POST",
-		PathPattern:        "/logout",
+		PathPattern:        "/orgs/{id}/invitations",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &LogoutReader{formats: a.formats},
+		Reader:             &InviteOrgMemberReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -280,66 +530,67 @@ func (a *Client) Logout(params *LogoutParams, authInfo runtime.ClientAuthInfoWri
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*LogoutNoContent)
+	success, ok := result.(*InviteOrgMemberNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*LogoutDefault)
+	unexpectedSuccess := result.(*InviteOrgMemberDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  RecoveryPassword Updates the password of the user who owns this recovery code.
+ListNotificationTasks Notification tasks search, latest first.
 */
-func (a *Client) RecoveryPassword(params *RecoveryPasswordParams) (*RecoveryPasswordNoContent, error) {
+func (a *Client) ListNotificationTasks(params *ListNotificationTasksParams, authInfo runtime.ClientAuthInfoWriter) (*ListNotificationTasksOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewRecoveryPasswordParams()
+		params = NewListNotificationTasksParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "recoveryPassword",
-		Method:             "POST",
-		PathPattern:        "/recovery-password",
+		ID:                 "listNotificationTasks",
+		Method:             "GET",
+		PathPattern:        "/admin/notifications",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &RecoveryPasswordReader{formats: a.formats},
+		Reader:             &ListNotificationTasksReader{formats: a.formats},
+		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*RecoveryPasswordNoContent)
+	success, ok := result.(*ListNotificationTasksOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*RecoveryPasswordDefault)
+	unexpectedSuccess := result.(*ListNotificationTasksDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  UpdateEmail Change email.
+ListOrgMembers Members of the organization in order of joining, the user must be a member.
 */
-func (a *Client) UpdateEmail(params *UpdateEmailParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateEmailNoContent, error) {
+func (a *Client) ListOrgMembers(params *ListOrgMembersParams, authInfo runtime.ClientAuthInfoWriter) (*ListOrgMembersOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewUpdateEmailParams()
+		params = NewListOrgMembersParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "updateEmail",
-		Method:             "PATCH",
-		PathPattern:        "/user/email",
+		ID:                 "listOrgMembers",
+		Method:             "GET",
+		PathPattern:        "/orgs/{id}/members",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &UpdateEmailReader{formats: a.formats},
+		Reader:             &ListOrgMembersReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -347,33 +598,33 @@ func (a *Client) UpdateEmail(params *UpdateEmailParams, authInfo runtime.ClientA
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*UpdateEmailNoContent)
+	success, ok := result.(*ListOrgMembersOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*UpdateEmailDefault)
+	unexpectedSuccess := result.(*ListOrgMembersDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  UpdatePassword Change password.
+ListOrgs Organizations of the user.
 */
-func (a *Client) UpdatePassword(params *UpdatePasswordParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePasswordNoContent, error) {
+func (a *Client) ListOrgs(params *ListOrgsParams, authInfo runtime.ClientAuthInfoWriter) (*ListOrgsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewUpdatePasswordParams()
+		params = NewListOrgsParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "updatePassword",
-		Method:             "PATCH",
-		PathPattern:        "/user/password",
+		ID:                 "listOrgs",
+		Method:             "GET",
+		PathPattern:        "/orgs",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &UpdatePasswordReader{formats: a.formats},
+		Reader:             &ListOrgsReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -381,33 +632,33 @@ func (a *Client) UpdatePassword(params *UpdatePasswordParams, authInfo runtime.C
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*UpdatePasswordNoContent)
+	success, ok := result.(*ListOrgsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*UpdatePasswordDefault)
+	unexpectedSuccess := result.(*ListOrgsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  UpdateUsername Change username.
+ListUserNotifications Notifications from the user inbox, latest first.
 */
-func (a *Client) UpdateUsername(params *UpdateUsernameParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateUsernameNoContent, error) {
+func (a *Client) ListUserNotifications(params *ListUserNotificationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListUserNotificationsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewUpdateUsernameParams()
+		params = NewListUserNotificationsParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "updateUsername",
-		Method:             "PATCH",
-		PathPattern:        "/user/username",
+		ID:                 "listUserNotifications",
+		Method:             "GET",
+		PathPattern:        "/user/notifications",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &UpdateUsernameReader{formats: a.formats},
+		Reader:             &ListUserNotificationsReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -415,73 +666,583 @@ func (a *Client) UpdateUsername(params *UpdateUsernameParams, authInfo runtime.C
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*UpdateUsernameNoContent)
+	success, ok := result.(*ListUserNotificationsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*UpdateUsernameDefault)
+	unexpectedSuccess := result.(*ListUserNotificationsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  VerificationEmail verification email API
+Login Login for user.
 */
-func (a *Client) VerificationEmail(params *VerificationEmailParams) (*VerificationEmailNoContent, error) {
+func (a *Client) Login(params *LoginParams) (*LoginOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewVerificationEmailParams()
+		params = NewLoginParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "verificationEmail",
+		ID:                 "login",
 		Method:             "POST",
-		PathPattern:        "/email/verification",
+		PathPattern:        "/login",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &VerificationEmailReader{formats: a.formats},
+		Reader:             &LoginReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*VerificationEmailNoContent)
+	success, ok := result.(*LoginOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*VerificationEmailDefault)
+	unexpectedSuccess := result.(*LoginDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  VerificationUsername verification username API
+Logout Logout for user
 */
-func (a *Client) VerificationUsername(params *VerificationUsernameParams) (*VerificationUsernameNoContent, error) {
+func (a *Client) Logout(params *LogoutParams, authInfo runtime.ClientAuthInfoWriter) (*LogoutNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewVerificationUsernameParams()
+		params = NewLogoutParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "verificationUsername",
+		ID:                 "logout",
 		Method:             "POST",
-		PathPattern:        "/username/verification",
+		PathPattern:        "/logout",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &VerificationUsernameReader{formats: a.formats},
+		Reader:             &LogoutReader{formats: a.formats},
+		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*VerificationUsernameNoContent)
+	success, ok := result.(*LogoutNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*LogoutDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+MarkUserNotificationsRead Marks notifications from the user inbox as read, all of them if ids are not passed.
+*/
+func (a *Client) MarkUserNotificationsRead(params *MarkUserNotificationsReadParams, authInfo runtime.ClientAuthInfoWriter) (*MarkUserNotificationsReadNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMarkUserNotificationsReadParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "markUserNotificationsRead",
+		Method:             "POST",
+		PathPattern:        "/user/notifications/read",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &MarkUserNotificationsReadReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MarkUserNotificationsReadNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*MarkUserNotificationsReadDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RecoveryPassword Updates the password of the user who owns this recovery code.
+*/
+func (a *Client) RecoveryPassword(params *RecoveryPasswordParams) (*RecoveryPasswordNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRecoveryPasswordParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "recoveryPassword",
+		Method:             "POST",
+		PathPattern:        "/recovery-password",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RecoveryPasswordReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RecoveryPasswordNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RecoveryPasswordDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+	RemoveOrgMember Removes the member, users leave organizations by removing themselves.
+
+The owner removes anyone, admins remove members, the owner must transfer ownership before leaving.
+*/
+func (a *Client) RemoveOrgMember(params *RemoveOrgMemberParams, authInfo runtime.ClientAuthInfoWriter) (*RemoveOrgMemberNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRemoveOrgMemberParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "removeOrgMember",
+		Method:             "DELETE",
+		PathPattern:        "/orgs/{id}/members/{userId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RemoveOrgMemberReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RemoveOrgMemberNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RemoveOrgMemberDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RequestPhoneVerification Sends by SMS a code to verify the phone, the phone is set after the verification.
+*/
+func (a *Client) RequestPhoneVerification(params *RequestPhoneVerificationParams, authInfo runtime.ClientAuthInfoWriter) (*RequestPhoneVerificationNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRequestPhoneVerificationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "requestPhoneVerification",
+		Method:             "POST",
+		PathPattern:        "/user/phone",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RequestPhoneVerificationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RequestPhoneVerificationNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RequestPhoneVerificationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ResendNotificationTask Creates a new pending task with the same recipient, kind and payload.
+*/
+func (a *Client) ResendNotificationTask(params *ResendNotificationTaskParams, authInfo runtime.ClientAuthInfoWriter) (*ResendNotificationTaskOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewResendNotificationTaskParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "resendNotificationTask",
+		Method:             "POST",
+		PathPattern:        "/admin/notifications/{id}/resend",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ResendNotificationTaskReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ResendNotificationTaskOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ResendNotificationTaskDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+	StreamUserNotifications Server-Sent Events stream of notifications added to the user inbox.
+
+Every notification is sent as `notification` event with UserNotification in data and its id as event id.
+*/
+func (a *Client) StreamUserNotifications(params *StreamUserNotificationsParams, authInfo runtime.ClientAuthInfoWriter) (*StreamUserNotificationsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStreamUserNotificationsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "streamUserNotifications",
+		Method:             "GET",
+		PathPattern:        "/user/notifications/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StreamUserNotificationsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StreamUserNotificationsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*StreamUserNotificationsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+TransferOrgOwnership Makes the member the owner, the user becomes an admin.
+*/
+func (a *Client) TransferOrgOwnership(params *TransferOrgOwnershipParams, authInfo runtime.ClientAuthInfoWriter) (*TransferOrgOwnershipNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTransferOrgOwnershipParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "transferOrgOwnership",
+		Method:             "POST",
+		PathPattern:        "/orgs/{id}/owner",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &TransferOrgOwnershipReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TransferOrgOwnershipNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*TransferOrgOwnershipDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+Unsubscribe Returns the kind of notification the link from email unsubscribes from without changing anything, the unsubscription is confirmed by POST with the same token.
+*/
+func (a *Client) Unsubscribe(params *UnsubscribeParams) (*UnsubscribeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUnsubscribeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "unsubscribe",
+		Method:             "GET",
+		PathPattern:        "/unsubscribe",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UnsubscribeReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UnsubscribeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UnsubscribeDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UnsubscribeOneClick Unsubscribes from the kind of notification, sent by the confirmation of the link from email or by email client as one-click unsubscribe (RFC 8058) using List-Unsubscribe header.
+*/
+func (a *Client) UnsubscribeOneClick(params *UnsubscribeOneClickParams) (*UnsubscribeOneClickNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUnsubscribeOneClickParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "unsubscribeOneClick",
+		Method:             "POST",
+		PathPattern:        "/unsubscribe",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/x-www-form-urlencoded"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UnsubscribeOneClickReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UnsubscribeOneClickNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UnsubscribeOneClickDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateEmail Change email.
+*/
+func (a *Client) UpdateEmail(params *UpdateEmailParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateEmailNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateEmailParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updateEmail",
+		Method:             "PATCH",
+		PathPattern:        "/user/email",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateEmailReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateEmailNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateEmailDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateNotificationSetting Opt-in or opt-out of the kind of notification.
+*/
+func (a *Client) UpdateNotificationSetting(params *UpdateNotificationSettingParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateNotificationSettingNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateNotificationSettingParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updateNotificationSetting",
+		Method:             "PATCH",
+		PathPattern:        "/user/notification-settings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateNotificationSettingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateNotificationSettingNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateNotificationSettingDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdatePassword Change password.
+*/
+func (a *Client) UpdatePassword(params *UpdatePasswordParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePasswordNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdatePasswordParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updatePassword",
+		Method:             "PATCH",
+		PathPattern:        "/user/password",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdatePasswordReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdatePasswordNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdatePasswordDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateUsername Change username.
+*/
+func (a *Client) UpdateUsername(params *UpdateUsernameParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateUsernameNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateUsernameParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updateUsername",
+		Method:             "PATCH",
+		PathPattern:        "/user/username",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateUsernameReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateUsernameNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateUsernameDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+VerificationEmail verification email API
+*/
+func (a *Client) VerificationEmail(params *VerificationEmailParams) (*VerificationEmailNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewVerificationEmailParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "verificationEmail",
+		Method:             "POST",
+		PathPattern:        "/email/verification",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &VerificationEmailReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*VerificationEmailNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*VerificationEmailDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+VerificationUsername verification username API
+*/
+func (a *Client) VerificationUsername(params *VerificationUsernameParams) (*VerificationUsernameNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewVerificationUsernameParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "verificationUsername",
+		Method:             "POST",
+		PathPattern:        "/username/verification",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &VerificationUsernameReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*VerificationUsernameNoContent)
 	if ok {
 		return success, nil
 	}
@@ -490,6 +1251,40 @@ func (a *Client) VerificationUsername(params *VerificationUsernameParams) (*Veri
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+VerifyPhone Sets the phone by the code sent to it.
+*/
+func (a *Client) VerifyPhone(params *VerifyPhoneParams, authInfo runtime.ClientAuthInfoWriter) (*VerifyPhoneNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewVerifyPhoneParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "verifyPhone",
+		Method:             "POST",
+		PathPattern:        "/user/phone/verify",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &VerifyPhoneReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*VerifyPhoneNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*VerifyPhoneDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
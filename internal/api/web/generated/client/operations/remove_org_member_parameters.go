@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewRemoveOrgMemberParams creates a new RemoveOrgMemberParams object
+// with the default values initialized.
+func NewRemoveOrgMemberParams() *RemoveOrgMemberParams {
+	var ()
+	return &RemoveOrgMemberParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRemoveOrgMemberParamsWithTimeout creates a new RemoveOrgMemberParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRemoveOrgMemberParamsWithTimeout(timeout time.Duration) *RemoveOrgMemberParams {
+	var ()
+	return &RemoveOrgMemberParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRemoveOrgMemberParamsWithContext creates a new RemoveOrgMemberParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRemoveOrgMemberParamsWithContext(ctx context.Context) *RemoveOrgMemberParams {
+	var ()
+	return &RemoveOrgMemberParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRemoveOrgMemberParamsWithHTTPClient creates a new RemoveOrgMemberParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRemoveOrgMemberParamsWithHTTPClient(client *http.Client) *RemoveOrgMemberParams {
+	var ()
+	return &RemoveOrgMemberParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RemoveOrgMemberParams contains all the parameters to send to the API endpoint
+for the remove org member operation typically these are written to a http.Request
+*/
+type RemoveOrgMemberParams struct {
+
+	/*ID*/
+	ID int32
+	/*UserID*/
+	UserID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the remove org member params
+func (o *RemoveOrgMemberParams) WithTimeout(timeout time.Duration) *RemoveOrgMemberParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the remove org member params
+func (o *RemoveOrgMemberParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the remove org member params
+func (o *RemoveOrgMemberParams) WithContext(ctx context.Context) *RemoveOrgMemberParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the remove org member params
+func (o *RemoveOrgMemberParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the remove org member params
+func (o *RemoveOrgMemberParams) WithHTTPClient(client *http.Client) *RemoveOrgMemberParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the remove org member params
+func (o *RemoveOrgMemberParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the remove org member params
+func (o *RemoveOrgMemberParams) WithID(id int32) *RemoveOrgMemberParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the remove org member params
+func (o *RemoveOrgMemberParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WithUserID adds the userID to the remove org member params
+func (o *RemoveOrgMemberParams) WithUserID(userID strfmt.UUID) *RemoveOrgMemberParams {
+	o.SetUserID(userID)
+	return o
+}
+
+// SetUserID adds the userId to the remove org member params
+func (o *RemoveOrgMemberParams) SetUserID(userID strfmt.UUID) {
+	o.UserID = userID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RemoveOrgMemberParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	// path param userId
+	if err := r.SetPathParam("userId", o.UserID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// RemoveOrgMemberReader is a Reader for the RemoveOrgMember structure.
+type RemoveOrgMemberReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RemoveOrgMemberReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRemoveOrgMemberNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRemoveOrgMemberDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRemoveOrgMemberNoContent creates a RemoveOrgMemberNoContent with default headers values
+func NewRemoveOrgMemberNoContent() *RemoveOrgMemberNoContent {
+	return &RemoveOrgMemberNoContent{}
+}
+
+/*
+RemoveOrgMemberNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type RemoveOrgMemberNoContent struct {
+}
+
+func (o *RemoveOrgMemberNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /orgs/{id}/members/{userId}][%d] removeOrgMemberNoContent ", 204)
+}
+
+func (o *RemoveOrgMemberNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRemoveOrgMemberDefault creates a RemoveOrgMemberDefault with default headers values
+func NewRemoveOrgMemberDefault(code int) *RemoveOrgMemberDefault {
+	return &RemoveOrgMemberDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RemoveOrgMemberDefault handles this case with default header values.
+
+Generic error response.
+*/
+type RemoveOrgMemberDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the remove org member default response
+func (o *RemoveOrgMemberDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RemoveOrgMemberDefault) Error() string {
+	return fmt.Sprintf("[DELETE /orgs/{id}/members/{userId}][%d] removeOrgMember default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RemoveOrgMemberDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RemoveOrgMemberDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
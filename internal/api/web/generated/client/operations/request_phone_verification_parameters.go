@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRequestPhoneVerificationParams creates a new RequestPhoneVerificationParams object
+// with the default values initialized.
+func NewRequestPhoneVerificationParams() *RequestPhoneVerificationParams {
+	var ()
+	return &RequestPhoneVerificationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRequestPhoneVerificationParamsWithTimeout creates a new RequestPhoneVerificationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRequestPhoneVerificationParamsWithTimeout(timeout time.Duration) *RequestPhoneVerificationParams {
+	var ()
+	return &RequestPhoneVerificationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRequestPhoneVerificationParamsWithContext creates a new RequestPhoneVerificationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRequestPhoneVerificationParamsWithContext(ctx context.Context) *RequestPhoneVerificationParams {
+	var ()
+	return &RequestPhoneVerificationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRequestPhoneVerificationParamsWithHTTPClient creates a new RequestPhoneVerificationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRequestPhoneVerificationParamsWithHTTPClient(client *http.Client) *RequestPhoneVerificationParams {
+	var ()
+	return &RequestPhoneVerificationParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RequestPhoneVerificationParams contains all the parameters to send to the API endpoint
+for the request phone verification operation typically these are written to a http.Request
+*/
+type RequestPhoneVerificationParams struct {
+
+	/*Args*/
+	Args RequestPhoneVerificationBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the request phone verification params
+func (o *RequestPhoneVerificationParams) WithTimeout(timeout time.Duration) *RequestPhoneVerificationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the request phone verification params
+func (o *RequestPhoneVerificationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the request phone verification params
+func (o *RequestPhoneVerificationParams) WithContext(ctx context.Context) *RequestPhoneVerificationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the request phone verification params
+func (o *RequestPhoneVerificationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the request phone verification params
+func (o *RequestPhoneVerificationParams) WithHTTPClient(client *http.Client) *RequestPhoneVerificationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the request phone verification params
+func (o *RequestPhoneVerificationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the request phone verification params
+func (o *RequestPhoneVerificationParams) WithArgs(args RequestPhoneVerificationBody) *RequestPhoneVerificationParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the request phone verification params
+func (o *RequestPhoneVerificationParams) SetArgs(args RequestPhoneVerificationBody) {
+	o.Args = args
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RequestPhoneVerificationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
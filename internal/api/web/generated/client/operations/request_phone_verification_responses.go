@@ -0,0 +1,164 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// RequestPhoneVerificationReader is a Reader for the RequestPhoneVerification structure.
+type RequestPhoneVerificationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RequestPhoneVerificationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRequestPhoneVerificationNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRequestPhoneVerificationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRequestPhoneVerificationNoContent creates a RequestPhoneVerificationNoContent with default headers values
+func NewRequestPhoneVerificationNoContent() *RequestPhoneVerificationNoContent {
+	return &RequestPhoneVerificationNoContent{}
+}
+
+/*
+RequestPhoneVerificationNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type RequestPhoneVerificationNoContent struct {
+}
+
+func (o *RequestPhoneVerificationNoContent) Error() string {
+	return fmt.Sprintf("[POST /user/phone][%d] requestPhoneVerificationNoContent ", 204)
+}
+
+func (o *RequestPhoneVerificationNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRequestPhoneVerificationDefault creates a RequestPhoneVerificationDefault with default headers values
+func NewRequestPhoneVerificationDefault(code int) *RequestPhoneVerificationDefault {
+	return &RequestPhoneVerificationDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RequestPhoneVerificationDefault handles this case with default header values.
+
+Generic error response.
+*/
+type RequestPhoneVerificationDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the request phone verification default response
+func (o *RequestPhoneVerificationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RequestPhoneVerificationDefault) Error() string {
+	return fmt.Sprintf("[POST /user/phone][%d] requestPhoneVerification default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RequestPhoneVerificationDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RequestPhoneVerificationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+RequestPhoneVerificationBody request phone verification body
+swagger:model RequestPhoneVerificationBody
+*/
+type RequestPhoneVerificationBody struct {
+
+	// phone
+	// Required: true
+	Phone models.Phone `json:"phone"`
+}
+
+// Validate validates this request phone verification body
+func (o *RequestPhoneVerificationBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validatePhone(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RequestPhoneVerificationBody) validatePhone(formats strfmt.Registry) error {
+
+	if err := o.Phone.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "phone")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *RequestPhoneVerificationBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *RequestPhoneVerificationBody) UnmarshalBinary(b []byte) error {
+	var res RequestPhoneVerificationBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewResendNotificationTaskParams creates a new ResendNotificationTaskParams object
+// with the default values initialized.
+func NewResendNotificationTaskParams() *ResendNotificationTaskParams {
+	var ()
+	return &ResendNotificationTaskParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewResendNotificationTaskParamsWithTimeout creates a new ResendNotificationTaskParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewResendNotificationTaskParamsWithTimeout(timeout time.Duration) *ResendNotificationTaskParams {
+	var ()
+	return &ResendNotificationTaskParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewResendNotificationTaskParamsWithContext creates a new ResendNotificationTaskParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewResendNotificationTaskParamsWithContext(ctx context.Context) *ResendNotificationTaskParams {
+	var ()
+	return &ResendNotificationTaskParams{
+
+		Context: ctx,
+	}
+}
+
+// NewResendNotificationTaskParamsWithHTTPClient creates a new ResendNotificationTaskParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewResendNotificationTaskParamsWithHTTPClient(client *http.Client) *ResendNotificationTaskParams {
+	var ()
+	return &ResendNotificationTaskParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ResendNotificationTaskParams contains all the parameters to send to the API endpoint
+for the resend notification task operation typically these are written to a http.Request
+*/
+type ResendNotificationTaskParams struct {
+
+	/*ID*/
+	ID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the resend notification task params
+func (o *ResendNotificationTaskParams) WithTimeout(timeout time.Duration) *ResendNotificationTaskParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the resend notification task params
+func (o *ResendNotificationTaskParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the resend notification task params
+func (o *ResendNotificationTaskParams) WithContext(ctx context.Context) *ResendNotificationTaskParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the resend notification task params
+func (o *ResendNotificationTaskParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the resend notification task params
+func (o *ResendNotificationTaskParams) WithHTTPClient(client *http.Client) *ResendNotificationTaskParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the resend notification task params
+func (o *ResendNotificationTaskParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the resend notification task params
+func (o *ResendNotificationTaskParams) WithID(id int32) *ResendNotificationTaskParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the resend notification task params
+func (o *ResendNotificationTaskParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ResendNotificationTaskParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ResendNotificationTaskReader is a Reader for the ResendNotificationTask structure.
+type ResendNotificationTaskReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ResendNotificationTaskReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewResendNotificationTaskOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewResendNotificationTaskDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewResendNotificationTaskOK creates a ResendNotificationTaskOK with default headers values
+func NewResendNotificationTaskOK() *ResendNotificationTaskOK {
+	return &ResendNotificationTaskOK{}
+}
+
+/*
+ResendNotificationTaskOK handles this case with default header values.
+
+OK
+*/
+type ResendNotificationTaskOK struct {
+	Payload *models.NotificationTask
+}
+
+func (o *ResendNotificationTaskOK) Error() string {
+	return fmt.Sprintf("[POST /admin/notifications/{id}/resend][%d] resendNotificationTaskOK  %+v", 200, o.Payload)
+}
+
+func (o *ResendNotificationTaskOK) GetPayload() *models.NotificationTask {
+	return o.Payload
+}
+
+func (o *ResendNotificationTaskOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NotificationTask)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewResendNotificationTaskDefault creates a ResendNotificationTaskDefault with default headers values
+func NewResendNotificationTaskDefault(code int) *ResendNotificationTaskDefault {
+	return &ResendNotificationTaskDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ResendNotificationTaskDefault handles this case with default header values.
+
+Generic error response.
+*/
+type ResendNotificationTaskDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the resend notification task default response
+func (o *ResendNotificationTaskDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ResendNotificationTaskDefault) Error() string {
+	return fmt.Sprintf("[POST /admin/notifications/{id}/resend][%d] resendNotificationTask default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ResendNotificationTaskDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ResendNotificationTaskDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewStreamUserNotificationsParams creates a new StreamUserNotificationsParams object
+// with the default values initialized.
+func NewStreamUserNotificationsParams() *StreamUserNotificationsParams {
+	var ()
+	return &StreamUserNotificationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStreamUserNotificationsParamsWithTimeout creates a new StreamUserNotificationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewStreamUserNotificationsParamsWithTimeout(timeout time.Duration) *StreamUserNotificationsParams {
+	var ()
+	return &StreamUserNotificationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewStreamUserNotificationsParamsWithContext creates a new StreamUserNotificationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewStreamUserNotificationsParamsWithContext(ctx context.Context) *StreamUserNotificationsParams {
+	var ()
+	return &StreamUserNotificationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewStreamUserNotificationsParamsWithHTTPClient creates a new StreamUserNotificationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewStreamUserNotificationsParamsWithHTTPClient(client *http.Client) *StreamUserNotificationsParams {
+	var ()
+	return &StreamUserNotificationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StreamUserNotificationsParams contains all the parameters to send to the API endpoint
+for the stream user notifications operation typically these are written to a http.Request
+*/
+type StreamUserNotificationsParams struct {
+
+	/*LastEventID
+	  Resume the stream after the notification with this id.
+
+	*/
+	LastEventID *int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the stream user notifications params
+func (o *StreamUserNotificationsParams) WithTimeout(timeout time.Duration) *StreamUserNotificationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the stream user notifications params
+func (o *StreamUserNotificationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the stream user notifications params
+func (o *StreamUserNotificationsParams) WithContext(ctx context.Context) *StreamUserNotificationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the stream user notifications params
+func (o *StreamUserNotificationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the stream user notifications params
+func (o *StreamUserNotificationsParams) WithHTTPClient(client *http.Client) *StreamUserNotificationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the stream user notifications params
+func (o *StreamUserNotificationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventID adds the lastEventID to the stream user notifications params
+func (o *StreamUserNotificationsParams) WithLastEventID(lastEventID *int32) *StreamUserNotificationsParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the stream user notifications params
+func (o *StreamUserNotificationsParams) SetLastEventID(lastEventID *int32) {
+	o.LastEventID = lastEventID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StreamUserNotificationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventID != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", swag.FormatInt32(*o.LastEventID)); err != nil {
+			return err
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
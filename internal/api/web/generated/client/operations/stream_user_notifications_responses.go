@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// StreamUserNotificationsReader is a Reader for the StreamUserNotifications structure.
+type StreamUserNotificationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StreamUserNotificationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStreamUserNotificationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewStreamUserNotificationsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewStreamUserNotificationsOK creates a StreamUserNotificationsOK with default headers values
+func NewStreamUserNotificationsOK() *StreamUserNotificationsOK {
+	return &StreamUserNotificationsOK{}
+}
+
+/*
+StreamUserNotificationsOK handles this case with default header values.
+
+OK
+*/
+type StreamUserNotificationsOK struct {
+	Payload string
+}
+
+func (o *StreamUserNotificationsOK) Error() string {
+	return fmt.Sprintf("[GET /user/notifications/stream][%d] streamUserNotificationsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamUserNotificationsOK) GetPayload() string {
+	return o.Payload
+}
+
+func (o *StreamUserNotificationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamUserNotificationsDefault creates a StreamUserNotificationsDefault with default headers values
+func NewStreamUserNotificationsDefault(code int) *StreamUserNotificationsDefault {
+	return &StreamUserNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+StreamUserNotificationsDefault handles this case with default header values.
+
+Generic error response.
+*/
+type StreamUserNotificationsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the stream user notifications default response
+func (o *StreamUserNotificationsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *StreamUserNotificationsDefault) Error() string {
+	return fmt.Sprintf("[GET /user/notifications/stream][%d] streamUserNotifications default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *StreamUserNotificationsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *StreamUserNotificationsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
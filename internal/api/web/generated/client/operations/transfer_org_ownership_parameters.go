@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewTransferOrgOwnershipParams creates a new TransferOrgOwnershipParams object
+// with the default values initialized.
+func NewTransferOrgOwnershipParams() *TransferOrgOwnershipParams {
+	var ()
+	return &TransferOrgOwnershipParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewTransferOrgOwnershipParamsWithTimeout creates a new TransferOrgOwnershipParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewTransferOrgOwnershipParamsWithTimeout(timeout time.Duration) *TransferOrgOwnershipParams {
+	var ()
+	return &TransferOrgOwnershipParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewTransferOrgOwnershipParamsWithContext creates a new TransferOrgOwnershipParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewTransferOrgOwnershipParamsWithContext(ctx context.Context) *TransferOrgOwnershipParams {
+	var ()
+	return &TransferOrgOwnershipParams{
+
+		Context: ctx,
+	}
+}
+
+// NewTransferOrgOwnershipParamsWithHTTPClient creates a new TransferOrgOwnershipParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewTransferOrgOwnershipParamsWithHTTPClient(client *http.Client) *TransferOrgOwnershipParams {
+	var ()
+	return &TransferOrgOwnershipParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+TransferOrgOwnershipParams contains all the parameters to send to the API endpoint
+for the transfer org ownership operation typically these are written to a http.Request
+*/
+type TransferOrgOwnershipParams struct {
+
+	/*Args*/
+	Args TransferOrgOwnershipBody
+	/*ID*/
+	ID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) WithTimeout(timeout time.Duration) *TransferOrgOwnershipParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) WithContext(ctx context.Context) *TransferOrgOwnershipParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) WithHTTPClient(client *http.Client) *TransferOrgOwnershipParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) WithArgs(args TransferOrgOwnershipBody) *TransferOrgOwnershipParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) SetArgs(args TransferOrgOwnershipBody) {
+	o.Args = args
+}
+
+// WithID adds the id to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) WithID(id int32) *TransferOrgOwnershipParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the transfer org ownership params
+func (o *TransferOrgOwnershipParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *TransferOrgOwnershipParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// TransferOrgOwnershipReader is a Reader for the TransferOrgOwnership structure.
+type TransferOrgOwnershipReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *TransferOrgOwnershipReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewTransferOrgOwnershipNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewTransferOrgOwnershipDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewTransferOrgOwnershipNoContent creates a TransferOrgOwnershipNoContent with default headers values
+func NewTransferOrgOwnershipNoContent() *TransferOrgOwnershipNoContent {
+	return &TransferOrgOwnershipNoContent{}
+}
+
+/*
+TransferOrgOwnershipNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type TransferOrgOwnershipNoContent struct {
+}
+
+func (o *TransferOrgOwnershipNoContent) Error() string {
+	return fmt.Sprintf("[POST /orgs/{id}/owner][%d] transferOrgOwnershipNoContent ", 204)
+}
+
+func (o *TransferOrgOwnershipNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewTransferOrgOwnershipDefault creates a TransferOrgOwnershipDefault with default headers values
+func NewTransferOrgOwnershipDefault(code int) *TransferOrgOwnershipDefault {
+	return &TransferOrgOwnershipDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+TransferOrgOwnershipDefault handles this case with default header values.
+
+Generic error response.
+*/
+type TransferOrgOwnershipDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the transfer org ownership default response
+func (o *TransferOrgOwnershipDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *TransferOrgOwnershipDefault) Error() string {
+	return fmt.Sprintf("[POST /orgs/{id}/owner][%d] transferOrgOwnership default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *TransferOrgOwnershipDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *TransferOrgOwnershipDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+TransferOrgOwnershipBody transfer org ownership body
+swagger:model TransferOrgOwnershipBody
+*/
+type TransferOrgOwnershipBody struct {
+
+	// user Id
+	// Required: true
+	// Format: uuid
+	UserID models.UserID `json:"userId"`
+}
+
+// Validate validates this transfer org ownership body
+func (o *TransferOrgOwnershipBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateUserID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *TransferOrgOwnershipBody) validateUserID(formats strfmt.Registry) error {
+
+	if err := o.UserID.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "userId")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *TransferOrgOwnershipBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *TransferOrgOwnershipBody) UnmarshalBinary(b []byte) error {
+	var res TransferOrgOwnershipBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,166 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUnsubscribeOneClickParams creates a new UnsubscribeOneClickParams object
+// with the default values initialized.
+func NewUnsubscribeOneClickParams() *UnsubscribeOneClickParams {
+	var ()
+	return &UnsubscribeOneClickParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUnsubscribeOneClickParamsWithTimeout creates a new UnsubscribeOneClickParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUnsubscribeOneClickParamsWithTimeout(timeout time.Duration) *UnsubscribeOneClickParams {
+	var ()
+	return &UnsubscribeOneClickParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUnsubscribeOneClickParamsWithContext creates a new UnsubscribeOneClickParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUnsubscribeOneClickParamsWithContext(ctx context.Context) *UnsubscribeOneClickParams {
+	var ()
+	return &UnsubscribeOneClickParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUnsubscribeOneClickParamsWithHTTPClient creates a new UnsubscribeOneClickParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUnsubscribeOneClickParamsWithHTTPClient(client *http.Client) *UnsubscribeOneClickParams {
+	var ()
+	return &UnsubscribeOneClickParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UnsubscribeOneClickParams contains all the parameters to send to the API endpoint
+for the unsubscribe one click operation typically these are written to a http.Request
+*/
+type UnsubscribeOneClickParams struct {
+
+	/*ListUnsubscribe*/
+	ListUnsubscribe *string
+	/*Token*/
+	Token string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) WithTimeout(timeout time.Duration) *UnsubscribeOneClickParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) WithContext(ctx context.Context) *UnsubscribeOneClickParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) WithHTTPClient(client *http.Client) *UnsubscribeOneClickParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithListUnsubscribe adds the listUnsubscribe to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) WithListUnsubscribe(listUnsubscribe *string) *UnsubscribeOneClickParams {
+	o.SetListUnsubscribe(listUnsubscribe)
+	return o
+}
+
+// SetListUnsubscribe adds the listUnsubscribe to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) SetListUnsubscribe(listUnsubscribe *string) {
+	o.ListUnsubscribe = listUnsubscribe
+}
+
+// WithToken adds the token to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) WithToken(token string) *UnsubscribeOneClickParams {
+	o.SetToken(token)
+	return o
+}
+
+// SetToken adds the token to the unsubscribe one click params
+func (o *UnsubscribeOneClickParams) SetToken(token string) {
+	o.Token = token
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UnsubscribeOneClickParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ListUnsubscribe != nil {
+
+		// form param List-Unsubscribe
+		var frListUnsubscribe string
+		if o.ListUnsubscribe != nil {
+			frListUnsubscribe = *o.ListUnsubscribe
+		}
+		fListUnsubscribe := frListUnsubscribe
+		if fListUnsubscribe != "" {
+			if err := r.SetFormParam("List-Unsubscribe", fListUnsubscribe); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// query param token
+	qrToken := o.Token
+	qToken := qrToken
+	if qToken != "" {
+		if err := r.SetQueryParam("token", qToken); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// UnsubscribeOneClickReader is a Reader for the UnsubscribeOneClick structure.
+type UnsubscribeOneClickReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UnsubscribeOneClickReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewUnsubscribeOneClickNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUnsubscribeOneClickDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUnsubscribeOneClickNoContent creates a UnsubscribeOneClickNoContent with default headers values
+func NewUnsubscribeOneClickNoContent() *UnsubscribeOneClickNoContent {
+	return &UnsubscribeOneClickNoContent{}
+}
+
+/*
+UnsubscribeOneClickNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type UnsubscribeOneClickNoContent struct {
+}
+
+func (o *UnsubscribeOneClickNoContent) Error() string {
+	return fmt.Sprintf("[POST /unsubscribe][%d] unsubscribeOneClickNoContent ", 204)
+}
+
+func (o *UnsubscribeOneClickNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUnsubscribeOneClickDefault creates a UnsubscribeOneClickDefault with default headers values
+func NewUnsubscribeOneClickDefault(code int) *UnsubscribeOneClickDefault {
+	return &UnsubscribeOneClickDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UnsubscribeOneClickDefault handles this case with default header values.
+
+Generic error response.
+*/
+type UnsubscribeOneClickDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the unsubscribe one click default response
+func (o *UnsubscribeOneClickDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UnsubscribeOneClickDefault) Error() string {
+	return fmt.Sprintf("[POST /unsubscribe][%d] unsubscribeOneClick default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UnsubscribeOneClickDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UnsubscribeOneClickDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
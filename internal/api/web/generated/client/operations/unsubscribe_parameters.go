@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUnsubscribeParams creates a new UnsubscribeParams object
+// with the default values initialized.
+func NewUnsubscribeParams() *UnsubscribeParams {
+	var ()
+	return &UnsubscribeParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUnsubscribeParamsWithTimeout creates a new UnsubscribeParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUnsubscribeParamsWithTimeout(timeout time.Duration) *UnsubscribeParams {
+	var ()
+	return &UnsubscribeParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUnsubscribeParamsWithContext creates a new UnsubscribeParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUnsubscribeParamsWithContext(ctx context.Context) *UnsubscribeParams {
+	var ()
+	return &UnsubscribeParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUnsubscribeParamsWithHTTPClient creates a new UnsubscribeParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUnsubscribeParamsWithHTTPClient(client *http.Client) *UnsubscribeParams {
+	var ()
+	return &UnsubscribeParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UnsubscribeParams contains all the parameters to send to the API endpoint
+for the unsubscribe operation typically these are written to a http.Request
+*/
+type UnsubscribeParams struct {
+
+	/*Token*/
+	Token string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the unsubscribe params
+func (o *UnsubscribeParams) WithTimeout(timeout time.Duration) *UnsubscribeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the unsubscribe params
+func (o *UnsubscribeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the unsubscribe params
+func (o *UnsubscribeParams) WithContext(ctx context.Context) *UnsubscribeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the unsubscribe params
+func (o *UnsubscribeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the unsubscribe params
+func (o *UnsubscribeParams) WithHTTPClient(client *http.Client) *UnsubscribeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the unsubscribe params
+func (o *UnsubscribeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithToken adds the token to the unsubscribe params
+func (o *UnsubscribeParams) WithToken(token string) *UnsubscribeParams {
+	o.SetToken(token)
+	return o
+}
+
+// SetToken adds the token to the unsubscribe params
+func (o *UnsubscribeParams) SetToken(token string) {
+	o.Token = token
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UnsubscribeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param token
+	qrToken := o.Token
+	qToken := qrToken
+	if qToken != "" {
+		if err := r.SetQueryParam("token", qToken); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
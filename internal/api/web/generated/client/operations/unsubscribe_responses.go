@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// UnsubscribeReader is a Reader for the Unsubscribe structure.
+type UnsubscribeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UnsubscribeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUnsubscribeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUnsubscribeDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUnsubscribeOK creates a UnsubscribeOK with default headers values
+func NewUnsubscribeOK() *UnsubscribeOK {
+	return &UnsubscribeOK{}
+}
+
+/*
+UnsubscribeOK handles this case with default header values.
+
+OK
+*/
+type UnsubscribeOK struct {
+	Payload *models.Unsubscription
+}
+
+func (o *UnsubscribeOK) Error() string {
+	return fmt.Sprintf("[GET /unsubscribe][%d] unsubscribeOK  %+v", 200, o.Payload)
+}
+
+func (o *UnsubscribeOK) GetPayload() *models.Unsubscription {
+	return o.Payload
+}
+
+func (o *UnsubscribeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Unsubscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUnsubscribeDefault creates a UnsubscribeDefault with default headers values
+func NewUnsubscribeDefault(code int) *UnsubscribeDefault {
+	return &UnsubscribeDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UnsubscribeDefault handles this case with default header values.
+
+Generic error response.
+*/
+type UnsubscribeDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the unsubscribe default response
+func (o *UnsubscribeDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UnsubscribeDefault) Error() string {
+	return fmt.Sprintf("[GET /unsubscribe][%d] unsubscribe default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UnsubscribeDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UnsubscribeDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -55,11 +55,17 @@ func NewUpdateEmailParamsWithHTTPClient(client *http.Client) *UpdateEmailParams
 	}
 }
 
-/*UpdateEmailParams contains all the parameters to send to the API endpoint
+/*
+UpdateEmailParams contains all the parameters to send to the API endpoint
 for the update email operation typically these are written to a http.Request
 */
 type UpdateEmailParams struct {
 
+	/*IfMatch
+	  ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.
+
+	*/
+	IfMatch *string
 	/*Args*/
 	Args UpdateEmailBody
 
@@ -101,6 +107,17 @@ func (o *UpdateEmailParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the update email params
+func (o *UpdateEmailParams) WithIfMatch(ifMatch *string) *UpdateEmailParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update email params
+func (o *UpdateEmailParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithArgs adds the args to the update email params
 func (o *UpdateEmailParams) WithArgs(args UpdateEmailBody) *UpdateEmailParams {
 	o.SetArgs(args)
@@ -120,6 +137,15 @@ func (o *UpdateEmailParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+
+	}
+
 	if err := r.SetBodyParam(o.Args); err != nil {
 		return err
 	}
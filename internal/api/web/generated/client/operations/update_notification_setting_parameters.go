@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUpdateNotificationSettingParams creates a new UpdateNotificationSettingParams object
+// with the default values initialized.
+func NewUpdateNotificationSettingParams() *UpdateNotificationSettingParams {
+	var ()
+	return &UpdateNotificationSettingParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateNotificationSettingParamsWithTimeout creates a new UpdateNotificationSettingParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateNotificationSettingParamsWithTimeout(timeout time.Duration) *UpdateNotificationSettingParams {
+	var ()
+	return &UpdateNotificationSettingParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateNotificationSettingParamsWithContext creates a new UpdateNotificationSettingParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateNotificationSettingParamsWithContext(ctx context.Context) *UpdateNotificationSettingParams {
+	var ()
+	return &UpdateNotificationSettingParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateNotificationSettingParamsWithHTTPClient creates a new UpdateNotificationSettingParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateNotificationSettingParamsWithHTTPClient(client *http.Client) *UpdateNotificationSettingParams {
+	var ()
+	return &UpdateNotificationSettingParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateNotificationSettingParams contains all the parameters to send to the API endpoint
+for the update notification setting operation typically these are written to a http.Request
+*/
+type UpdateNotificationSettingParams struct {
+
+	/*Args*/
+	Args UpdateNotificationSettingBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update notification setting params
+func (o *UpdateNotificationSettingParams) WithTimeout(timeout time.Duration) *UpdateNotificationSettingParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update notification setting params
+func (o *UpdateNotificationSettingParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update notification setting params
+func (o *UpdateNotificationSettingParams) WithContext(ctx context.Context) *UpdateNotificationSettingParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update notification setting params
+func (o *UpdateNotificationSettingParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update notification setting params
+func (o *UpdateNotificationSettingParams) WithHTTPClient(client *http.Client) *UpdateNotificationSettingParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update notification setting params
+func (o *UpdateNotificationSettingParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the update notification setting params
+func (o *UpdateNotificationSettingParams) WithArgs(args UpdateNotificationSettingBody) *UpdateNotificationSettingParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the update notification setting params
+func (o *UpdateNotificationSettingParams) SetArgs(args UpdateNotificationSettingBody) {
+	o.Args = args
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateNotificationSettingParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
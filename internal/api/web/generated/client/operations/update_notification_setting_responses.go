@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// UpdateNotificationSettingReader is a Reader for the UpdateNotificationSetting structure.
+type UpdateNotificationSettingReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateNotificationSettingReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewUpdateNotificationSettingNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUpdateNotificationSettingDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateNotificationSettingNoContent creates a UpdateNotificationSettingNoContent with default headers values
+func NewUpdateNotificationSettingNoContent() *UpdateNotificationSettingNoContent {
+	return &UpdateNotificationSettingNoContent{}
+}
+
+/*
+UpdateNotificationSettingNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type UpdateNotificationSettingNoContent struct {
+}
+
+func (o *UpdateNotificationSettingNoContent) Error() string {
+	return fmt.Sprintf("[PATCH /user/notification-settings][%d] updateNotificationSettingNoContent ", 204)
+}
+
+func (o *UpdateNotificationSettingNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUpdateNotificationSettingDefault creates a UpdateNotificationSettingDefault with default headers values
+func NewUpdateNotificationSettingDefault(code int) *UpdateNotificationSettingDefault {
+	return &UpdateNotificationSettingDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateNotificationSettingDefault handles this case with default header values.
+
+Generic error response.
+*/
+type UpdateNotificationSettingDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the update notification setting default response
+func (o *UpdateNotificationSettingDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateNotificationSettingDefault) Error() string {
+	return fmt.Sprintf("[PATCH /user/notification-settings][%d] updateNotificationSetting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateNotificationSettingDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateNotificationSettingDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+UpdateNotificationSettingBody update notification setting body
+swagger:model UpdateNotificationSettingBody
+*/
+type UpdateNotificationSettingBody struct {
+
+	// enabled
+	// Required: true
+	Enabled *bool `json:"enabled"`
+
+	// kind
+	// Required: true
+	Kind models.MessageKind `json:"kind"`
+}
+
+// Validate validates this update notification setting body
+func (o *UpdateNotificationSettingBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpdateNotificationSettingBody) validateEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("args"+"."+"enabled", "body", o.Enabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *UpdateNotificationSettingBody) validateKind(formats strfmt.Registry) error {
+
+	if err := o.Kind.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "kind")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateNotificationSettingBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateNotificationSettingBody) UnmarshalBinary(b []byte) error {
+	var res UpdateNotificationSettingBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
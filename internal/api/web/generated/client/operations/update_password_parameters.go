@@ -57,11 +57,17 @@ func NewUpdatePasswordParamsWithHTTPClient(client *http.Client) *UpdatePasswordP
 	}
 }
 
-/*UpdatePasswordParams contains all the parameters to send to the API endpoint
+/*
+UpdatePasswordParams contains all the parameters to send to the API endpoint
 for the update password operation typically these are written to a http.Request
 */
 type UpdatePasswordParams struct {
 
+	/*IfMatch
+	  ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.
+
+	*/
+	IfMatch *string
 	/*Args*/
 	Args *models.UpdatePassword
 
@@ -103,6 +109,17 @@ func (o *UpdatePasswordParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the update password params
+func (o *UpdatePasswordParams) WithIfMatch(ifMatch *string) *UpdatePasswordParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update password params
+func (o *UpdatePasswordParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithArgs adds the args to the update password params
 func (o *UpdatePasswordParams) WithArgs(args *models.UpdatePassword) *UpdatePasswordParams {
 	o.SetArgs(args)
@@ -122,6 +139,15 @@ func (o *UpdatePasswordParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+
+	}
+
 	if o.Args != nil {
 		if err := r.SetBodyParam(o.Args); err != nil {
 			return err
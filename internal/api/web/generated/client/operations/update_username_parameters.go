@@ -55,11 +55,17 @@ func NewUpdateUsernameParamsWithHTTPClient(client *http.Client) *UpdateUsernameP
 	}
 }
 
-/*UpdateUsernameParams contains all the parameters to send to the API endpoint
+/*
+UpdateUsernameParams contains all the parameters to send to the API endpoint
 for the update username operation typically these are written to a http.Request
 */
 type UpdateUsernameParams struct {
 
+	/*IfMatch
+	  ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.
+
+	*/
+	IfMatch *string
 	/*Args*/
 	Args UpdateUsernameBody
 
@@ -101,6 +107,17 @@ func (o *UpdateUsernameParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the update username params
+func (o *UpdateUsernameParams) WithIfMatch(ifMatch *string) *UpdateUsernameParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update username params
+func (o *UpdateUsernameParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithArgs adds the args to the update username params
 func (o *UpdateUsernameParams) WithArgs(args UpdateUsernameBody) *UpdateUsernameParams {
 	o.SetArgs(args)
@@ -120,6 +137,15 @@ func (o *UpdateUsernameParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+
+	}
+
 	if err := r.SetBodyParam(o.Args); err != nil {
 		return err
 	}
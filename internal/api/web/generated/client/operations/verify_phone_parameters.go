@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewVerifyPhoneParams creates a new VerifyPhoneParams object
+// with the default values initialized.
+func NewVerifyPhoneParams() *VerifyPhoneParams {
+	var ()
+	return &VerifyPhoneParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewVerifyPhoneParamsWithTimeout creates a new VerifyPhoneParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewVerifyPhoneParamsWithTimeout(timeout time.Duration) *VerifyPhoneParams {
+	var ()
+	return &VerifyPhoneParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewVerifyPhoneParamsWithContext creates a new VerifyPhoneParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewVerifyPhoneParamsWithContext(ctx context.Context) *VerifyPhoneParams {
+	var ()
+	return &VerifyPhoneParams{
+
+		Context: ctx,
+	}
+}
+
+// NewVerifyPhoneParamsWithHTTPClient creates a new VerifyPhoneParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewVerifyPhoneParamsWithHTTPClient(client *http.Client) *VerifyPhoneParams {
+	var ()
+	return &VerifyPhoneParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+VerifyPhoneParams contains all the parameters to send to the API endpoint
+for the verify phone operation typically these are written to a http.Request
+*/
+type VerifyPhoneParams struct {
+
+	/*Args*/
+	Args VerifyPhoneBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the verify phone params
+func (o *VerifyPhoneParams) WithTimeout(timeout time.Duration) *VerifyPhoneParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the verify phone params
+func (o *VerifyPhoneParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the verify phone params
+func (o *VerifyPhoneParams) WithContext(ctx context.Context) *VerifyPhoneParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the verify phone params
+func (o *VerifyPhoneParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the verify phone params
+func (o *VerifyPhoneParams) WithHTTPClient(client *http.Client) *VerifyPhoneParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the verify phone params
+func (o *VerifyPhoneParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArgs adds the args to the verify phone params
+func (o *VerifyPhoneParams) WithArgs(args VerifyPhoneBody) *VerifyPhoneParams {
+	o.SetArgs(args)
+	return o
+}
+
+// SetArgs adds the args to the verify phone params
+func (o *VerifyPhoneParams) SetArgs(args VerifyPhoneBody) {
+	o.Args = args
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *VerifyPhoneParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Args); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
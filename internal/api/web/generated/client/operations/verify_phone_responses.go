@@ -0,0 +1,164 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// VerifyPhoneReader is a Reader for the VerifyPhone structure.
+type VerifyPhoneReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *VerifyPhoneReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewVerifyPhoneNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewVerifyPhoneDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewVerifyPhoneNoContent creates a VerifyPhoneNoContent with default headers values
+func NewVerifyPhoneNoContent() *VerifyPhoneNoContent {
+	return &VerifyPhoneNoContent{}
+}
+
+/*
+VerifyPhoneNoContent handles this case with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type VerifyPhoneNoContent struct {
+}
+
+func (o *VerifyPhoneNoContent) Error() string {
+	return fmt.Sprintf("[POST /user/phone/verify][%d] verifyPhoneNoContent ", 204)
+}
+
+func (o *VerifyPhoneNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewVerifyPhoneDefault creates a VerifyPhoneDefault with default headers values
+func NewVerifyPhoneDefault(code int) *VerifyPhoneDefault {
+	return &VerifyPhoneDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+VerifyPhoneDefault handles this case with default header values.
+
+Generic error response.
+*/
+type VerifyPhoneDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the verify phone default response
+func (o *VerifyPhoneDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *VerifyPhoneDefault) Error() string {
+	return fmt.Sprintf("[POST /user/phone/verify][%d] verifyPhone default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *VerifyPhoneDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *VerifyPhoneDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+VerifyPhoneBody verify phone body
+swagger:model VerifyPhoneBody
+*/
+type VerifyPhoneBody struct {
+
+	// code
+	// Required: true
+	Code models.RecoveryCode `json:"code"`
+}
+
+// Validate validates this verify phone body
+func (o *VerifyPhoneBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *VerifyPhoneBody) validateCode(formats strfmt.Registry) error {
+
+	if err := o.Code.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "code")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *VerifyPhoneBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *VerifyPhoneBody) UnmarshalBinary(b []byte) error {
+	var res VerifyPhoneBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EmailProviderEvent Event of the email provider in SendGrid Event Webhook format, unknown events are ignored.
+//
+// swagger:model EmailProviderEvent
+type EmailProviderEvent struct {
+
+	// email
+	// Required: true
+	Email *string `json:"email"`
+
+	// event
+	// Required: true
+	Event *string `json:"event"`
+
+	// reason
+	Reason string `json:"reason,omitempty"`
+
+	// sg event id
+	SgEventID string `json:"sg_event_id,omitempty"`
+
+	// timestamp
+	Timestamp int64 `json:"timestamp,omitempty"`
+
+	// Kind of the bounce, blocked messages are not bounced addresses.
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this email provider event
+func (m *EmailProviderEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEvent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EmailProviderEvent) validateEmail(formats strfmt.Registry) error {
+
+	if err := validate.Required("email", "body", m.Email); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EmailProviderEvent) validateEvent(formats strfmt.Registry) error {
+
+	if err := validate.Required("event", "body", m.Event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EmailProviderEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EmailProviderEvent) UnmarshalBinary(b []byte) error {
+	var res EmailProviderEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,31 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// MessageKind message kind
+//
+// swagger:model MessageKind
+type MessageKind string
+
+// Validate validates this message kind
+func (m MessageKind) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := validate.MinLength("", "body", string(m), 1); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
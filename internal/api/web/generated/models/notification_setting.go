@@ -0,0 +1,101 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NotificationSetting notification setting
+//
+// swagger:model NotificationSetting
+type NotificationSetting struct {
+
+	// enabled
+	// Required: true
+	Enabled *bool `json:"enabled"`
+
+	// kind
+	// Required: true
+	Kind MessageKind `json:"kind"`
+
+	// The user can't opt-out of security-critical notifications.
+	// Required: true
+	Mandatory *bool `json:"mandatory"`
+}
+
+// Validate validates this notification setting
+func (m *NotificationSetting) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMandatory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationSetting) validateEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("enabled", "body", m.Enabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationSetting) validateKind(formats strfmt.Registry) error {
+
+	if err := m.Kind.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("kind")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationSetting) validateMandatory(formats strfmt.Registry) error {
+
+	if err := validate.Required("mandatory", "body", m.Mandatory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationSetting) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationSetting) UnmarshalBinary(b []byte) error {
+	var res NotificationSetting
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
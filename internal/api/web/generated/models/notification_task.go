@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NotificationTask notification task
+//
+// swagger:model NotificationTask
+type NotificationTask struct {
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// email
+	// Required: true
+	// Format: email
+	Email Email `json:"email"`
+
+	// The error of the last execution attempt.
+	Error string `json:"error,omitempty"`
+
+	// Time of execution, absent if the task has not been executed.
+	// Format: date-time
+	ExecTime *strfmt.DateTime `json:"execTime,omitempty"`
+
+	// id
+	// Required: true
+	ID *int32 `json:"id"`
+
+	// kind
+	// Required: true
+	Kind MessageKind `json:"kind"`
+
+	// The email provider which delivered the message.
+	Provider string `json:"provider,omitempty"`
+
+	// The earliest time to execute the task.
+	// Required: true
+	// Format: date-time
+	RunAt *strfmt.DateTime `json:"runAt"`
+
+	// status
+	// Required: true
+	Status TaskStatus `json:"status"`
+}
+
+// Validate validates this notification task
+func (m *NotificationTask) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExecTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRunAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationTask) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTask) validateEmail(formats strfmt.Registry) error {
+
+	if err := m.Email.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("email")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTask) validateExecTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExecTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("execTime", "body", "date-time", m.ExecTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTask) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTask) validateKind(formats strfmt.Registry) error {
+
+	if err := m.Kind.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("kind")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTask) validateRunAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("runAt", "body", m.RunAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("runAt", "body", "date-time", m.RunAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTask) validateStatus(formats strfmt.Registry) error {
+
+	if err := m.Status.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("status")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationTask) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationTask) UnmarshalBinary(b []byte) error {
+	var res NotificationTask
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,20 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+)
+
+// OrgID org ID
+//
+// swagger:model OrgID
+type OrgID int32
+
+// Validate validates this org ID
+func (m OrgID) Validate(formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,35 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// OrgName org name
+//
+// swagger:model OrgName
+type OrgName string
+
+// Validate validates this org name
+func (m OrgName) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := validate.MinLength("", "body", string(m), 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("", "body", string(m), 100); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
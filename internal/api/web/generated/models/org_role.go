@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// OrgRole org role
+//
+// swagger:model OrgRole
+type OrgRole string
+
+const (
+
+	// OrgRoleOwner captures enum value "owner"
+	OrgRoleOwner OrgRole = "owner"
+
+	// OrgRoleAdmin captures enum value "admin"
+	OrgRoleAdmin OrgRole = "admin"
+
+	// OrgRoleMember captures enum value "member"
+	OrgRoleMember OrgRole = "member"
+)
+
+// for schema
+var orgRoleEnum []interface{}
+
+func init() {
+	var res []OrgRole
+	if err := json.Unmarshal([]byte(`["owner","admin","member"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		orgRoleEnum = append(orgRoleEnum, v)
+	}
+}
+
+func (m OrgRole) validateOrgRoleEnum(path, location string, value OrgRole) error {
+	if err := validate.Enum(path, location, value, orgRoleEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this org role
+func (m OrgRole) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateOrgRoleEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// Phone Phone in E.164 format, spaces, dashes and brackets are ignored.
+//
+// swagger:model Phone
+type Phone string
+
+// Validate validates this phone
+func (m Phone) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := validate.MinLength("", "body", string(m), 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("", "body", string(m), 32); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
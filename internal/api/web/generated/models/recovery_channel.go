@@ -0,0 +1,63 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// RecoveryChannel recovery channel
+//
+// swagger:model RecoveryChannel
+type RecoveryChannel string
+
+const (
+
+	// RecoveryChannelEmail captures enum value "email"
+	RecoveryChannelEmail RecoveryChannel = "email"
+
+	// RecoveryChannelSms captures enum value "sms"
+	RecoveryChannelSms RecoveryChannel = "sms"
+)
+
+// for schema
+var recoveryChannelEnum []interface{}
+
+func init() {
+	var res []RecoveryChannel
+	if err := json.Unmarshal([]byte(`["email","sms"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		recoveryChannelEnum = append(recoveryChannelEnum, v)
+	}
+}
+
+func (m RecoveryChannel) validateRecoveryChannelEnum(path, location string, value RecoveryChannel) error {
+	if err := validate.Enum(path, location, value, recoveryChannelEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this recovery channel
+func (m RecoveryChannel) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateRecoveryChannelEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// TaskStatus task status
+//
+// swagger:model TaskStatus
+type TaskStatus string
+
+const (
+
+	// TaskStatusPending captures enum value "pending"
+	TaskStatusPending TaskStatus = "pending"
+
+	// TaskStatusDone captures enum value "done"
+	TaskStatusDone TaskStatus = "done"
+
+	// TaskStatusCancelled captures enum value "cancelled"
+	TaskStatusCancelled TaskStatus = "cancelled"
+)
+
+// for schema
+var taskStatusEnum []interface{}
+
+func init() {
+	var res []TaskStatus
+	if err := json.Unmarshal([]byte(`["pending","done","cancelled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		taskStatusEnum = append(taskStatusEnum, v)
+	}
+}
+
+func (m TaskStatus) validateTaskStatusEnum(path, location string, value TaskStatus) error {
+	if err := validate.Enum(path, location, value, taskStatusEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this task status
+func (m TaskStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateTaskStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -21,10 +21,17 @@ type User struct {
 	// Format: email
 	Email Email `json:"email"`
 
+	// Set on login if mail to the email bounces or is reported as spam, the user should change the email.
+	EmailUndeliverable bool `json:"emailUndeliverable,omitempty"`
+
 	// id
 	// Required: true
+	// Format: uuid
 	ID UserID `json:"id"`
 
+	// Verified phone, it's used for SMS notifications.
+	Phone string `json:"phone,omitempty"`
+
 	// username
 	// Required: true
 	Username Username `json:"username"`
@@ -4,6 +4,7 @@ package restapi
 
 import (
 	"crypto/tls"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -31,9 +32,19 @@ func configureAPI(api *operations.ServiceBoilerplateAPI) http.Handler {
 	// api.Logger = log.Printf
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	api.UrlformConsumer = runtime.DiscardConsumer
 
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 
+	// Applies when the "X-Admin-Key" header is set
+	if api.AdminKeyAuth == nil {
+		api.AdminKeyAuth = func(token string) (*app.AuthUser, error) {
+			return nil, errors.NotImplemented("api key auth (adminKey) X-Admin-Key from header param [X-Admin-Key] has not yet been implemented")
+		}
+	}
 	// Applies when the "Cookie" header is set
 	if api.CookieKeyAuth == nil {
 		api.CookieKeyAuth = func(token string) (*app.AuthUser, error) {
@@ -46,6 +57,21 @@ func configureAPI(api *operations.ServiceBoilerplateAPI) http.Handler {
 	//
 	// Example:
 	// api.APIAuthorizer = security.Authorized()
+	if api.AcceptOrgInvitationHandler == nil {
+		api.AcceptOrgInvitationHandler = operations.AcceptOrgInvitationHandlerFunc(func(params operations.AcceptOrgInvitationParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.AcceptOrgInvitation has not yet been implemented")
+		})
+	}
+	if api.CancelNotificationTaskHandler == nil {
+		api.CancelNotificationTaskHandler = operations.CancelNotificationTaskHandlerFunc(func(params operations.CancelNotificationTaskParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CancelNotificationTask has not yet been implemented")
+		})
+	}
+	if api.CreateOrgHandler == nil {
+		api.CreateOrgHandler = operations.CreateOrgHandlerFunc(func(params operations.CreateOrgParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CreateOrg has not yet been implemented")
+		})
+	}
 	if api.CreateRecoveryCodeHandler == nil {
 		api.CreateRecoveryCodeHandler = operations.CreateRecoveryCodeHandlerFunc(func(params operations.CreateRecoveryCodeParams) middleware.Responder {
 			return middleware.NotImplemented("operation operations.CreateRecoveryCode has not yet been implemented")
@@ -56,11 +82,26 @@ func configureAPI(api *operations.ServiceBoilerplateAPI) http.Handler {
 			return middleware.NotImplemented("operation operations.CreateUser has not yet been implemented")
 		})
 	}
+	if api.DeletePhoneHandler == nil {
+		api.DeletePhoneHandler = operations.DeletePhoneHandlerFunc(func(params operations.DeletePhoneParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.DeletePhone has not yet been implemented")
+		})
+	}
 	if api.DeleteUserHandler == nil {
 		api.DeleteUserHandler = operations.DeleteUserHandlerFunc(func(params operations.DeleteUserParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation operations.DeleteUser has not yet been implemented")
 		})
 	}
+	if api.GetNotificationSettingsHandler == nil {
+		api.GetNotificationSettingsHandler = operations.GetNotificationSettingsHandlerFunc(func(params operations.GetNotificationSettingsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetNotificationSettings has not yet been implemented")
+		})
+	}
+	if api.GetUnreadNotificationCountHandler == nil {
+		api.GetUnreadNotificationCountHandler = operations.GetUnreadNotificationCountHandlerFunc(func(params operations.GetUnreadNotificationCountParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetUnreadNotificationCount has not yet been implemented")
+		})
+	}
 	if api.GetUserHandler == nil {
 		api.GetUserHandler = operations.GetUserHandlerFunc(func(params operations.GetUserParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation operations.GetUser has not yet been implemented")
@@ -71,6 +112,36 @@ func configureAPI(api *operations.ServiceBoilerplateAPI) http.Handler {
 			return middleware.NotImplemented("operation operations.GetUsers has not yet been implemented")
 		})
 	}
+	if api.HandleEmailEventsHandler == nil {
+		api.HandleEmailEventsHandler = operations.HandleEmailEventsHandlerFunc(func(params operations.HandleEmailEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.HandleEmailEvents has not yet been implemented")
+		})
+	}
+	if api.InviteOrgMemberHandler == nil {
+		api.InviteOrgMemberHandler = operations.InviteOrgMemberHandlerFunc(func(params operations.InviteOrgMemberParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.InviteOrgMember has not yet been implemented")
+		})
+	}
+	if api.ListNotificationTasksHandler == nil {
+		api.ListNotificationTasksHandler = operations.ListNotificationTasksHandlerFunc(func(params operations.ListNotificationTasksParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListNotificationTasks has not yet been implemented")
+		})
+	}
+	if api.ListOrgMembersHandler == nil {
+		api.ListOrgMembersHandler = operations.ListOrgMembersHandlerFunc(func(params operations.ListOrgMembersParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListOrgMembers has not yet been implemented")
+		})
+	}
+	if api.ListOrgsHandler == nil {
+		api.ListOrgsHandler = operations.ListOrgsHandlerFunc(func(params operations.ListOrgsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListOrgs has not yet been implemented")
+		})
+	}
+	if api.ListUserNotificationsHandler == nil {
+		api.ListUserNotificationsHandler = operations.ListUserNotificationsHandlerFunc(func(params operations.ListUserNotificationsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListUserNotifications has not yet been implemented")
+		})
+	}
 	if api.LoginHandler == nil {
 		api.LoginHandler = operations.LoginHandlerFunc(func(params operations.LoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation operations.Login has not yet been implemented")
@@ -81,16 +152,61 @@ func configureAPI(api *operations.ServiceBoilerplateAPI) http.Handler {
 			return middleware.NotImplemented("operation operations.Logout has not yet been implemented")
 		})
 	}
+	if api.MarkUserNotificationsReadHandler == nil {
+		api.MarkUserNotificationsReadHandler = operations.MarkUserNotificationsReadHandlerFunc(func(params operations.MarkUserNotificationsReadParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.MarkUserNotificationsRead has not yet been implemented")
+		})
+	}
 	if api.RecoveryPasswordHandler == nil {
 		api.RecoveryPasswordHandler = operations.RecoveryPasswordHandlerFunc(func(params operations.RecoveryPasswordParams) middleware.Responder {
 			return middleware.NotImplemented("operation operations.RecoveryPassword has not yet been implemented")
 		})
 	}
+	if api.RemoveOrgMemberHandler == nil {
+		api.RemoveOrgMemberHandler = operations.RemoveOrgMemberHandlerFunc(func(params operations.RemoveOrgMemberParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.RemoveOrgMember has not yet been implemented")
+		})
+	}
+	if api.RequestPhoneVerificationHandler == nil {
+		api.RequestPhoneVerificationHandler = operations.RequestPhoneVerificationHandlerFunc(func(params operations.RequestPhoneVerificationParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.RequestPhoneVerification has not yet been implemented")
+		})
+	}
+	if api.ResendNotificationTaskHandler == nil {
+		api.ResendNotificationTaskHandler = operations.ResendNotificationTaskHandlerFunc(func(params operations.ResendNotificationTaskParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ResendNotificationTask has not yet been implemented")
+		})
+	}
+	if api.StreamUserNotificationsHandler == nil {
+		api.StreamUserNotificationsHandler = operations.StreamUserNotificationsHandlerFunc(func(params operations.StreamUserNotificationsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.StreamUserNotifications has not yet been implemented")
+		})
+	}
+	if api.TransferOrgOwnershipHandler == nil {
+		api.TransferOrgOwnershipHandler = operations.TransferOrgOwnershipHandlerFunc(func(params operations.TransferOrgOwnershipParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.TransferOrgOwnership has not yet been implemented")
+		})
+	}
+	if api.UnsubscribeHandler == nil {
+		api.UnsubscribeHandler = operations.UnsubscribeHandlerFunc(func(params operations.UnsubscribeParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.Unsubscribe has not yet been implemented")
+		})
+	}
+	if api.UnsubscribeOneClickHandler == nil {
+		api.UnsubscribeOneClickHandler = operations.UnsubscribeOneClickHandlerFunc(func(params operations.UnsubscribeOneClickParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.UnsubscribeOneClick has not yet been implemented")
+		})
+	}
 	if api.UpdateEmailHandler == nil {
 		api.UpdateEmailHandler = operations.UpdateEmailHandlerFunc(func(params operations.UpdateEmailParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation operations.UpdateEmail has not yet been implemented")
 		})
 	}
+	if api.UpdateNotificationSettingHandler == nil {
+		api.UpdateNotificationSettingHandler = operations.UpdateNotificationSettingHandlerFunc(func(params operations.UpdateNotificationSettingParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.UpdateNotificationSetting has not yet been implemented")
+		})
+	}
 	if api.UpdatePasswordHandler == nil {
 		api.UpdatePasswordHandler = operations.UpdatePasswordHandlerFunc(func(params operations.UpdatePasswordParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation operations.UpdatePassword has not yet been implemented")
@@ -111,6 +227,11 @@ func configureAPI(api *operations.ServiceBoilerplateAPI) http.Handler {
 			return middleware.NotImplemented("operation operations.VerificationUsername has not yet been implemented")
 		})
 	}
+	if api.VerifyPhoneHandler == nil {
+		api.VerifyPhoneHandler = operations.VerifyPhoneHandlerFunc(func(params operations.VerifyPhoneParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation operations.VerifyPhone has not yet been implemented")
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
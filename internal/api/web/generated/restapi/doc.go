@@ -2,18 +2,20 @@
 
 // Package restapi Service boilerplate.
 //
-//  Schemes:
-//    http
-//  Host: localhost
-//  BasePath: /api/v1
-//  Version: 0.1.0
-//  License: MIT
+//	Schemes:
+//	  http
+//	Host: localhost
+//	BasePath: /api/v1
+//	Version: 0.1.0
+//	License: MIT
 //
-//  Consumes:
-//    - application/json
+//	Consumes:
+//	  - application/json
+//	  - application/x-www-form-urlencoded
 //
-//  Produces:
-//    - application/json
+//	Produces:
+//	  - application/json
+//	  - text/event-stream
 //
 // swagger:meta
 package restapi
@@ -34,31 +34,71 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
-    "/email/verification": {
-      "post": {
-        "security": [],
-        "operationId": "verificationEmail",
+    "/admin/notifications": {
+      "get": {
+        "security": [
+          {
+            "adminKey": []
+          }
+        ],
+        "description": "Notification tasks search, latest first.",
+        "operationId": "listNotificationTasks",
         "parameters": [
           {
-            "name": "args",
-            "in": "body",
-            "required": true,
+            "type": "string",
+            "name": "email",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "kind",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "pending",
+              "done",
+              "cancelled"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
             "schema": {
               "type": "object",
-              "required": [
-                "email"
-              ],
               "properties": {
-                "email": {
-                  "$ref": "#/definitions/Email"
+                "tasks": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "items": {
+                    "$ref": "#/definitions/NotificationTask"
+                  }
+                },
+                "total": {
+                  "type": "integer",
+                  "format": "int32"
                 }
               }
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "$ref": "#/responses/NoContent"
           },
           "default": {
             "$ref": "#/responses/GenericError"
@@ -66,33 +106,27 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/admin/notifications/{id}/cancel": {
       "post": {
-        "security": [],
-        "description": "Login for user.",
-        "operationId": "login",
+        "security": [
+          {
+            "adminKey": []
+          }
+        ],
+        "description": "Cancels the pending task.",
+        "operationId": "cancelNotificationTask",
         "parameters": [
           {
-            "name": "args",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/LoginParam"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/User"
-            },
-            "headers": {
-              "Set-Cookie": {
-                "type": "string",
-                "description": "Session auth."
-              }
-            }
+          "204": {
+            "$ref": "#/responses/NoContent"
           },
           "default": {
             "$ref": "#/responses/GenericError"
@@ -100,13 +134,30 @@ func init() {
         }
       }
     },
-    "/logout": {
+    "/admin/notifications/{id}/resend": {
       "post": {
-        "description": "Logout for user",
-        "operationId": "logout",
+        "security": [
+          {
+            "adminKey": []
+          }
+        ],
+        "description": "Creates a new pending task with the same recipient, kind and payload.",
+        "operationId": "resendNotificationTask",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
-          "204": {
-            "$ref": "#/responses/NoContent"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/NotificationTask"
+            }
           },
           "default": {
             "$ref": "#/responses/GenericError"
@@ -114,25 +165,32 @@ func init() {
         }
       }
     },
-    "/recovery-code": {
+    "/email/events": {
       "post": {
         "security": [],
-        "description": "Creates a password recovery token and sends it to the email.",
-        "operationId": "createRecoveryCode",
+        "description": "Receives bounces and complaints from the email provider, the request must be signed by the provider.",
+        "operationId": "handleEmailEvents",
         "parameters": [
           {
-            "name": "args",
+            "type": "string",
+            "name": "X-Twilio-Email-Event-Webhook-Signature",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "X-Twilio-Email-Event-Webhook-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "name": "events",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "email"
-              ],
-              "properties": {
-                "email": {
-                  "$ref": "#/definitions/Email"
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/EmailProviderEvent"
               }
             }
           }
@@ -147,11 +205,10 @@ func init() {
         }
       }
     },
-    "/recovery-password": {
+    "/email/verification": {
       "post": {
         "security": [],
-        "description": "Updates the password of the user who owns this recovery code.",
-        "operationId": "recoveryPassword",
+        "operationId": "verificationEmail",
         "parameters": [
           {
             "name": "args",
@@ -160,19 +217,11 @@ func init() {
             "schema": {
               "type": "object",
               "required": [
-                "email",
-                "recovery_code",
-                "password"
+                "email"
               ],
               "properties": {
                 "email": {
                   "$ref": "#/definitions/Email"
-                },
-                "password": {
-                  "$ref": "#/definitions/Password"
-                },
-                "recoveryCode": {
-                  "$ref": "#/definitions/RecoveryCode"
                 }
               }
             }
@@ -188,41 +237,18 @@ func init() {
         }
       }
     },
-    "/user": {
-      "get": {
-        "description": "Open user profile.",
-        "operationId": "getUser",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "id",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/User"
-            }
-          },
-          "default": {
-            "$ref": "#/responses/GenericError"
-          }
-        }
-      },
+    "/login": {
       "post": {
         "security": [],
-        "description": "New user registration. If it is not sent to username, it will be the userID",
-        "operationId": "createUser",
+        "description": "Login for user.",
+        "operationId": "login",
         "parameters": [
           {
             "name": "args",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/CreateUserParams"
+              "$ref": "#/definitions/LoginParam"
             }
           }
         ],
@@ -243,10 +269,12 @@ func init() {
             "$ref": "#/responses/GenericError"
           }
         }
-      },
-      "delete": {
-        "description": "Deletion of your account.",
-        "operationId": "deleteUser",
+      }
+    },
+    "/logout": {
+      "post": {
+        "description": "Logout for user",
+        "operationId": "logout",
         "responses": {
           "204": {
             "$ref": "#/responses/NoContent"
@@ -257,10 +285,28 @@ func init() {
         }
       }
     },
-    "/user/email": {
-      "patch": {
-        "description": "Change email.",
-        "operationId": "updateEmail",
+    "/orgs": {
+      "get": {
+        "description": "Organizations of the user.",
+        "operationId": "listOrgs",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Org"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "post": {
+        "description": "Creates the organization owned by the user.",
+        "operationId": "createOrg",
         "parameters": [
           {
             "name": "args",
@@ -269,19 +315,22 @@ func init() {
             "schema": {
               "type": "object",
               "required": [
-                "email"
+                "name"
               ],
               "properties": {
-                "email": {
-                  "$ref": "#/definitions/Email"
+                "name": {
+                  "$ref": "#/definitions/OrgName"
                 }
               }
             }
           }
         ],
         "responses": {
-          "204": {
-            "$ref": "#/responses/NoContent"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Org"
+            }
           },
           "default": {
             "$ref": "#/responses/GenericError"
@@ -289,23 +338,35 @@ func init() {
         }
       }
     },
-    "/user/password": {
-      "patch": {
-        "description": "Change password.",
-        "operationId": "updatePassword",
+    "/orgs/invitations": {
+      "post": {
+        "description": "Joins the organization by the token from the invitation sent to the user's email.",
+        "operationId": "acceptOrgInvitation",
         "parameters": [
           {
             "name": "args",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/UpdatePassword"
+              "type": "object",
+              "required": [
+                "token"
+              ],
+              "properties": {
+                "token": {
+                  "type": "string",
+                  "minLength": 1
+                }
+              }
             }
           }
         ],
         "responses": {
-          "204": {
-            "$ref": "#/responses/NoContent"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Org"
+            }
           },
           "default": {
             "$ref": "#/responses/GenericError"
@@ -313,11 +374,18 @@ func init() {
         }
       }
     },
-    "/user/username": {
-      "patch": {
-        "description": "Change username.",
-        "operationId": "updateUsername",
+    "/orgs/{id}/invitations": {
+      "post": {
+        "description": "Sends the invitation with the accept link to the email, which is accepted after signing up if the email has no user yet.\nAdmins invite members, the owner invites admins too.\n",
+        "operationId": "inviteOrgMember",
         "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "args",
             "in": "body",
@@ -325,11 +393,19 @@ func init() {
             "schema": {
               "type": "object",
               "required": [
-                "username"
+                "email",
+                "role"
               ],
               "properties": {
-                "username": {
-                  "$ref": "#/definitions/Username"
+                "email": {
+                  "$ref": "#/definitions/Email"
+                },
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "admin",
+                    "member"
+                  ]
                 }
               }
             }
@@ -345,23 +421,1596 @@ func init() {
         }
       }
     },
-    "/username/verification": {
-      "post": {
-        "security": [],
-        "operationId": "verificationUsername",
+    "/orgs/{id}/members": {
+      "get": {
+        "description": "Members of the organization in order of joining, the user must be a member.",
+        "operationId": "listOrgMembers",
         "parameters": [
           {
-            "name": "args",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "members": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "items": {
+                    "$ref": "#/definitions/OrgMember"
+                  }
+                },
+                "total": {
+                  "type": "integer",
+                  "format": "int32"
+                }
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/orgs/{id}/members/{userId}": {
+      "delete": {
+        "description": "Removes the member, users leave organizations by removing themselves.\nThe owner removes anyone, admins remove members, the owner must transfer ownership before leaving.\n",
+        "operationId": "removeOrgMember",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "userId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/orgs/{id}/owner": {
+      "post": {
+        "description": "Makes the member the owner, the user becomes an admin.",
+        "operationId": "transferOrgOwnership",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "userId"
+              ],
+              "properties": {
+                "userId": {
+                  "$ref": "#/definitions/UserID"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/recovery-code": {
+      "post": {
+        "security": [],
+        "description": "Creates a password recovery token and sends it to the email or by SMS to the verified phone.",
+        "operationId": "createRecoveryCode",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email"
+              ],
+              "properties": {
+                "channel": {
+                  "$ref": "#/definitions/RecoveryChannel"
+                },
+                "email": {
+                  "$ref": "#/definitions/Email"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/recovery-password": {
+      "post": {
+        "security": [],
+        "description": "Updates the password of the user who owns this recovery code.",
+        "operationId": "recoveryPassword",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email",
+                "recovery_code",
+                "password"
+              ],
+              "properties": {
+                "email": {
+                  "$ref": "#/definitions/Email"
+                },
+                "password": {
+                  "$ref": "#/definitions/Password"
+                },
+                "recoveryCode": {
+                  "$ref": "#/definitions/RecoveryCode"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/unsubscribe": {
+      "get": {
+        "security": [],
+        "description": "Returns the kind of notification the link from email unsubscribes from without changing anything, the unsubscription is confirmed by POST with the same token.",
+        "operationId": "unsubscribe",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Unsubscription"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "description": "Unsubscribes from the kind of notification, sent by the confirmation of the link from email or by email client as one-click unsubscribe (RFC 8058) using List-Unsubscribe header.",
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "unsubscribeOneClick",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "List-Unsubscribe",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user": {
+      "get": {
+        "description": "Open user profile, the profile of the user itself is returned if the id isn't sent.",
+        "operationId": "getUser",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/User"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the user, it's sent in If-Match of updates."
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "description": "New user registration. If it is not sent to username, it will be the userID",
+        "operationId": "createUser",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateUserParams"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/User"
+            },
+            "headers": {
+              "Set-Cookie": {
+                "type": "string",
+                "description": "Session auth."
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletion of your account.",
+        "operationId": "deleteUser",
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/email": {
+      "patch": {
+        "description": "Change email.",
+        "operationId": "updateEmail",
+        "parameters": [
+          {
+            "$ref": "#/parameters/IfMatch"
+          },
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email"
+              ],
+              "properties": {
+                "email": {
+                  "$ref": "#/definitions/Email"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/notification-settings": {
+      "get": {
+        "description": "User preferences for every kind of notification.",
+        "operationId": "getNotificationSettings",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/NotificationSetting"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "patch": {
+        "description": "Opt-in or opt-out of the kind of notification.",
+        "operationId": "updateNotificationSetting",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "kind",
+                "enabled"
+              ],
+              "properties": {
+                "enabled": {
+                  "type": "boolean"
+                },
+                "kind": {
+                  "$ref": "#/definitions/MessageKind"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/notifications": {
+      "get": {
+        "description": "Notifications from the user inbox, latest first.",
+        "operationId": "listUserNotifications",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "notifications": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "items": {
+                    "$ref": "#/definitions/UserNotification"
+                  }
+                },
+                "total": {
+                  "type": "integer",
+                  "format": "int32"
+                }
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/notifications/read": {
+      "post": {
+        "description": "Marks notifications from the user inbox as read, all of them if ids are not passed.",
+        "operationId": "markUserNotificationsRead",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ids": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "items": {
+                    "type": "integer",
+                    "format": "int32"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/notifications/stream": {
+      "get": {
+        "description": "Server-Sent Events stream of notifications added to the user inbox.\nEvery notification is sent as ` + "`" + `notification` + "`" + ` event with UserNotification in data and its id as event id.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "operationId": "streamUserNotifications",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "Resume the stream after the notification with this id.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/notifications/unread-count": {
+      "get": {
+        "description": "Number of unread notifications in the user inbox.",
+        "operationId": "getUnreadNotificationCount",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "required": [
+                "count"
+              ],
+              "properties": {
+                "count": {
+                  "type": "integer",
+                  "format": "int32"
+                }
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/password": {
+      "patch": {
+        "description": "Change password.",
+        "operationId": "updatePassword",
+        "parameters": [
+          {
+            "$ref": "#/parameters/IfMatch"
+          },
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UpdatePassword"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/phone": {
+      "post": {
+        "description": "Sends by SMS a code to verify the phone, the phone is set after the verification.",
+        "operationId": "requestPhoneVerification",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "phone"
+              ],
+              "properties": {
+                "phone": {
+                  "$ref": "#/definitions/Phone"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "delete": {
+        "description": "Removes the phone.",
+        "operationId": "deletePhone",
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/phone/verify": {
+      "post": {
+        "description": "Sets the phone by the code sent to it.",
+        "operationId": "verifyPhone",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "code"
+              ],
+              "properties": {
+                "code": {
+                  "$ref": "#/definitions/RecoveryCode"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/user/username": {
+      "patch": {
+        "description": "Change username.",
+        "operationId": "updateUsername",
+        "parameters": [
+          {
+            "$ref": "#/parameters/IfMatch"
+          },
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "username"
+              ],
+              "properties": {
+                "username": {
+                  "$ref": "#/definitions/Username"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/username/verification": {
+      "post": {
+        "security": [],
+        "operationId": "verificationUsername",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "username"
+              ],
+              "properties": {
+                "username": {
+                  "$ref": "#/definitions/Username"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "description": "User search.",
+        "operationId": "getUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "username",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "total": {
+                  "type": "integer",
+                  "format": "int32"
+                },
+                "users": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "uniqueItems": true,
+                  "items": {
+                    "$ref": "#/definitions/User"
+                  }
+                }
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "CreateUserParams": {
+      "type": "object",
+      "required": [
+        "email",
+        "password",
+        "username"
+      ],
+      "properties": {
+        "email": {
+          "$ref": "#/definitions/Email"
+        },
+        "password": {
+          "$ref": "#/definitions/Password"
+        },
+        "username": {
+          "$ref": "#/definitions/Username"
+        }
+      }
+    },
+    "Email": {
+      "type": "string",
+      "format": "email",
+      "maxLength": 255,
+      "minLength": 1
+    },
+    "EmailProviderEvent": {
+      "description": "Event of the email provider in SendGrid Event Webhook format, unknown events are ignored.",
+      "type": "object",
+      "required": [
+        "email",
+        "event"
+      ],
+      "properties": {
+        "email": {
+          "type": "string"
+        },
+        "event": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "sg_event_id": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "description": "Kind of the bounce, blocked messages are not bounced addresses.",
+          "type": "string"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "LoginParam": {
+      "type": "object",
+      "required": [
+        "email",
+        "password"
+      ],
+      "properties": {
+        "email": {
+          "$ref": "#/definitions/Email"
+        },
+        "password": {
+          "$ref": "#/definitions/Password"
+        }
+      }
+    },
+    "MessageKind": {
+      "type": "string",
+      "minLength": 1
+    },
+    "NotificationSetting": {
+      "type": "object",
+      "required": [
+        "kind",
+        "enabled",
+        "mandatory"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        },
+        "mandatory": {
+          "description": "The user can't opt-out of security-critical notifications.",
+          "type": "boolean"
+        }
+      }
+    },
+    "NotificationTask": {
+      "type": "object",
+      "required": [
+        "id",
+        "email",
+        "kind",
+        "status",
+        "createdAt",
+        "runAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "email": {
+          "$ref": "#/definitions/Email"
+        },
+        "error": {
+          "description": "The error of the last execution attempt.",
+          "type": "string"
+        },
+        "execTime": {
+          "description": "Time of execution, absent if the task has not been executed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        },
+        "provider": {
+          "description": "The email provider which delivered the message.",
+          "type": "string"
+        },
+        "runAt": {
+          "description": "The earliest time to execute the task.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "$ref": "#/definitions/TaskStatus"
+        }
+      }
+    },
+    "Org": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "role",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "$ref": "#/definitions/OrgID"
+        },
+        "name": {
+          "$ref": "#/definitions/OrgName"
+        },
+        "role": {
+          "description": "Role of the user in the organization.",
+          "$ref": "#/definitions/OrgRole"
+        }
+      }
+    },
+    "OrgID": {
+      "type": "integer",
+      "format": "int32"
+    },
+    "OrgMember": {
+      "type": "object",
+      "required": [
+        "userId",
+        "username",
+        "email",
+        "role",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "description": "Time of joining the organization.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "email": {
+          "$ref": "#/definitions/Email"
+        },
+        "role": {
+          "$ref": "#/definitions/OrgRole"
+        },
+        "userId": {
+          "$ref": "#/definitions/UserID"
+        },
+        "username": {
+          "$ref": "#/definitions/Username"
+        }
+      }
+    },
+    "OrgName": {
+      "type": "string",
+      "maxLength": 100,
+      "minLength": 1
+    },
+    "OrgRole": {
+      "type": "string",
+      "enum": [
+        "owner",
+        "admin",
+        "member"
+      ]
+    },
+    "Password": {
+      "type": "string",
+      "format": "password",
+      "maxLength": 100,
+      "minLength": 8
+    },
+    "Phone": {
+      "description": "Phone in E.164 format, spaces, dashes and brackets are ignored.",
+      "type": "string",
+      "maxLength": 32,
+      "minLength": 1
+    },
+    "RecoveryChannel": {
+      "type": "string",
+      "enum": [
+        "email",
+        "sms"
+      ]
+    },
+    "RecoveryCode": {
+      "type": "string",
+      "maxLength": 6,
+      "minLength": 1
+    },
+    "TaskStatus": {
+      "type": "string",
+      "enum": [
+        "pending",
+        "done",
+        "cancelled"
+      ]
+    },
+    "Unsubscription": {
+      "type": "object",
+      "required": [
+        "kind"
+      ],
+      "properties": {
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        }
+      }
+    },
+    "UpdatePassword": {
+      "type": "object",
+      "required": [
+        "old",
+        "new"
+      ],
+      "properties": {
+        "new": {
+          "$ref": "#/definitions/Password"
+        },
+        "old": {
+          "$ref": "#/definitions/Password"
+        }
+      }
+    },
+    "User": {
+      "type": "object",
+      "required": [
+        "id",
+        "username",
+        "email"
+      ],
+      "properties": {
+        "email": {
+          "$ref": "#/definitions/Email"
+        },
+        "emailUndeliverable": {
+          "description": "Set on login if mail to the email bounces or is reported as spam, the user should change the email.",
+          "type": "boolean"
+        },
+        "id": {
+          "$ref": "#/definitions/UserID"
+        },
+        "phone": {
+          "description": "Verified phone, it's used for SMS notifications.",
+          "type": "string"
+        },
+        "username": {
+          "$ref": "#/definitions/Username"
+        }
+      }
+    },
+    "UserID": {
+      "description": "Random public id of the user.",
+      "type": "string",
+      "format": "uuid"
+    },
+    "UserNotification": {
+      "type": "object",
+      "required": [
+        "id",
+        "kind",
+        "content",
+        "read",
+        "createdAt"
+      ],
+      "properties": {
+        "content": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        },
+        "read": {
+          "type": "boolean"
+        }
+      }
+    },
+    "Username": {
+      "type": "string",
+      "maxLength": 30,
+      "minLength": 1
+    }
+  },
+  "parameters": {
+    "IfMatch": {
+      "type": "string",
+      "description": "ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.",
+      "name": "If-Match",
+      "in": "header"
+    }
+  },
+  "responses": {
+    "GenericError": {
+      "description": "Generic error response.",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
+    },
+    "NoContent": {
+      "description": "The server successfully processed the request and is not returning any content."
+    }
+  },
+  "securityDefinitions": {
+    "adminKey": {
+      "description": "Static key of the service administrator.",
+      "type": "apiKey",
+      "name": "X-Admin-Key",
+      "in": "header"
+    },
+    "cookieKey": {
+      "description": "Session auth inside cookie.",
+      "type": "apiKey",
+      "name": "Cookie",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "cookieKey": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "Service boilerplate.",
+    "license": {
+      "name": "MIT"
+    },
+    "version": "0.1.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/admin/notifications": {
+      "get": {
+        "security": [
+          {
+            "adminKey": []
+          }
+        ],
+        "description": "Notification tasks search, latest first.",
+        "operationId": "listNotificationTasks",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "email",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "kind",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "pending",
+              "done",
+              "cancelled"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "tasks": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "items": {
+                    "$ref": "#/definitions/NotificationTask"
+                  }
+                },
+                "total": {
+                  "type": "integer",
+                  "format": "int32",
+                  "minimum": 0
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/notifications/{id}/cancel": {
+      "post": {
+        "security": [
+          {
+            "adminKey": []
+          }
+        ],
+        "description": "Cancels the pending task.",
+        "operationId": "cancelNotificationTask",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/notifications/{id}/resend": {
+      "post": {
+        "security": [
+          {
+            "adminKey": []
+          }
+        ],
+        "description": "Creates a new pending task with the same recipient, kind and payload.",
+        "operationId": "resendNotificationTask",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/NotificationTask"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/email/events": {
+      "post": {
+        "security": [],
+        "description": "Receives bounces and complaints from the email provider, the request must be signed by the provider.",
+        "operationId": "handleEmailEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "X-Twilio-Email-Event-Webhook-Signature",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "X-Twilio-Email-Event-Webhook-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "name": "events",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/EmailProviderEvent"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/email/verification": {
+      "post": {
+        "security": [],
+        "operationId": "verificationEmail",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email"
+              ],
+              "properties": {
+                "email": {
+                  "$ref": "#/definitions/Email"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/login": {
+      "post": {
+        "security": [],
+        "description": "Login for user.",
+        "operationId": "login",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoginParam"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/User"
+            },
+            "headers": {
+              "Set-Cookie": {
+                "type": "string",
+                "description": "Session auth."
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/logout": {
+      "post": {
+        "description": "Logout for user",
+        "operationId": "logout",
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orgs": {
+      "get": {
+        "description": "Organizations of the user.",
+        "operationId": "listOrgs",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Org"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates the organization owned by the user.",
+        "operationId": "createOrg",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name"
+              ],
+              "properties": {
+                "name": {
+                  "$ref": "#/definitions/OrgName"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Org"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orgs/invitations": {
+      "post": {
+        "description": "Joins the organization by the token from the invitation sent to the user's email.",
+        "operationId": "acceptOrgInvitation",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "token"
+              ],
+              "properties": {
+                "token": {
+                  "type": "string",
+                  "minLength": 1
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Org"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orgs/{id}/invitations": {
+      "post": {
+        "description": "Sends the invitation with the accept link to the email, which is accepted after signing up if the email has no user yet.\nAdmins invite members, the owner invites admins too.\n",
+        "operationId": "inviteOrgMember",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
               "required": [
-                "username"
+                "email",
+                "role"
               ],
               "properties": {
-                "username": {
-                  "$ref": "#/definitions/Username"
+                "email": {
+                  "$ref": "#/definitions/Email"
+                },
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "admin",
+                    "member"
+                  ]
                 }
               }
             }
@@ -369,23 +2018,27 @@ func init() {
         ],
         "responses": {
           "204": {
-            "$ref": "#/responses/NoContent"
+            "description": "The server successfully processed the request and is not returning any content."
           },
           "default": {
-            "$ref": "#/responses/GenericError"
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "/users": {
+    "/orgs/{id}/members": {
       "get": {
-        "description": "User search.",
-        "operationId": "getUsers",
+        "description": "Members of the organization in order of joining, the user must be a member.",
+        "operationId": "listOrgMembers",
         "parameters": [
           {
-            "type": "string",
-            "name": "username",
-            "in": "query",
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
             "required": true
           },
           {
@@ -410,181 +2063,110 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
-                "total": {
-                  "type": "integer",
-                  "format": "int32"
-                },
-                "users": {
+                "members": {
                   "type": "array",
                   "maxItems": 100,
-                  "uniqueItems": true,
                   "items": {
-                    "$ref": "#/definitions/User"
+                    "$ref": "#/definitions/OrgMember"
                   }
+                },
+                "total": {
+                  "type": "integer",
+                  "format": "int32",
+                  "minimum": 0
                 }
               }
             }
           },
           "default": {
-            "$ref": "#/responses/GenericError"
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "CreateUserParams": {
-      "type": "object",
-      "required": [
-        "email",
-        "password",
-        "username"
-      ],
-      "properties": {
-        "email": {
-          "$ref": "#/definitions/Email"
-        },
-        "password": {
-          "$ref": "#/definitions/Password"
-        },
-        "username": {
-          "$ref": "#/definitions/Username"
-        }
-      }
-    },
-    "Email": {
-      "type": "string",
-      "format": "email",
-      "maxLength": 255,
-      "minLength": 1
-    },
-    "Error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "LoginParam": {
-      "type": "object",
-      "required": [
-        "email",
-        "password"
-      ],
-      "properties": {
-        "email": {
-          "$ref": "#/definitions/Email"
-        },
-        "password": {
-          "$ref": "#/definitions/Password"
-        }
-      }
-    },
-    "Password": {
-      "type": "string",
-      "format": "password",
-      "maxLength": 100,
-      "minLength": 8
-    },
-    "RecoveryCode": {
-      "type": "string",
-      "maxLength": 6,
-      "minLength": 1
-    },
-    "UpdatePassword": {
-      "type": "object",
-      "required": [
-        "old",
-        "new"
-      ],
-      "properties": {
-        "new": {
-          "$ref": "#/definitions/Password"
-        },
-        "old": {
-          "$ref": "#/definitions/Password"
-        }
-      }
     },
-    "User": {
-      "type": "object",
-      "required": [
-        "id",
-        "username",
-        "email"
-      ],
-      "properties": {
-        "email": {
-          "$ref": "#/definitions/Email"
-        },
-        "id": {
-          "$ref": "#/definitions/UserID"
-        },
-        "username": {
-          "$ref": "#/definitions/Username"
+    "/orgs/{id}/members/{userId}": {
+      "delete": {
+        "description": "Removes the member, users leave organizations by removing themselves.\nThe owner removes anyone, admins remove members, the owner must transfer ownership before leaving.\n",
+        "operationId": "removeOrgMember",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "userId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "UserID": {
-      "type": "integer",
-      "format": "int32"
-    },
-    "Username": {
-      "type": "string",
-      "maxLength": 30,
-      "minLength": 1
-    }
-  },
-  "responses": {
-    "GenericError": {
-      "description": "Generic error response.",
-      "schema": {
-        "$ref": "#/definitions/Error"
-      }
-    },
-    "NoContent": {
-      "description": "The server successfully processed the request and is not returning any content."
-    }
-  },
-  "securityDefinitions": {
-    "cookieKey": {
-      "description": "Session auth inside cookie.",
-      "type": "apiKey",
-      "name": "Cookie",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "cookieKey": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "Service boilerplate.",
-    "license": {
-      "name": "MIT"
+    "/orgs/{id}/owner": {
+      "post": {
+        "description": "Makes the member the owner, the user becomes an admin.",
+        "operationId": "transferOrgOwnership",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "userId"
+              ],
+              "properties": {
+                "userId": {
+                  "$ref": "#/definitions/UserID"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
     },
-    "version": "0.1.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/email/verification": {
+    "/recovery-code": {
       "post": {
         "security": [],
-        "operationId": "verificationEmail",
+        "description": "Creates a password recovery token and sends it to the email or by SMS to the verified phone.",
+        "operationId": "createRecoveryCode",
         "parameters": [
           {
             "name": "args",
@@ -596,6 +2178,9 @@ func init() {
                 "email"
               ],
               "properties": {
+                "channel": {
+                  "$ref": "#/definitions/RecoveryChannel"
+                },
                 "email": {
                   "$ref": "#/definitions/Email"
                 }
@@ -616,18 +2201,155 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/recovery-password": {
       "post": {
         "security": [],
-        "description": "Login for user.",
-        "operationId": "login",
+        "description": "Updates the password of the user who owns this recovery code.",
+        "operationId": "recoveryPassword",
         "parameters": [
           {
             "name": "args",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/LoginParam"
+              "type": "object",
+              "required": [
+                "email",
+                "recovery_code",
+                "password"
+              ],
+              "properties": {
+                "email": {
+                  "$ref": "#/definitions/Email"
+                },
+                "password": {
+                  "$ref": "#/definitions/Password"
+                },
+                "recoveryCode": {
+                  "$ref": "#/definitions/RecoveryCode"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/unsubscribe": {
+      "get": {
+        "security": [],
+        "description": "Returns the kind of notification the link from email unsubscribes from without changing anything, the unsubscription is confirmed by POST with the same token.",
+        "operationId": "unsubscribe",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Unsubscription"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "description": "Unsubscribes from the kind of notification, sent by the confirmation of the link from email or by email client as one-click unsubscribe (RFC 8058) using List-Unsubscribe header.",
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "unsubscribeOneClick",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "List-Unsubscribe",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/user": {
+      "get": {
+        "description": "Open user profile, the profile of the user itself is returned if the id isn't sent.",
+        "operationId": "getUser",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/User"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the user, it's sent in If-Match of updates."
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "description": "New user registration. If it is not sent to username, it will be the userID",
+        "operationId": "createUser",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateUserParams"
             }
           }
         ],
@@ -651,12 +2373,10 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/logout": {
-      "post": {
-        "description": "Logout for user",
-        "operationId": "logout",
+      },
+      "delete": {
+        "description": "Deletion of your account.",
+        "operationId": "deleteUser",
         "responses": {
           "204": {
             "description": "The server successfully processed the request and is not returning any content."
@@ -670,12 +2390,17 @@ func init() {
         }
       }
     },
-    "/recovery-code": {
-      "post": {
-        "security": [],
-        "description": "Creates a password recovery token and sends it to the email.",
-        "operationId": "createRecoveryCode",
+    "/user/email": {
+      "patch": {
+        "description": "Change email.",
+        "operationId": "updateEmail",
         "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.",
+            "name": "If-Match",
+            "in": "header"
+          },
           {
             "name": "args",
             "in": "body",
@@ -706,11 +2431,121 @@ func init() {
         }
       }
     },
-    "/recovery-password": {
+    "/user/notification-settings": {
+      "get": {
+        "description": "User preferences for every kind of notification.",
+        "operationId": "getNotificationSettings",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/NotificationSetting"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Opt-in or opt-out of the kind of notification.",
+        "operationId": "updateNotificationSetting",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "kind",
+                "enabled"
+              ],
+              "properties": {
+                "enabled": {
+                  "type": "boolean"
+                },
+                "kind": {
+                  "$ref": "#/definitions/MessageKind"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/user/notifications": {
+      "get": {
+        "description": "Notifications from the user inbox, latest first.",
+        "operationId": "listUserNotifications",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "notifications": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "items": {
+                    "$ref": "#/definitions/UserNotification"
+                  }
+                },
+                "total": {
+                  "type": "integer",
+                  "format": "int32",
+                  "minimum": 0
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/user/notifications/read": {
       "post": {
-        "security": [],
-        "description": "Updates the password of the user who owns this recovery code.",
-        "operationId": "recoveryPassword",
+        "description": "Marks notifications from the user inbox as read, all of them if ids are not passed.",
+        "operationId": "markUserNotificationsRead",
         "parameters": [
           {
             "name": "args",
@@ -718,20 +2553,14 @@ func init() {
             "required": true,
             "schema": {
               "type": "object",
-              "required": [
-                "email",
-                "recovery_code",
-                "password"
-              ],
               "properties": {
-                "email": {
-                  "$ref": "#/definitions/Email"
-                },
-                "password": {
-                  "$ref": "#/definitions/Password"
-                },
-                "recoveryCode": {
-                  "$ref": "#/definitions/RecoveryCode"
+                "ids": {
+                  "type": "array",
+                  "maxItems": 100,
+                  "items": {
+                    "type": "integer",
+                    "format": "int32"
+                  }
                 }
               }
             }
@@ -750,23 +2579,27 @@ func init() {
         }
       }
     },
-    "/user": {
+    "/user/notifications/stream": {
       "get": {
-        "description": "Open user profile.",
-        "operationId": "getUser",
+        "description": "Server-Sent Events stream of notifications added to the user inbox.\nEvery notification is sent as ` + "`" + `notification` + "`" + ` event with UserNotification in data and its id as event id.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "operationId": "streamUserNotifications",
         "parameters": [
           {
             "type": "integer",
             "format": "int32",
-            "name": "id",
-            "in": "query"
+            "description": "Resume the stream after the notification with this id.",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/User"
+              "type": "string"
             }
           },
           "default": {
@@ -776,31 +2609,26 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [],
-        "description": "New user registration. If it is not sent to username, it will be the userID",
-        "operationId": "createUser",
-        "parameters": [
-          {
-            "name": "args",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/CreateUserParams"
-            }
-          }
-        ],
+      }
+    },
+    "/user/notifications/unread-count": {
+      "get": {
+        "description": "Number of unread notifications in the user inbox.",
+        "operationId": "getUnreadNotificationCount",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/User"
-            },
-            "headers": {
-              "Set-Cookie": {
-                "type": "string",
-                "description": "Session auth."
+              "type": "object",
+              "required": [
+                "count"
+              ],
+              "properties": {
+                "count": {
+                  "type": "integer",
+                  "format": "int32",
+                  "minimum": 0
+                }
               }
             }
           },
@@ -811,10 +2639,28 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletion of your account.",
-        "operationId": "deleteUser",
+      }
+    },
+    "/user/password": {
+      "patch": {
+        "description": "Change password.",
+        "operationId": "updatePassword",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UpdatePassword"
+            }
+          }
+        ],
         "responses": {
           "204": {
             "description": "The server successfully processed the request and is not returning any content."
@@ -828,10 +2674,10 @@ func init() {
         }
       }
     },
-    "/user/email": {
-      "patch": {
-        "description": "Change email.",
-        "operationId": "updateEmail",
+    "/user/phone": {
+      "post": {
+        "description": "Sends by SMS a code to verify the phone, the phone is set after the verification.",
+        "operationId": "requestPhoneVerification",
         "parameters": [
           {
             "name": "args",
@@ -840,11 +2686,11 @@ func init() {
             "schema": {
               "type": "object",
               "required": [
-                "email"
+                "phone"
               ],
               "properties": {
-                "email": {
-                  "$ref": "#/definitions/Email"
+                "phone": {
+                  "$ref": "#/definitions/Phone"
                 }
               }
             }
@@ -861,19 +2707,42 @@ func init() {
             }
           }
         }
+      },
+      "delete": {
+        "description": "Removes the phone.",
+        "operationId": "deletePhone",
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "/user/password": {
-      "patch": {
-        "description": "Change password.",
-        "operationId": "updatePassword",
+    "/user/phone/verify": {
+      "post": {
+        "description": "Sets the phone by the code sent to it.",
+        "operationId": "verifyPhone",
         "parameters": [
           {
             "name": "args",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/UpdatePassword"
+              "type": "object",
+              "required": [
+                "code"
+              ],
+              "properties": {
+                "code": {
+                  "$ref": "#/definitions/RecoveryCode"
+                }
+              }
             }
           }
         ],
@@ -895,6 +2764,12 @@ func init() {
         "description": "Change username.",
         "operationId": "updateUsername",
         "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.",
+            "name": "If-Match",
+            "in": "header"
+          },
           {
             "name": "args",
             "in": "body",
@@ -1045,6 +2920,36 @@ func init() {
       "maxLength": 255,
       "minLength": 1
     },
+    "EmailProviderEvent": {
+      "description": "Event of the email provider in SendGrid Event Webhook format, unknown events are ignored.",
+      "type": "object",
+      "required": [
+        "email",
+        "event"
+      ],
+      "properties": {
+        "email": {
+          "type": "string"
+        },
+        "event": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "sg_event_id": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "description": "Kind of the bounce, blocked messages are not bounced addresses.",
+          "type": "string"
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "required": [
@@ -1071,17 +2976,193 @@ func init() {
         }
       }
     },
+    "MessageKind": {
+      "type": "string",
+      "minLength": 1
+    },
+    "NotificationSetting": {
+      "type": "object",
+      "required": [
+        "kind",
+        "enabled",
+        "mandatory"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        },
+        "mandatory": {
+          "description": "The user can't opt-out of security-critical notifications.",
+          "type": "boolean"
+        }
+      }
+    },
+    "NotificationTask": {
+      "type": "object",
+      "required": [
+        "id",
+        "email",
+        "kind",
+        "status",
+        "createdAt",
+        "runAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "email": {
+          "$ref": "#/definitions/Email"
+        },
+        "error": {
+          "description": "The error of the last execution attempt.",
+          "type": "string"
+        },
+        "execTime": {
+          "description": "Time of execution, absent if the task has not been executed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        },
+        "provider": {
+          "description": "The email provider which delivered the message.",
+          "type": "string"
+        },
+        "runAt": {
+          "description": "The earliest time to execute the task.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "$ref": "#/definitions/TaskStatus"
+        }
+      }
+    },
+    "Org": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "role",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "$ref": "#/definitions/OrgID"
+        },
+        "name": {
+          "$ref": "#/definitions/OrgName"
+        },
+        "role": {
+          "description": "Role of the user in the organization.",
+          "$ref": "#/definitions/OrgRole"
+        }
+      }
+    },
+    "OrgID": {
+      "type": "integer",
+      "format": "int32"
+    },
+    "OrgMember": {
+      "type": "object",
+      "required": [
+        "userId",
+        "username",
+        "email",
+        "role",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "description": "Time of joining the organization.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "email": {
+          "$ref": "#/definitions/Email"
+        },
+        "role": {
+          "$ref": "#/definitions/OrgRole"
+        },
+        "userId": {
+          "$ref": "#/definitions/UserID"
+        },
+        "username": {
+          "$ref": "#/definitions/Username"
+        }
+      }
+    },
+    "OrgName": {
+      "type": "string",
+      "maxLength": 100,
+      "minLength": 1
+    },
+    "OrgRole": {
+      "type": "string",
+      "enum": [
+        "owner",
+        "admin",
+        "member"
+      ]
+    },
     "Password": {
       "type": "string",
       "format": "password",
       "maxLength": 100,
       "minLength": 8
     },
+    "Phone": {
+      "description": "Phone in E.164 format, spaces, dashes and brackets are ignored.",
+      "type": "string",
+      "maxLength": 32,
+      "minLength": 1
+    },
+    "RecoveryChannel": {
+      "type": "string",
+      "enum": [
+        "email",
+        "sms"
+      ]
+    },
     "RecoveryCode": {
       "type": "string",
       "maxLength": 6,
       "minLength": 1
     },
+    "TaskStatus": {
+      "type": "string",
+      "enum": [
+        "pending",
+        "done",
+        "cancelled"
+      ]
+    },
+    "Unsubscription": {
+      "type": "object",
+      "required": [
+        "kind"
+      ],
+      "properties": {
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        }
+      }
+    },
     "UpdatePassword": {
       "type": "object",
       "required": [
@@ -1108,17 +3189,55 @@ func init() {
         "email": {
           "$ref": "#/definitions/Email"
         },
+        "emailUndeliverable": {
+          "description": "Set on login if mail to the email bounces or is reported as spam, the user should change the email.",
+          "type": "boolean"
+        },
         "id": {
           "$ref": "#/definitions/UserID"
         },
+        "phone": {
+          "description": "Verified phone, it's used for SMS notifications.",
+          "type": "string"
+        },
         "username": {
           "$ref": "#/definitions/Username"
         }
       }
     },
     "UserID": {
-      "type": "integer",
-      "format": "int32"
+      "description": "Random public id of the user.",
+      "type": "string",
+      "format": "uuid"
+    },
+    "UserNotification": {
+      "type": "object",
+      "required": [
+        "id",
+        "kind",
+        "content",
+        "read",
+        "createdAt"
+      ],
+      "properties": {
+        "content": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "kind": {
+          "$ref": "#/definitions/MessageKind"
+        },
+        "read": {
+          "type": "boolean"
+        }
+      }
     },
     "Username": {
       "type": "string",
@@ -1126,6 +3245,14 @@ func init() {
       "minLength": 1
     }
   },
+  "parameters": {
+    "IfMatch": {
+      "type": "string",
+      "description": "ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.",
+      "name": "If-Match",
+      "in": "header"
+    }
+  },
   "responses": {
     "GenericError": {
       "description": "Generic error response.",
@@ -1138,6 +3265,12 @@ func init() {
     }
   },
   "securityDefinitions": {
+    "adminKey": {
+      "description": "Static key of the service administrator.",
+      "type": "apiKey",
+      "name": "X-Admin-Key",
+      "in": "header"
+    },
     "cookieKey": {
       "description": "Session auth inside cookie.",
       "type": "apiKey",
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// AcceptOrgInvitationHandlerFunc turns a function with the right signature into a accept org invitation handler
+type AcceptOrgInvitationHandlerFunc func(AcceptOrgInvitationParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AcceptOrgInvitationHandlerFunc) Handle(params AcceptOrgInvitationParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AcceptOrgInvitationHandler interface for that can handle valid accept org invitation params
+type AcceptOrgInvitationHandler interface {
+	Handle(AcceptOrgInvitationParams, *app.AuthUser) middleware.Responder
+}
+
+// NewAcceptOrgInvitation creates a new http.Handler for the accept org invitation operation
+func NewAcceptOrgInvitation(ctx *middleware.Context, handler AcceptOrgInvitationHandler) *AcceptOrgInvitation {
+	return &AcceptOrgInvitation{Context: ctx, Handler: handler}
+}
+
+/*
+AcceptOrgInvitation swagger:route POST /orgs/invitations acceptOrgInvitation
+
+Joins the organization by the token from the invitation sent to the user's email.
+*/
+type AcceptOrgInvitation struct {
+	Context *middleware.Context
+	Handler AcceptOrgInvitationHandler
+}
+
+func (o *AcceptOrgInvitation) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewAcceptOrgInvitationParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// AcceptOrgInvitationBody accept org invitation body
+//
+// swagger:model AcceptOrgInvitationBody
+type AcceptOrgInvitationBody struct {
+
+	// token
+	// Required: true
+	// Min Length: 1
+	Token *string `json:"token"`
+}
+
+// Validate validates this accept org invitation body
+func (o *AcceptOrgInvitationBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AcceptOrgInvitationBody) validateToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("args"+"."+"token", "body", o.Token); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("args"+"."+"token", "body", string(*o.Token), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AcceptOrgInvitationBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AcceptOrgInvitationBody) UnmarshalBinary(b []byte) error {
+	var res AcceptOrgInvitationBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewAcceptOrgInvitationParams creates a new AcceptOrgInvitationParams object
+// no default values defined in spec.
+func NewAcceptOrgInvitationParams() AcceptOrgInvitationParams {
+
+	return AcceptOrgInvitationParams{}
+}
+
+// AcceptOrgInvitationParams contains all the bound params for the accept org invitation operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters acceptOrgInvitation
+type AcceptOrgInvitationParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Args AcceptOrgInvitationBody
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewAcceptOrgInvitationParams() beforehand.
+func (o *AcceptOrgInvitationParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body AcceptOrgInvitationBody
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("args", "body"))
+			} else {
+				res = append(res, errors.NewParseError("args", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Args = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("args", "body"))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// AcceptOrgInvitationOKCode is the HTTP code returned for type AcceptOrgInvitationOK
+const AcceptOrgInvitationOKCode int = 200
+
+/*
+AcceptOrgInvitationOK OK
+
+swagger:response acceptOrgInvitationOK
+*/
+type AcceptOrgInvitationOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Org `json:"body,omitempty"`
+}
+
+// NewAcceptOrgInvitationOK creates AcceptOrgInvitationOK with default headers values
+func NewAcceptOrgInvitationOK() *AcceptOrgInvitationOK {
+
+	return &AcceptOrgInvitationOK{}
+}
+
+// WithPayload adds the payload to the accept org invitation o k response
+func (o *AcceptOrgInvitationOK) WithPayload(payload *models.Org) *AcceptOrgInvitationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the accept org invitation o k response
+func (o *AcceptOrgInvitationOK) SetPayload(payload *models.Org) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcceptOrgInvitationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+AcceptOrgInvitationDefault Generic error response.
+
+swagger:response acceptOrgInvitationDefault
+*/
+type AcceptOrgInvitationDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAcceptOrgInvitationDefault creates AcceptOrgInvitationDefault with default headers values
+func NewAcceptOrgInvitationDefault(code int) *AcceptOrgInvitationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AcceptOrgInvitationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the accept org invitation default response
+func (o *AcceptOrgInvitationDefault) WithStatusCode(code int) *AcceptOrgInvitationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the accept org invitation default response
+func (o *AcceptOrgInvitationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the accept org invitation default response
+func (o *AcceptOrgInvitationDefault) WithPayload(payload *models.Error) *AcceptOrgInvitationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the accept org invitation default response
+func (o *AcceptOrgInvitationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcceptOrgInvitationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
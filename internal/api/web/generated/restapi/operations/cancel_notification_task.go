@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CancelNotificationTaskHandlerFunc turns a function with the right signature into a cancel notification task handler
+type CancelNotificationTaskHandlerFunc func(CancelNotificationTaskParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CancelNotificationTaskHandlerFunc) Handle(params CancelNotificationTaskParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CancelNotificationTaskHandler interface for that can handle valid cancel notification task params
+type CancelNotificationTaskHandler interface {
+	Handle(CancelNotificationTaskParams, *app.AuthUser) middleware.Responder
+}
+
+// NewCancelNotificationTask creates a new http.Handler for the cancel notification task operation
+func NewCancelNotificationTask(ctx *middleware.Context, handler CancelNotificationTaskHandler) *CancelNotificationTask {
+	return &CancelNotificationTask{Context: ctx, Handler: handler}
+}
+
+/*
+CancelNotificationTask swagger:route POST /admin/notifications/{id}/cancel cancelNotificationTask
+
+Cancels the pending task.
+*/
+type CancelNotificationTask struct {
+	Context *middleware.Context
+	Handler CancelNotificationTaskHandler
+}
+
+func (o *CancelNotificationTask) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCancelNotificationTaskParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
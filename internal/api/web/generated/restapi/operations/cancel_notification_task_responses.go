@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// CancelNotificationTaskNoContentCode is the HTTP code returned for type CancelNotificationTaskNoContent
+const CancelNotificationTaskNoContentCode int = 204
+
+/*
+CancelNotificationTaskNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response cancelNotificationTaskNoContent
+*/
+type CancelNotificationTaskNoContent struct {
+}
+
+// NewCancelNotificationTaskNoContent creates CancelNotificationTaskNoContent with default headers values
+func NewCancelNotificationTaskNoContent() *CancelNotificationTaskNoContent {
+
+	return &CancelNotificationTaskNoContent{}
+}
+
+// WriteResponse to the client
+func (o *CancelNotificationTaskNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+CancelNotificationTaskDefault Generic error response.
+
+swagger:response cancelNotificationTaskDefault
+*/
+type CancelNotificationTaskDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCancelNotificationTaskDefault creates CancelNotificationTaskDefault with default headers values
+func NewCancelNotificationTaskDefault(code int) *CancelNotificationTaskDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CancelNotificationTaskDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the cancel notification task default response
+func (o *CancelNotificationTaskDefault) WithStatusCode(code int) *CancelNotificationTaskDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the cancel notification task default response
+func (o *CancelNotificationTaskDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the cancel notification task default response
+func (o *CancelNotificationTaskDefault) WithPayload(payload *models.Error) *CancelNotificationTaskDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel notification task default response
+func (o *CancelNotificationTaskDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelNotificationTaskDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
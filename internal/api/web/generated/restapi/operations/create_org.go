@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateOrgHandlerFunc turns a function with the right signature into a create org handler
+type CreateOrgHandlerFunc func(CreateOrgParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateOrgHandlerFunc) Handle(params CreateOrgParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateOrgHandler interface for that can handle valid create org params
+type CreateOrgHandler interface {
+	Handle(CreateOrgParams, *app.AuthUser) middleware.Responder
+}
+
+// NewCreateOrg creates a new http.Handler for the create org operation
+func NewCreateOrg(ctx *middleware.Context, handler CreateOrgHandler) *CreateOrg {
+	return &CreateOrg{Context: ctx, Handler: handler}
+}
+
+/*
+CreateOrg swagger:route POST /orgs createOrg
+
+Creates the organization owned by the user.
+*/
+type CreateOrg struct {
+	Context *middleware.Context
+	Handler CreateOrgHandler
+}
+
+func (o *CreateOrg) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateOrgParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreateOrgBody create org body
+//
+// swagger:model CreateOrgBody
+type CreateOrgBody struct {
+
+	// name
+	// Required: true
+	Name models.OrgName `json:"name"`
+}
+
+// Validate validates this create org body
+func (o *CreateOrgBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateOrgBody) validateName(formats strfmt.Registry) error {
+
+	if err := o.Name.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateOrgBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateOrgBody) UnmarshalBinary(b []byte) error {
+	var res CreateOrgBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
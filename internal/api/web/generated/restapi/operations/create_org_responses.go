@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// CreateOrgOKCode is the HTTP code returned for type CreateOrgOK
+const CreateOrgOKCode int = 200
+
+/*
+CreateOrgOK OK
+
+swagger:response createOrgOK
+*/
+type CreateOrgOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Org `json:"body,omitempty"`
+}
+
+// NewCreateOrgOK creates CreateOrgOK with default headers values
+func NewCreateOrgOK() *CreateOrgOK {
+
+	return &CreateOrgOK{}
+}
+
+// WithPayload adds the payload to the create org o k response
+func (o *CreateOrgOK) WithPayload(payload *models.Org) *CreateOrgOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create org o k response
+func (o *CreateOrgOK) SetPayload(payload *models.Org) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrgOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateOrgDefault Generic error response.
+
+swagger:response createOrgDefault
+*/
+type CreateOrgDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrgDefault creates CreateOrgDefault with default headers values
+func NewCreateOrgDefault(code int) *CreateOrgDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateOrgDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create org default response
+func (o *CreateOrgDefault) WithStatusCode(code int) *CreateOrgDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create org default response
+func (o *CreateOrgDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create org default response
+func (o *CreateOrgDefault) WithPayload(payload *models.Error) *CreateOrgDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create org default response
+func (o *CreateOrgDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrgDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -34,10 +34,10 @@ func NewCreateRecoveryCode(ctx *middleware.Context, handler CreateRecoveryCodeHa
 	return &CreateRecoveryCode{Context: ctx, Handler: handler}
 }
 
-/*CreateRecoveryCode swagger:route POST /recovery-code createRecoveryCode
-
-Creates a password recovery token and sends it to the email.
+/*
+CreateRecoveryCode swagger:route POST /recovery-code createRecoveryCode
 
+Creates a password recovery token and sends it to the email or by SMS to the verified phone.
 */
 type CreateRecoveryCode struct {
 	Context *middleware.Context
@@ -67,6 +67,9 @@ func (o *CreateRecoveryCode) ServeHTTP(rw http.ResponseWriter, r *http.Request)
 // swagger:model CreateRecoveryCodeBody
 type CreateRecoveryCodeBody struct {
 
+	// channel
+	Channel models.RecoveryChannel `json:"channel,omitempty"`
+
 	// email
 	// Required: true
 	// Format: email
@@ -77,6 +80,10 @@ type CreateRecoveryCodeBody struct {
 func (o *CreateRecoveryCodeBody) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.validateChannel(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateEmail(formats); err != nil {
 		res = append(res, err)
 	}
@@ -87,6 +94,22 @@ func (o *CreateRecoveryCodeBody) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *CreateRecoveryCodeBody) validateChannel(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Channel) { // not required
+		return nil
+	}
+
+	if err := o.Channel.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "channel")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (o *CreateRecoveryCodeBody) validateEmail(formats strfmt.Registry) error {
 
 	if err := o.Email.Validate(formats); err != nil {
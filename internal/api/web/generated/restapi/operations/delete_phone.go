@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// DeletePhoneHandlerFunc turns a function with the right signature into a delete phone handler
+type DeletePhoneHandlerFunc func(DeletePhoneParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeletePhoneHandlerFunc) Handle(params DeletePhoneParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeletePhoneHandler interface for that can handle valid delete phone params
+type DeletePhoneHandler interface {
+	Handle(DeletePhoneParams, *app.AuthUser) middleware.Responder
+}
+
+// NewDeletePhone creates a new http.Handler for the delete phone operation
+func NewDeletePhone(ctx *middleware.Context, handler DeletePhoneHandler) *DeletePhone {
+	return &DeletePhone{Context: ctx, Handler: handler}
+}
+
+/*
+DeletePhone swagger:route DELETE /user/phone deletePhone
+
+Removes the phone.
+*/
+type DeletePhone struct {
+	Context *middleware.Context
+	Handler DeletePhoneHandler
+}
+
+func (o *DeletePhone) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewDeletePhoneParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
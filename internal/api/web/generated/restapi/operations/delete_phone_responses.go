@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// DeletePhoneNoContentCode is the HTTP code returned for type DeletePhoneNoContent
+const DeletePhoneNoContentCode int = 204
+
+/*
+DeletePhoneNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response deletePhoneNoContent
+*/
+type DeletePhoneNoContent struct {
+}
+
+// NewDeletePhoneNoContent creates DeletePhoneNoContent with default headers values
+func NewDeletePhoneNoContent() *DeletePhoneNoContent {
+
+	return &DeletePhoneNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeletePhoneNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+DeletePhoneDefault Generic error response.
+
+swagger:response deletePhoneDefault
+*/
+type DeletePhoneDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePhoneDefault creates DeletePhoneDefault with default headers values
+func NewDeletePhoneDefault(code int) *DeletePhoneDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeletePhoneDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete phone default response
+func (o *DeletePhoneDefault) WithStatusCode(code int) *DeletePhoneDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete phone default response
+func (o *DeletePhoneDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete phone default response
+func (o *DeletePhoneDefault) WithPayload(payload *models.Error) *DeletePhoneDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete phone default response
+func (o *DeletePhoneDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePhoneDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// GetNotificationSettingsHandlerFunc turns a function with the right signature into a get notification settings handler
+type GetNotificationSettingsHandlerFunc func(GetNotificationSettingsParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetNotificationSettingsHandlerFunc) Handle(params GetNotificationSettingsParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetNotificationSettingsHandler interface for that can handle valid get notification settings params
+type GetNotificationSettingsHandler interface {
+	Handle(GetNotificationSettingsParams, *app.AuthUser) middleware.Responder
+}
+
+// NewGetNotificationSettings creates a new http.Handler for the get notification settings operation
+func NewGetNotificationSettings(ctx *middleware.Context, handler GetNotificationSettingsHandler) *GetNotificationSettings {
+	return &GetNotificationSettings{Context: ctx, Handler: handler}
+}
+
+/*
+GetNotificationSettings swagger:route GET /user/notification-settings getNotificationSettings
+
+User preferences for every kind of notification.
+*/
+type GetNotificationSettings struct {
+	Context *middleware.Context
+	Handler GetNotificationSettingsHandler
+}
+
+func (o *GetNotificationSettings) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetNotificationSettingsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
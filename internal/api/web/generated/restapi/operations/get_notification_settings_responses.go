@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// GetNotificationSettingsOKCode is the HTTP code returned for type GetNotificationSettingsOK
+const GetNotificationSettingsOKCode int = 200
+
+/*
+GetNotificationSettingsOK OK
+
+swagger:response getNotificationSettingsOK
+*/
+type GetNotificationSettingsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.NotificationSetting `json:"body,omitempty"`
+}
+
+// NewGetNotificationSettingsOK creates GetNotificationSettingsOK with default headers values
+func NewGetNotificationSettingsOK() *GetNotificationSettingsOK {
+
+	return &GetNotificationSettingsOK{}
+}
+
+// WithPayload adds the payload to the get notification settings o k response
+func (o *GetNotificationSettingsOK) WithPayload(payload []*models.NotificationSetting) *GetNotificationSettingsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get notification settings o k response
+func (o *GetNotificationSettingsOK) SetPayload(payload []*models.NotificationSetting) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetNotificationSettingsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.NotificationSetting, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+GetNotificationSettingsDefault Generic error response.
+
+swagger:response getNotificationSettingsDefault
+*/
+type GetNotificationSettingsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetNotificationSettingsDefault creates GetNotificationSettingsDefault with default headers values
+func NewGetNotificationSettingsDefault(code int) *GetNotificationSettingsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetNotificationSettingsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get notification settings default response
+func (o *GetNotificationSettingsDefault) WithStatusCode(code int) *GetNotificationSettingsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get notification settings default response
+func (o *GetNotificationSettingsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get notification settings default response
+func (o *GetNotificationSettingsDefault) WithPayload(payload *models.Error) *GetNotificationSettingsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get notification settings default response
+func (o *GetNotificationSettingsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetNotificationSettingsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// GetUnreadNotificationCountHandlerFunc turns a function with the right signature into a get unread notification count handler
+type GetUnreadNotificationCountHandlerFunc func(GetUnreadNotificationCountParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetUnreadNotificationCountHandlerFunc) Handle(params GetUnreadNotificationCountParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetUnreadNotificationCountHandler interface for that can handle valid get unread notification count params
+type GetUnreadNotificationCountHandler interface {
+	Handle(GetUnreadNotificationCountParams, *app.AuthUser) middleware.Responder
+}
+
+// NewGetUnreadNotificationCount creates a new http.Handler for the get unread notification count operation
+func NewGetUnreadNotificationCount(ctx *middleware.Context, handler GetUnreadNotificationCountHandler) *GetUnreadNotificationCount {
+	return &GetUnreadNotificationCount{Context: ctx, Handler: handler}
+}
+
+/*
+GetUnreadNotificationCount swagger:route GET /user/notifications/unread-count getUnreadNotificationCount
+
+Number of unread notifications in the user inbox.
+*/
+type GetUnreadNotificationCount struct {
+	Context *middleware.Context
+	Handler GetUnreadNotificationCountHandler
+}
+
+func (o *GetUnreadNotificationCount) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetUnreadNotificationCountParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// GetUnreadNotificationCountOKBody get unread notification count o k body
+//
+// swagger:model GetUnreadNotificationCountOKBody
+type GetUnreadNotificationCountOKBody struct {
+
+	// count
+	// Required: true
+	// Minimum: 0
+	Count *int32 `json:"count"`
+}
+
+// Validate validates this get unread notification count o k body
+func (o *GetUnreadNotificationCountOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetUnreadNotificationCountOKBody) validateCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("getUnreadNotificationCountOK"+"."+"count", "body", o.Count); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("getUnreadNotificationCountOK"+"."+"count", "body", int64(*o.Count), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetUnreadNotificationCountOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetUnreadNotificationCountOKBody) UnmarshalBinary(b []byte) error {
+	var res GetUnreadNotificationCountOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
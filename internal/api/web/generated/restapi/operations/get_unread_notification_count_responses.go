@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// GetUnreadNotificationCountOKCode is the HTTP code returned for type GetUnreadNotificationCountOK
+const GetUnreadNotificationCountOKCode int = 200
+
+/*
+GetUnreadNotificationCountOK OK
+
+swagger:response getUnreadNotificationCountOK
+*/
+type GetUnreadNotificationCountOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *GetUnreadNotificationCountOKBody `json:"body,omitempty"`
+}
+
+// NewGetUnreadNotificationCountOK creates GetUnreadNotificationCountOK with default headers values
+func NewGetUnreadNotificationCountOK() *GetUnreadNotificationCountOK {
+
+	return &GetUnreadNotificationCountOK{}
+}
+
+// WithPayload adds the payload to the get unread notification count o k response
+func (o *GetUnreadNotificationCountOK) WithPayload(payload *GetUnreadNotificationCountOKBody) *GetUnreadNotificationCountOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get unread notification count o k response
+func (o *GetUnreadNotificationCountOK) SetPayload(payload *GetUnreadNotificationCountOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUnreadNotificationCountOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetUnreadNotificationCountDefault Generic error response.
+
+swagger:response getUnreadNotificationCountDefault
+*/
+type GetUnreadNotificationCountDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUnreadNotificationCountDefault creates GetUnreadNotificationCountDefault with default headers values
+func NewGetUnreadNotificationCountDefault(code int) *GetUnreadNotificationCountDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetUnreadNotificationCountDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get unread notification count default response
+func (o *GetUnreadNotificationCountDefault) WithStatusCode(code int) *GetUnreadNotificationCountDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get unread notification count default response
+func (o *GetUnreadNotificationCountDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get unread notification count default response
+func (o *GetUnreadNotificationCountDefault) WithPayload(payload *models.Error) *GetUnreadNotificationCountDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get unread notification count default response
+func (o *GetUnreadNotificationCountDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUnreadNotificationCountDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -30,10 +30,10 @@ func NewGetUser(ctx *middleware.Context, handler GetUserHandler) *GetUser {
 	return &GetUser{Context: ctx, Handler: handler}
 }
 
-/*GetUser swagger:route GET /user getUser
-
-Open user profile.
+/*
+GetUser swagger:route GET /user getUser
 
+Open user profile, the profile of the user itself is returned if the id isn't sent.
 */
 type GetUser struct {
 	Context *middleware.Context
@@ -12,7 +12,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewGetUserParams creates a new GetUserParams object
@@ -34,7 +34,7 @@ type GetUserParams struct {
 	/*
 	  In: query
 	*/
-	ID *int32
+	ID *strfmt.UUID
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -72,11 +72,25 @@ func (o *GetUserParams) bindID(rawData []string, hasKey bool, formats strfmt.Reg
 		return nil
 	}
 
-	value, err := swag.ConvertInt32(raw)
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
 	if err != nil {
-		return errors.InvalidType("id", "query", "int32", raw)
+		return errors.InvalidType("id", "query", "strfmt.UUID", raw)
 	}
-	o.ID = &value
+	o.ID = (value.(*strfmt.UUID))
 
+	if err := o.validateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateID carries on validations for parameter ID
+func (o *GetUserParams) validateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("id", "query", "uuid", o.ID.String(), formats); err != nil {
+		return err
+	}
 	return nil
 }
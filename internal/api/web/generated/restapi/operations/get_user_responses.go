@@ -16,11 +16,16 @@ import (
 // GetUserOKCode is the HTTP code returned for type GetUserOK
 const GetUserOKCode int = 200
 
-/*GetUserOK OK
+/*
+GetUserOK OK
 
 swagger:response getUserOK
 */
 type GetUserOK struct {
+	/*Version of the user, it's sent in If-Match of updates.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +39,17 @@ func NewGetUserOK() *GetUserOK {
 	return &GetUserOK{}
 }
 
+// WithETag adds the eTag to the get user o k response
+func (o *GetUserOK) WithETag(eTag string) *GetUserOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get user o k response
+func (o *GetUserOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get user o k response
 func (o *GetUserOK) WithPayload(payload *models.User) *GetUserOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *GetUserOK) SetPayload(payload *models.User) {
 // WriteResponse to the client
 func (o *GetUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -57,7 +80,8 @@ func (o *GetUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produ
 	}
 }
 
-/*GetUserDefault Generic error response.
+/*
+GetUserDefault Generic error response.
 
 swagger:response getUserDefault
 */
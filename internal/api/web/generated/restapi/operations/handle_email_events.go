@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// HandleEmailEventsHandlerFunc turns a function with the right signature into a handle email events handler
+type HandleEmailEventsHandlerFunc func(HandleEmailEventsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn HandleEmailEventsHandlerFunc) Handle(params HandleEmailEventsParams) middleware.Responder {
+	return fn(params)
+}
+
+// HandleEmailEventsHandler interface for that can handle valid handle email events params
+type HandleEmailEventsHandler interface {
+	Handle(HandleEmailEventsParams) middleware.Responder
+}
+
+// NewHandleEmailEvents creates a new http.Handler for the handle email events operation
+func NewHandleEmailEvents(ctx *middleware.Context, handler HandleEmailEventsHandler) *HandleEmailEvents {
+	return &HandleEmailEvents{Context: ctx, Handler: handler}
+}
+
+/*
+HandleEmailEvents swagger:route POST /email/events handleEmailEvents
+
+Receives bounces and complaints from the email provider, the request must be signed by the provider.
+*/
+type HandleEmailEvents struct {
+	Context *middleware.Context
+	Handler HandleEmailEventsHandler
+}
+
+func (o *HandleEmailEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewHandleEmailEventsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
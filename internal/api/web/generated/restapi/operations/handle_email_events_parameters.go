@@ -0,0 +1,144 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// NewHandleEmailEventsParams creates a new HandleEmailEventsParams object
+// no default values defined in spec.
+func NewHandleEmailEventsParams() HandleEmailEventsParams {
+
+	return HandleEmailEventsParams{}
+}
+
+// HandleEmailEventsParams contains all the bound params for the handle email events operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters handleEmailEvents
+type HandleEmailEventsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: header
+	*/
+	XTwilioEmailEventWebhookSignature string
+	/*
+	  Required: true
+	  In: header
+	*/
+	XTwilioEmailEventWebhookTimestamp string
+	/*
+	  Required: true
+	  In: body
+	*/
+	Events []*models.EmailProviderEvent
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewHandleEmailEventsParams() beforehand.
+func (o *HandleEmailEventsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := o.bindXTwilioEmailEventWebhookSignature(r.Header[http.CanonicalHeaderKey("X-Twilio-Email-Event-Webhook-Signature")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXTwilioEmailEventWebhookTimestamp(r.Header[http.CanonicalHeaderKey("X-Twilio-Email-Event-Webhook-Timestamp")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body []*models.EmailProviderEvent
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("events", "body"))
+			} else {
+				res = append(res, errors.NewParseError("events", "body", "", err))
+			}
+		} else {
+			// validate array of body objects
+			for i := range body {
+				if body[i] == nil {
+					continue
+				}
+				if err := body[i].Validate(route.Formats); err != nil {
+					res = append(res, err)
+					break
+				}
+			}
+			if len(res) == 0 {
+				o.Events = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("events", "body"))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindXTwilioEmailEventWebhookSignature binds and validates parameter XTwilioEmailEventWebhookSignature from header.
+func (o *HandleEmailEventsParams) bindXTwilioEmailEventWebhookSignature(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("X-Twilio-Email-Event-Webhook-Signature", "header")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("X-Twilio-Email-Event-Webhook-Signature", "header", raw); err != nil {
+		return err
+	}
+
+	o.XTwilioEmailEventWebhookSignature = raw
+
+	return nil
+}
+
+// bindXTwilioEmailEventWebhookTimestamp binds and validates parameter XTwilioEmailEventWebhookTimestamp from header.
+func (o *HandleEmailEventsParams) bindXTwilioEmailEventWebhookTimestamp(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("X-Twilio-Email-Event-Webhook-Timestamp", "header")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("X-Twilio-Email-Event-Webhook-Timestamp", "header", raw); err != nil {
+		return err
+	}
+
+	o.XTwilioEmailEventWebhookTimestamp = raw
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// HandleEmailEventsNoContentCode is the HTTP code returned for type HandleEmailEventsNoContent
+const HandleEmailEventsNoContentCode int = 204
+
+/*
+HandleEmailEventsNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response handleEmailEventsNoContent
+*/
+type HandleEmailEventsNoContent struct {
+}
+
+// NewHandleEmailEventsNoContent creates HandleEmailEventsNoContent with default headers values
+func NewHandleEmailEventsNoContent() *HandleEmailEventsNoContent {
+
+	return &HandleEmailEventsNoContent{}
+}
+
+// WriteResponse to the client
+func (o *HandleEmailEventsNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+HandleEmailEventsDefault Generic error response.
+
+swagger:response handleEmailEventsDefault
+*/
+type HandleEmailEventsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewHandleEmailEventsDefault creates HandleEmailEventsDefault with default headers values
+func NewHandleEmailEventsDefault(code int) *HandleEmailEventsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &HandleEmailEventsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the handle email events default response
+func (o *HandleEmailEventsDefault) WithStatusCode(code int) *HandleEmailEventsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the handle email events default response
+func (o *HandleEmailEventsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the handle email events default response
+func (o *HandleEmailEventsDefault) WithPayload(payload *models.Error) *HandleEmailEventsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the handle email events default response
+func (o *HandleEmailEventsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *HandleEmailEventsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
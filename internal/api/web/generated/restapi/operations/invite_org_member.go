@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// InviteOrgMemberHandlerFunc turns a function with the right signature into a invite org member handler
+type InviteOrgMemberHandlerFunc func(InviteOrgMemberParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn InviteOrgMemberHandlerFunc) Handle(params InviteOrgMemberParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// InviteOrgMemberHandler interface for that can handle valid invite org member params
+type InviteOrgMemberHandler interface {
+	Handle(InviteOrgMemberParams, *app.AuthUser) middleware.Responder
+}
+
+// NewInviteOrgMember creates a new http.Handler for the invite org member operation
+func NewInviteOrgMember(ctx *middleware.Context, handler InviteOrgMemberHandler) *InviteOrgMember {
+	return &InviteOrgMember{Context: ctx, Handler: handler}
+}
+
+/*
+InviteOrgMember swagger:route POST /orgs/{id}/invitations inviteOrgMember
+
+Sends the invitation with the accept link to the email, which is accepted after signing up if the email has no user yet.
+Admins invite members, the owner invites admins too.
+*/
+type InviteOrgMember struct {
+	Context *middleware.Context
+	Handler InviteOrgMemberHandler
+}
+
+func (o *InviteOrgMember) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewInviteOrgMemberParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// InviteOrgMemberBody invite org member body
+//
+// swagger:model InviteOrgMemberBody
+type InviteOrgMemberBody struct {
+
+	// email
+	// Required: true
+	// Format: email
+	Email models.Email `json:"email"`
+
+	// role
+	// Required: true
+	// Enum: [admin member]
+	Role *string `json:"role"`
+}
+
+// Validate validates this invite org member body
+func (o *InviteOrgMemberBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *InviteOrgMemberBody) validateEmail(formats strfmt.Registry) error {
+
+	if err := o.Email.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "email")
+		}
+		return err
+	}
+
+	return nil
+}
+
+var inviteOrgMemberBodyTypeRolePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["admin","member"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		inviteOrgMemberBodyTypeRolePropEnum = append(inviteOrgMemberBodyTypeRolePropEnum, v)
+	}
+}
+
+const (
+
+	// InviteOrgMemberBodyRoleAdmin captures enum value "admin"
+	InviteOrgMemberBodyRoleAdmin string = "admin"
+
+	// InviteOrgMemberBodyRoleMember captures enum value "member"
+	InviteOrgMemberBodyRoleMember string = "member"
+)
+
+// prop value enum
+func (o *InviteOrgMemberBody) validateRoleEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, inviteOrgMemberBodyTypeRolePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *InviteOrgMemberBody) validateRole(formats strfmt.Registry) error {
+
+	if err := validate.Required("args"+"."+"role", "body", o.Role); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateRoleEnum("args"+"."+"role", "body", *o.Role); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *InviteOrgMemberBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *InviteOrgMemberBody) UnmarshalBinary(b []byte) error {
+	var res InviteOrgMemberBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// InviteOrgMemberNoContentCode is the HTTP code returned for type InviteOrgMemberNoContent
+const InviteOrgMemberNoContentCode int = 204
+
+/*
+InviteOrgMemberNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response inviteOrgMemberNoContent
+*/
+type InviteOrgMemberNoContent struct {
+}
+
+// NewInviteOrgMemberNoContent creates InviteOrgMemberNoContent with default headers values
+func NewInviteOrgMemberNoContent() *InviteOrgMemberNoContent {
+
+	return &InviteOrgMemberNoContent{}
+}
+
+// WriteResponse to the client
+func (o *InviteOrgMemberNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+InviteOrgMemberDefault Generic error response.
+
+swagger:response inviteOrgMemberDefault
+*/
+type InviteOrgMemberDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewInviteOrgMemberDefault creates InviteOrgMemberDefault with default headers values
+func NewInviteOrgMemberDefault(code int) *InviteOrgMemberDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &InviteOrgMemberDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the invite org member default response
+func (o *InviteOrgMemberDefault) WithStatusCode(code int) *InviteOrgMemberDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the invite org member default response
+func (o *InviteOrgMemberDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the invite org member default response
+func (o *InviteOrgMemberDefault) WithPayload(payload *models.Error) *InviteOrgMemberDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the invite org member default response
+func (o *InviteOrgMemberDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *InviteOrgMemberDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// ListNotificationTasksHandlerFunc turns a function with the right signature into a list notification tasks handler
+type ListNotificationTasksHandlerFunc func(ListNotificationTasksParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListNotificationTasksHandlerFunc) Handle(params ListNotificationTasksParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListNotificationTasksHandler interface for that can handle valid list notification tasks params
+type ListNotificationTasksHandler interface {
+	Handle(ListNotificationTasksParams, *app.AuthUser) middleware.Responder
+}
+
+// NewListNotificationTasks creates a new http.Handler for the list notification tasks operation
+func NewListNotificationTasks(ctx *middleware.Context, handler ListNotificationTasksHandler) *ListNotificationTasks {
+	return &ListNotificationTasks{Context: ctx, Handler: handler}
+}
+
+/*
+ListNotificationTasks swagger:route GET /admin/notifications listNotificationTasks
+
+Notification tasks search, latest first.
+*/
+type ListNotificationTasks struct {
+	Context *middleware.Context
+	Handler ListNotificationTasksHandler
+}
+
+func (o *ListNotificationTasks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListNotificationTasksParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// ListNotificationTasksOKBody list notification tasks o k body
+//
+// swagger:model ListNotificationTasksOKBody
+type ListNotificationTasksOKBody struct {
+
+	// tasks
+	// Max Items: 100
+	Tasks []*models.NotificationTask `json:"tasks"`
+
+	// total
+	// Minimum: 0
+	Total *int32 `json:"total,omitempty"`
+}
+
+// Validate validates this list notification tasks o k body
+func (o *ListNotificationTasksOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateTasks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListNotificationTasksOKBody) validateTasks(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Tasks) { // not required
+		return nil
+	}
+
+	iTasksSize := int64(len(o.Tasks))
+
+	if err := validate.MaxItems("listNotificationTasksOK"+"."+"tasks", "body", iTasksSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(o.Tasks); i++ {
+		if swag.IsZero(o.Tasks[i]) { // not required
+			continue
+		}
+
+		if o.Tasks[i] != nil {
+			if err := o.Tasks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("listNotificationTasksOK" + "." + "tasks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListNotificationTasksOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Total) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("listNotificationTasksOK"+"."+"total", "body", int64(*o.Total), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListNotificationTasksOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListNotificationTasksOKBody) UnmarshalBinary(b []byte) error {
+	var res ListNotificationTasksOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
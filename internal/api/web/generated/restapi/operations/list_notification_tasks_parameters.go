@@ -0,0 +1,227 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewListNotificationTasksParams creates a new ListNotificationTasksParams object
+// with the default values initialized.
+func NewListNotificationTasksParams() ListNotificationTasksParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault  = int32(100)
+		offsetDefault = int32(0)
+	)
+
+	return ListNotificationTasksParams{
+		Limit: limitDefault,
+
+		Offset: &offsetDefault,
+	}
+}
+
+// ListNotificationTasksParams contains all the bound params for the list notification tasks operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listNotificationTasks
+type ListNotificationTasksParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: query
+	*/
+	Email *string
+	/*
+	  In: query
+	*/
+	Kind *string
+	/*
+	  Required: true
+	  In: query
+	  Default: 100
+	*/
+	Limit int32
+	/*
+	  In: query
+	  Default: 0
+	*/
+	Offset *int32
+	/*
+	  In: query
+	*/
+	Status *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListNotificationTasksParams() beforehand.
+func (o *ListNotificationTasksParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qEmail, qhkEmail, _ := qs.GetOK("email")
+	if err := o.bindEmail(qEmail, qhkEmail, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qKind, qhkKind, _ := qs.GetOK("kind")
+	if err := o.bindKind(qKind, qhkKind, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindEmail binds and validates parameter Email from query.
+func (o *ListNotificationTasksParams) bindEmail(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Email = &raw
+
+	return nil
+}
+
+// bindKind binds and validates parameter Kind from query.
+func (o *ListNotificationTasksParams) bindKind(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Kind = &raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListNotificationTasksParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("limit", "query")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("limit", "query", raw); err != nil {
+		return err
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int32", raw)
+	}
+	o.Limit = value
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *ListNotificationTasksParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListNotificationTasksParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int32", raw)
+	}
+	o.Offset = &value
+
+	return nil
+}
+
+// bindStatus binds and validates parameter Status from query.
+func (o *ListNotificationTasksParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Status = &raw
+
+	if err := o.validateStatus(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStatus carries on validations for parameter Status
+func (o *ListNotificationTasksParams) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Enum("status", "query", *o.Status, []interface{}{"pending", "done", "cancelled"}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListNotificationTasksOKCode is the HTTP code returned for type ListNotificationTasksOK
+const ListNotificationTasksOKCode int = 200
+
+/*
+ListNotificationTasksOK OK
+
+swagger:response listNotificationTasksOK
+*/
+type ListNotificationTasksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *ListNotificationTasksOKBody `json:"body,omitempty"`
+}
+
+// NewListNotificationTasksOK creates ListNotificationTasksOK with default headers values
+func NewListNotificationTasksOK() *ListNotificationTasksOK {
+
+	return &ListNotificationTasksOK{}
+}
+
+// WithPayload adds the payload to the list notification tasks o k response
+func (o *ListNotificationTasksOK) WithPayload(payload *ListNotificationTasksOKBody) *ListNotificationTasksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list notification tasks o k response
+func (o *ListNotificationTasksOK) SetPayload(payload *ListNotificationTasksOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListNotificationTasksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListNotificationTasksDefault Generic error response.
+
+swagger:response listNotificationTasksDefault
+*/
+type ListNotificationTasksDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListNotificationTasksDefault creates ListNotificationTasksDefault with default headers values
+func NewListNotificationTasksDefault(code int) *ListNotificationTasksDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListNotificationTasksDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list notification tasks default response
+func (o *ListNotificationTasksDefault) WithStatusCode(code int) *ListNotificationTasksDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list notification tasks default response
+func (o *ListNotificationTasksDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list notification tasks default response
+func (o *ListNotificationTasksDefault) WithPayload(payload *models.Error) *ListNotificationTasksDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list notification tasks default response
+func (o *ListNotificationTasksDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListNotificationTasksDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// ListOrgMembersHandlerFunc turns a function with the right signature into a list org members handler
+type ListOrgMembersHandlerFunc func(ListOrgMembersParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOrgMembersHandlerFunc) Handle(params ListOrgMembersParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListOrgMembersHandler interface for that can handle valid list org members params
+type ListOrgMembersHandler interface {
+	Handle(ListOrgMembersParams, *app.AuthUser) middleware.Responder
+}
+
+// NewListOrgMembers creates a new http.Handler for the list org members operation
+func NewListOrgMembers(ctx *middleware.Context, handler ListOrgMembersHandler) *ListOrgMembers {
+	return &ListOrgMembers{Context: ctx, Handler: handler}
+}
+
+/*
+ListOrgMembers swagger:route GET /orgs/{id}/members listOrgMembers
+
+Members of the organization in order of joining, the user must be a member.
+*/
+type ListOrgMembers struct {
+	Context *middleware.Context
+	Handler ListOrgMembersHandler
+}
+
+func (o *ListOrgMembers) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListOrgMembersParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// ListOrgMembersOKBody list org members o k body
+//
+// swagger:model ListOrgMembersOKBody
+type ListOrgMembersOKBody struct {
+
+	// members
+	// Max Items: 100
+	Members []*models.OrgMember `json:"members"`
+
+	// total
+	// Minimum: 0
+	Total *int32 `json:"total,omitempty"`
+}
+
+// Validate validates this list org members o k body
+func (o *ListOrgMembersOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateMembers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListOrgMembersOKBody) validateMembers(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Members) { // not required
+		return nil
+	}
+
+	iMembersSize := int64(len(o.Members))
+
+	if err := validate.MaxItems("listOrgMembersOK"+"."+"members", "body", iMembersSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(o.Members); i++ {
+		if swag.IsZero(o.Members[i]) { // not required
+			continue
+		}
+
+		if o.Members[i] != nil {
+			if err := o.Members[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("listOrgMembersOK" + "." + "members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListOrgMembersOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Total) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("listOrgMembersOK"+"."+"total", "body", int64(*o.Total), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListOrgMembersOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListOrgMembersOKBody) UnmarshalBinary(b []byte) error {
+	var res ListOrgMembersOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListOrgMembersOKCode is the HTTP code returned for type ListOrgMembersOK
+const ListOrgMembersOKCode int = 200
+
+/*
+ListOrgMembersOK OK
+
+swagger:response listOrgMembersOK
+*/
+type ListOrgMembersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *ListOrgMembersOKBody `json:"body,omitempty"`
+}
+
+// NewListOrgMembersOK creates ListOrgMembersOK with default headers values
+func NewListOrgMembersOK() *ListOrgMembersOK {
+
+	return &ListOrgMembersOK{}
+}
+
+// WithPayload adds the payload to the list org members o k response
+func (o *ListOrgMembersOK) WithPayload(payload *ListOrgMembersOKBody) *ListOrgMembersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list org members o k response
+func (o *ListOrgMembersOK) SetPayload(payload *ListOrgMembersOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOrgMembersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListOrgMembersDefault Generic error response.
+
+swagger:response listOrgMembersDefault
+*/
+type ListOrgMembersDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListOrgMembersDefault creates ListOrgMembersDefault with default headers values
+func NewListOrgMembersDefault(code int) *ListOrgMembersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListOrgMembersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list org members default response
+func (o *ListOrgMembersDefault) WithStatusCode(code int) *ListOrgMembersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list org members default response
+func (o *ListOrgMembersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list org members default response
+func (o *ListOrgMembersDefault) WithPayload(payload *models.Error) *ListOrgMembersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list org members default response
+func (o *ListOrgMembersDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOrgMembersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
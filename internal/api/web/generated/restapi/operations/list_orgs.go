@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// ListOrgsHandlerFunc turns a function with the right signature into a list orgs handler
+type ListOrgsHandlerFunc func(ListOrgsParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOrgsHandlerFunc) Handle(params ListOrgsParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListOrgsHandler interface for that can handle valid list orgs params
+type ListOrgsHandler interface {
+	Handle(ListOrgsParams, *app.AuthUser) middleware.Responder
+}
+
+// NewListOrgs creates a new http.Handler for the list orgs operation
+func NewListOrgs(ctx *middleware.Context, handler ListOrgsHandler) *ListOrgs {
+	return &ListOrgs{Context: ctx, Handler: handler}
+}
+
+/*
+ListOrgs swagger:route GET /orgs listOrgs
+
+Organizations of the user.
+*/
+type ListOrgs struct {
+	Context *middleware.Context
+	Handler ListOrgsHandler
+}
+
+func (o *ListOrgs) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListOrgsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
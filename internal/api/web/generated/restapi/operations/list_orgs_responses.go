@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListOrgsOKCode is the HTTP code returned for type ListOrgsOK
+const ListOrgsOKCode int = 200
+
+/*
+ListOrgsOK OK
+
+swagger:response listOrgsOK
+*/
+type ListOrgsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Org `json:"body,omitempty"`
+}
+
+// NewListOrgsOK creates ListOrgsOK with default headers values
+func NewListOrgsOK() *ListOrgsOK {
+
+	return &ListOrgsOK{}
+}
+
+// WithPayload adds the payload to the list orgs o k response
+func (o *ListOrgsOK) WithPayload(payload []*models.Org) *ListOrgsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list orgs o k response
+func (o *ListOrgsOK) SetPayload(payload []*models.Org) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOrgsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Org, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+ListOrgsDefault Generic error response.
+
+swagger:response listOrgsDefault
+*/
+type ListOrgsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListOrgsDefault creates ListOrgsDefault with default headers values
+func NewListOrgsDefault(code int) *ListOrgsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListOrgsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list orgs default response
+func (o *ListOrgsDefault) WithStatusCode(code int) *ListOrgsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list orgs default response
+func (o *ListOrgsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list orgs default response
+func (o *ListOrgsDefault) WithPayload(payload *models.Error) *ListOrgsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list orgs default response
+func (o *ListOrgsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOrgsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
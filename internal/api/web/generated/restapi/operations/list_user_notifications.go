@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// ListUserNotificationsHandlerFunc turns a function with the right signature into a list user notifications handler
+type ListUserNotificationsHandlerFunc func(ListUserNotificationsParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListUserNotificationsHandlerFunc) Handle(params ListUserNotificationsParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListUserNotificationsHandler interface for that can handle valid list user notifications params
+type ListUserNotificationsHandler interface {
+	Handle(ListUserNotificationsParams, *app.AuthUser) middleware.Responder
+}
+
+// NewListUserNotifications creates a new http.Handler for the list user notifications operation
+func NewListUserNotifications(ctx *middleware.Context, handler ListUserNotificationsHandler) *ListUserNotifications {
+	return &ListUserNotifications{Context: ctx, Handler: handler}
+}
+
+/*
+ListUserNotifications swagger:route GET /user/notifications listUserNotifications
+
+Notifications from the user inbox, latest first.
+*/
+type ListUserNotifications struct {
+	Context *middleware.Context
+	Handler ListUserNotificationsHandler
+}
+
+func (o *ListUserNotifications) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListUserNotificationsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// ListUserNotificationsOKBody list user notifications o k body
+//
+// swagger:model ListUserNotificationsOKBody
+type ListUserNotificationsOKBody struct {
+
+	// notifications
+	// Max Items: 100
+	Notifications []*models.UserNotification `json:"notifications"`
+
+	// total
+	// Minimum: 0
+	Total *int32 `json:"total,omitempty"`
+}
+
+// Validate validates this list user notifications o k body
+func (o *ListUserNotificationsOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateNotifications(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListUserNotificationsOKBody) validateNotifications(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Notifications) { // not required
+		return nil
+	}
+
+	iNotificationsSize := int64(len(o.Notifications))
+
+	if err := validate.MaxItems("listUserNotificationsOK"+"."+"notifications", "body", iNotificationsSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(o.Notifications); i++ {
+		if swag.IsZero(o.Notifications[i]) { // not required
+			continue
+		}
+
+		if o.Notifications[i] != nil {
+			if err := o.Notifications[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("listUserNotificationsOK" + "." + "notifications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListUserNotificationsOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Total) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("listUserNotificationsOK"+"."+"total", "body", int64(*o.Total), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListUserNotificationsOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListUserNotificationsOKBody) UnmarshalBinary(b []byte) error {
+	var res ListUserNotificationsOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ListUserNotificationsOKCode is the HTTP code returned for type ListUserNotificationsOK
+const ListUserNotificationsOKCode int = 200
+
+/*
+ListUserNotificationsOK OK
+
+swagger:response listUserNotificationsOK
+*/
+type ListUserNotificationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *ListUserNotificationsOKBody `json:"body,omitempty"`
+}
+
+// NewListUserNotificationsOK creates ListUserNotificationsOK with default headers values
+func NewListUserNotificationsOK() *ListUserNotificationsOK {
+
+	return &ListUserNotificationsOK{}
+}
+
+// WithPayload adds the payload to the list user notifications o k response
+func (o *ListUserNotificationsOK) WithPayload(payload *ListUserNotificationsOKBody) *ListUserNotificationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user notifications o k response
+func (o *ListUserNotificationsOK) SetPayload(payload *ListUserNotificationsOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserNotificationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListUserNotificationsDefault Generic error response.
+
+swagger:response listUserNotificationsDefault
+*/
+type ListUserNotificationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserNotificationsDefault creates ListUserNotificationsDefault with default headers values
+func NewListUserNotificationsDefault(code int) *ListUserNotificationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListUserNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list user notifications default response
+func (o *ListUserNotificationsDefault) WithStatusCode(code int) *ListUserNotificationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list user notifications default response
+func (o *ListUserNotificationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list user notifications default response
+func (o *ListUserNotificationsDefault) WithPayload(payload *models.Error) *ListUserNotificationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user notifications default response
+func (o *ListUserNotificationsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserNotificationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
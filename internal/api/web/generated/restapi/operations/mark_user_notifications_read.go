@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MarkUserNotificationsReadHandlerFunc turns a function with the right signature into a mark user notifications read handler
+type MarkUserNotificationsReadHandlerFunc func(MarkUserNotificationsReadParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn MarkUserNotificationsReadHandlerFunc) Handle(params MarkUserNotificationsReadParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// MarkUserNotificationsReadHandler interface for that can handle valid mark user notifications read params
+type MarkUserNotificationsReadHandler interface {
+	Handle(MarkUserNotificationsReadParams, *app.AuthUser) middleware.Responder
+}
+
+// NewMarkUserNotificationsRead creates a new http.Handler for the mark user notifications read operation
+func NewMarkUserNotificationsRead(ctx *middleware.Context, handler MarkUserNotificationsReadHandler) *MarkUserNotificationsRead {
+	return &MarkUserNotificationsRead{Context: ctx, Handler: handler}
+}
+
+/*
+MarkUserNotificationsRead swagger:route POST /user/notifications/read markUserNotificationsRead
+
+Marks notifications from the user inbox as read, all of them if ids are not passed.
+*/
+type MarkUserNotificationsRead struct {
+	Context *middleware.Context
+	Handler MarkUserNotificationsReadHandler
+}
+
+func (o *MarkUserNotificationsRead) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewMarkUserNotificationsReadParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// MarkUserNotificationsReadBody mark user notifications read body
+//
+// swagger:model MarkUserNotificationsReadBody
+type MarkUserNotificationsReadBody struct {
+
+	// ids
+	// Max Items: 100
+	Ids []int32 `json:"ids"`
+}
+
+// Validate validates this mark user notifications read body
+func (o *MarkUserNotificationsReadBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *MarkUserNotificationsReadBody) validateIds(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Ids) { // not required
+		return nil
+	}
+
+	iIdsSize := int64(len(o.Ids))
+
+	if err := validate.MaxItems("args"+"."+"ids", "body", iIdsSize, 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *MarkUserNotificationsReadBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *MarkUserNotificationsReadBody) UnmarshalBinary(b []byte) error {
+	var res MarkUserNotificationsReadBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
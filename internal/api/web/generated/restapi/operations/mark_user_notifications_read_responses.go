@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// MarkUserNotificationsReadNoContentCode is the HTTP code returned for type MarkUserNotificationsReadNoContent
+const MarkUserNotificationsReadNoContentCode int = 204
+
+/*
+MarkUserNotificationsReadNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response markUserNotificationsReadNoContent
+*/
+type MarkUserNotificationsReadNoContent struct {
+}
+
+// NewMarkUserNotificationsReadNoContent creates MarkUserNotificationsReadNoContent with default headers values
+func NewMarkUserNotificationsReadNoContent() *MarkUserNotificationsReadNoContent {
+
+	return &MarkUserNotificationsReadNoContent{}
+}
+
+// WriteResponse to the client
+func (o *MarkUserNotificationsReadNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+MarkUserNotificationsReadDefault Generic error response.
+
+swagger:response markUserNotificationsReadDefault
+*/
+type MarkUserNotificationsReadDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMarkUserNotificationsReadDefault creates MarkUserNotificationsReadDefault with default headers values
+func NewMarkUserNotificationsReadDefault(code int) *MarkUserNotificationsReadDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &MarkUserNotificationsReadDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the mark user notifications read default response
+func (o *MarkUserNotificationsReadDefault) WithStatusCode(code int) *MarkUserNotificationsReadDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the mark user notifications read default response
+func (o *MarkUserNotificationsReadDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the mark user notifications read default response
+func (o *MarkUserNotificationsReadDefault) WithPayload(payload *models.Error) *MarkUserNotificationsReadDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the mark user notifications read default response
+func (o *MarkUserNotificationsReadDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MarkUserNotificationsReadDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
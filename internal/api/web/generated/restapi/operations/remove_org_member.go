@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// RemoveOrgMemberHandlerFunc turns a function with the right signature into a remove org member handler
+type RemoveOrgMemberHandlerFunc func(RemoveOrgMemberParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RemoveOrgMemberHandlerFunc) Handle(params RemoveOrgMemberParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RemoveOrgMemberHandler interface for that can handle valid remove org member params
+type RemoveOrgMemberHandler interface {
+	Handle(RemoveOrgMemberParams, *app.AuthUser) middleware.Responder
+}
+
+// NewRemoveOrgMember creates a new http.Handler for the remove org member operation
+func NewRemoveOrgMember(ctx *middleware.Context, handler RemoveOrgMemberHandler) *RemoveOrgMember {
+	return &RemoveOrgMember{Context: ctx, Handler: handler}
+}
+
+/*
+RemoveOrgMember swagger:route DELETE /orgs/{id}/members/{userId} removeOrgMember
+
+Removes the member, users leave organizations by removing themselves.
+The owner removes anyone, admins remove members, the owner must transfer ownership before leaving.
+*/
+type RemoveOrgMember struct {
+	Context *middleware.Context
+	Handler RemoveOrgMemberHandler
+}
+
+func (o *RemoveOrgMember) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewRemoveOrgMemberParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewRemoveOrgMemberParams creates a new RemoveOrgMemberParams object
+// no default values defined in spec.
+func NewRemoveOrgMemberParams() RemoveOrgMemberParams {
+
+	return RemoveOrgMemberParams{}
+}
+
+// RemoveOrgMemberParams contains all the bound params for the remove org member operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters removeOrgMember
+type RemoveOrgMemberParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	ID int32
+	/*
+	  Required: true
+	  In: path
+	*/
+	UserID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewRemoveOrgMemberParams() beforehand.
+func (o *RemoveOrgMemberParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rUserID, rhkUserID, _ := route.Params.GetOK("userId")
+	if err := o.bindUserID(rUserID, rhkUserID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *RemoveOrgMemberParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("id", "path", "int32", raw)
+	}
+	o.ID = value
+
+	return nil
+}
+
+// bindUserID binds and validates parameter UserID from path.
+func (o *RemoveOrgMemberParams) bindUserID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("userId", "path", "strfmt.UUID", raw)
+	}
+	o.UserID = *(value.(*strfmt.UUID))
+
+	if err := o.validateUserID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUserID carries on validations for parameter UserID
+func (o *RemoveOrgMemberParams) validateUserID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("userId", "path", "uuid", o.UserID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// RemoveOrgMemberNoContentCode is the HTTP code returned for type RemoveOrgMemberNoContent
+const RemoveOrgMemberNoContentCode int = 204
+
+/*
+RemoveOrgMemberNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response removeOrgMemberNoContent
+*/
+type RemoveOrgMemberNoContent struct {
+}
+
+// NewRemoveOrgMemberNoContent creates RemoveOrgMemberNoContent with default headers values
+func NewRemoveOrgMemberNoContent() *RemoveOrgMemberNoContent {
+
+	return &RemoveOrgMemberNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RemoveOrgMemberNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+RemoveOrgMemberDefault Generic error response.
+
+swagger:response removeOrgMemberDefault
+*/
+type RemoveOrgMemberDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRemoveOrgMemberDefault creates RemoveOrgMemberDefault with default headers values
+func NewRemoveOrgMemberDefault(code int) *RemoveOrgMemberDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RemoveOrgMemberDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the remove org member default response
+func (o *RemoveOrgMemberDefault) WithStatusCode(code int) *RemoveOrgMemberDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the remove org member default response
+func (o *RemoveOrgMemberDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the remove org member default response
+func (o *RemoveOrgMemberDefault) WithPayload(payload *models.Error) *RemoveOrgMemberDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the remove org member default response
+func (o *RemoveOrgMemberDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RemoveOrgMemberDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
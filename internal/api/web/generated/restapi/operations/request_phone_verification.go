@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// RequestPhoneVerificationHandlerFunc turns a function with the right signature into a request phone verification handler
+type RequestPhoneVerificationHandlerFunc func(RequestPhoneVerificationParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RequestPhoneVerificationHandlerFunc) Handle(params RequestPhoneVerificationParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RequestPhoneVerificationHandler interface for that can handle valid request phone verification params
+type RequestPhoneVerificationHandler interface {
+	Handle(RequestPhoneVerificationParams, *app.AuthUser) middleware.Responder
+}
+
+// NewRequestPhoneVerification creates a new http.Handler for the request phone verification operation
+func NewRequestPhoneVerification(ctx *middleware.Context, handler RequestPhoneVerificationHandler) *RequestPhoneVerification {
+	return &RequestPhoneVerification{Context: ctx, Handler: handler}
+}
+
+/*
+RequestPhoneVerification swagger:route POST /user/phone requestPhoneVerification
+
+Sends by SMS a code to verify the phone, the phone is set after the verification.
+*/
+type RequestPhoneVerification struct {
+	Context *middleware.Context
+	Handler RequestPhoneVerificationHandler
+}
+
+func (o *RequestPhoneVerification) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewRequestPhoneVerificationParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// RequestPhoneVerificationBody request phone verification body
+//
+// swagger:model RequestPhoneVerificationBody
+type RequestPhoneVerificationBody struct {
+
+	// phone
+	// Required: true
+	Phone models.Phone `json:"phone"`
+}
+
+// Validate validates this request phone verification body
+func (o *RequestPhoneVerificationBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validatePhone(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RequestPhoneVerificationBody) validatePhone(formats strfmt.Registry) error {
+
+	if err := o.Phone.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "phone")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *RequestPhoneVerificationBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *RequestPhoneVerificationBody) UnmarshalBinary(b []byte) error {
+	var res RequestPhoneVerificationBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// RequestPhoneVerificationNoContentCode is the HTTP code returned for type RequestPhoneVerificationNoContent
+const RequestPhoneVerificationNoContentCode int = 204
+
+/*
+RequestPhoneVerificationNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response requestPhoneVerificationNoContent
+*/
+type RequestPhoneVerificationNoContent struct {
+}
+
+// NewRequestPhoneVerificationNoContent creates RequestPhoneVerificationNoContent with default headers values
+func NewRequestPhoneVerificationNoContent() *RequestPhoneVerificationNoContent {
+
+	return &RequestPhoneVerificationNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RequestPhoneVerificationNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+RequestPhoneVerificationDefault Generic error response.
+
+swagger:response requestPhoneVerificationDefault
+*/
+type RequestPhoneVerificationDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRequestPhoneVerificationDefault creates RequestPhoneVerificationDefault with default headers values
+func NewRequestPhoneVerificationDefault(code int) *RequestPhoneVerificationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RequestPhoneVerificationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the request phone verification default response
+func (o *RequestPhoneVerificationDefault) WithStatusCode(code int) *RequestPhoneVerificationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the request phone verification default response
+func (o *RequestPhoneVerificationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the request phone verification default response
+func (o *RequestPhoneVerificationDefault) WithPayload(payload *models.Error) *RequestPhoneVerificationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the request phone verification default response
+func (o *RequestPhoneVerificationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RequestPhoneVerificationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// ResendNotificationTaskHandlerFunc turns a function with the right signature into a resend notification task handler
+type ResendNotificationTaskHandlerFunc func(ResendNotificationTaskParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ResendNotificationTaskHandlerFunc) Handle(params ResendNotificationTaskParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ResendNotificationTaskHandler interface for that can handle valid resend notification task params
+type ResendNotificationTaskHandler interface {
+	Handle(ResendNotificationTaskParams, *app.AuthUser) middleware.Responder
+}
+
+// NewResendNotificationTask creates a new http.Handler for the resend notification task operation
+func NewResendNotificationTask(ctx *middleware.Context, handler ResendNotificationTaskHandler) *ResendNotificationTask {
+	return &ResendNotificationTask{Context: ctx, Handler: handler}
+}
+
+/*
+ResendNotificationTask swagger:route POST /admin/notifications/{id}/resend resendNotificationTask
+
+Creates a new pending task with the same recipient, kind and payload.
+*/
+type ResendNotificationTask struct {
+	Context *middleware.Context
+	Handler ResendNotificationTaskHandler
+}
+
+func (o *ResendNotificationTask) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewResendNotificationTaskParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
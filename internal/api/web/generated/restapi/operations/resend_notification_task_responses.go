@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// ResendNotificationTaskOKCode is the HTTP code returned for type ResendNotificationTaskOK
+const ResendNotificationTaskOKCode int = 200
+
+/*
+ResendNotificationTaskOK OK
+
+swagger:response resendNotificationTaskOK
+*/
+type ResendNotificationTaskOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NotificationTask `json:"body,omitempty"`
+}
+
+// NewResendNotificationTaskOK creates ResendNotificationTaskOK with default headers values
+func NewResendNotificationTaskOK() *ResendNotificationTaskOK {
+
+	return &ResendNotificationTaskOK{}
+}
+
+// WithPayload adds the payload to the resend notification task o k response
+func (o *ResendNotificationTaskOK) WithPayload(payload *models.NotificationTask) *ResendNotificationTaskOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resend notification task o k response
+func (o *ResendNotificationTaskOK) SetPayload(payload *models.NotificationTask) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResendNotificationTaskOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ResendNotificationTaskDefault Generic error response.
+
+swagger:response resendNotificationTaskDefault
+*/
+type ResendNotificationTaskDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResendNotificationTaskDefault creates ResendNotificationTaskDefault with default headers values
+func NewResendNotificationTaskDefault(code int) *ResendNotificationTaskDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ResendNotificationTaskDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the resend notification task default response
+func (o *ResendNotificationTaskDefault) WithStatusCode(code int) *ResendNotificationTaskDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the resend notification task default response
+func (o *ResendNotificationTaskDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the resend notification task default response
+func (o *ResendNotificationTaskDefault) WithPayload(payload *models.Error) *ResendNotificationTaskDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resend notification task default response
+func (o *ResendNotificationTaskDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResendNotificationTaskDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
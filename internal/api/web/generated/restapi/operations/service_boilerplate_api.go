@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -38,37 +39,104 @@ func NewServiceBoilerplateAPI(spec *loads.Document) *ServiceBoilerplateAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
-		JSONConsumer: runtime.JSONConsumer(),
+		JSONConsumer:    runtime.JSONConsumer(),
+		UrlformConsumer: runtime.DiscardConsumer,
 
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
+		AcceptOrgInvitationHandler: AcceptOrgInvitationHandlerFunc(func(params AcceptOrgInvitationParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation AcceptOrgInvitation has not yet been implemented")
+		}),
+		CancelNotificationTaskHandler: CancelNotificationTaskHandlerFunc(func(params CancelNotificationTaskParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation CancelNotificationTask has not yet been implemented")
+		}),
+		CreateOrgHandler: CreateOrgHandlerFunc(func(params CreateOrgParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation CreateOrg has not yet been implemented")
+		}),
 		CreateRecoveryCodeHandler: CreateRecoveryCodeHandlerFunc(func(params CreateRecoveryCodeParams) middleware.Responder {
 			return middleware.NotImplemented("operation CreateRecoveryCode has not yet been implemented")
 		}),
 		CreateUserHandler: CreateUserHandlerFunc(func(params CreateUserParams) middleware.Responder {
 			return middleware.NotImplemented("operation CreateUser has not yet been implemented")
 		}),
+		DeletePhoneHandler: DeletePhoneHandlerFunc(func(params DeletePhoneParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation DeletePhone has not yet been implemented")
+		}),
 		DeleteUserHandler: DeleteUserHandlerFunc(func(params DeleteUserParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteUser has not yet been implemented")
 		}),
+		GetNotificationSettingsHandler: GetNotificationSettingsHandlerFunc(func(params GetNotificationSettingsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation GetNotificationSettings has not yet been implemented")
+		}),
+		GetUnreadNotificationCountHandler: GetUnreadNotificationCountHandlerFunc(func(params GetUnreadNotificationCountParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation GetUnreadNotificationCount has not yet been implemented")
+		}),
 		GetUserHandler: GetUserHandlerFunc(func(params GetUserParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation GetUser has not yet been implemented")
 		}),
 		GetUsersHandler: GetUsersHandlerFunc(func(params GetUsersParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation GetUsers has not yet been implemented")
 		}),
+		HandleEmailEventsHandler: HandleEmailEventsHandlerFunc(func(params HandleEmailEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation HandleEmailEvents has not yet been implemented")
+		}),
+		InviteOrgMemberHandler: InviteOrgMemberHandlerFunc(func(params InviteOrgMemberParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation InviteOrgMember has not yet been implemented")
+		}),
+		ListNotificationTasksHandler: ListNotificationTasksHandlerFunc(func(params ListNotificationTasksParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation ListNotificationTasks has not yet been implemented")
+		}),
+		ListOrgMembersHandler: ListOrgMembersHandlerFunc(func(params ListOrgMembersParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation ListOrgMembers has not yet been implemented")
+		}),
+		ListOrgsHandler: ListOrgsHandlerFunc(func(params ListOrgsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation ListOrgs has not yet been implemented")
+		}),
+		ListUserNotificationsHandler: ListUserNotificationsHandlerFunc(func(params ListUserNotificationsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation ListUserNotifications has not yet been implemented")
+		}),
 		LoginHandler: LoginHandlerFunc(func(params LoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation Login has not yet been implemented")
 		}),
 		LogoutHandler: LogoutHandlerFunc(func(params LogoutParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation Logout has not yet been implemented")
 		}),
+		MarkUserNotificationsReadHandler: MarkUserNotificationsReadHandlerFunc(func(params MarkUserNotificationsReadParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation MarkUserNotificationsRead has not yet been implemented")
+		}),
 		RecoveryPasswordHandler: RecoveryPasswordHandlerFunc(func(params RecoveryPasswordParams) middleware.Responder {
 			return middleware.NotImplemented("operation RecoveryPassword has not yet been implemented")
 		}),
+		RemoveOrgMemberHandler: RemoveOrgMemberHandlerFunc(func(params RemoveOrgMemberParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation RemoveOrgMember has not yet been implemented")
+		}),
+		RequestPhoneVerificationHandler: RequestPhoneVerificationHandlerFunc(func(params RequestPhoneVerificationParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation RequestPhoneVerification has not yet been implemented")
+		}),
+		ResendNotificationTaskHandler: ResendNotificationTaskHandlerFunc(func(params ResendNotificationTaskParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation ResendNotificationTask has not yet been implemented")
+		}),
+		StreamUserNotificationsHandler: StreamUserNotificationsHandlerFunc(func(params StreamUserNotificationsParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation StreamUserNotifications has not yet been implemented")
+		}),
+		TransferOrgOwnershipHandler: TransferOrgOwnershipHandlerFunc(func(params TransferOrgOwnershipParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation TransferOrgOwnership has not yet been implemented")
+		}),
+		UnsubscribeHandler: UnsubscribeHandlerFunc(func(params UnsubscribeParams) middleware.Responder {
+			return middleware.NotImplemented("operation Unsubscribe has not yet been implemented")
+		}),
+		UnsubscribeOneClickHandler: UnsubscribeOneClickHandlerFunc(func(params UnsubscribeOneClickParams) middleware.Responder {
+			return middleware.NotImplemented("operation UnsubscribeOneClick has not yet been implemented")
+		}),
 		UpdateEmailHandler: UpdateEmailHandlerFunc(func(params UpdateEmailParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation UpdateEmail has not yet been implemented")
 		}),
+		UpdateNotificationSettingHandler: UpdateNotificationSettingHandlerFunc(func(params UpdateNotificationSettingParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateNotificationSetting has not yet been implemented")
+		}),
 		UpdatePasswordHandler: UpdatePasswordHandlerFunc(func(params UpdatePasswordParams, principal *app.AuthUser) middleware.Responder {
 			return middleware.NotImplemented("operation UpdatePassword has not yet been implemented")
 		}),
@@ -81,7 +149,14 @@ func NewServiceBoilerplateAPI(spec *loads.Document) *ServiceBoilerplateAPI {
 		VerificationUsernameHandler: VerificationUsernameHandlerFunc(func(params VerificationUsernameParams) middleware.Responder {
 			return middleware.NotImplemented("operation VerificationUsername has not yet been implemented")
 		}),
+		VerifyPhoneHandler: VerifyPhoneHandlerFunc(func(params VerifyPhoneParams, principal *app.AuthUser) middleware.Responder {
+			return middleware.NotImplemented("operation VerifyPhone has not yet been implemented")
+		}),
 
+		// Applies when the "X-Admin-Key" header is set
+		AdminKeyAuth: func(token string) (*app.AuthUser, error) {
+			return nil, errors.NotImplemented("api key auth (adminKey) X-Admin-Key from header param [X-Admin-Key] has not yet been implemented")
+		},
 		// Applies when the "Cookie" header is set
 		CookieKeyAuth: func(token string) (*app.AuthUser, error) {
 			return nil, errors.NotImplemented("api key auth (cookieKey) Cookie from header param [Cookie] has not yet been implemented")
@@ -116,10 +191,20 @@ type ServiceBoilerplateAPI struct {
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// UrlformConsumer registers a consumer for the following mime types:
+	//   - application/x-www-form-urlencoded
+	UrlformConsumer runtime.Consumer
 
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
+
+	// AdminKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-Admin-Key provided in the header
+	AdminKeyAuth func(string) (*app.AuthUser, error)
 
 	// CookieKeyAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key Cookie provided in the header
@@ -128,24 +213,66 @@ type ServiceBoilerplateAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AcceptOrgInvitationHandler sets the operation handler for the accept org invitation operation
+	AcceptOrgInvitationHandler AcceptOrgInvitationHandler
+	// CancelNotificationTaskHandler sets the operation handler for the cancel notification task operation
+	CancelNotificationTaskHandler CancelNotificationTaskHandler
+	// CreateOrgHandler sets the operation handler for the create org operation
+	CreateOrgHandler CreateOrgHandler
 	// CreateRecoveryCodeHandler sets the operation handler for the create recovery code operation
 	CreateRecoveryCodeHandler CreateRecoveryCodeHandler
 	// CreateUserHandler sets the operation handler for the create user operation
 	CreateUserHandler CreateUserHandler
+	// DeletePhoneHandler sets the operation handler for the delete phone operation
+	DeletePhoneHandler DeletePhoneHandler
 	// DeleteUserHandler sets the operation handler for the delete user operation
 	DeleteUserHandler DeleteUserHandler
+	// GetNotificationSettingsHandler sets the operation handler for the get notification settings operation
+	GetNotificationSettingsHandler GetNotificationSettingsHandler
+	// GetUnreadNotificationCountHandler sets the operation handler for the get unread notification count operation
+	GetUnreadNotificationCountHandler GetUnreadNotificationCountHandler
 	// GetUserHandler sets the operation handler for the get user operation
 	GetUserHandler GetUserHandler
 	// GetUsersHandler sets the operation handler for the get users operation
 	GetUsersHandler GetUsersHandler
+	// HandleEmailEventsHandler sets the operation handler for the handle email events operation
+	HandleEmailEventsHandler HandleEmailEventsHandler
+	// InviteOrgMemberHandler sets the operation handler for the invite org member operation
+	InviteOrgMemberHandler InviteOrgMemberHandler
+	// ListNotificationTasksHandler sets the operation handler for the list notification tasks operation
+	ListNotificationTasksHandler ListNotificationTasksHandler
+	// ListOrgMembersHandler sets the operation handler for the list org members operation
+	ListOrgMembersHandler ListOrgMembersHandler
+	// ListOrgsHandler sets the operation handler for the list orgs operation
+	ListOrgsHandler ListOrgsHandler
+	// ListUserNotificationsHandler sets the operation handler for the list user notifications operation
+	ListUserNotificationsHandler ListUserNotificationsHandler
 	// LoginHandler sets the operation handler for the login operation
 	LoginHandler LoginHandler
 	// LogoutHandler sets the operation handler for the logout operation
 	LogoutHandler LogoutHandler
+	// MarkUserNotificationsReadHandler sets the operation handler for the mark user notifications read operation
+	MarkUserNotificationsReadHandler MarkUserNotificationsReadHandler
 	// RecoveryPasswordHandler sets the operation handler for the recovery password operation
 	RecoveryPasswordHandler RecoveryPasswordHandler
+	// RemoveOrgMemberHandler sets the operation handler for the remove org member operation
+	RemoveOrgMemberHandler RemoveOrgMemberHandler
+	// RequestPhoneVerificationHandler sets the operation handler for the request phone verification operation
+	RequestPhoneVerificationHandler RequestPhoneVerificationHandler
+	// ResendNotificationTaskHandler sets the operation handler for the resend notification task operation
+	ResendNotificationTaskHandler ResendNotificationTaskHandler
+	// StreamUserNotificationsHandler sets the operation handler for the stream user notifications operation
+	StreamUserNotificationsHandler StreamUserNotificationsHandler
+	// TransferOrgOwnershipHandler sets the operation handler for the transfer org ownership operation
+	TransferOrgOwnershipHandler TransferOrgOwnershipHandler
+	// UnsubscribeHandler sets the operation handler for the unsubscribe operation
+	UnsubscribeHandler UnsubscribeHandler
+	// UnsubscribeOneClickHandler sets the operation handler for the unsubscribe one click operation
+	UnsubscribeOneClickHandler UnsubscribeOneClickHandler
 	// UpdateEmailHandler sets the operation handler for the update email operation
 	UpdateEmailHandler UpdateEmailHandler
+	// UpdateNotificationSettingHandler sets the operation handler for the update notification setting operation
+	UpdateNotificationSettingHandler UpdateNotificationSettingHandler
 	// UpdatePasswordHandler sets the operation handler for the update password operation
 	UpdatePasswordHandler UpdatePasswordHandler
 	// UpdateUsernameHandler sets the operation handler for the update username operation
@@ -154,6 +281,8 @@ type ServiceBoilerplateAPI struct {
 	VerificationEmailHandler VerificationEmailHandler
 	// VerificationUsernameHandler sets the operation handler for the verification username operation
 	VerificationUsernameHandler VerificationUsernameHandler
+	// VerifyPhoneHandler sets the operation handler for the verify phone operation
+	VerifyPhoneHandler VerifyPhoneHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -215,42 +344,114 @@ func (o *ServiceBoilerplateAPI) Validate() error {
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.UrlformConsumer == nil {
+		unregistered = append(unregistered, "UrlformConsumer")
+	}
 
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
+	if o.AdminKeyAuth == nil {
+		unregistered = append(unregistered, "XAdminKeyAuth")
+	}
 	if o.CookieKeyAuth == nil {
 		unregistered = append(unregistered, "CookieAuth")
 	}
 
+	if o.AcceptOrgInvitationHandler == nil {
+		unregistered = append(unregistered, "AcceptOrgInvitationHandler")
+	}
+	if o.CancelNotificationTaskHandler == nil {
+		unregistered = append(unregistered, "CancelNotificationTaskHandler")
+	}
+	if o.CreateOrgHandler == nil {
+		unregistered = append(unregistered, "CreateOrgHandler")
+	}
 	if o.CreateRecoveryCodeHandler == nil {
 		unregistered = append(unregistered, "CreateRecoveryCodeHandler")
 	}
 	if o.CreateUserHandler == nil {
 		unregistered = append(unregistered, "CreateUserHandler")
 	}
+	if o.DeletePhoneHandler == nil {
+		unregistered = append(unregistered, "DeletePhoneHandler")
+	}
 	if o.DeleteUserHandler == nil {
 		unregistered = append(unregistered, "DeleteUserHandler")
 	}
+	if o.GetNotificationSettingsHandler == nil {
+		unregistered = append(unregistered, "GetNotificationSettingsHandler")
+	}
+	if o.GetUnreadNotificationCountHandler == nil {
+		unregistered = append(unregistered, "GetUnreadNotificationCountHandler")
+	}
 	if o.GetUserHandler == nil {
 		unregistered = append(unregistered, "GetUserHandler")
 	}
 	if o.GetUsersHandler == nil {
 		unregistered = append(unregistered, "GetUsersHandler")
 	}
+	if o.HandleEmailEventsHandler == nil {
+		unregistered = append(unregistered, "HandleEmailEventsHandler")
+	}
+	if o.InviteOrgMemberHandler == nil {
+		unregistered = append(unregistered, "InviteOrgMemberHandler")
+	}
+	if o.ListNotificationTasksHandler == nil {
+		unregistered = append(unregistered, "ListNotificationTasksHandler")
+	}
+	if o.ListOrgMembersHandler == nil {
+		unregistered = append(unregistered, "ListOrgMembersHandler")
+	}
+	if o.ListOrgsHandler == nil {
+		unregistered = append(unregistered, "ListOrgsHandler")
+	}
+	if o.ListUserNotificationsHandler == nil {
+		unregistered = append(unregistered, "ListUserNotificationsHandler")
+	}
 	if o.LoginHandler == nil {
 		unregistered = append(unregistered, "LoginHandler")
 	}
 	if o.LogoutHandler == nil {
 		unregistered = append(unregistered, "LogoutHandler")
 	}
+	if o.MarkUserNotificationsReadHandler == nil {
+		unregistered = append(unregistered, "MarkUserNotificationsReadHandler")
+	}
 	if o.RecoveryPasswordHandler == nil {
 		unregistered = append(unregistered, "RecoveryPasswordHandler")
 	}
+	if o.RemoveOrgMemberHandler == nil {
+		unregistered = append(unregistered, "RemoveOrgMemberHandler")
+	}
+	if o.RequestPhoneVerificationHandler == nil {
+		unregistered = append(unregistered, "RequestPhoneVerificationHandler")
+	}
+	if o.ResendNotificationTaskHandler == nil {
+		unregistered = append(unregistered, "ResendNotificationTaskHandler")
+	}
+	if o.StreamUserNotificationsHandler == nil {
+		unregistered = append(unregistered, "StreamUserNotificationsHandler")
+	}
+	if o.TransferOrgOwnershipHandler == nil {
+		unregistered = append(unregistered, "TransferOrgOwnershipHandler")
+	}
+	if o.UnsubscribeHandler == nil {
+		unregistered = append(unregistered, "UnsubscribeHandler")
+	}
+	if o.UnsubscribeOneClickHandler == nil {
+		unregistered = append(unregistered, "UnsubscribeOneClickHandler")
+	}
 	if o.UpdateEmailHandler == nil {
 		unregistered = append(unregistered, "UpdateEmailHandler")
 	}
+	if o.UpdateNotificationSettingHandler == nil {
+		unregistered = append(unregistered, "UpdateNotificationSettingHandler")
+	}
 	if o.UpdatePasswordHandler == nil {
 		unregistered = append(unregistered, "UpdatePasswordHandler")
 	}
@@ -263,6 +464,9 @@ func (o *ServiceBoilerplateAPI) Validate() error {
 	if o.VerificationUsernameHandler == nil {
 		unregistered = append(unregistered, "VerificationUsernameHandler")
 	}
+	if o.VerifyPhoneHandler == nil {
+		unregistered = append(unregistered, "VerifyPhoneHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -281,6 +485,12 @@ func (o *ServiceBoilerplateAPI) AuthenticatorsFor(schemes map[string]spec.Securi
 	result := make(map[string]runtime.Authenticator)
 	for name := range schemes {
 		switch name {
+		case "adminKey":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (interface{}, error) {
+				return o.AdminKeyAuth(token)
+			})
+
 		case "cookieKey":
 			scheme := schemes[name]
 			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (interface{}, error) {
@@ -305,6 +515,8 @@ func (o *ServiceBoilerplateAPI) ConsumersFor(mediaTypes []string) map[string]run
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/x-www-form-urlencoded":
+			result["application/x-www-form-urlencoded"] = o.UrlformConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -322,6 +534,8 @@ func (o *ServiceBoilerplateAPI) ProducersFor(mediaTypes []string) map[string]run
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -362,6 +576,18 @@ func (o *ServiceBoilerplateAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orgs/invitations"] = NewAcceptOrgInvitation(o.context, o.AcceptOrgInvitationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/notifications/{id}/cancel"] = NewCancelNotificationTask(o.context, o.CancelNotificationTaskHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orgs"] = NewCreateOrg(o.context, o.CreateOrgHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -373,10 +599,22 @@ func (o *ServiceBoilerplateAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/user/phone"] = NewDeletePhone(o.context, o.DeletePhoneHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/user"] = NewDeleteUser(o.context, o.DeleteUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/user/notification-settings"] = NewGetNotificationSettings(o.context, o.GetNotificationSettingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/notifications/unread-count"] = NewGetUnreadNotificationCount(o.context, o.GetUnreadNotificationCountHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user"] = NewGetUser(o.context, o.GetUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -385,6 +623,30 @@ func (o *ServiceBoilerplateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/email/events"] = NewHandleEmailEvents(o.context, o.HandleEmailEventsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orgs/{id}/invitations"] = NewInviteOrgMember(o.context, o.InviteOrgMemberHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/notifications"] = NewListNotificationTasks(o.context, o.ListNotificationTasksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orgs/{id}/members"] = NewListOrgMembers(o.context, o.ListOrgMembersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orgs"] = NewListOrgs(o.context, o.ListOrgsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/notifications"] = NewListUserNotifications(o.context, o.ListUserNotificationsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/login"] = NewLogin(o.context, o.LoginHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -393,7 +655,39 @@ func (o *ServiceBoilerplateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/user/notifications/read"] = NewMarkUserNotificationsRead(o.context, o.MarkUserNotificationsReadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/recovery-password"] = NewRecoveryPassword(o.context, o.RecoveryPasswordHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/orgs/{id}/members/{userId}"] = NewRemoveOrgMember(o.context, o.RemoveOrgMemberHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/phone"] = NewRequestPhoneVerification(o.context, o.RequestPhoneVerificationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/notifications/{id}/resend"] = NewResendNotificationTask(o.context, o.ResendNotificationTaskHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/notifications/stream"] = NewStreamUserNotifications(o.context, o.StreamUserNotificationsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orgs/{id}/owner"] = NewTransferOrgOwnership(o.context, o.TransferOrgOwnershipHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/unsubscribe"] = NewUnsubscribe(o.context, o.UnsubscribeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/unsubscribe"] = NewUnsubscribeOneClick(o.context, o.UnsubscribeOneClickHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -401,6 +695,10 @@ func (o *ServiceBoilerplateAPI) initHandlerCache() {
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/user/notification-settings"] = NewUpdateNotificationSetting(o.context, o.UpdateNotificationSettingHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/user/password"] = NewUpdatePassword(o.context, o.UpdatePasswordHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
@@ -414,6 +712,10 @@ func (o *ServiceBoilerplateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/username/verification"] = NewVerificationUsername(o.context, o.VerificationUsernameHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/phone/verify"] = NewVerifyPhone(o.context, o.VerifyPhoneHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
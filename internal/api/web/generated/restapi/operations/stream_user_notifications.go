@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// StreamUserNotificationsHandlerFunc turns a function with the right signature into a stream user notifications handler
+type StreamUserNotificationsHandlerFunc func(StreamUserNotificationsParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn StreamUserNotificationsHandlerFunc) Handle(params StreamUserNotificationsParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// StreamUserNotificationsHandler interface for that can handle valid stream user notifications params
+type StreamUserNotificationsHandler interface {
+	Handle(StreamUserNotificationsParams, *app.AuthUser) middleware.Responder
+}
+
+// NewStreamUserNotifications creates a new http.Handler for the stream user notifications operation
+func NewStreamUserNotifications(ctx *middleware.Context, handler StreamUserNotificationsHandler) *StreamUserNotifications {
+	return &StreamUserNotifications{Context: ctx, Handler: handler}
+}
+
+/*
+StreamUserNotifications swagger:route GET /user/notifications/stream streamUserNotifications
+
+Server-Sent Events stream of notifications added to the user inbox.
+Every notification is sent as `notification` event with UserNotification in data and its id as event id.
+*/
+type StreamUserNotifications struct {
+	Context *middleware.Context
+	Handler StreamUserNotificationsHandler
+}
+
+func (o *StreamUserNotifications) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewStreamUserNotificationsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
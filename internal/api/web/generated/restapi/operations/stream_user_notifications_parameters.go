@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewStreamUserNotificationsParams creates a new StreamUserNotificationsParams object
+// no default values defined in spec.
+func NewStreamUserNotificationsParams() StreamUserNotificationsParams {
+
+	return StreamUserNotificationsParams{}
+}
+
+// StreamUserNotificationsParams contains all the bound params for the stream user notifications operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters streamUserNotifications
+type StreamUserNotificationsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Resume the stream after the notification with this id.
+	  In: header
+	*/
+	LastEventID *int32
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewStreamUserNotificationsParams() beforehand.
+func (o *StreamUserNotificationsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := o.bindLastEventID(r.Header[http.CanonicalHeaderKey("Last-Event-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLastEventID binds and validates parameter LastEventID from header.
+func (o *StreamUserNotificationsParams) bindLastEventID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("Last-Event-ID", "header", "int32", raw)
+	}
+	o.LastEventID = &value
+
+	return nil
+}
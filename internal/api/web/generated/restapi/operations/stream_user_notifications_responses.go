@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// StreamUserNotificationsOKCode is the HTTP code returned for type StreamUserNotificationsOK
+const StreamUserNotificationsOKCode int = 200
+
+/*
+StreamUserNotificationsOK OK
+
+swagger:response streamUserNotificationsOK
+*/
+type StreamUserNotificationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewStreamUserNotificationsOK creates StreamUserNotificationsOK with default headers values
+func NewStreamUserNotificationsOK() *StreamUserNotificationsOK {
+
+	return &StreamUserNotificationsOK{}
+}
+
+// WithPayload adds the payload to the stream user notifications o k response
+func (o *StreamUserNotificationsOK) WithPayload(payload string) *StreamUserNotificationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream user notifications o k response
+func (o *StreamUserNotificationsOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamUserNotificationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+StreamUserNotificationsDefault Generic error response.
+
+swagger:response streamUserNotificationsDefault
+*/
+type StreamUserNotificationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewStreamUserNotificationsDefault creates StreamUserNotificationsDefault with default headers values
+func NewStreamUserNotificationsDefault(code int) *StreamUserNotificationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &StreamUserNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the stream user notifications default response
+func (o *StreamUserNotificationsDefault) WithStatusCode(code int) *StreamUserNotificationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the stream user notifications default response
+func (o *StreamUserNotificationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the stream user notifications default response
+func (o *StreamUserNotificationsDefault) WithPayload(payload *models.Error) *StreamUserNotificationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream user notifications default response
+func (o *StreamUserNotificationsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamUserNotificationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
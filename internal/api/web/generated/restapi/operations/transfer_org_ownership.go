@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// TransferOrgOwnershipHandlerFunc turns a function with the right signature into a transfer org ownership handler
+type TransferOrgOwnershipHandlerFunc func(TransferOrgOwnershipParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn TransferOrgOwnershipHandlerFunc) Handle(params TransferOrgOwnershipParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// TransferOrgOwnershipHandler interface for that can handle valid transfer org ownership params
+type TransferOrgOwnershipHandler interface {
+	Handle(TransferOrgOwnershipParams, *app.AuthUser) middleware.Responder
+}
+
+// NewTransferOrgOwnership creates a new http.Handler for the transfer org ownership operation
+func NewTransferOrgOwnership(ctx *middleware.Context, handler TransferOrgOwnershipHandler) *TransferOrgOwnership {
+	return &TransferOrgOwnership{Context: ctx, Handler: handler}
+}
+
+/*
+TransferOrgOwnership swagger:route POST /orgs/{id}/owner transferOrgOwnership
+
+Makes the member the owner, the user becomes an admin.
+*/
+type TransferOrgOwnership struct {
+	Context *middleware.Context
+	Handler TransferOrgOwnershipHandler
+}
+
+func (o *TransferOrgOwnership) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewTransferOrgOwnershipParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// TransferOrgOwnershipBody transfer org ownership body
+//
+// swagger:model TransferOrgOwnershipBody
+type TransferOrgOwnershipBody struct {
+
+	// user Id
+	// Required: true
+	// Format: uuid
+	UserID models.UserID `json:"userId"`
+}
+
+// Validate validates this transfer org ownership body
+func (o *TransferOrgOwnershipBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateUserID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *TransferOrgOwnershipBody) validateUserID(formats strfmt.Registry) error {
+
+	if err := o.UserID.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "userId")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *TransferOrgOwnershipBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *TransferOrgOwnershipBody) UnmarshalBinary(b []byte) error {
+	var res TransferOrgOwnershipBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// TransferOrgOwnershipNoContentCode is the HTTP code returned for type TransferOrgOwnershipNoContent
+const TransferOrgOwnershipNoContentCode int = 204
+
+/*
+TransferOrgOwnershipNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response transferOrgOwnershipNoContent
+*/
+type TransferOrgOwnershipNoContent struct {
+}
+
+// NewTransferOrgOwnershipNoContent creates TransferOrgOwnershipNoContent with default headers values
+func NewTransferOrgOwnershipNoContent() *TransferOrgOwnershipNoContent {
+
+	return &TransferOrgOwnershipNoContent{}
+}
+
+// WriteResponse to the client
+func (o *TransferOrgOwnershipNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+TransferOrgOwnershipDefault Generic error response.
+
+swagger:response transferOrgOwnershipDefault
+*/
+type TransferOrgOwnershipDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransferOrgOwnershipDefault creates TransferOrgOwnershipDefault with default headers values
+func NewTransferOrgOwnershipDefault(code int) *TransferOrgOwnershipDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TransferOrgOwnershipDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the transfer org ownership default response
+func (o *TransferOrgOwnershipDefault) WithStatusCode(code int) *TransferOrgOwnershipDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the transfer org ownership default response
+func (o *TransferOrgOwnershipDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the transfer org ownership default response
+func (o *TransferOrgOwnershipDefault) WithPayload(payload *models.Error) *TransferOrgOwnershipDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transfer org ownership default response
+func (o *TransferOrgOwnershipDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransferOrgOwnershipDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UnsubscribeOneClickHandlerFunc turns a function with the right signature into a unsubscribe one click handler
+type UnsubscribeOneClickHandlerFunc func(UnsubscribeOneClickParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UnsubscribeOneClickHandlerFunc) Handle(params UnsubscribeOneClickParams) middleware.Responder {
+	return fn(params)
+}
+
+// UnsubscribeOneClickHandler interface for that can handle valid unsubscribe one click params
+type UnsubscribeOneClickHandler interface {
+	Handle(UnsubscribeOneClickParams) middleware.Responder
+}
+
+// NewUnsubscribeOneClick creates a new http.Handler for the unsubscribe one click operation
+func NewUnsubscribeOneClick(ctx *middleware.Context, handler UnsubscribeOneClickHandler) *UnsubscribeOneClick {
+	return &UnsubscribeOneClick{Context: ctx, Handler: handler}
+}
+
+/*
+UnsubscribeOneClick swagger:route POST /unsubscribe unsubscribeOneClick
+
+Unsubscribes from the kind of notification, sent by the confirmation of the link from email or by email client as one-click unsubscribe (RFC 8058) using List-Unsubscribe header.
+*/
+type UnsubscribeOneClick struct {
+	Context *middleware.Context
+	Handler UnsubscribeOneClickHandler
+}
+
+func (o *UnsubscribeOneClick) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUnsubscribeOneClickParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
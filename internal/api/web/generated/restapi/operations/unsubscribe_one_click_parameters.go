@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewUnsubscribeOneClickParams creates a new UnsubscribeOneClickParams object
+// no default values defined in spec.
+func NewUnsubscribeOneClickParams() UnsubscribeOneClickParams {
+
+	return UnsubscribeOneClickParams{}
+}
+
+// UnsubscribeOneClickParams contains all the bound params for the unsubscribe one click operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters unsubscribeOneClick
+type UnsubscribeOneClickParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: formData
+	*/
+	ListUnsubscribe *string
+	/*
+	  Required: true
+	  In: query
+	*/
+	Token string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewUnsubscribeOneClickParams() beforehand.
+func (o *UnsubscribeOneClickParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		if err != http.ErrNotMultipart {
+			return errors.New(400, "%v", err)
+		} else if err := r.ParseForm(); err != nil {
+			return errors.New(400, "%v", err)
+		}
+	}
+	fds := runtime.Values(r.Form)
+
+	fdListUnsubscribe, fdhkListUnsubscribe, _ := fds.GetOK("List-Unsubscribe")
+	if err := o.bindListUnsubscribe(fdListUnsubscribe, fdhkListUnsubscribe, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qToken, qhkToken, _ := qs.GetOK("token")
+	if err := o.bindToken(qToken, qhkToken, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindListUnsubscribe binds and validates parameter ListUnsubscribe from formData.
+func (o *UnsubscribeOneClickParams) bindListUnsubscribe(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ListUnsubscribe = &raw
+
+	return nil
+}
+
+// bindToken binds and validates parameter Token from query.
+func (o *UnsubscribeOneClickParams) bindToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("token", "query")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("token", "query", raw); err != nil {
+		return err
+	}
+
+	o.Token = raw
+
+	return nil
+}
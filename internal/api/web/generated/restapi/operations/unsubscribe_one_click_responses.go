@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// UnsubscribeOneClickNoContentCode is the HTTP code returned for type UnsubscribeOneClickNoContent
+const UnsubscribeOneClickNoContentCode int = 204
+
+/*
+UnsubscribeOneClickNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response unsubscribeOneClickNoContent
+*/
+type UnsubscribeOneClickNoContent struct {
+}
+
+// NewUnsubscribeOneClickNoContent creates UnsubscribeOneClickNoContent with default headers values
+func NewUnsubscribeOneClickNoContent() *UnsubscribeOneClickNoContent {
+
+	return &UnsubscribeOneClickNoContent{}
+}
+
+// WriteResponse to the client
+func (o *UnsubscribeOneClickNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+UnsubscribeOneClickDefault Generic error response.
+
+swagger:response unsubscribeOneClickDefault
+*/
+type UnsubscribeOneClickDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUnsubscribeOneClickDefault creates UnsubscribeOneClickDefault with default headers values
+func NewUnsubscribeOneClickDefault(code int) *UnsubscribeOneClickDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UnsubscribeOneClickDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the unsubscribe one click default response
+func (o *UnsubscribeOneClickDefault) WithStatusCode(code int) *UnsubscribeOneClickDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the unsubscribe one click default response
+func (o *UnsubscribeOneClickDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the unsubscribe one click default response
+func (o *UnsubscribeOneClickDefault) WithPayload(payload *models.Error) *UnsubscribeOneClickDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unsubscribe one click default response
+func (o *UnsubscribeOneClickDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnsubscribeOneClickDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// UnsubscribeOKCode is the HTTP code returned for type UnsubscribeOK
+const UnsubscribeOKCode int = 200
+
+/*
+UnsubscribeOK OK
+
+swagger:response unsubscribeOK
+*/
+type UnsubscribeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Unsubscription `json:"body,omitempty"`
+}
+
+// NewUnsubscribeOK creates UnsubscribeOK with default headers values
+func NewUnsubscribeOK() *UnsubscribeOK {
+
+	return &UnsubscribeOK{}
+}
+
+// WithPayload adds the payload to the unsubscribe o k response
+func (o *UnsubscribeOK) WithPayload(payload *models.Unsubscription) *UnsubscribeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unsubscribe o k response
+func (o *UnsubscribeOK) SetPayload(payload *models.Unsubscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnsubscribeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+UnsubscribeDefault Generic error response.
+
+swagger:response unsubscribeDefault
+*/
+type UnsubscribeDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUnsubscribeDefault creates UnsubscribeDefault with default headers values
+func NewUnsubscribeDefault(code int) *UnsubscribeDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UnsubscribeDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the unsubscribe default response
+func (o *UnsubscribeDefault) WithStatusCode(code int) *UnsubscribeDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the unsubscribe default response
+func (o *UnsubscribeDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the unsubscribe default response
+func (o *UnsubscribeDefault) WithPayload(payload *models.Error) *UnsubscribeDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unsubscribe default response
+func (o *UnsubscribeDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnsubscribeDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
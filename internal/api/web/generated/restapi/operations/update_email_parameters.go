@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 )
 
 // NewUpdateEmailParams creates a new UpdateEmailParams object
@@ -30,6 +31,10 @@ type UpdateEmailParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: body
@@ -46,6 +51,10 @@ func (o *UpdateEmailParams) BindRequest(r *http.Request, route *middleware.Match
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body UpdateEmailBody
@@ -73,3 +82,21 @@ func (o *UpdateEmailParams) BindRequest(r *http.Request, route *middleware.Match
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *UpdateEmailParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IfMatch = &raw
+
+	return nil
+}
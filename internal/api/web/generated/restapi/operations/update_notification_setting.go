@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// UpdateNotificationSettingHandlerFunc turns a function with the right signature into a update notification setting handler
+type UpdateNotificationSettingHandlerFunc func(UpdateNotificationSettingParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateNotificationSettingHandlerFunc) Handle(params UpdateNotificationSettingParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdateNotificationSettingHandler interface for that can handle valid update notification setting params
+type UpdateNotificationSettingHandler interface {
+	Handle(UpdateNotificationSettingParams, *app.AuthUser) middleware.Responder
+}
+
+// NewUpdateNotificationSetting creates a new http.Handler for the update notification setting operation
+func NewUpdateNotificationSetting(ctx *middleware.Context, handler UpdateNotificationSettingHandler) *UpdateNotificationSetting {
+	return &UpdateNotificationSetting{Context: ctx, Handler: handler}
+}
+
+/*
+UpdateNotificationSetting swagger:route PATCH /user/notification-settings updateNotificationSetting
+
+Opt-in or opt-out of the kind of notification.
+*/
+type UpdateNotificationSetting struct {
+	Context *middleware.Context
+	Handler UpdateNotificationSettingHandler
+}
+
+func (o *UpdateNotificationSetting) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUpdateNotificationSettingParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// UpdateNotificationSettingBody update notification setting body
+//
+// swagger:model UpdateNotificationSettingBody
+type UpdateNotificationSettingBody struct {
+
+	// enabled
+	// Required: true
+	Enabled *bool `json:"enabled"`
+
+	// kind
+	// Required: true
+	Kind models.MessageKind `json:"kind"`
+}
+
+// Validate validates this update notification setting body
+func (o *UpdateNotificationSettingBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpdateNotificationSettingBody) validateEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("args"+"."+"enabled", "body", o.Enabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *UpdateNotificationSettingBody) validateKind(formats strfmt.Registry) error {
+
+	if err := o.Kind.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "kind")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateNotificationSettingBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateNotificationSettingBody) UnmarshalBinary(b []byte) error {
+	var res UpdateNotificationSettingBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
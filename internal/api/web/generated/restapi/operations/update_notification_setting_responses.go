@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// UpdateNotificationSettingNoContentCode is the HTTP code returned for type UpdateNotificationSettingNoContent
+const UpdateNotificationSettingNoContentCode int = 204
+
+/*
+UpdateNotificationSettingNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response updateNotificationSettingNoContent
+*/
+type UpdateNotificationSettingNoContent struct {
+}
+
+// NewUpdateNotificationSettingNoContent creates UpdateNotificationSettingNoContent with default headers values
+func NewUpdateNotificationSettingNoContent() *UpdateNotificationSettingNoContent {
+
+	return &UpdateNotificationSettingNoContent{}
+}
+
+// WriteResponse to the client
+func (o *UpdateNotificationSettingNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+UpdateNotificationSettingDefault Generic error response.
+
+swagger:response updateNotificationSettingDefault
+*/
+type UpdateNotificationSettingDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateNotificationSettingDefault creates UpdateNotificationSettingDefault with default headers values
+func NewUpdateNotificationSettingDefault(code int) *UpdateNotificationSettingDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateNotificationSettingDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update notification setting default response
+func (o *UpdateNotificationSettingDefault) WithStatusCode(code int) *UpdateNotificationSettingDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update notification setting default response
+func (o *UpdateNotificationSettingDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update notification setting default response
+func (o *UpdateNotificationSettingDefault) WithPayload(payload *models.Error) *UpdateNotificationSettingDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update notification setting default response
+func (o *UpdateNotificationSettingDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateNotificationSettingDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 
 	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
 )
@@ -32,6 +33,10 @@ type UpdatePasswordParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*ETag of the user returned by getUser, the update fails with 412 if the user has been changed since.
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: body
@@ -48,6 +53,10 @@ func (o *UpdatePasswordParams) BindRequest(r *http.Request, route *middleware.Ma
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.UpdatePassword
@@ -75,3 +84,21 @@ func (o *UpdatePasswordParams) BindRequest(r *http.Request, route *middleware.Ma
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *UpdatePasswordParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IfMatch = &raw
+
+	return nil
+}
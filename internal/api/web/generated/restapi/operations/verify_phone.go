@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// VerifyPhoneHandlerFunc turns a function with the right signature into a verify phone handler
+type VerifyPhoneHandlerFunc func(VerifyPhoneParams, *app.AuthUser) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn VerifyPhoneHandlerFunc) Handle(params VerifyPhoneParams, principal *app.AuthUser) middleware.Responder {
+	return fn(params, principal)
+}
+
+// VerifyPhoneHandler interface for that can handle valid verify phone params
+type VerifyPhoneHandler interface {
+	Handle(VerifyPhoneParams, *app.AuthUser) middleware.Responder
+}
+
+// NewVerifyPhone creates a new http.Handler for the verify phone operation
+func NewVerifyPhone(ctx *middleware.Context, handler VerifyPhoneHandler) *VerifyPhone {
+	return &VerifyPhone{Context: ctx, Handler: handler}
+}
+
+/*
+VerifyPhone swagger:route POST /user/phone/verify verifyPhone
+
+Sets the phone by the code sent to it.
+*/
+type VerifyPhone struct {
+	Context *middleware.Context
+	Handler VerifyPhoneHandler
+}
+
+func (o *VerifyPhone) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewVerifyPhoneParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *app.AuthUser
+	if uprinc != nil {
+		principal = uprinc.(*app.AuthUser) // this is really a app.AuthUser, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// VerifyPhoneBody verify phone body
+//
+// swagger:model VerifyPhoneBody
+type VerifyPhoneBody struct {
+
+	// code
+	// Required: true
+	Code models.RecoveryCode `json:"code"`
+}
+
+// Validate validates this verify phone body
+func (o *VerifyPhoneBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *VerifyPhoneBody) validateCode(formats strfmt.Registry) error {
+
+	if err := o.Code.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("args" + "." + "code")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *VerifyPhoneBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *VerifyPhoneBody) UnmarshalBinary(b []byte) error {
+	var res VerifyPhoneBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
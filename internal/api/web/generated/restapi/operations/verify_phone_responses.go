@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+)
+
+// VerifyPhoneNoContentCode is the HTTP code returned for type VerifyPhoneNoContent
+const VerifyPhoneNoContentCode int = 204
+
+/*
+VerifyPhoneNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response verifyPhoneNoContent
+*/
+type VerifyPhoneNoContent struct {
+}
+
+// NewVerifyPhoneNoContent creates VerifyPhoneNoContent with default headers values
+func NewVerifyPhoneNoContent() *VerifyPhoneNoContent {
+
+	return &VerifyPhoneNoContent{}
+}
+
+// WriteResponse to the client
+func (o *VerifyPhoneNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+VerifyPhoneDefault Generic error response.
+
+swagger:response verifyPhoneDefault
+*/
+type VerifyPhoneDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewVerifyPhoneDefault creates VerifyPhoneDefault with default headers values
+func NewVerifyPhoneDefault(code int) *VerifyPhoneDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &VerifyPhoneDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the verify phone default response
+func (o *VerifyPhoneDefault) WithStatusCode(code int) *VerifyPhoneDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the verify phone default response
+func (o *VerifyPhoneDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the verify phone default response
+func (o *VerifyPhoneDefault) WithPayload(payload *models.Error) *VerifyPhoneDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify phone default response
+func (o *VerifyPhoneDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyPhoneDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
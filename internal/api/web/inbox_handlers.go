@@ -0,0 +1,152 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi/operations"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"go.uber.org/zap"
+)
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var streamKeepAlive = 15 * time.Second
+
+func (svc *service) listUserNotifications(params operations.ListUserNotificationsParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	page := app.Page{
+		Limit:  int(params.Limit),
+		Offset: int(swag.Int32Value(params.Offset)),
+	}
+
+	notifications, total, err := svc.inboxApp.UserNotifications(ctx, *authUser, page)
+	switch {
+	case err == nil:
+		return operations.NewListUserNotificationsOK().WithPayload(&operations.ListUserNotificationsOKBody{
+			Notifications: UserNotifications(notifications),
+			Total:         swag.Int32(int32(total)),
+		})
+	default:
+		return errListUserNotifications(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) markUserNotificationsRead(params operations.MarkUserNotificationsReadParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	ids := make([]int, len(params.Args.Ids))
+	for i := range params.Args.Ids {
+		ids[i] = int(params.Args.Ids[i])
+	}
+
+	err := svc.inboxApp.MarkNotificationsRead(ctx, *authUser, ids)
+	switch {
+	case err == nil:
+		return operations.NewMarkUserNotificationsReadNoContent()
+	default:
+		return errMarkUserNotificationsRead(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) getUnreadNotificationCount(params operations.GetUnreadNotificationCountParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	count, err := svc.inboxApp.UnreadNotificationCount(ctx, *authUser)
+	switch {
+	case err == nil:
+		return operations.NewGetUnreadNotificationCountOK().WithPayload(&operations.GetUnreadNotificationCountOKBody{
+			Count: swag.Int32(int32(count)),
+		})
+	default:
+		return errGetUnreadNotificationCount(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) streamUserNotifications(params operations.StreamUserNotificationsParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+	afterID := int(swag.Int32Value(params.LastEventID))
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			log.Warn("response writer does not support streaming")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream := &eventStream{w: w, flusher: flusher}
+		errc := make(chan error, 1)
+		go func() {
+			errc <- svc.inboxApp.WatchUserNotifications(ctx, *authUser, afterID, stream.notification)
+		}()
+
+		keepAlive := time.NewTicker(streamKeepAlive)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case err := <-errc:
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Warn("failed to stream notifications", zap.Error(err))
+				}
+				return
+			case <-keepAlive.C:
+				if err := stream.keepAlive(); err != nil {
+					cancel()
+				}
+			}
+		}
+	})
+}
+
+// eventStream writes Server-Sent Events, it is safe for concurrent use.
+type eventStream struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func (s *eventStream) notification(n app.UserNotification) error {
+	data, err := json.Marshal(UserNotification(&n))
+	if err != nil {
+		return fmt.Errorf("marshal notification: %w", err)
+	}
+
+	return s.write(fmt.Sprintf("id: %d\nevent: notification\ndata: %s\n\n", n.ID, data))
+}
+
+// keepAlive writes a comment to prevent closing the idle connection by proxies.
+func (s *eventStream) keepAlive() error {
+	return s.write(":\n\n")
+}
+
+func (s *eventStream) write(event string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.w.Write([]byte(event))
+	if err != nil {
+		return err
+	}
+	s.flusher.Flush()
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package web_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/api/web"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client/operations"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var userNotification = app.UserNotification{
+	ID:        2,
+	Kind:      app.Welcome,
+	Content:   "Welcome",
+	CreatedAt: time.Now().Truncate(time.Second).UTC(),
+}
+
+func TestServiceListUserNotifications(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	notifications := []app.UserNotification{userNotification}
+
+	testCases := []struct {
+		name    string
+		appRes  []app.UserNotification
+		appErr  error
+		want    *operations.ListUserNotificationsOKBody
+		wantErr *models.Error
+	}{
+		{"success", notifications, nil, &operations.ListUserNotificationsOKBody{
+			Notifications: web.UserNotifications(notifications),
+			Total:         swag.Int32(1),
+		}, nil},
+		{"internal error", nil, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().UserNotifications(gomock.Any(), authUser, app.Page{Limit: 10}).
+				Return(tc.appRes, len(tc.appRes), tc.appErr)
+
+			params := operations.NewListUserNotificationsParams().WithLimit(10)
+			res, err := client.Operations.ListUserNotifications(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res.Payload)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceMarkUserNotificationsRead(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	testCases := []struct {
+		name   string
+		ids    []int32
+		appIDs []int
+		appErr error
+		want   *models.Error
+	}{
+		{"success", []int32{1, 2}, []int{1, 2}, nil, nil},
+		{"success all", nil, []int{}, nil, nil},
+		{"internal error", []int32{1}, []int{1}, errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().MarkNotificationsRead(gomock.Any(), authUser, tc.appIDs).Return(tc.appErr)
+
+			params := operations.NewMarkUserNotificationsReadParams().
+				WithArgs(operations.MarkUserNotificationsReadBody{Ids: tc.ids})
+			_, err := client.Operations.MarkUserNotificationsRead(params, apiKeyAuth)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
+
+func TestServiceGetUnreadNotificationCount(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	testCases := []struct {
+		name    string
+		appRes  int
+		appErr  error
+		want    *operations.GetUnreadNotificationCountOKBody
+		wantErr *models.Error
+	}{
+		{"success", 3, nil, &operations.GetUnreadNotificationCountOKBody{Count: swag.Int32(3)}, nil},
+		{"internal error", 0, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().UnreadNotificationCount(gomock.Any(), authUser).Return(tc.appRes, tc.appErr)
+
+			res, err := client.Operations.GetUnreadNotificationCount(operations.NewGetUnreadNotificationCountParams(), apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res.Payload)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceStreamUserNotifications(t *testing.T) {
+	t.Parallel()
+
+	url, shutdown, mockApp, _ := testNewServer(t)
+	defer shutdown()
+
+	const lastEventID = 1
+	mockApp.EXPECT().WatchUserNotifications(gomock.Any(), authUser, lastEventID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ app.AuthUser, _ int, fn func(app.UserNotification) error) error {
+			err := fn(userNotification)
+			if err != nil {
+				return err
+			}
+
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s%s/user/notifications/stream", url, client.DefaultBasePath), nil)
+	assert.Nil(t, err)
+	req.Header.Set("Cookie", "authKey="+sessUser)
+	req.Header.Set("Last-Event-ID", fmt.Sprint(lastEventID))
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	data, err := json.Marshal(web.UserNotification(&userNotification))
+	assert.Nil(t, err)
+	want := []string{
+		fmt.Sprintf("id: %d", userNotification.ID),
+		"event: notification",
+		"data: " + string(data),
+		"",
+	}
+
+	reader := bufio.NewReader(res.Body)
+	for i := range want {
+		line, err := reader.ReadString('\n')
+		assert.Nil(t, err)
+		assert.Equal(t, want[i]+"\n", line)
+	}
+}
@@ -1,6 +1,10 @@
 package web_test
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"net"
@@ -18,6 +22,7 @@ import (
 	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
 	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi"
 	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/consistency"
 	"github.com/zergslaw/boilerplate/internal/metrics"
 	"github.com/zergslaw/boilerplate/internal/mock"
 	"go.uber.org/zap"
@@ -39,9 +44,16 @@ var (
 	username         = "username"
 	password         = "password"
 
+	tenant = app.Tenant{
+		ID:   2,
+		Name: "tenant",
+	}
+
 	authToken app.AuthToken = "token"
 	user                    = app.User{
 		ID:        1,
+		PublicID:  "0b7d4a3e-9c2f-4d61-a8e5-3f1c6b2d9e70",
+		TenantID:  tenant.ID,
 		Email:     email,
 		Name:      username,
 		PassHash:  []byte(password),
@@ -63,9 +75,12 @@ var (
 		Session: session,
 	}
 
-	sessUser   = "sessUser"
-	apiKeyAuth = httptransport.APIKeyAuth("Cookie", "header", "authKey="+sessUser)
-	restUser   = web.User(&user)
+	sessUser      = "sessUser"
+	apiKeyAuth    = httptransport.APIKeyAuth("Cookie", "header", "authKey="+sessUser)
+	adminKey      = "adminKey"
+	adminKeyAuth  = httptransport.APIKeyAuth("X-Admin-Key", "header", adminKey)
+	emailEventKey = generateEmailEventKey()
+	restUser      = web.User(&user)
 )
 
 func testNewServer(t *testing.T) (string, func(), *mock.MockApp, *client.ServiceBoilerplate) {
@@ -74,13 +89,18 @@ func testNewServer(t *testing.T) (string, func(), *mock.MockApp, *client.Service
 	ctrl := gomock.NewController(t)
 	mockApp := mock.NewMockApp(ctrl)
 	mockApp.EXPECT().UserByAuthToken(gomock.Any(), app.AuthToken(sessUser)).
+		Do(func(ctx context.Context, _ app.AuthToken) {
+			assert.True(t, consistency.Tracked(ctx), "the token is authenticated in the context of the request")
+		}).
 		Return(&authUser, nil).AnyTimes()
+	mockApp.EXPECT().Tenant(gomock.Any(), "", gomock.Any()).
+		Return(&tenant, nil).AnyTimes()
 
 	log, err := zap.NewDevelopment(zap.AddStacktrace(zap.FatalLevel))
 	assert.NoError(t, err)
 
 	randomPort := web.SetPort(0)
-	server, err := web.New(mockApp, log, randomPort)
+	server, err := web.New(mockApp, log, randomPort, web.SetAdminKey(adminKey), web.SetEmailEventKey(&emailEventKey.PublicKey))
 	assert.NoError(t, err, "NewServer")
 	assert.NoError(t, server.Listen(), "server.Listen")
 
@@ -102,6 +122,15 @@ func testNewServer(t *testing.T) (string, func(), *mock.MockApp, *client.Service
 	return url, shutdown, mockApp, c
 }
 
+func generateEmailEventKey() *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	return key
+}
+
 // APIError returns model.Error with given msg.
 func APIError(msg string) *models.Error {
 	return &models.Error{
@@ -137,6 +166,50 @@ func errPayload(err interface{}) *models.Error {
 		return err.Payload
 	case *operations.RecoveryPasswordDefault:
 		return err.Payload
+	case *operations.ListNotificationTasksDefault:
+		return err.Payload
+	case *operations.ResendNotificationTaskDefault:
+		return err.Payload
+	case *operations.CancelNotificationTaskDefault:
+		return err.Payload
+	case *operations.GetNotificationSettingsDefault:
+		return err.Payload
+	case *operations.UpdateNotificationSettingDefault:
+		return err.Payload
+	case *operations.UnsubscribeDefault:
+		return err.Payload
+	case *operations.UnsubscribeOneClickDefault:
+		return err.Payload
+	case *operations.ListUserNotificationsDefault:
+		return err.Payload
+	case *operations.MarkUserNotificationsReadDefault:
+		return err.Payload
+	case *operations.GetUnreadNotificationCountDefault:
+		return err.Payload
+	case *operations.StreamUserNotificationsDefault:
+		return err.Payload
+	case *operations.HandleEmailEventsDefault:
+		return err.Payload
+	case *operations.RequestPhoneVerificationDefault:
+		return err.Payload
+	case *operations.VerifyPhoneDefault:
+		return err.Payload
+	case *operations.DeletePhoneDefault:
+		return err.Payload
+	case *operations.ListOrgsDefault:
+		return err.Payload
+	case *operations.CreateOrgDefault:
+		return err.Payload
+	case *operations.AcceptOrgInvitationDefault:
+		return err.Payload
+	case *operations.ListOrgMembersDefault:
+		return err.Payload
+	case *operations.RemoveOrgMemberDefault:
+		return err.Payload
+	case *operations.InviteOrgMemberDefault:
+		return err.Payload
+	case *operations.TransferOrgOwnershipDefault:
+		return err.Payload
 	default:
 		return nil
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/felixge/httpsnoop"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/zergslaw/boilerplate/internal/consistency"
 	"github.com/zergslaw/boilerplate/internal/log"
 	"github.com/zergslaw/boilerplate/internal/metrics"
 	"go.uber.org/zap"
@@ -63,6 +64,13 @@ func createLogger(basePath string, logger *zap.Logger) middlewareFunc {
 	}
 }
 
+// trackWrites makes reads following a write of the request go to the primary database.
+func trackWrites(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(consistency.NewContext(r.Context())))
+	})
+}
+
 func accessLog(basePath string) middlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,73 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi/operations"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func (svc *service) listNotificationTasks(params operations.ListNotificationTasksParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	filter := app.TaskFilter{
+		Email:  swag.StringValue(params.Email),
+		Status: app.TaskStatus(swag.StringValue(params.Status)),
+	}
+	if params.Kind != nil {
+		kind, err := app.ParseMessageKind(*params.Kind)
+		if err != nil {
+			return errListNotificationTasks(log, err, http.StatusBadRequest)
+		}
+		filter.Kind = kind
+	}
+
+	page := app.Page{
+		Limit:  int(params.Limit),
+		Offset: int(swag.Int32Value(params.Offset)),
+	}
+
+	tasks, total, err := svc.adminApp.ListNotificationTasks(ctx, filter, page)
+	switch {
+	case err == nil:
+		return operations.NewListNotificationTasksOK().WithPayload(&operations.ListNotificationTasksOKBody{
+			Tasks: NotificationTasks(tasks),
+			Total: swag.Int32(int32(total)),
+		})
+	default:
+		return errListNotificationTasks(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) resendNotificationTask(params operations.ResendNotificationTaskParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	task, err := svc.adminApp.ResendNotificationTask(ctx, int(params.ID))
+	switch {
+	case err == nil:
+		return operations.NewResendNotificationTaskOK().WithPayload(NotificationTask(task))
+	case errors.Is(err, app.ErrNotFound):
+		return errResendNotificationTask(log, err, http.StatusNotFound)
+	default:
+		return errResendNotificationTask(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) cancelNotificationTask(params operations.CancelNotificationTaskParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	err := svc.adminApp.CancelNotificationTask(ctx, int(params.ID))
+	switch {
+	case err == nil:
+		return operations.NewCancelNotificationTaskNoContent()
+	case errors.Is(err, app.ErrNotFound):
+		return errCancelNotificationTask(log, err, http.StatusNotFound)
+	case errors.Is(err, app.ErrTaskNotPending):
+		return errCancelNotificationTask(log, err, http.StatusConflict)
+	default:
+		return errCancelNotificationTask(log, err, http.StatusInternalServerError)
+	}
+}
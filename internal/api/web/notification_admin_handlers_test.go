@@ -0,0 +1,185 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/api/web"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client/operations"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestServiceListNotificationTasks(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	tasks := []app.TaskNotificationInfo{
+		{
+			TaskNotification: app.TaskNotification{ID: 2, Email: email, Kind: app.PassRecovery},
+			Status:           app.TaskPending,
+			Error:            errAny.Error(),
+			CreatedAt:        time.Now().UTC().Truncate(time.Millisecond),
+		},
+		{
+			TaskNotification: app.TaskNotification{ID: 1, Email: email, Kind: app.Welcome},
+			Status:           app.TaskDone,
+			CreatedAt:        time.Now().UTC().Truncate(time.Millisecond),
+			ExecTime:         time.Now().UTC().Truncate(time.Millisecond),
+		},
+	}
+	filter := app.TaskFilter{Email: email, Kind: app.PassRecovery, Status: app.TaskPending}
+	page := app.Page{Limit: 10}
+
+	testCases := []struct {
+		name    string
+		kind    string
+		appErr  error
+		want    *operations.ListNotificationTasksOKBody
+		wantErr *models.Error
+	}{
+		{"success", app.PassRecovery.String(), nil, &operations.ListNotificationTasksOKBody{
+			Tasks: web.NotificationTasks(tasks),
+			Total: swag.Int32(2),
+		}, nil},
+		{"unknown kind", "LoginAlert", nil, nil, APIError("unknown task kind: LoginAlert")},
+		{"internal error", app.PassRecovery.String(), errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.kind == app.PassRecovery.String() {
+				total := 0
+				if tc.appErr == nil {
+					total = len(tasks)
+				}
+				mockApp.EXPECT().ListNotificationTasks(gomock.Any(), filter, page).Return(tasks, total, tc.appErr)
+			}
+
+			params := operations.NewListNotificationTasksParams().
+				WithEmail(swag.String(email)).
+				WithKind(swag.String(tc.kind)).
+				WithStatus(swag.String(string(app.TaskPending))).
+				WithLimit(int32(page.Limit))
+			res, err := client.Operations.ListNotificationTasks(params, adminKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res.Payload)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceAdminKeyAuth(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, _, client := testNewServer(t)
+	defer shutdown()
+
+	testCases := []struct {
+		name string
+		auth runtime.ClientAuthInfoWriter
+	}{
+		{"wrong key", httptransport.APIKeyAuth("X-Admin-Key", "header", "wrong")},
+		{"user session", apiKeyAuth},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := operations.NewListNotificationTasksParams().WithLimit(10)
+			res, err := client.Operations.ListNotificationTasks(params, tc.auth)
+			assert.Nil(t, res)
+			errDefault, ok := err.(*operations.ListNotificationTasksDefault)
+			assert.True(t, ok)
+			assert.Equal(t, http.StatusUnauthorized, errDefault.Code())
+		})
+	}
+}
+
+func TestServiceResendNotificationTask(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	task := app.TaskNotificationInfo{
+		TaskNotification: app.TaskNotification{ID: 2, Email: email, Kind: app.PassRecovery},
+		Status:           app.TaskPending,
+		CreatedAt:        time.Now().UTC().Truncate(time.Millisecond),
+	}
+
+	testCases := []struct {
+		name    string
+		appRes  *app.TaskNotificationInfo
+		appErr  error
+		want    *models.NotificationTask
+		wantErr *models.Error
+	}{
+		{"success", &task, nil, web.NotificationTask(&task), nil},
+		{"not found", nil, app.ErrNotFound, nil, APIError(app.ErrNotFound.Error())},
+		{"internal error", nil, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().ResendNotificationTask(gomock.Any(), 1).Return(tc.appRes, tc.appErr)
+
+			params := operations.NewResendNotificationTaskParams().WithID(1)
+			res, err := client.Operations.ResendNotificationTask(params, adminKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res.Payload)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceCancelNotificationTask(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	testCases := []struct {
+		name    string
+		appErr  error
+		wantErr *models.Error
+	}{
+		{"success", nil, nil},
+		{"not found", app.ErrNotFound, APIError(app.ErrNotFound.Error())},
+		{"not pending", app.ErrTaskNotPending, APIError(app.ErrTaskNotPending.Error())},
+		{"internal error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().CancelNotificationTask(gomock.Any(), 1).Return(tc.appErr)
+
+			params := operations.NewCancelNotificationTaskParams().WithID(1)
+			_, err := client.Operations.CancelNotificationTask(params, adminKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
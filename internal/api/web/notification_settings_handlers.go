@@ -0,0 +1,78 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi/operations"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func (svc *service) getNotificationSettings(params operations.GetNotificationSettingsParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	settings, err := svc.settingsApp.NotificationSettings(ctx, *authUser)
+	switch {
+	case err == nil:
+		return operations.NewGetNotificationSettingsOK().WithPayload(NotificationSettings(settings))
+	default:
+		return errGetNotificationSettings(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) updateNotificationSetting(params operations.UpdateNotificationSettingParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	kind, err := app.ParseMessageKind(string(params.Args.Kind))
+	if err != nil {
+		return errUpdateNotificationSetting(log, err, http.StatusBadRequest)
+	}
+
+	err = svc.settingsApp.UpdateNotificationSetting(ctx, *authUser, app.NotificationSetting{
+		Kind:    kind,
+		Enabled: swag.BoolValue(params.Args.Enabled),
+	})
+	switch {
+	case err == nil:
+		return operations.NewUpdateNotificationSettingNoContent()
+	case errors.Is(err, app.ErrNotificationMandatory):
+		return errUpdateNotificationSetting(log, err, http.StatusBadRequest)
+	default:
+		return errUpdateNotificationSetting(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) unsubscribe(params operations.UnsubscribeParams) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
+
+	kind, err := svc.settingsApp.UnsubscribeKind(ctx, params.Token)
+	switch {
+	case err == nil:
+		return operations.NewUnsubscribeOK().WithPayload(&models.Unsubscription{Kind: models.MessageKind(kind.String())})
+	case errors.Is(err, app.ErrInvalidToken):
+		return errUnsubscribe(log, err, http.StatusBadRequest)
+	case errors.Is(err, app.ErrNotificationMandatory):
+		return errUnsubscribe(log, err, http.StatusBadRequest)
+	default:
+		return errUnsubscribe(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) unsubscribeOneClick(params operations.UnsubscribeOneClickParams) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
+
+	err := svc.settingsApp.Unsubscribe(ctx, params.Token)
+	switch {
+	case err == nil:
+		return operations.NewUnsubscribeOneClickNoContent()
+	case errors.Is(err, app.ErrInvalidToken):
+		return errUnsubscribeOneClick(log, err, http.StatusBadRequest)
+	case errors.Is(err, app.ErrNotificationMandatory):
+		return errUnsubscribeOneClick(log, err, http.StatusBadRequest)
+	default:
+		return errUnsubscribeOneClick(log, err, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,138 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/api/web"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client/operations"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestServiceGetNotificationSettings(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	settings := []app.NotificationSetting{
+		{Kind: app.Welcome, Enabled: false},
+		{Kind: app.PassRecovery, Enabled: true},
+	}
+
+	testCases := []struct {
+		name     string
+		settings []app.NotificationSetting
+		appErr   error
+		want     []*models.NotificationSetting
+		wantErr  *models.Error
+	}{
+		{"success", settings, nil, web.NotificationSettings(settings), nil},
+		{"internal error", nil, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().NotificationSettings(gomock.Any(), authUser).Return(tc.settings, tc.appErr)
+
+			params := operations.NewGetNotificationSettingsParams()
+			res, err := client.Operations.GetNotificationSettings(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res.Payload)
+				assert.False(t, *res.Payload[0].Mandatory)
+				assert.True(t, *res.Payload[1].Mandatory)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceUpdateNotificationSetting(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	testCases := []struct {
+		name    string
+		kind    string
+		appErr  error
+		wantErr *models.Error
+	}{
+		{"success", app.Welcome.String(), nil, nil},
+		{"mandatory", app.PassRecovery.String(), app.ErrNotificationMandatory, APIError("notification is mandatory")},
+		{"internal error", app.Welcome.String(), errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			kind, err := app.ParseMessageKind(tc.kind)
+			assert.Nil(t, err)
+			mockApp.EXPECT().UpdateNotificationSetting(gomock.Any(), authUser, app.NotificationSetting{Kind: kind}).
+				Return(tc.appErr)
+
+			params := operations.NewUpdateNotificationSettingParams().WithArgs(operations.UpdateNotificationSettingBody{
+				Kind:    models.MessageKind(tc.kind),
+				Enabled: swag.Bool(false),
+			})
+			_, err = client.Operations.UpdateNotificationSetting(params, apiKeyAuth)
+			assert.Equal(t, tc.wantErr, errPayload(err))
+		})
+	}
+
+	t.Run("unknown kind", func(t *testing.T) {
+		params := operations.NewUpdateNotificationSettingParams().WithArgs(operations.UpdateNotificationSettingBody{
+			Kind:    "LoginAlert",
+			Enabled: swag.Bool(false),
+		})
+		_, err := client.Operations.UpdateNotificationSetting(params, apiKeyAuth)
+		assert.Equal(t, APIError("unknown task kind: LoginAlert"), errPayload(err))
+	})
+}
+
+func TestServiceUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	const unsubscribeToken = "unsubscribeToken"
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"not valid token", app.ErrInvalidToken, APIError("not valid auth")},
+		{"internal error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().UnsubscribeKind(gomock.Any(), unsubscribeToken).Return(app.Welcome, tc.appErr)
+			mockApp.EXPECT().Unsubscribe(gomock.Any(), unsubscribeToken).Return(tc.appErr)
+
+			res, err := client.Operations.Unsubscribe(operations.NewUnsubscribeParams().WithToken(unsubscribeToken))
+			assert.Equal(t, tc.want, errPayload(err))
+			if tc.appErr == nil {
+				assert.Equal(t, &models.Unsubscription{Kind: models.MessageKind(app.Welcome.String())}, res.Payload)
+			}
+
+			oneClickParams := operations.NewUnsubscribeOneClickParams().
+				WithToken(unsubscribeToken).
+				WithListUnsubscribe(swag.String("One-Click"))
+			_, err = client.Operations.UnsubscribeOneClick(oneClickParams)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi/operations"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func (svc *service) listOrgs(params operations.ListOrgsParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	orgs, err := svc.orgApp.ListOrgs(ctx, *authUser)
+	switch {
+	case err == nil:
+		return operations.NewListOrgsOK().WithPayload(Orgs(orgs))
+	default:
+		return errListOrgs(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) createOrg(params operations.CreateOrgParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	org, err := svc.orgApp.CreateOrg(ctx, *authUser, string(params.Args.Name))
+	switch {
+	case err == nil:
+		return operations.NewCreateOrgOK().WithPayload(Org(&app.OrgMembership{Org: *org, Role: app.OrgRoleOwner}))
+	default:
+		return errCreateOrg(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) acceptOrgInvitation(params operations.AcceptOrgInvitationParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	membership, err := svc.orgApp.AcceptOrgInvitation(ctx, *authUser, swag.StringValue(params.Args.Token))
+	switch {
+	case err == nil:
+		return operations.NewAcceptOrgInvitationOK().WithPayload(Org(membership))
+	case errors.Is(err, app.ErrNotFound):
+		return errAcceptOrgInvitation(log, err, http.StatusNotFound)
+	case errors.Is(err, app.ErrInvitationExpired):
+		return errAcceptOrgInvitation(log, err, http.StatusBadRequest)
+	case errors.Is(err, app.ErrOrgMemberExist):
+		return errAcceptOrgInvitation(log, err, http.StatusConflict)
+	default:
+		return errAcceptOrgInvitation(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) listOrgMembers(params operations.ListOrgMembersParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	page := app.Page{
+		Limit:  int(params.Limit),
+		Offset: int(swag.Int32Value(params.Offset)),
+	}
+
+	members, total, err := svc.orgApp.ListOrgMembers(ctx, *authUser, app.OrgID(params.ID), page)
+	switch {
+	case err == nil:
+		return operations.NewListOrgMembersOK().WithPayload(&operations.ListOrgMembersOKBody{
+			Members: OrgMembers(members),
+			Total:   swag.Int32(int32(total)),
+		})
+	case errors.Is(err, app.ErrNotFound):
+		return errListOrgMembers(log, err, http.StatusNotFound)
+	default:
+		return errListOrgMembers(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) removeOrgMember(params operations.RemoveOrgMemberParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	err := svc.orgApp.RemoveOrgMember(ctx, *authUser, app.OrgID(params.ID), app.PublicID(params.UserID))
+	switch {
+	case err == nil:
+		return operations.NewRemoveOrgMemberNoContent()
+	case errors.Is(err, app.ErrNotFound):
+		return errRemoveOrgMember(log, err, http.StatusNotFound)
+	case errors.Is(err, app.ErrOrgPermission):
+		return errRemoveOrgMember(log, err, http.StatusForbidden)
+	case errors.Is(err, app.ErrOrgOwner):
+		return errRemoveOrgMember(log, err, http.StatusConflict)
+	default:
+		return errRemoveOrgMember(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) inviteOrgMember(params operations.InviteOrgMemberParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	role := app.OrgRole(swag.StringValue(params.Args.Role))
+	err := svc.orgApp.InviteOrgMember(ctx, *authUser, app.OrgID(params.ID), string(params.Args.Email), role)
+	switch {
+	case err == nil:
+		return operations.NewInviteOrgMemberNoContent()
+	case errors.Is(err, app.ErrNotFound):
+		return errInviteOrgMember(log, err, http.StatusNotFound)
+	case errors.Is(err, app.ErrInvalidOrgRole):
+		return errInviteOrgMember(log, err, http.StatusBadRequest)
+	case errors.Is(err, app.ErrOrgPermission):
+		return errInviteOrgMember(log, err, http.StatusForbidden)
+	case errors.Is(err, app.ErrOrgMemberExist):
+		return errInviteOrgMember(log, err, http.StatusConflict)
+	default:
+		return errInviteOrgMember(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) transferOrgOwnership(params operations.TransferOrgOwnershipParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	err := svc.orgApp.TransferOrgOwnership(ctx, *authUser, app.OrgID(params.ID), app.PublicID(params.Args.UserID))
+	switch {
+	case err == nil:
+		return operations.NewTransferOrgOwnershipNoContent()
+	case errors.Is(err, app.ErrNotFound):
+		return errTransferOrgOwnership(log, err, http.StatusNotFound)
+	case errors.Is(err, app.ErrOrgPermission):
+		return errTransferOrgOwnership(log, err, http.StatusForbidden)
+	default:
+		return errTransferOrgOwnership(log, err, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,263 @@
+package web_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/api/web"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client/operations"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+const orgID app.OrgID = 3
+
+var org = app.Org{
+	ID:        orgID,
+	TenantID:  app.DefaultTenant,
+	Name:      "org",
+	CreatedAt: time.Now().Truncate(time.Second).UTC(),
+}
+
+func TestServiceListOrgs(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	orgs := []app.OrgMembership{{Org: org, Role: app.OrgRoleAdmin}}
+
+	testCases := []struct {
+		name    string
+		appRes  []app.OrgMembership
+		appErr  error
+		want    []*models.Org
+		wantErr *models.Error
+	}{
+		{"success", orgs, nil, web.Orgs(orgs), nil},
+		{"internal error", nil, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().ListOrgs(gomock.Any(), authUser).Return(tc.appRes, tc.appErr)
+
+			res, err := client.Operations.ListOrgs(operations.NewListOrgsParams(), apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res.Payload)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceCreateOrg(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	mockApp.EXPECT().CreateOrg(gomock.Any(), authUser, org.Name).Return(&org, nil)
+
+	params := operations.NewCreateOrgParams().WithArgs(operations.CreateOrgBody{Name: models.OrgName(org.Name)})
+	res, err := client.Operations.CreateOrg(params, apiKeyAuth)
+	assert.Nil(t, err)
+	assert.Equal(t, web.Org(&app.OrgMembership{Org: org, Role: app.OrgRoleOwner}), res.Payload)
+}
+
+func TestServiceAcceptOrgInvitation(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	const invitationToken = "invitationToken"
+	membership := &app.OrgMembership{Org: org, Role: app.OrgRoleMember}
+
+	testCases := []struct {
+		name    string
+		appRes  *app.OrgMembership
+		appErr  error
+		wantErr *models.Error
+	}{
+		{"success", membership, nil, nil},
+		{"not found", nil, app.ErrNotFound, APIError("not found")},
+		{"expired", nil, app.ErrInvitationExpired, APIError("invitation is expired")},
+		{"member exist", nil, app.ErrOrgMemberExist, APIError("organization member exist")},
+		{"any error", nil, errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().AcceptOrgInvitation(gomock.Any(), authUser, invitationToken).Return(tc.appRes, tc.appErr)
+
+			params := operations.NewAcceptOrgInvitationParams().
+				WithArgs(operations.AcceptOrgInvitationBody{Token: swag.String(invitationToken)})
+			res, err := client.Operations.AcceptOrgInvitation(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, web.Org(membership), res.Payload)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceListOrgMembers(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	members := []app.OrgMember{{
+		OrgID:        orgID,
+		UserID:       authUser.ID,
+		UserPublicID: authUser.PublicID,
+		Username:     authUser.Name,
+		Email:        authUser.Email,
+		Role:         app.OrgRoleOwner,
+		CreatedAt:    org.CreatedAt,
+	}}
+
+	testCases := []struct {
+		name    string
+		appRes  []app.OrgMember
+		appErr  error
+		want    *operations.ListOrgMembersOKBody
+		wantErr *models.Error
+	}{
+		{"success", members, nil, &operations.ListOrgMembersOKBody{
+			Members: web.OrgMembers(members),
+			Total:   swag.Int32(1),
+		}, nil},
+		{"not found", nil, app.ErrNotFound, nil, APIError("not found")},
+		{"internal error", nil, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().ListOrgMembers(gomock.Any(), authUser, orgID, app.Page{Limit: 10}).
+				Return(tc.appRes, len(tc.appRes), tc.appErr)
+
+			params := operations.NewListOrgMembersParams().WithID(int32(orgID)).WithLimit(10)
+			res, err := client.Operations.ListOrgMembers(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res.Payload)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestServiceRemoveOrgMember(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	const publicID app.PublicID = "5e2a9f1c-7b3d-4c8e-9a6f-1d0b2c3e4f5a"
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"not found", app.ErrNotFound, APIError("not found")},
+		{"permission", app.ErrOrgPermission, APIError("not enough permissions in organization")},
+		{"owner", app.ErrOrgOwner, APIError("organization owner can't be removed")},
+		{"any error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().RemoveOrgMember(gomock.Any(), authUser, orgID, publicID).Return(tc.appErr)
+
+			params := operations.NewRemoveOrgMemberParams().WithID(int32(orgID)).WithUserID(strfmt.UUID(publicID))
+			_, err := client.Operations.RemoveOrgMember(params, apiKeyAuth)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
+
+func TestServiceInviteOrgMember(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	const email = "invitee@gmail.com"
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"not found", app.ErrNotFound, APIError("not found")},
+		{"invalid role", app.ErrInvalidOrgRole, APIError("invalid organization role")},
+		{"permission", app.ErrOrgPermission, APIError("not enough permissions in organization")},
+		{"member exist", app.ErrOrgMemberExist, APIError("organization member exist")},
+		{"any error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().InviteOrgMember(gomock.Any(), authUser, orgID, email, app.OrgRoleAdmin).Return(tc.appErr)
+
+			params := operations.NewInviteOrgMemberParams().WithID(int32(orgID)).
+				WithArgs(operations.InviteOrgMemberBody{Email: email, Role: swag.String("admin")})
+			_, err := client.Operations.InviteOrgMember(params, apiKeyAuth)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
+
+func TestServiceTransferOrgOwnership(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	const publicID app.PublicID = "5e2a9f1c-7b3d-4c8e-9a6f-1d0b2c3e4f5a"
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"not found", app.ErrNotFound, APIError("not found")},
+		{"permission", app.ErrOrgPermission, APIError("not enough permissions in organization")},
+		{"any error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().TransferOrgOwnership(gomock.Any(), authUser, orgID, publicID).Return(tc.appErr)
+
+			params := operations.NewTransferOrgOwnershipParams().WithID(int32(orgID)).
+				WithArgs(operations.TransferOrgOwnershipBody{UserID: models.UserID(publicID)})
+			_, err := client.Operations.TransferOrgOwnership(params, apiKeyAuth)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi/operations"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func (svc *service) requestPhoneVerification(params operations.RequestPhoneVerificationParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	err := svc.phoneApp.RequestPhoneVerification(ctx, *authUser, string(params.Args.Phone))
+	switch {
+	case err == nil:
+		return operations.NewRequestPhoneVerificationNoContent()
+	case errors.Is(err, app.ErrInvalidPhone):
+		return errRequestPhoneVerification(log, err, http.StatusBadRequest)
+	case errors.Is(err, app.ErrPhoneExist):
+		return errRequestPhoneVerification(log, err, http.StatusConflict)
+	case errors.Is(err, app.ErrSMSDisabled):
+		return errRequestPhoneVerification(log, err, http.StatusNotImplemented)
+	default:
+		return errRequestPhoneVerification(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) verifyPhone(params operations.VerifyPhoneParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	err := svc.phoneApp.VerifyPhone(ctx, *authUser, string(params.Args.Code))
+	switch {
+	case err == nil:
+		return operations.NewVerifyPhoneNoContent()
+	case errors.Is(err, app.ErrNotFound):
+		return errVerifyPhone(log, err, http.StatusNotFound)
+	case errors.Is(err, app.ErrNotValidCode):
+		return errVerifyPhone(log, err, http.StatusBadRequest)
+	case errors.Is(err, app.ErrCodeExpired):
+		return errVerifyPhone(log, err, http.StatusBadRequest)
+	case errors.Is(err, app.ErrPhoneExist):
+		return errVerifyPhone(log, err, http.StatusConflict)
+	default:
+		return errVerifyPhone(log, err, http.StatusInternalServerError)
+	}
+}
+
+func (svc *service) deletePhone(params operations.DeletePhoneParams, authUser *app.AuthUser) middleware.Responder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
+
+	err := svc.phoneApp.DeletePhone(ctx, *authUser)
+	switch {
+	case err == nil:
+		return operations.NewDeletePhoneNoContent()
+	default:
+		return errDeletePhone(log, err, http.StatusInternalServerError)
+	}
+}
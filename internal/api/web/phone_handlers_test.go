@@ -0,0 +1,103 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client/operations"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+const phone = "+15551234567"
+
+func TestServiceRequestPhoneVerification(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"invalid phone", app.ErrInvalidPhone, APIError("invalid phone")},
+		{"phone exist", app.ErrPhoneExist, APIError("phone exist")},
+		{"sms disabled", app.ErrSMSDisabled, APIError("sms is disabled")},
+		{"any error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().RequestPhoneVerification(gomock.Any(), authUser, phone).Return(tc.appErr)
+
+			params := operations.NewRequestPhoneVerificationParams().
+				WithArgs(operations.RequestPhoneVerificationBody{Phone: phone})
+			_, err := client.Operations.RequestPhoneVerification(params, apiKeyAuth)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
+
+func TestServiceVerifyPhone(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+	const code = "123456"
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"not found", app.ErrNotFound, APIError("not found")},
+		{"not valid code", app.ErrNotValidCode, APIError("code not equal")},
+		{"code expired", app.ErrCodeExpired, APIError("code is expired")},
+		{"phone exist", app.ErrPhoneExist, APIError("phone exist")},
+		{"any error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().VerifyPhone(gomock.Any(), authUser, code).Return(tc.appErr)
+
+			params := operations.NewVerifyPhoneParams().
+				WithArgs(operations.VerifyPhoneBody{Code: code})
+			_, err := client.Operations.VerifyPhone(params, apiKeyAuth)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
+
+func TestServiceDeletePhone(t *testing.T) {
+	t.Parallel()
+
+	_, shutdown, mockApp, client := testNewServer(t)
+	defer shutdown()
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"any error", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mockApp.EXPECT().DeletePhone(gomock.Any(), authUser).Return(tc.appErr)
+
+			_, err := client.Operations.DeletePhone(operations.NewDeletePhoneParams(), apiKeyAuth)
+			assert.Equal(t, tc.want, errPayload(err))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/log"
+	"go.uber.org/zap"
+)
+
+// tenantHeader selects the tenant by name, otherwise the tenant is bound to the Host of the request.
+const tenantHeader = "X-Tenant"
+
+var errOtherTenant = errors.New("token of other tenant")
+
+type tenantKey struct{}
+
+// resolveTenant saves the tenant of the request in the context,
+// requests of unknown tenants are rejected.
+func resolveTenant(tenantApp app.TenantApp) middlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant, err := tenantApp.Tenant(r.Context(), r.Header.Get(tenantHeader), r.Host)
+			switch {
+			case errors.Is(err, app.ErrNotFound):
+				writeError(w, http.StatusNotFound, "tenant not found")
+				return
+			case err != nil:
+				log.FromContext(r.Context()).With(zap.Error(err)).Warn("resolve tenant")
+				writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), tenantKey{}, tenant.ID)))
+		})
+	}
+}
+
+// tenantID returns the tenant of the request saved by resolveTenant.
+func tenantID(ctx context.Context) app.TenantID {
+	id, _ := ctx.Value(tenantKey{}).(app.TenantID)
+	return id
+}
+
+// authorizeTenant rejects tokens of users of other tenants, admin requests aren't bound to tenants.
+// The runtime responds with 403 to any error of the authorizer.
+func authorizeTenant(r *http.Request, principal interface{}) error {
+	authUser, ok := principal.(*app.AuthUser)
+	if !ok || authUser.TenantID == 0 || authUser.TenantID == tenantID(r.Context()) {
+		return nil
+	}
+
+	return errOtherTenant
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(&models.Error{Message: swag.String(msg)})
+}
@@ -0,0 +1,60 @@
+package web_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/client"
+	"github.com/zergslaw/boilerplate/internal/api/web/generated/models"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestServiceTenant(t *testing.T) {
+	t.Parallel()
+
+	url, shutdown, mockApp, _ := testNewServer(t)
+	defer shutdown()
+
+	other := app.Tenant{ID: tenant.ID + 1, Name: "other"}
+	mockApp.EXPECT().Tenant(gomock.Any(), other.Name, gomock.Any()).Return(&other, nil).AnyTimes()
+	mockApp.EXPECT().Tenant(gomock.Any(), "unknown", gomock.Any()).Return(nil, app.ErrNotFound).AnyTimes()
+	mockApp.EXPECT().User(gomock.Any(), authUser, user.PublicID).Return(&user, nil)
+
+	testCases := []struct {
+		name       string
+		tenantName string
+		wantCode   int
+		wantErr    *models.Error
+	}{
+		{"success", "", http.StatusOK, nil},
+		{"token of other tenant", other.Name, http.StatusForbidden, APIError("token of other tenant")},
+		{"unknown tenant", "unknown", http.StatusNotFound, APIError("tenant not found")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s%s/user", url, client.DefaultBasePath), nil)
+			assert.Nil(t, err)
+			req.Header.Set("Cookie", "authKey="+sessUser)
+			if tc.tenantName != "" {
+				req.Header.Set("X-Tenant", tc.tenantName)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			assert.Nil(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.wantCode, res.StatusCode)
+			if tc.wantErr != nil {
+				apiErr := &models.Error{}
+				assert.Nil(t, json.NewDecoder(res.Body).Decode(apiErr))
+				assert.Equal(t, tc.wantErr, apiErr)
+			}
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 func (svc *service) verificationEmail(params operations.VerificationEmailParams) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
 
-	err := svc.userApp.VerificationEmail(ctx, string(params.Args.Email))
+	err := svc.userApp.VerificationEmail(ctx, tenantID(ctx), string(params.Args.Email))
 	switch {
 	case err == nil:
 		return operations.NewVerificationEmailNoContent()
@@ -28,7 +28,7 @@ func (svc *service) verificationEmail(params operations.VerificationEmailParams)
 func (svc *service) verificationUsername(params operations.VerificationUsernameParams) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
 
-	err := svc.userApp.VerificationUsername(ctx, string(params.Args.Username))
+	err := svc.userApp.VerificationUsername(ctx, tenantID(ctx), string(params.Args.Username))
 	switch {
 	case err == nil:
 		return operations.NewVerificationUsernameNoContent()
@@ -49,6 +49,7 @@ func (svc *service) createUser(params operations.CreateUserParams) middleware.Re
 
 	u, token, err := svc.userApp.CreateUser(
 		ctx,
+		tenantID(ctx),
 		string(params.Args.Email),
 		string(params.Args.Username),
 		string(params.Args.Password),
@@ -75,7 +76,7 @@ func (svc *service) login(params operations.LoginParams) middleware.Responder {
 		UserAgent: params.HTTPRequest.Header.Get("User-Agent"),
 	}
 
-	u, token, err := svc.userApp.Login(ctx, string(params.Args.Email), string(params.Args.Password), origin)
+	u, token, err := svc.userApp.Login(ctx, tenantID(ctx), string(params.Args.Email), string(params.Args.Password), origin)
 	switch {
 	case err == nil:
 		cookie := generateCookie(token)
@@ -104,15 +105,15 @@ func (svc *service) logout(params operations.LogoutParams, authUser *app.AuthUse
 func (svc *service) getUser(params operations.GetUserParams, authUser *app.AuthUser) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
 
-	getUserID := authUser.ID
+	publicID := authUser.PublicID
 	if params.ID != nil {
-		getUserID = app.UserID(*params.ID)
+		publicID = app.PublicID(*params.ID)
 	}
 
-	u, err := svc.userApp.User(ctx, *authUser, getUserID)
+	u, err := svc.userApp.User(ctx, *authUser, publicID)
 	switch {
 	case err == nil:
-		return operations.NewGetUserOK().WithPayload(User(u))
+		return operations.NewGetUserOK().WithETag(ETag(u.Version)).WithPayload(User(u))
 	case errors.Is(err, app.ErrNotFound):
 		return errGetUser(log, err, http.StatusNotFound)
 	default:
@@ -135,10 +136,17 @@ func (svc *service) deleteUser(params operations.DeleteUserParams, authUser *app
 func (svc *service) updatePassword(params operations.UpdatePasswordParams, authUser *app.AuthUser) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
 
-	err := svc.userApp.UpdatePassword(ctx, *authUser, string(params.Args.Old), string(params.Args.New))
+	version, ok := Version(params.IfMatch)
+	if !ok {
+		return errUpdatePassword(log, app.ErrVersionMismatch, http.StatusPreconditionFailed)
+	}
+
+	err := svc.userApp.UpdatePassword(ctx, *authUser, string(params.Args.Old), string(params.Args.New), version)
 	switch {
 	case err == nil:
 		return operations.NewUpdatePasswordNoContent()
+	case errors.Is(err, app.ErrVersionMismatch):
+		return errUpdatePassword(log, err, http.StatusPreconditionFailed)
 	case errors.Is(err, app.ErrNotValidPassword):
 		return errUpdatePassword(log, err, http.StatusConflict)
 	default:
@@ -149,10 +157,17 @@ func (svc *service) updatePassword(params operations.UpdatePasswordParams, authU
 func (svc *service) updateUsername(params operations.UpdateUsernameParams, authUser *app.AuthUser) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
 
-	err := svc.userApp.UpdateUsername(ctx, *authUser, string(params.Args.Username))
+	version, ok := Version(params.IfMatch)
+	if !ok {
+		return errUpdateUsername(log, app.ErrVersionMismatch, http.StatusPreconditionFailed)
+	}
+
+	err := svc.userApp.UpdateUsername(ctx, *authUser, string(params.Args.Username), version)
 	switch {
 	case err == nil:
 		return operations.NewUpdateUsernameNoContent()
+	case errors.Is(err, app.ErrVersionMismatch):
+		return errUpdateUsername(log, err, http.StatusPreconditionFailed)
 	case errors.Is(err, app.ErrUsernameExist):
 		return errUpdateUsername(log, err, http.StatusConflict)
 	case errors.Is(err, app.ErrUsernameNeedDifferentiate):
@@ -165,10 +180,17 @@ func (svc *service) updateUsername(params operations.UpdateUsernameParams, authU
 func (svc *service) updateEmail(params operations.UpdateEmailParams, authUser *app.AuthUser) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, authUser)
 
-	err := svc.userApp.UpdateEmail(ctx, *authUser, string(params.Args.Email))
+	version, ok := Version(params.IfMatch)
+	if !ok {
+		return errUpdateEmail(log, app.ErrVersionMismatch, http.StatusPreconditionFailed)
+	}
+
+	err := svc.userApp.UpdateEmail(ctx, *authUser, string(params.Args.Email), version)
 	switch {
 	case err == nil:
 		return operations.NewUpdateEmailNoContent()
+	case errors.Is(err, app.ErrVersionMismatch):
+		return errUpdateEmail(log, err, http.StatusPreconditionFailed)
 	case errors.Is(err, app.ErrEmailExist):
 		return errUpdateEmail(log, err, http.StatusConflict)
 	case errors.Is(err, app.ErrEmailNeedDifferentiate):
@@ -181,12 +203,16 @@ func (svc *service) updateEmail(params operations.UpdateEmailParams, authUser *a
 func (svc *service) createRecoveryCode(params operations.CreateRecoveryCodeParams) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
 
-	err := svc.userApp.CreateRecoveryCode(ctx, string(params.Args.Email))
+	err := svc.userApp.CreateRecoveryCode(ctx, tenantID(ctx), string(params.Args.Email), RecoveryChannel(params.Args.Channel))
 	switch {
 	case err == nil:
 		return operations.NewCreateRecoveryCodeNoContent()
 	case errors.Is(err, app.ErrNotFound):
 		return errCreateRecoveryCode(log, err, http.StatusNotFound)
+	case errors.Is(err, app.ErrPhoneNotVerified):
+		return errCreateRecoveryCode(log, err, http.StatusConflict)
+	case errors.Is(err, app.ErrSMSDisabled):
+		return errCreateRecoveryCode(log, err, http.StatusNotImplemented)
 	default:
 		return errCreateRecoveryCode(log, err, http.StatusInternalServerError)
 	}
@@ -195,7 +221,7 @@ func (svc *service) createRecoveryCode(params operations.CreateRecoveryCodeParam
 func (svc *service) recoveryPassword(params operations.RecoveryPasswordParams) middleware.Responder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
 
-	err := svc.userApp.RecoveryPassword(ctx, string(params.Args.Email), string(params.Args.RecoveryCode), string(params.Args.Password))
+	err := svc.userApp.RecoveryPassword(ctx, tenantID(ctx), string(params.Args.Email), string(params.Args.RecoveryCode), string(params.Args.Password))
 	switch {
 	case err == nil:
 		return operations.NewRecoveryPasswordNoContent()
@@ -3,6 +3,7 @@ package web_test
 import (
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +33,7 @@ func TestServiceVerificationEmail(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().VerificationEmail(gomock.Any(), tc.email).Return(tc.appErr)
+			mockApp.EXPECT().VerificationEmail(gomock.Any(), tenant.ID, tc.email).Return(tc.appErr)
 
 			params := operations.NewVerificationEmailParams().
 				WithArgs(operations.VerificationEmailBody{Email: models.Email(tc.email)})
@@ -62,7 +63,7 @@ func TestServiceVerificationUsername(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().VerificationUsername(gomock.Any(), tc.username).Return(tc.appErr)
+			mockApp.EXPECT().VerificationUsername(gomock.Any(), tenant.ID, tc.username).Return(tc.appErr)
 
 			params := operations.NewVerificationUsernameParams().
 				WithArgs(operations.VerificationUsernameBody{Username: models.Username(tc.username)})
@@ -103,7 +104,7 @@ func TestServiceCreateUser(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			mockApp.EXPECT().
-				CreateUser(gomock.Any(), tc.email, tc.username, tc.password, origin).
+				CreateUser(gomock.Any(), tenant.ID, tc.email, tc.username, tc.password, origin).
 				Return(tc.user, tc.token, tc.appErr)
 
 			params := operations.NewCreateUserParams().WithArgs(&models.CreateUserParams{
@@ -154,7 +155,7 @@ func TestServiceLogin(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			mockApp.EXPECT().
-				Login(gomock.Any(), tc.email, tc.password, origin).
+				Login(gomock.Any(), tenant.ID, tc.email, tc.password, origin).
 				Return(tc.user, tc.token, tc.appErr)
 
 			params := operations.NewLoginParams().WithArgs(&models.LoginParam{
@@ -222,13 +223,14 @@ func TestServiceGetUser(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().User(gomock.Any(), authUser, authUser.ID).Return(tc.user, tc.appErr)
+			mockApp.EXPECT().User(gomock.Any(), authUser, authUser.PublicID).Return(tc.user, tc.appErr)
 
-			params := operations.NewGetUserParams().WithID(swag.Int32(int32(user.ID)))
+			params := operations.NewGetUserParams().WithID((*strfmt.UUID)(swag.String(string(user.PublicID))))
 			res, err := client.Operations.GetUser(params, apiKeyAuth)
 			if tc.wantErr == nil {
 				assert.Nil(t, err)
 				assert.Equal(t, tc.want, res.Payload)
+				assert.Equal(t, web.ETag(tc.user.Version), res.ETag)
 			} else {
 				assert.Nil(t, res)
 				assert.Equal(t, tc.wantErr, errPayload(err))
@@ -278,13 +280,14 @@ func TestServiceUpdatePassword(t *testing.T) {
 	}{
 		{"success", password, "NewPassword", nil, nil},
 		{"not valid password", "notCorrectPass", "NewPassword", app.ErrNotValidPassword, APIError("not valid password")},
+		{"version mismatch", password, "NewPassword", app.ErrVersionMismatch, APIError("version mismatch")},
 		{"any error", password, "NewPassword", errAny, APIError("Internal Server Error")},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().UpdatePassword(gomock.Any(), authUser, tc.oldPass, tc.newPass).Return(tc.appErr)
+			mockApp.EXPECT().UpdatePassword(gomock.Any(), authUser, tc.oldPass, tc.newPass, 0).Return(tc.appErr)
 
 			params := operations.NewUpdatePasswordParams().WithArgs(&models.UpdatePassword{
 				New: models.Password(tc.newPass),
@@ -305,21 +308,30 @@ func TestServiceUpdateUsername(t *testing.T) {
 	testCases := []struct {
 		name     string
 		username string
+		ifMatch  *string
+		version  int
 		appErr   error
 		want     *models.Error
 	}{
-		{"success", username, nil, nil},
-		{"username exist", username, app.ErrUsernameExist, APIError("username exist")},
-		{"username not different", username, app.ErrUsernameNeedDifferentiate, APIError("username need to differentiate")},
-		{"any error", username, errAny, APIError("Internal Server Error")},
+		{"success", username, nil, 0, nil, nil},
+		{"success if match", username, swag.String(`"2"`), 2, nil, nil},
+		{"any version", username, swag.String("*"), 0, nil, nil},
+		{"version mismatch", username, swag.String(`"1"`), 1, app.ErrVersionMismatch, APIError("version mismatch")},
+		{"weak etag", username, swag.String(`W/"2"`), 0, nil, APIError("version mismatch")},
+		{"username exist", username, nil, 0, app.ErrUsernameExist, APIError("username exist")},
+		{"username not different", username, nil, 0, app.ErrUsernameNeedDifferentiate, APIError("username need to differentiate")},
+		{"any error", username, nil, 0, errAny, APIError("Internal Server Error")},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().UpdateUsername(gomock.Any(), authUser, tc.username).Return(tc.appErr)
+			if _, ok := web.Version(tc.ifMatch); ok {
+				mockApp.EXPECT().UpdateUsername(gomock.Any(), authUser, tc.username, tc.version).Return(tc.appErr)
+			}
 
 			params := operations.NewUpdateUsernameParams().
+				WithIfMatch(tc.ifMatch).
 				WithArgs(operations.UpdateUsernameBody{Username: models.Username(tc.username)})
 
 			_, err := client.Operations.UpdateUsername(params, apiKeyAuth)
@@ -343,13 +355,14 @@ func TestServiceUpdateEmail(t *testing.T) {
 		{"success", email, nil, nil},
 		{"email exist", email, app.ErrEmailExist, APIError("email exist")},
 		{"email not different", email, app.ErrEmailNeedDifferentiate, APIError("email need to differentiate")},
+		{"version mismatch", email, app.ErrVersionMismatch, APIError("version mismatch")},
 		{"any error", email, errAny, APIError("Internal Server Error")},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().UpdateEmail(gomock.Any(), authUser, tc.email).Return(tc.appErr)
+			mockApp.EXPECT().UpdateEmail(gomock.Any(), authUser, tc.email, 0).Return(tc.appErr)
 
 			params := operations.NewUpdateEmailParams().
 				WithArgs(operations.UpdateEmailBody{Email: models.Email(tc.email)})
@@ -415,23 +428,28 @@ func TestServiceCreateRecoveryCode(t *testing.T) {
 	defer shutdown()
 
 	testCases := []struct {
-		name   string
-		email  string
-		appErr error
-		want   *models.Error
+		name       string
+		email      string
+		channel    models.RecoveryChannel
+		appChannel app.Channel
+		appErr     error
+		want       *models.Error
 	}{
-		{"success", email, nil, nil},
-		{"not found", notExistEmail, app.ErrNotFound, APIError("not found")},
-		{"any error", email, errAny, APIError("Internal Server Error")},
+		{"success", email, "", app.ChannelEmail, nil, nil},
+		{"success sms", email, models.RecoveryChannelSms, app.ChannelSMS, nil, nil},
+		{"not found", notExistEmail, models.RecoveryChannelEmail, app.ChannelEmail, app.ErrNotFound, APIError("not found")},
+		{"phone not verified", email, models.RecoveryChannelSms, app.ChannelSMS, app.ErrPhoneNotVerified, APIError("phone is not verified")},
+		{"sms disabled", email, models.RecoveryChannelSms, app.ChannelSMS, app.ErrSMSDisabled, APIError("sms is disabled")},
+		{"any error", email, "", app.ChannelEmail, errAny, APIError("Internal Server Error")},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().CreateRecoveryCode(gomock.Any(), tc.email).Return(tc.appErr)
+			mockApp.EXPECT().CreateRecoveryCode(gomock.Any(), tenant.ID, tc.email, tc.appChannel).Return(tc.appErr)
 
 			params := operations.NewCreateRecoveryCodeParams().
-				WithArgs(operations.CreateRecoveryCodeBody{Email: models.Email(tc.email)})
+				WithArgs(operations.CreateRecoveryCodeBody{Email: models.Email(tc.email), Channel: tc.channel})
 			_, err := client.Operations.CreateRecoveryCode(params)
 			assert.Equal(t, tc.want, errPayload(err))
 		})
@@ -459,7 +477,7 @@ func TestServiceRecoveryPassword(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			mockApp.EXPECT().RecoveryPassword(gomock.Any(), email, recoveryCode, password).Return(tc.appErr)
+			mockApp.EXPECT().RecoveryPassword(gomock.Any(), tenant.ID, email, recoveryCode, password).Return(tc.appErr)
 
 			params := operations.NewRecoveryPasswordParams().
 				WithArgs(operations.RecoveryPasswordBody{
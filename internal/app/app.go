@@ -19,12 +19,44 @@ var (
 	ErrNotUnknownKindTask        = errors.New("unknown task kind")
 	ErrCodeExpired               = errors.New("code is expired")
 	ErrNotValidCode              = errors.New("code not equal")
+	ErrNotificationMandatory     = errors.New("notification is mandatory")
+	ErrUnknownEventType          = errors.New("unknown event type")
+	ErrUnknownTaskStatus         = errors.New("unknown task status")
+	ErrTaskNotPending            = errors.New("task is not pending")
+	ErrInvalidRateLimit          = errors.New("invalid rate limit")
+	ErrNotificationDuplicate     = errors.New("duplicate of the latest task")
+	ErrNotificationRateLimit     = errors.New("notification rate limit exceeded")
+	ErrUndeliverable             = errors.New("no channel can reach the recipient")
+	ErrInvalidPhone              = errors.New("invalid phone")
+	ErrPhoneExist                = errors.New("phone exist")
+	ErrPhoneNotVerified          = errors.New("phone is not verified")
+	ErrSMSDisabled               = errors.New("sms is disabled")
+	ErrUnknownChannel            = errors.New("unknown channel")
+	ErrJobExist                  = errors.New("job exist")
+	ErrUnknownJobKind            = errors.New("unknown job kind")
+	ErrInvalidJobPayload         = errors.New("invalid job payload")
+	ErrNotLeader                 = errors.New("not leader")
+	ErrVersionMismatch           = errors.New("version mismatch")
+	ErrTenantExist               = errors.New("tenant exist")
+	ErrInvalidOrgRole            = errors.New("invalid organization role")
+	ErrOrgPermission             = errors.New("not enough permissions in organization")
+	ErrOrgMemberExist            = errors.New("organization member exist")
+	ErrOrgOwner                  = errors.New("organization owner can't be removed")
+	ErrInvitationExpired         = errors.New("invitation is expired")
 )
 
 type (
 	// App implements the business logic.
 	App interface {
 		UserApp
+		NotificationSettingsApp
+		NotificationAdminApp
+		NotificationScheduleApp
+		InboxApp
+		DeliverabilityApp
+		PhoneApp
+		TenantApp
+		OrgApp
 	}
 	// Page for search in repo.
 	Page struct {
@@ -33,39 +65,121 @@ type (
 	}
 	// Application implements interface App.
 	Application struct {
-		userRepo     UserRepo
-		sessionRepo  SessionRepo
-		codeRepo     CodeRepo
-		password     Password
-		auth         Auth
-		wal          WAL
-		notification Notification
-		code         Code
+		userRepo      UserRepo
+		sessionRepo   SessionRepo
+		codeRepo      CodeRepo
+		settingsRepo  NotificationSettingsRepo
+		webhookRepo   WebhookRepo
+		eventRepo     EventRepo
+		inboxRepo     InboxRepo
+		deliverRepo   DeliverabilityRepo
+		jobRepo       JobRepo
+		purgeRepo     PurgeRepo
+		leaderRepo    LeaderRepo
+		tenantRepo    TenantRepo
+		orgRepo       OrgRepo
+		password      Password
+		auth          Auth
+		wal           WAL
+		notification  Notification
+		inbox         Notification
+		sms           Notification
+		code          Code
+		webhook       WebhookSender
+		broker        Broker
+		eventMetrics  EventMetrics
+		metrics       NotificationMetrics
+		purgeMetrics  PurgeMetrics
+		leaderMetrics LeaderMetrics
+		// authCache is optional, users of tokens are read from the repository if it is nil.
+		authCache        AuthCache
+		authCacheMetrics AuthCacheMetrics
+		periodicJobs     []PeriodicJob
+		instance         string
+		// notificationLimits contains rate limits by kinds, kinds without limit are not limited.
+		notificationLimits map[MessageKind]RateLimit
+		orgInvitationURL   string
 	}
 )
 
 // Config for build project.
+// SMS is optional, the SMS channel is disabled if it is nil.
 type Config struct {
-	UserRepo     UserRepo
-	SessionRepo  SessionRepo
-	CodeRepo     CodeRepo
-	Password     Password
-	Auth         Auth
-	Wal          WAL
-	Notification Notification
-	Code         Code
+	UserRepo      UserRepo
+	SessionRepo   SessionRepo
+	CodeRepo      CodeRepo
+	SettingsRepo  NotificationSettingsRepo
+	WebhookRepo   WebhookRepo
+	EventRepo     EventRepo
+	InboxRepo     InboxRepo
+	DeliverRepo   DeliverabilityRepo
+	JobRepo       JobRepo
+	PurgeRepo     PurgeRepo
+	LeaderRepo    LeaderRepo
+	TenantRepo    TenantRepo
+	OrgRepo       OrgRepo
+	Password      Password
+	Auth          Auth
+	Wal           WAL
+	Notification  Notification
+	Inbox         Notification
+	SMS           Notification
+	Code          Code
+	Webhook       WebhookSender
+	Broker        Broker
+	EventMetrics  EventMetrics
+	Metrics       NotificationMetrics
+	PurgeMetrics  PurgeMetrics
+	LeaderMetrics LeaderMetrics
+	// AuthCache is optional, AuthCacheMetrics is required with it.
+	AuthCache        AuthCache
+	AuthCacheMetrics AuthCacheMetrics
+	// PeriodicJobs are created by StartScheduler, e.g. purges of expired data.
+	PeriodicJobs []PeriodicJob
+	// Instance identifies the process in leader elections, e.g. hostname.
+	Instance string
+	// NotificationLimits contains per-recipient rate limits by kinds of messages.
+	NotificationLimits map[MessageKind]RateLimit
+	// OrgInvitationURL is a public address of the page accepting invitations to organizations,
+	// the token of the invitation is added to it as the token query parameter.
+	OrgInvitationURL string
 }
 
 // New creates and returns new App.
 func New(cfg Config) *Application {
 	return &Application{
-		userRepo:     cfg.UserRepo,
-		sessionRepo:  cfg.SessionRepo,
-		codeRepo:     cfg.CodeRepo,
-		password:     cfg.Password,
-		auth:         cfg.Auth,
-		wal:          cfg.Wal,
-		code:         cfg.Code,
-		notification: cfg.Notification,
+		userRepo:         cfg.UserRepo,
+		sessionRepo:      cfg.SessionRepo,
+		codeRepo:         cfg.CodeRepo,
+		settingsRepo:     cfg.SettingsRepo,
+		webhookRepo:      cfg.WebhookRepo,
+		eventRepo:        cfg.EventRepo,
+		inboxRepo:        cfg.InboxRepo,
+		deliverRepo:      cfg.DeliverRepo,
+		jobRepo:          cfg.JobRepo,
+		purgeRepo:        cfg.PurgeRepo,
+		leaderRepo:       cfg.LeaderRepo,
+		tenantRepo:       cfg.TenantRepo,
+		orgRepo:          cfg.OrgRepo,
+		password:         cfg.Password,
+		auth:             cfg.Auth,
+		wal:              cfg.Wal,
+		code:             cfg.Code,
+		notification:     cfg.Notification,
+		inbox:            cfg.Inbox,
+		sms:              cfg.SMS,
+		webhook:          cfg.Webhook,
+		broker:           cfg.Broker,
+		eventMetrics:     cfg.EventMetrics,
+		metrics:          cfg.Metrics,
+		purgeMetrics:     cfg.PurgeMetrics,
+		leaderMetrics:    cfg.LeaderMetrics,
+		authCache:        cfg.AuthCache,
+		authCacheMetrics: cfg.AuthCacheMetrics,
+		periodicJobs:     cfg.PeriodicJobs,
+		instance:         cfg.Instance,
+
+		notificationLimits: cfg.NotificationLimits,
+		orgInvitationURL:   cfg.OrgInvitationURL,
 	}
 }
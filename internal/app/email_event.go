@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+type (
+	// DeliverabilityApp implements the business logic for feedback of the email provider.
+	DeliverabilityApp interface {
+		// HandleEmailEvents records bounces and complaints, their addresses become undeliverable.
+		// Errors: unknown.
+		HandleEmailEvents(ctx context.Context, events []EmailEvent) error
+	}
+	// DeliverabilityRepo interface for saving feedback of the email provider.
+	DeliverabilityRepo interface {
+		// SaveEmailEvent records the event, it is not recorded twice for the same ExternalID.
+		// Events without ExternalID are always recorded.
+		// Errors: unknown.
+		SaveEmailEvent(ctx context.Context, event EmailEvent) error
+		// LastEmailEvent returns the latest event recorded for the address.
+		// Errors: ErrNotFound, unknown.
+		LastEmailEvent(ctx context.Context, email string) (*EmailEvent, error)
+	}
+	// EmailEvent contains information about the bounce or complaint reported by the email provider.
+	EmailEvent struct {
+		// ExternalID is the id of the event assigned by the email provider.
+		ExternalID string
+		Email      string
+		Kind       EmailEventKind
+		Reason     string
+		CreatedAt  time.Time
+	}
+	// EmailEventKind is a kind of the email provider feedback.
+	EmailEventKind string
+)
+
+// Email event kinds.
+const (
+	EmailBounce    EmailEventKind = "bounce"
+	EmailComplaint EmailEventKind = "complaint"
+)
+
+// HandleEmailEvents for implemented DeliverabilityApp.
+func (a *Application) HandleEmailEvents(ctx context.Context, events []EmailEvent) error {
+	for i := range events {
+		events[i].Email = strings.ToLower(events[i].Email)
+
+		err := a.deliverRepo.SaveEmailEvent(ctx, events[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emailUndeliverable reports whether the email provider stopped delivering mail to the address.
+func (a *Application) emailUndeliverable(ctx context.Context, email string) (bool, error) {
+	_, err := a.deliverRepo.LastEmailEvent(ctx, email)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
@@ -0,0 +1,52 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_HandleEmailEvents(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	bounce := app.EmailEvent{
+		ExternalID: "1",
+		Email:      userEmail,
+		Kind:       app.EmailBounce,
+		Reason:     "550 5.1.1 The email account that you tried to reach does not exist",
+	}
+	complaint := app.EmailEvent{
+		ExternalID: "2",
+		Email:      userEmail,
+		Kind:       app.EmailComplaint,
+	}
+
+	mocks.deliverRepo.EXPECT().SaveEmailEvent(ctx, bounce).Return(nil)
+	mocks.deliverRepo.EXPECT().SaveEmailEvent(ctx, complaint).Return(nil)
+	mocks.deliverRepo.EXPECT().SaveEmailEvent(ctx, bounce).Return(errAny)
+
+	upperBounce := bounce
+	upperBounce.Email = "Email@Email.com"
+
+	testCases := []struct {
+		name   string
+		events []app.EmailEvent
+		want   error
+	}{
+		{"success", []app.EmailEvent{upperBounce, complaint}, nil},
+		{"err any", []app.EmailEvent{bounce, complaint}, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.HandleEmailEvents(ctx, tc.events)
+			assert.True(t, errors.Is(err, tc.want))
+		})
+	}
+}
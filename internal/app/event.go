@@ -0,0 +1,125 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// EventApplication a provider to relay domain events to the broker.
+	EventApplication interface {
+		// StartEventRelay starts the task of publishing events from the outbox to the broker.
+		StartEventRelay(ctx context.Context) error
+	}
+	// EventRepo interface for the domain events outbox.
+	// Events are written by UserRepo, SessionRepo and CodeRepo in the same transaction as the mutation.
+	EventRepo interface {
+		// UnpublishedEvents returns the oldest not published events in the order they were created.
+		// Errors: unknown.
+		UnpublishedEvents(ctx context.Context, limit int) ([]Event, error)
+		// EventsPublished marks events as published.
+		// Errors: unknown.
+		EventsPublished(ctx context.Context, ids []int) error
+		// LastUserEvent returns the latest event of the type for the user.
+		// Errors: ErrNotFound, unknown.
+		LastUserEvent(ctx context.Context, userID UserID, eventType EventType) (*Event, error)
+	}
+	// Broker module for publishing domain events.
+	Broker interface {
+		// Publish sends events in the given order.
+		// The broker has accepted all events if no error is returned.
+		// Errors: unknown.
+		Publish(ctx context.Context, events []Event) error
+	}
+	// EventMetrics module for collecting statistics of the event relay.
+	EventMetrics interface {
+		// PublishFailed is called when the broker didn't accept the batch of events.
+		PublishFailed(events []Event, err error)
+	}
+	// EventType is a kind of domain event.
+	EventType string
+	// Event contains information about domain event.
+	Event struct {
+		ID     int
+		Type   EventType
+		UserID UserID
+		// UserPublicID is set by the repository, subscribers identify the user by it.
+		UserPublicID PublicID
+		Email        string
+		CreatedAt    time.Time
+	}
+)
+
+// Event types.
+const (
+	EventUserCreated         EventType = "user.created"
+	EventUserEmailChanged    EventType = "user.email_changed"
+	EventUserUsernameChanged EventType = "user.username_changed"
+	EventUserPasswordChanged EventType = "user.password_changed"
+	EventUserPhoneChanged    EventType = "user.phone_changed"
+	EventUserDeleted         EventType = "user.deleted"
+	EventSessionRevoked      EventType = "session.revoked"
+	EventRecoveryCodeCreated EventType = "recovery_code.created"
+)
+
+// EventTypes returns all known event types.
+func EventTypes() []EventType {
+	return []EventType{
+		EventUserCreated, EventUserEmailChanged, EventUserUsernameChanged, EventUserPasswordChanged,
+		EventUserPhoneChanged, EventUserDeleted, EventSessionRevoked, EventRecoveryCodeCreated,
+	}
+}
+
+// Valid reports whether the event type is known.
+func (t EventType) Valid() bool {
+	for _, eventType := range EventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EventRelayBatch is a max number of events published at once.
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var EventRelayBatch = 100
+
+// StartEventRelay for implemented EventApplication.
+// Events are published strictly in the order they were created, so the order per user is kept.
+// A batch is marked as published only after the broker accepted it, if marking fails the
+// batch is published again, so the consumers must deduplicate events by ID.
+func (a *Application) StartEventRelay(ctx context.Context) error {
+	for ctx.Err() == nil {
+		events, err := a.eventRepo.UnpublishedEvents(ctx, EventRelayBatch)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			wait(ctx)
+			continue
+		}
+
+		err = a.broker.Publish(ctx, events)
+		if err != nil {
+			a.eventMetrics.PublishFailed(events, err)
+			// Retry the same batch so the order is not broken.
+			wait(ctx)
+			continue
+		}
+
+		ids := make([]int, len(events))
+		for i := range events {
+			ids[i] = events[i].ID
+		}
+
+		err = a.eventRepo.EventsPublished(ctx, ids)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,50 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_StartEventRelay(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	events := []app.Event{
+		{ID: 1, Type: app.EventUserCreated, UserID: 1, Email: userEmail},
+		{ID: 2, Type: app.EventUserDeleted, UserID: 1, Email: userEmail},
+	}
+
+	gomock.InOrder(
+		mocks.eventRepo.EXPECT().UnpublishedEvents(gomock.Any(), app.EventRelayBatch).Return(events, nil),
+		mocks.broker.EXPECT().Publish(gomock.Any(), events).Return(errAny),
+		mocks.eventMetrics.EXPECT().PublishFailed(events, errAny),
+		// The same batch is published again after the broker error.
+		mocks.eventRepo.EXPECT().UnpublishedEvents(gomock.Any(), app.EventRelayBatch).Return(events, nil),
+		mocks.broker.EXPECT().Publish(gomock.Any(), events).Return(nil),
+		mocks.eventRepo.EXPECT().EventsPublished(gomock.Any(), []int{1, 2}).Return(errAny),
+
+		mocks.eventRepo.EXPECT().UnpublishedEvents(gomock.Any(), app.EventRelayBatch).Return(nil, errAny),
+	)
+
+	testCases := []struct {
+		name string
+		want error
+	}{
+		{"err mark published", errAny},
+		{"err get events", errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.StartEventRelay(ctx)
+			assert.True(t, errors.Is(err, tc.want))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// InboxApp implements the business logic for the in-app notification inbox.
+	InboxApp interface {
+		// UserNotifications returns notifications from the user inbox, latest first.
+		// Errors: unknown.
+		UserNotifications(ctx context.Context, authUser AuthUser, page Page) ([]UserNotification, int, error)
+		// MarkNotificationsRead marks notifications from the user inbox as read, all of them if ids is empty.
+		// Errors: unknown.
+		MarkNotificationsRead(ctx context.Context, authUser AuthUser, ids []int) error
+		// UnreadNotificationCount returns the number of unread notifications in the user inbox.
+		// Errors: unknown.
+		UnreadNotificationCount(ctx context.Context, authUser AuthUser) (int, error)
+		// WatchUserNotifications calls fn for every notification added to the user inbox
+		// after the one with afterID, or after the call if afterID is zero, in the order they were added.
+		// It returns when ctx is done or fn returns an error.
+		// Errors: unknown.
+		WatchUserNotifications(ctx context.Context, authUser AuthUser, afterID int, fn func(UserNotification) error) error
+	}
+	// InboxRepo interface for saving the user inbox.
+	InboxRepo interface {
+		// CreateUserNotification adds the message to the inbox of the user of msg.TenantID with the email.
+		// The message is not added twice for the same TaskID and is ignored if the user does not exist.
+		// Errors: unknown.
+		CreateUserNotification(ctx context.Context, email string, msg Message) error
+		// UserNotifications returns notifications of the user, latest first.
+		// Errors: unknown.
+		UserNotifications(ctx context.Context, userID UserID, page Page) ([]UserNotification, int, error)
+		// UserNotificationsAfter returns notifications of the user added after the one with afterID, oldest first.
+		// Errors: unknown.
+		UserNotificationsAfter(ctx context.Context, userID UserID, afterID int, limit int) ([]UserNotification, error)
+		// MarkUserNotificationsRead marks notifications of the user as read, all of them if ids is empty.
+		// Errors: unknown.
+		MarkUserNotificationsRead(ctx context.Context, userID UserID, ids []int) error
+		// UnreadUserNotificationCount returns the number of unread notifications of the user.
+		// Errors: unknown.
+		UnreadUserNotificationCount(ctx context.Context, userID UserID) (int, error)
+	}
+	// UserNotification contains information about the message in the user inbox.
+	UserNotification struct {
+		ID        int
+		Kind      MessageKind
+		Content   string
+		Read      bool
+		CreatedAt time.Time
+	}
+)
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var (
+	InboxPollInterval = time.Second
+	InboxWatchBatch   = 100
+)
+
+// UserNotifications for implemented InboxApp.
+func (a *Application) UserNotifications(ctx context.Context, authUser AuthUser, page Page) ([]UserNotification, int, error) {
+	return a.inboxRepo.UserNotifications(ctx, authUser.ID, page)
+}
+
+// MarkNotificationsRead for implemented InboxApp.
+func (a *Application) MarkNotificationsRead(ctx context.Context, authUser AuthUser, ids []int) error {
+	return a.inboxRepo.MarkUserNotificationsRead(ctx, authUser.ID, ids)
+}
+
+// UnreadNotificationCount for implemented InboxApp.
+func (a *Application) UnreadNotificationCount(ctx context.Context, authUser AuthUser) (int, error) {
+	return a.inboxRepo.UnreadUserNotificationCount(ctx, authUser.ID)
+}
+
+// WatchUserNotifications for implemented InboxApp.
+// The inbox is polled, so the notifications added by any instance of the service are delivered.
+func (a *Application) WatchUserNotifications(ctx context.Context, authUser AuthUser, afterID int, fn func(UserNotification) error) error {
+	if afterID == 0 {
+		latest, _, err := a.inboxRepo.UserNotifications(ctx, authUser.ID, Page{Limit: 1})
+		if err != nil {
+			return err
+		}
+		if len(latest) > 0 {
+			afterID = latest[0].ID
+		}
+	}
+
+	for ctx.Err() == nil {
+		notifications, err := a.inboxRepo.UserNotificationsAfter(ctx, authUser.ID, afterID, InboxWatchBatch)
+		if err != nil {
+			return err
+		}
+
+		for i := range notifications {
+			err = fn(notifications[i])
+			if err != nil {
+				return err
+			}
+			afterID = notifications[i].ID
+		}
+
+		if len(notifications) < InboxWatchBatch {
+			select {
+			case <-ctx.Done():
+			case <-time.After(InboxPollInterval):
+			}
+		}
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,171 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_UserNotifications(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	authUser := app.AuthUser{User: userGen(t)}
+	page := app.Page{Limit: 10}
+	notifications := []app.UserNotification{{
+		ID:        1,
+		Kind:      app.Welcome,
+		Content:   "Welcome",
+		CreatedAt: time.Now(),
+	}}
+
+	mocks.inboxRepo.EXPECT().UserNotifications(ctx, authUser.ID, page).Return(notifications, 1, nil)
+	mocks.inboxRepo.EXPECT().UserNotifications(ctx, authUser.ID, page).Return(nil, 0, errAny)
+
+	testCases := []struct {
+		name      string
+		want      []app.UserNotification
+		wantTotal int
+		wantErr   error
+	}{
+		{"success", notifications, 1, nil},
+		{"err any", nil, 0, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, total, err := application.UserNotifications(ctx, authUser, page)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, tc.wantTotal, total)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestApp_MarkNotificationsRead(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	authUser := app.AuthUser{User: userGen(t)}
+	ids := []int{1, 2}
+
+	mocks.inboxRepo.EXPECT().MarkUserNotificationsRead(ctx, authUser.ID, ids).Return(nil)
+	mocks.inboxRepo.EXPECT().MarkUserNotificationsRead(ctx, authUser.ID, ids).Return(errAny)
+
+	testCases := []struct {
+		name string
+		want error
+	}{
+		{"success", nil},
+		{"err any", errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.MarkNotificationsRead(ctx, authUser, ids)
+			assert.True(t, errors.Is(err, tc.want))
+		})
+	}
+}
+
+func TestApp_UnreadNotificationCount(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	authUser := app.AuthUser{User: userGen(t)}
+
+	mocks.inboxRepo.EXPECT().UnreadUserNotificationCount(ctx, authUser.ID).Return(3, nil)
+	mocks.inboxRepo.EXPECT().UnreadUserNotificationCount(ctx, authUser.ID).Return(0, errAny)
+
+	testCases := []struct {
+		name    string
+		want    int
+		wantErr error
+	}{
+		{"success", 3, nil},
+		{"err any", 0, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.UnreadNotificationCount(ctx, authUser)
+			assert.Equal(t, tc.want, res)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestApp_WatchUserNotifications(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	authUser := app.AuthUser{User: userGen(t)}
+	latest := app.UserNotification{ID: 1, Kind: app.Welcome}
+	first := app.UserNotification{ID: 2, Kind: app.Welcome}
+	second := app.UserNotification{ID: 3, Kind: app.ChangeEmail}
+	errStop := errors.New("stop")
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	mocks.inboxRepo.EXPECT().UserNotifications(ctx, authUser.ID, app.Page{Limit: 1}).
+		Return([]app.UserNotification{latest}, 1, nil)
+	mocks.inboxRepo.EXPECT().UserNotificationsAfter(ctx, authUser.ID, latest.ID, app.InboxWatchBatch).
+		Return([]app.UserNotification{first, second}, nil)
+	mocks.inboxRepo.EXPECT().UserNotificationsAfter(watchCtx, authUser.ID, first.ID, app.InboxWatchBatch).
+		Return([]app.UserNotification{second}, nil)
+	mocks.inboxRepo.EXPECT().UserNotificationsAfter(ctx, authUser.ID, second.ID, app.InboxWatchBatch).
+		Return(nil, errAny)
+	mocks.inboxRepo.EXPECT().UserNotifications(ctx, authUser.ID, app.Page{Limit: 1}).
+		Return(nil, 0, errAny)
+
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		afterID int
+		fn      func(app.UserNotification) error
+		want    []app.UserNotification
+		wantErr error
+	}{
+		{"err stop", ctx, 0, func(n app.UserNotification) error {
+			if n.ID == second.ID {
+				return errStop
+			}
+			return nil
+		}, []app.UserNotification{first, second}, errStop},
+		{"cancelled", watchCtx, first.ID, func(app.UserNotification) error {
+			cancel()
+			return nil
+		}, []app.UserNotification{second}, context.Canceled},
+		{"err after", ctx, second.ID, nil, nil, errAny},
+		{"err latest", ctx, 0, nil, nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var res []app.UserNotification
+			err := application.WatchUserNotifications(tc.ctx, authUser, tc.afterID, func(n app.UserNotification) error {
+				res = append(res, n)
+				return tc.fn(n)
+			})
+			assert.Equal(t, tc.want, res)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
@@ -17,16 +17,22 @@ import (
 const (
 	username  = "username"
 	userEmail = "email@email.com"
+	publicID  = "publicID"
 
 	notExistEmail    = "notExist@email.com"
 	notExistUsername = "notExistUsername"
 
 	password = "password"
 
+	tenantID app.TenantID = 2
+
 	token   app.AuthToken = "token"
 	tokenID app.TokenID   = "tokenID"
 
-	recoveryCode = "123456"
+	recoveryCode  = "123456"
+	recoveryLimit = 3
+
+	phone = "+15551234567"
 
 	ip        = "192.100.10.4"
 	userAgent = "UserAgent"
@@ -56,9 +62,12 @@ func userGenerator() func(t *testing.T) app.User {
 		xStr := strconv.Itoa(int(x))
 		return app.User{
 			ID:        x,
+			PublicID:  app.PublicID(publicID + xStr),
+			TenantID:  tenantID,
 			Email:     userEmail + xStr,
 			Name:      username + xStr,
 			PassHash:  []byte(password + xStr),
+			Version:   int(x),
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		}
@@ -104,11 +113,27 @@ type Mocks struct {
 	userRepo     *mock.MockUserRepo
 	sessionRepo  *mock.MockSessionRepo
 	codeRepo     *mock.MockCodeRepo
+	settingsRepo *mock.MockNotificationSettingsRepo
 	code         *mock.MockCode
 	password     *mock.MockPassword
 	auth         *mock.MockAuth
 	wal          *mock.MockWAL
 	notification *mock.MockNotification
+	inbox        *mock.MockNotification
+	sms          *mock.MockNotification
+	inboxRepo    *mock.MockInboxRepo
+	deliverRepo  *mock.MockDeliverabilityRepo
+	webhookRepo  *mock.MockWebhookRepo
+	webhook      *mock.MockWebhookSender
+	eventRepo    *mock.MockEventRepo
+	broker       *mock.MockBroker
+	eventMetrics *mock.MockEventMetrics
+	metrics      *mock.MockNotificationMetrics
+	jobRepo      *mock.MockJobRepo
+	purgeRepo    *mock.MockPurgeRepo
+	purgeMetrics *mock.MockPurgeMetrics
+	tenantRepo   *mock.MockTenantRepo
+	orgRepo      *mock.MockOrgRepo
 }
 
 func initTest(t *testing.T) (*app.Application, *Mocks, func()) {
@@ -118,33 +143,95 @@ func initTest(t *testing.T) (*app.Application, *Mocks, func()) {
 	mockUserRepo := mock.NewMockUserRepo(ctrl)
 	mockSessionRepo := mock.NewMockSessionRepo(ctrl)
 	mockCodeRepo := mock.NewMockCodeRepo(ctrl)
+	mockSettingsRepo := mock.NewMockNotificationSettingsRepo(ctrl)
 	mockCode := mock.NewMockCode(ctrl)
 	mockPass := mock.NewMockPassword(ctrl)
 	mockToken := mock.NewMockAuth(ctrl)
 	mockWal := mock.NewMockWAL(ctrl)
 	mockNotification := mock.NewMockNotification(ctrl)
+	mockInbox := mock.NewMockNotification(ctrl)
+	mockSMS := mock.NewMockNotification(ctrl)
+	mockInboxRepo := mock.NewMockInboxRepo(ctrl)
+	mockDeliverRepo := mock.NewMockDeliverabilityRepo(ctrl)
+	mockWebhookRepo := mock.NewMockWebhookRepo(ctrl)
+	mockWebhook := mock.NewMockWebhookSender(ctrl)
+	mockEventRepo := mock.NewMockEventRepo(ctrl)
+	mockBroker := mock.NewMockBroker(ctrl)
+	mockEventMetrics := mock.NewMockEventMetrics(ctrl)
+	mockMetrics := mock.NewMockNotificationMetrics(ctrl)
+	mockJobRepo := mock.NewMockJobRepo(ctrl)
+	mockPurgeRepo := mock.NewMockPurgeRepo(ctrl)
+	mockPurgeMetrics := mock.NewMockPurgeMetrics(ctrl)
+	mockTenantRepo := mock.NewMockTenantRepo(ctrl)
+	mockOrgRepo := mock.NewMockOrgRepo(ctrl)
 
 	appl := app.New(app.Config{
 		UserRepo:     mockUserRepo,
 		SessionRepo:  mockSessionRepo,
 		CodeRepo:     mockCodeRepo,
+		SettingsRepo: mockSettingsRepo,
+		WebhookRepo:  mockWebhookRepo,
+		EventRepo:    mockEventRepo,
+		InboxRepo:    mockInboxRepo,
+		DeliverRepo:  mockDeliverRepo,
+		JobRepo:      mockJobRepo,
+		PurgeRepo:    mockPurgeRepo,
+		TenantRepo:   mockTenantRepo,
+		OrgRepo:      mockOrgRepo,
 		Password:     mockPass,
 		Auth:         mockToken,
 		Wal:          mockWal,
 		Notification: mockNotification,
+		Inbox:        mockInbox,
+		SMS:          mockSMS,
 		Code:         mockCode,
+		Webhook:      mockWebhook,
+		Broker:       mockBroker,
+		EventMetrics: mockEventMetrics,
+		Metrics:      mockMetrics,
+		PurgeMetrics: mockPurgeMetrics,
+
+		NotificationLimits: map[app.MessageKind]app.RateLimit{
+			app.PassRecovery: {Count: recoveryLimit, Period: time.Hour},
+		},
 	})
 
 	mocks := &Mocks{
 		userRepo:     mockUserRepo,
 		sessionRepo:  mockSessionRepo,
 		codeRepo:     mockCodeRepo,
+		settingsRepo: mockSettingsRepo,
 		code:         mockCode,
 		password:     mockPass,
 		auth:         mockToken,
 		wal:          mockWal,
 		notification: mockNotification,
+		inbox:        mockInbox,
+		sms:          mockSMS,
+		inboxRepo:    mockInboxRepo,
+		deliverRepo:  mockDeliverRepo,
+		webhookRepo:  mockWebhookRepo,
+		webhook:      mockWebhook,
+		eventRepo:    mockEventRepo,
+		broker:       mockBroker,
+		eventMetrics: mockEventMetrics,
+		metrics:      mockMetrics,
+		jobRepo:      mockJobRepo,
+		purgeRepo:    mockPurgeRepo,
+		purgeMetrics: mockPurgeMetrics,
+		tenantRepo:   mockTenantRepo,
+		orgRepo:      mockOrgRepo,
 	}
 
 	return appl, mocks, ctrl.Finish
 }
+
+// cachedAuthUser returns the auth user as it's read from the auth cache, without credentials and personal data.
+// The stored user is returned by UserByID.
+func cachedAuthUser(mocks *Mocks, user app.User) app.AuthUser {
+	stored := user
+	mocks.userRepo.EXPECT().UserByID(ctx, user.ID).Return(&stored, nil).AnyTimes()
+	user.PassHash, user.Email, user.Phone = nil, "", ""
+
+	return app.AuthUser{User: user}
+}
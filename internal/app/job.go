@@ -0,0 +1,328 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type (
+	// JobApplication a provider to run background jobs.
+	JobApplication interface {
+		// StartJobs starts the worker running jobs of all registered kinds except singleton ones.
+		StartJobs(ctx context.Context) error
+		// StartSingletonJobs starts the worker running singleton jobs, e.g. purges of expired data.
+		// It must be run by the leader only, so these jobs never run concurrently.
+		StartSingletonJobs(ctx context.Context) error
+		// StartScheduler starts creating periodic jobs on activations of their schedules.
+		// It is safe to run in several processes, every activation is created once,
+		// though it is enough to run it by the leader only.
+		StartScheduler(ctx context.Context) error
+	}
+	// JobRepo interface for the queue of background jobs.
+	JobRepo interface {
+		// CreateJob adds a new pending job.
+		// The job isn't added if a pending job with the same UniqueKey exists.
+		// Errors: ErrJobExist, unknown.
+		CreateJob(ctx context.Context, job Job) (id int, err error)
+		// NextJob locks and returns the pending job of the kinds which run time has come, jobs with
+		// higher priority go first. The lock expires after the lease, so the job is run again
+		// if the worker has died. The returned Attempts include the current one.
+		// Errors: ErrNotFound, unknown.
+		NextJob(ctx context.Context, kinds []JobKind, lease time.Duration) (*Job, error)
+		// CompleteJob marks the job done.
+		// Errors: unknown.
+		CompleteJob(ctx context.Context, id int) error
+		// RetryJob unlocks the job saving the error of the run, the job is run again at runAt.
+		// Errors: unknown.
+		RetryJob(ctx context.Context, id int, jobErr error, runAt time.Time) error
+		// FailJob marks the job failed saving the error of the run, it isn't run again.
+		// Errors: unknown.
+		FailJob(ctx context.Context, id int, jobErr error) error
+	}
+	// Job contains information to run the background job.
+	Job struct {
+		ID      int
+		Kind    JobKind
+		Payload JobPayload
+		// Jobs with higher Priority run first.
+		Priority int
+		// RunAt is the earliest time to run the job, zero means as soon as possible.
+		RunAt time.Time
+		// UniqueKey prevents adding the job while a pending one with the same key exists,
+		// empty key is not checked.
+		UniqueKey string
+		// Attempts is the number of started runs.
+		Attempts int
+	}
+	// Schedule returns the next activation time later than the given time, e.g. a parsed cron expression.
+	Schedule interface {
+		Next(time.Time) time.Time
+	}
+	// PeriodicJob is the job created on every activation of the schedule.
+	PeriodicJob struct {
+		Job      Job
+		Schedule Schedule
+	}
+	// JobKind selects the handler of the job.
+	JobKind string
+	// JobPayload contains the data stored with the job and required to run it.
+	// Payloads are stored as JSON, so they must be serializable.
+	JobPayload interface{}
+	// NotificationJobPayload payload for JobNotification.
+	NotificationJobPayload struct {
+		TaskID int `json:"task_id"`
+	}
+	// WebhookDeliveryJobPayload payload for JobWebhookDelivery.
+	WebhookDeliveryJobPayload struct {
+		DeliveryID int `json:"delivery_id"`
+	}
+)
+
+// Job kinds.
+const (
+	JobNotification       JobKind = "notification"
+	JobWebhookDelivery    JobKind = "webhook_delivery"
+	JobPurgeSessions      JobKind = "purge_sessions"
+	JobPurgeRecoveryCodes JobKind = "purge_recovery_codes"
+	JobPurgeNotifications JobKind = "purge_notifications"
+	JobPurgeJobs          JobKind = "purge_jobs"
+)
+
+// Job priorities.
+const (
+	// JobPriorityLow is used for maintenance jobs, they must not delay jobs of users.
+	JobPriorityLow     = -10
+	JobPriorityDefault = 0
+	// JobPriorityHigh is used for security-critical jobs, e.g. sending recovery codes.
+	JobPriorityHigh = 10
+)
+
+type jobKindInfo struct {
+	newPayload func() JobPayload
+	run        func(a *Application, ctx context.Context, payload JobPayload) error
+	// maxAttempts is the number of runs before the job fails, zero means the job is retried until it succeeds.
+	maxAttempts int
+	// retryDelay returns the delay before the next run after the failed attempts, jobRetryDelay is used if nil.
+	retryDelay func(attempts int) time.Duration
+	// singleton jobs are run by StartSingletonJobs instead of StartJobs.
+	singleton bool
+}
+
+// Registry of known job kinds.
+// To add a new kind of job, declare the constant and the payload and register the handler here.
+// nolint:gochecknoglobals
+var jobKinds = map[JobKind]jobKindInfo{
+	JobNotification: {
+		newPayload: func() JobPayload { return &NotificationJobPayload{} },
+		run:        (*Application).runNotificationJob,
+	},
+	JobWebhookDelivery: {
+		newPayload: func() JobPayload { return &WebhookDeliveryJobPayload{} },
+		run:        (*Application).runWebhookDeliveryJob,
+		// The delivery fails by itself after WebhookMaxAttempts.
+		retryDelay: webhookRetryDelay,
+	},
+	JobPurgeSessions: {
+		newPayload: newPurgeJobPayload,
+		run:        purgeJobHandler(JobPurgeSessions, PurgeRepo.PurgeSessions),
+		singleton:  true,
+	},
+	JobPurgeRecoveryCodes: {
+		newPayload: newPurgeJobPayload,
+		run:        purgeJobHandler(JobPurgeRecoveryCodes, PurgeRepo.PurgeRecoveryCodes),
+		singleton:  true,
+	},
+	JobPurgeNotifications: {
+		newPayload: newPurgeJobPayload,
+		run:        purgeJobHandler(JobPurgeNotifications, PurgeRepo.PurgeNotifications),
+		singleton:  true,
+	},
+	JobPurgeJobs: {
+		newPayload: newPurgeJobPayload,
+		run:        purgeJobHandler(JobPurgeJobs, PurgeRepo.PurgeJobs),
+		singleton:  true,
+	},
+}
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var (
+	JobLease      = 5 * time.Minute
+	JobRetryDelay = 10 * time.Second
+)
+
+const jobMaxRetryDelay = time.Hour
+
+// JobKinds returns registered kinds of jobs run by StartJobs ordered by name.
+func JobKinds() []JobKind {
+	return jobKindsBy(false)
+}
+
+// SingletonJobKinds returns registered kinds of jobs run by StartSingletonJobs ordered by name.
+func SingletonJobKinds() []JobKind {
+	return jobKindsBy(true)
+}
+
+func jobKindsBy(singleton bool) []JobKind {
+	kinds := make([]JobKind, 0, len(jobKinds))
+	for kind, info := range jobKinds {
+		if info.singleton == singleton {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+
+	return kinds
+}
+
+// NewPayload returns a new empty payload for this kind of job.
+// Errors: ErrUnknownJobKind.
+func (k JobKind) NewPayload() (JobPayload, error) {
+	info, ok := jobKinds[k]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownJobKind, k)
+	}
+
+	return info.newPayload(), nil
+}
+
+// NewNotificationJob returns the job executing the notification task,
+// it must be created together with the task.
+func NewNotificationJob(taskID int, task TaskNotification) Job {
+	priority := JobPriorityDefault
+	if task.Kind.IsMandatory() {
+		priority = JobPriorityHigh
+	}
+
+	return Job{
+		Kind:      JobNotification,
+		Payload:   &NotificationJobPayload{TaskID: taskID},
+		Priority:  priority,
+		RunAt:     task.RunAt,
+		UniqueKey: fmt.Sprintf("%s:%d", JobNotification, taskID),
+	}
+}
+
+// NewWebhookDeliveryJob returns the job delivering the event to the webhook,
+// it must be created together with the delivery.
+func NewWebhookDeliveryJob(deliveryID int) Job {
+	return Job{
+		Kind:      JobWebhookDelivery,
+		Payload:   &WebhookDeliveryJobPayload{DeliveryID: deliveryID},
+		Priority:  JobPriorityDefault,
+		UniqueKey: fmt.Sprintf("%s:%d", JobWebhookDelivery, deliveryID),
+	}
+}
+
+// StartJobs for implemented JobApplication.
+func (a *Application) StartJobs(ctx context.Context) error {
+	return a.startJobs(ctx, JobKinds())
+}
+
+// StartSingletonJobs for implemented JobApplication.
+func (a *Application) StartSingletonJobs(ctx context.Context) error {
+	return a.startJobs(ctx, SingletonJobKinds())
+}
+
+func (a *Application) startJobs(ctx context.Context, kinds []JobKind) error {
+	for ctx.Err() == nil {
+		job, err := a.jobRepo.NextJob(ctx, kinds, JobLease)
+		switch {
+		case err == nil:
+			err := a.runJob(ctx, *job)
+			if err != nil {
+				return err
+			}
+		case errors.Is(err, ErrNotFound):
+			wait(ctx)
+		default:
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// StartScheduler for implemented JobApplication.
+func (a *Application) StartScheduler(ctx context.Context) error {
+	// The next activation is created as soon as the current one is due,
+	// so the job is waiting in the queue when its time comes.
+	due := make([]time.Time, len(a.periodicJobs))
+
+	for ctx.Err() == nil {
+		now := time.Now()
+		for i, periodic := range a.periodicJobs {
+			if now.Before(due[i]) {
+				continue
+			}
+
+			runAt := periodic.Schedule.Next(now)
+			err := a.schedule(ctx, periodic.Job, runAt)
+			if err != nil {
+				return err
+			}
+			due[i] = runAt
+		}
+
+		wait(ctx)
+	}
+
+	return ctx.Err()
+}
+
+// schedule creates the activation of the periodic job, the unique key prevents
+// creating the same activation by several schedulers.
+func (a *Application) schedule(ctx context.Context, job Job, runAt time.Time) error {
+	job.RunAt = runAt
+	job.UniqueKey = fmt.Sprintf("%s:%d", job.Kind, runAt.Unix())
+
+	_, err := a.jobRepo.CreateJob(ctx, job)
+	if errors.Is(err, ErrJobExist) {
+		return nil
+	}
+
+	return err
+}
+
+// runJob runs the handler of the job and saves the result,
+// the job is retried if the handler fails until the attempts of its kind are over.
+func (a *Application) runJob(ctx context.Context, job Job) error {
+	info, ok := jobKinds[job.Kind]
+	if !ok {
+		return a.jobRepo.FailJob(ctx, job.ID, fmt.Errorf("%w: %s", ErrUnknownJobKind, job.Kind))
+	}
+
+	err := info.run(a, ctx, job.Payload)
+	switch {
+	case err == nil:
+		return a.jobRepo.CompleteJob(ctx, job.ID)
+	case errors.Is(err, ErrInvalidJobPayload):
+		return a.jobRepo.FailJob(ctx, job.ID, err)
+	case info.maxAttempts > 0 && job.Attempts >= info.maxAttempts:
+		return a.jobRepo.FailJob(ctx, job.ID, err)
+	}
+
+	retryDelay := jobRetryDelay
+	if info.retryDelay != nil {
+		retryDelay = info.retryDelay
+	}
+
+	return a.jobRepo.RetryJob(ctx, job.ID, err, time.Now().Add(retryDelay(job.Attempts)))
+}
+
+// jobRetryDelay returns exponential delay before the next run.
+func jobRetryDelay(attempts int) time.Duration {
+	delay := JobRetryDelay
+	for i := 1; i < attempts && delay < jobMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > jobMaxRetryDelay {
+		return jobMaxRetryDelay
+	}
+
+	return delay
+}
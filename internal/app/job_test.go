@@ -0,0 +1,173 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/mock"
+)
+
+func TestJobKind_NewPayload(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		kind    app.JobKind
+		want    app.JobPayload
+		wantErr error
+	}{
+		{app.JobNotification, &app.NotificationJobPayload{}, nil},
+		{app.JobWebhookDelivery, &app.WebhookDeliveryJobPayload{}, nil},
+		{app.JobPurgeSessions, &app.PurgeJobPayload{}, nil},
+		{"export", nil, app.ErrUnknownJobKind},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.kind), func(t *testing.T) {
+			payload, err := tc.kind.NewPayload()
+			assert.Equal(t, tc.want, payload)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+
+	assert.Equal(t, []app.JobKind{app.JobNotification, app.JobWebhookDelivery}, app.JobKinds())
+	assert.Equal(t, []app.JobKind{
+		app.JobPurgeJobs,
+		app.JobPurgeNotifications,
+		app.JobPurgeRecoveryCodes,
+		app.JobPurgeSessions,
+	}, app.SingletonJobKinds())
+}
+
+func TestNewNotificationJob(t *testing.T) {
+	t.Parallel()
+
+	runAt := time.Now().Add(time.Hour)
+	testCases := []struct {
+		task app.TaskNotification
+		want app.Job
+	}{
+		{
+			app.TaskNotification{Kind: app.Welcome, RunAt: runAt},
+			app.Job{
+				Kind:      app.JobNotification,
+				Payload:   &app.NotificationJobPayload{TaskID: 1},
+				Priority:  app.JobPriorityDefault,
+				RunAt:     runAt,
+				UniqueKey: "notification:1",
+			},
+		},
+		{
+			app.TaskNotification{Kind: app.PassRecovery},
+			app.Job{
+				Kind:      app.JobNotification,
+				Payload:   &app.NotificationJobPayload{TaskID: 1},
+				Priority:  app.JobPriorityHigh,
+				UniqueKey: "notification:1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.task.Kind.String(), func(t *testing.T) {
+			assert.Equal(t, tc.want, app.NewNotificationJob(1, tc.task))
+		})
+	}
+}
+
+func TestApp_StartJobs(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	unknownJob := app.Job{ID: 1, Kind: "export", Attempts: 1}
+	job := app.NewNotificationJob(1, app.TaskNotification{Kind: app.Welcome})
+	job.ID = 2
+	job.Attempts = 3
+
+	gomock.InOrder(
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&unknownJob, nil),
+		mocks.jobRepo.EXPECT().FailJob(gomock.Any(), unknownJob.ID, errMatcher{app.ErrUnknownJobKind}).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&job, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), 1).Return(nil, errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), job.ID, errAny, timeMatcher{time.Now().Add(4 * app.JobRetryDelay)}).
+			Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&job, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), 1).Return(nil, app.ErrNotFound),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), job.ID).Return(errAny),
+	)
+
+	err := application.StartJobs(ctx)
+	assert.Equal(t, errAny, err)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = application.StartJobs(cancelledCtx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestApp_StartScheduler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockJobRepo := mock.NewMockJobRepo(ctrl)
+
+	runAt := time.Date(2020, 6, 1, 3, 0, 0, 0, time.UTC)
+	daily := scheduleFunc(func(time.Time) time.Time { return runAt })
+	sessions := app.NewPurgeJob(app.JobPurgeSessions, time.Hour, 10)
+	codes := app.NewPurgeJob(app.JobPurgeRecoveryCodes, time.Hour, 10)
+	application := app.New(app.Config{
+		JobRepo: mockJobRepo,
+		PeriodicJobs: []app.PeriodicJob{
+			{Job: sessions, Schedule: daily},
+			{Job: codes, Schedule: daily},
+		},
+	})
+
+	scheduledSessions := sessions
+	scheduledSessions.RunAt = runAt
+	scheduledSessions.UniqueKey = fmt.Sprintf("purge_sessions:%d", runAt.Unix())
+	scheduledCodes := codes
+	scheduledCodes.RunAt = runAt
+	scheduledCodes.UniqueKey = fmt.Sprintf("purge_recovery_codes:%d", runAt.Unix())
+
+	gomock.InOrder(
+		mockJobRepo.EXPECT().CreateJob(gomock.Any(), scheduledSessions).Return(0, fmt.Errorf("create: %w", app.ErrJobExist)),
+		mockJobRepo.EXPECT().CreateJob(gomock.Any(), scheduledCodes).Return(0, errAny),
+	)
+
+	err := application.StartScheduler(ctx)
+	assert.Equal(t, errAny, err)
+}
+
+type scheduleFunc func(time.Time) time.Time
+
+func (f scheduleFunc) Next(t time.Time) time.Time { return f(t) }
+
+type errMatcher struct{ target error }
+
+func (m errMatcher) Matches(x interface{}) bool {
+	err, ok := x.(error)
+	return ok && errors.Is(err, m.target)
+}
+
+func (m errMatcher) String() string { return fmt.Sprintf("is error %q", m.target) }
+
+// timeMatcher matches the time close to the expected one, it is computed at the start of the test.
+type timeMatcher struct{ want time.Time }
+
+func (m timeMatcher) Matches(x interface{}) bool {
+	t, ok := x.(time.Time)
+	return ok && !t.Before(m.want) && t.Sub(m.want) < time.Second
+}
+
+func (m timeMatcher) String() string { return fmt.Sprintf("is about %s", m.want) }
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type (
+	// LeaderApplication a provider to run workers on exactly one process.
+	LeaderApplication interface {
+		// RunAsLeader campaigns in the election and runs start while the process is the leader.
+		// The context passed to start is cancelled when the leadership is lost, after that
+		// the process campaigns again. Returns the error of start or of the election.
+		// Writes of start aren't fenced by the term, a leader paused longer than the lease
+		// (e.g. by GC or a frozen VM) may finish a write after the next one is elected,
+		// so start must keep its writes idempotent.
+		RunAsLeader(ctx context.Context, election string, start func(context.Context) error) error
+	}
+	// LeaderRepo interface for electing the single leader among processes.
+	LeaderRepo interface {
+		// AcquireLeadership makes the holder the leader of the election for ttl.
+		// A new leader isn't elected until the lease of the previous one expires,
+		// every new leader gets the greater term.
+		// Errors: ErrNotLeader, unknown.
+		AcquireLeadership(ctx context.Context, election, holder string, ttl time.Duration) (*Lease, error)
+		// RenewLeadership extends the lease for ttl.
+		// Errors: ErrNotLeader, unknown.
+		RenewLeadership(ctx context.Context, lease Lease, ttl time.Duration) error
+		// ReleaseLeadership gives up the leadership, so the next leader is elected at once.
+		// Errors: unknown.
+		ReleaseLeadership(ctx context.Context, lease Lease) error
+	}
+	// LeaderMetrics module for collecting statistics of leader elections.
+	LeaderMetrics interface {
+		// Leader is called when the process becomes the leader of the election and when it loses the leadership.
+		Leader(lease Lease, isLeader bool)
+	}
+	// Lease contains information about the leadership.
+	Lease struct {
+		Election string
+		Holder   string
+		// Term is a fencing token, it is increased on every election,
+		// so a deposed leader can't renew the lease taken over by another one.
+		Term int64
+	}
+)
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var (
+	// LeaderTTL is a time the leader keeps the leadership without renewal.
+	LeaderTTL = 15 * time.Second
+	// LeaderRenewInterval must be several times less than LeaderTTL, so the renewal is retried before the lease expires.
+	LeaderRenewInterval = 5 * time.Second
+	// LeaderRetryInterval is a delay between campaigns of the process which isn't the leader.
+	LeaderRetryInterval = 5 * time.Second
+)
+
+// RunAsLeader for implemented LeaderApplication.
+func (a *Application) RunAsLeader(ctx context.Context, election string, start func(context.Context) error) error {
+	for ctx.Err() == nil {
+		lease, err := a.leaderRepo.AcquireLeadership(ctx, election, a.instance, LeaderTTL)
+		switch {
+		case err == nil:
+			err = a.lead(ctx, *lease, start)
+			if err != nil {
+				return err
+			}
+		case errors.Is(err, ErrNotLeader):
+			sleep(ctx, LeaderRetryInterval)
+		default:
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// lead runs start renewing the lease until start returns or the leadership is lost.
+// The leader stops as soon as the lease may expire, even if the renewal isn't
+// rejected, e.g. the database is unreachable, so two leaders don't work together.
+// The renewal is waited only until the lease expires.
+// Returns nil if the leadership is lost.
+func (a *Application) lead(ctx context.Context, lease Lease, start func(context.Context) error) error {
+	expiresAt := time.Now().Add(LeaderTTL)
+	a.leaderMetrics.Leader(lease, true)
+	defer a.leaderMetrics.Leader(lease, false)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- start(leaderCtx) }()
+
+	ticker := time.NewTicker(LeaderRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			releaseErr := a.releaseLeadership(lease)
+			if err != nil {
+				return err
+			}
+
+			return releaseErr
+		case <-ticker.C:
+			renewedAt := time.Now()
+			renewCtx, cancelRenew := context.WithDeadline(ctx, expiresAt)
+			err := a.leaderRepo.RenewLeadership(renewCtx, lease, LeaderTTL)
+			cancelRenew()
+			switch {
+			case err == nil:
+				expiresAt = renewedAt.Add(LeaderTTL)
+			case errors.Is(err, ErrNotLeader):
+				cancel()
+				<-done
+				return nil
+			case !time.Now().Before(expiresAt):
+				cancel()
+				<-done
+				return a.releaseLeadership(lease)
+			}
+		}
+	}
+}
+
+// releaseLeadership gives up the leadership even if the context of the leader is done.
+func (a *Application) releaseLeadership(lease Lease) error {
+	ctx, cancel := context.WithTimeout(context.Background(), LeaderRenewInterval)
+	defer cancel()
+
+	return a.leaderRepo.ReleaseLeadership(ctx, lease)
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,137 @@
+package app_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/mock"
+)
+
+const (
+	election = "election"
+	instance = "instance"
+)
+
+// setLeaderIntervals speeds up elections, it must be called by tests which aren't parallel.
+func setLeaderIntervals(t *testing.T) {
+	t.Helper()
+
+	ttl, renew, retry := app.LeaderTTL, app.LeaderRenewInterval, app.LeaderRetryInterval
+	app.LeaderTTL, app.LeaderRenewInterval, app.LeaderRetryInterval = 250*time.Millisecond, 100*time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() {
+		app.LeaderTTL, app.LeaderRenewInterval, app.LeaderRetryInterval = ttl, renew, retry
+	})
+}
+
+func initLeaderTest(t *testing.T) (*app.Application, *mock.MockLeaderRepo, *mock.MockLeaderMetrics, func()) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockLeaderRepo := mock.NewMockLeaderRepo(ctrl)
+	mockLeaderMetrics := mock.NewMockLeaderMetrics(ctrl)
+	application := app.New(app.Config{
+		LeaderRepo:    mockLeaderRepo,
+		LeaderMetrics: mockLeaderMetrics,
+		Instance:      instance,
+	})
+
+	return application, mockLeaderRepo, mockLeaderMetrics, ctrl.Finish
+}
+
+func TestApp_RunAsLeader(t *testing.T) {
+	setLeaderIntervals(t)
+	application, mockLeaderRepo, mockLeaderMetrics, shutdown := initLeaderTest(t)
+	defer shutdown()
+
+	lease := app.Lease{Election: election, Holder: instance, Term: 1}
+	nextLease := app.Lease{Election: election, Holder: instance, Term: 2}
+
+	gomock.InOrder(
+		mockLeaderRepo.EXPECT().AcquireLeadership(gomock.Any(), election, instance, app.LeaderTTL).
+			Return(nil, fmt.Errorf("acquire: %w", app.ErrNotLeader)),
+		mockLeaderRepo.EXPECT().AcquireLeadership(gomock.Any(), election, instance, app.LeaderTTL).Return(&lease, nil),
+		mockLeaderMetrics.EXPECT().Leader(lease, true),
+		mockLeaderRepo.EXPECT().RenewLeadership(gomock.Any(), lease, app.LeaderTTL).Return(nil),
+		mockLeaderRepo.EXPECT().RenewLeadership(gomock.Any(), lease, app.LeaderTTL).Return(fmt.Errorf("renew: %w", app.ErrNotLeader)),
+		mockLeaderMetrics.EXPECT().Leader(lease, false),
+		mockLeaderRepo.EXPECT().AcquireLeadership(gomock.Any(), election, instance, app.LeaderTTL).Return(&nextLease, nil),
+		mockLeaderMetrics.EXPECT().Leader(nextLease, true),
+		mockLeaderRepo.EXPECT().ReleaseLeadership(gomock.Any(), nextLease).Return(nil),
+		mockLeaderMetrics.EXPECT().Leader(nextLease, false),
+	)
+
+	runs := 0
+	err := application.RunAsLeader(ctx, election, func(ctx context.Context) error {
+		runs++
+		if runs == 1 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+
+		return errAny
+	})
+	assert.Equal(t, errAny, err)
+	assert.Equal(t, 2, runs)
+}
+
+func TestApp_RunAsLeaderExpired(t *testing.T) {
+	setLeaderIntervals(t)
+	application, mockLeaderRepo, mockLeaderMetrics, shutdown := initLeaderTest(t)
+	defer shutdown()
+
+	lease := app.Lease{Election: election, Holder: instance, Term: 1}
+
+	gomock.InOrder(
+		mockLeaderRepo.EXPECT().AcquireLeadership(gomock.Any(), election, instance, app.LeaderTTL).Return(&lease, nil),
+		mockLeaderMetrics.EXPECT().Leader(lease, true),
+		mockLeaderRepo.EXPECT().RenewLeadership(gomock.Any(), lease, app.LeaderTTL).Return(errAny).MinTimes(2),
+		mockLeaderRepo.EXPECT().ReleaseLeadership(gomock.Any(), lease).Return(nil),
+		mockLeaderMetrics.EXPECT().Leader(lease, false),
+		mockLeaderRepo.EXPECT().AcquireLeadership(gomock.Any(), election, instance, app.LeaderTTL).Return(nil, errAny),
+	)
+
+	stopped := false
+	err := application.RunAsLeader(ctx, election, func(ctx context.Context) error {
+		<-ctx.Done()
+		stopped = true
+		return ctx.Err()
+	})
+	assert.Equal(t, errAny, err)
+	assert.True(t, stopped)
+}
+
+func TestApp_RunAsLeaderRenewHangs(t *testing.T) {
+	setLeaderIntervals(t)
+	application, mockLeaderRepo, mockLeaderMetrics, shutdown := initLeaderTest(t)
+	defer shutdown()
+
+	lease := app.Lease{Election: election, Holder: instance, Term: 1}
+
+	gomock.InOrder(
+		mockLeaderRepo.EXPECT().AcquireLeadership(gomock.Any(), election, instance, app.LeaderTTL).Return(&lease, nil),
+		mockLeaderMetrics.EXPECT().Leader(lease, true),
+		mockLeaderRepo.EXPECT().RenewLeadership(gomock.Any(), lease, app.LeaderTTL).
+			DoAndReturn(func(ctx context.Context, _ app.Lease, _ time.Duration) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}),
+		mockLeaderRepo.EXPECT().ReleaseLeadership(gomock.Any(), lease).Return(nil),
+		mockLeaderMetrics.EXPECT().Leader(lease, false),
+		mockLeaderRepo.EXPECT().AcquireLeadership(gomock.Any(), election, instance, app.LeaderTTL).Return(nil, errAny),
+	)
+
+	var stoppedAfter time.Duration
+	start := time.Now()
+	err := application.RunAsLeader(ctx, election, func(ctx context.Context) error {
+		<-ctx.Done()
+		stoppedAfter = time.Since(start)
+		return ctx.Err()
+	})
+	assert.Equal(t, errAny, err)
+	assert.Less(t, int64(stoppedAfter), int64(app.LeaderTTL+app.LeaderRenewInterval/2), "stopped when the lease expired")
+}
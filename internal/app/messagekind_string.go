@@ -11,11 +11,14 @@ func _() {
 	_ = x[Welcome-1]
 	_ = x[ChangeEmail-2]
 	_ = x[PassRecovery-3]
+	_ = x[PassRecoverySMS-4]
+	_ = x[PhoneVerification-5]
+	_ = x[OrgInvite-6]
 }
 
-const _MessageKind_name = "WelcomeChangeEmailPassRecovery"
+const _MessageKind_name = "WelcomeChangeEmailPassRecoveryPassRecoverySMSPhoneVerificationOrgInvite"
 
-var _MessageKind_index = [...]uint8{0, 7, 18, 30}
+var _MessageKind_index = [...]uint8{0, 7, 18, 30, 45, 62, 71}
 
 func (i MessageKind) String() string {
 	i -= 1
@@ -2,6 +2,9 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 )
 
@@ -13,19 +16,64 @@ type (
 		// transfer it to the Application.
 		Notification(contact string, msg Message) error
 	}
+	// ProviderRepo interface for saving which provider delivered the message.
+	ProviderRepo interface {
+		// SaveTaskNotificationProvider records the provider which delivered the message of the task.
+		// Errors: unknown.
+		SaveTaskNotificationProvider(ctx context.Context, taskID int, provider string) error
+	}
 	// Message contains sent info.
 	Message struct {
-		Kind    MessageKind
-		Content string
+		// TaskID is the id of the task sending this message, the same message is sent again
+		// with the same TaskID if the task is retried, so channels may deduplicate it.
+		TaskID int
+		// TenantID is the tenant of the recipient, contacts are unique only within the tenant.
+		TenantID TenantID
+		Kind     MessageKind
+		Content  string
+		// UnsubscribeToken is set for messages the user can opt-out of.
+		UnsubscribeToken string
 	}
 	// TaskNotification contains information to perform the task of notifying the user.
 	TaskNotification struct {
-		ID    int
-		Email string
-		Kind  MessageKind
+		ID int
+		// TenantID is the tenant of the user with the email.
+		TenantID TenantID
+		Email    string
+		Kind     MessageKind
+		Payload  MessagePayload
+		// RunAt is the earliest time to execute the task, zero means as soon as possible.
+		RunAt time.Time
 	}
 	// MessageKind selects the type of message to be sent.
 	MessageKind int
+	// Channel selects the way the message is delivered to the user.
+	Channel int
+	// MessagePayload contains the data stored with the task and required to build the message.
+	// Payloads are stored as JSON, so they must be serializable.
+	MessagePayload interface {
+		// Content returns message text.
+		Content() string
+	}
+	// WelcomePayload payload for Welcome message.
+	WelcomePayload struct{}
+	// ChangeEmailPayload payload for ChangeEmail message.
+	ChangeEmailPayload struct{}
+	// PassRecoveryPayload payload for PassRecovery message.
+	PassRecoveryPayload struct {
+		Code string `json:"code"`
+	}
+	// PhoneVerificationPayload payload for PhoneVerification message.
+	PhoneVerificationPayload struct {
+		Code string `json:"code"`
+		// Phone isn't verified yet, so the message is sent to it instead of the user's phone.
+		Phone string `json:"phone"`
+	}
+	// OrgInvitePayload payload for OrgInvite message.
+	OrgInvitePayload struct {
+		Org  string `json:"org"`
+		Link string `json:"link"`
+	}
 )
 
 // Message enums.
@@ -33,8 +81,134 @@ const (
 	Welcome MessageKind = iota + 1
 	ChangeEmail
 	PassRecovery
+	PassRecoverySMS
+	PhoneVerification
+	OrgInvite
 )
 
+// Channel enums.
+const (
+	ChannelEmail Channel = iota + 1
+	ChannelInbox
+	ChannelSMS
+)
+
+type messageKindInfo struct {
+	newPayload func() MessagePayload
+	channels   []Channel
+	// Mandatory messages are security-critical, the user can't opt-out of them.
+	mandatory bool
+	// Messages for guests are sent to emails without users too, such emails have no preferences.
+	guests bool
+}
+
+// Registry of known message kinds.
+// To add a new kind of message, declare the constant, re-run stringer and register it here.
+// nolint:gochecknoglobals
+var messageKinds = map[MessageKind]messageKindInfo{
+	Welcome: {
+		newPayload: func() MessagePayload { return &WelcomePayload{} },
+		channels:   []Channel{ChannelInbox, ChannelEmail},
+	},
+	ChangeEmail: {
+		newPayload: func() MessagePayload { return &ChangeEmailPayload{} },
+		channels:   []Channel{ChannelInbox, ChannelEmail, ChannelSMS},
+		mandatory:  true,
+	},
+	PassRecovery: {
+		newPayload: func() MessagePayload { return &PassRecoveryPayload{} },
+		// The code is needed by the user who can't sign in, so it isn't shown in the inbox.
+		channels:  []Channel{ChannelEmail},
+		mandatory: true,
+	},
+	PassRecoverySMS: {
+		newPayload: func() MessagePayload { return &PassRecoveryPayload{} },
+		channels:   []Channel{ChannelSMS},
+		mandatory:  true,
+	},
+	PhoneVerification: {
+		newPayload: func() MessagePayload { return &PhoneVerificationPayload{} },
+		channels:   []Channel{ChannelSMS},
+		mandatory:  true,
+	},
+	OrgInvite: {
+		newPayload: func() MessagePayload { return &OrgInvitePayload{} },
+		channels:   []Channel{ChannelInbox, ChannelEmail},
+		guests:     true,
+	},
+}
+
+// MessageKinds returns all registered message kinds ordered by value.
+func MessageKinds() []MessageKind {
+	kinds := make([]MessageKind, 0, len(messageKinds))
+	for kind := range messageKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+
+	return kinds
+}
+
+// ParseMessageKind returns registered MessageKind by its name.
+// Errors: ErrNotUnknownKindTask.
+func ParseMessageKind(name string) (MessageKind, error) {
+	for kind := range messageKinds {
+		if kind.String() == name {
+			return kind, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrNotUnknownKindTask, name)
+}
+
+// NewPayload returns a new empty payload for this kind of message.
+// Errors: ErrNotUnknownKindTask.
+func (k MessageKind) NewPayload() (MessagePayload, error) {
+	info, ok := messageKinds[k]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotUnknownKindTask, k)
+	}
+
+	return info.newPayload(), nil
+}
+
+// Channels returns the channels the message is sent through, in the order of sending.
+func (k MessageKind) Channels() []Channel {
+	return messageKinds[k].channels
+}
+
+// IsMandatory reports whether the user can't opt-out of this kind of message.
+func (k MessageKind) IsMandatory() bool {
+	return messageKinds[k].mandatory
+}
+
+// forGuests reports whether this kind of message is sent to emails without users.
+func (k MessageKind) forGuests() bool {
+	return messageKinds[k].guests
+}
+
+const (
+	welcomeMsg     = `Welcome`
+	changeEmailMsg = `Change email successful`
+)
+
+// Content for implemented MessagePayload.
+func (*WelcomePayload) Content() string { return welcomeMsg }
+
+// Content for implemented MessagePayload.
+func (*ChangeEmailPayload) Content() string { return changeEmailMsg }
+
+// Content for implemented MessagePayload.
+func (p *PassRecoveryPayload) Content() string { return p.Code }
+
+// Content for implemented MessagePayload.
+func (p *PhoneVerificationPayload) Content() string { return p.Code }
+
+// Content for implemented MessagePayload.
+func (p *OrgInvitePayload) Content() string {
+	return fmt.Sprintf("You are invited to %s: %s", p.Org, p.Link)
+}
+
 func wait(ctx context.Context) {
 	const timeDelay = time.Second
 
@@ -44,41 +218,151 @@ func wait(ctx context.Context) {
 	}
 }
 
-func (a *Application) execNotification(ctx context.Context, task TaskNotification) (err error) {
-	switch task.Kind {
-	case Welcome:
-		err = a.sendNotification(Welcome, task.Email, welcomeMsg)
-	case ChangeEmail:
-		err = a.sendNotification(ChangeEmail, task.Email, changeEmailMsg)
-	case PassRecovery:
-		err = a.sendRecoveryCode(ctx, task.Email)
-	default:
-		err = ErrNotUnknownKindTask
+func (a *Application) execNotification(ctx context.Context, task TaskNotification) error {
+	payload, err := task.Kind.NewPayload()
+	if err != nil {
+		return err
 	}
+	if task.Payload != nil {
+		payload = task.Payload
+	}
+
+	suppressed, err := a.suppressNotification(ctx, task)
+	if err != nil || suppressed {
+		return err
+	}
+
+	recipients, err := a.recipients(ctx, task, payload)
 	if err != nil {
 		return err
 	}
+	if len(recipients) == 0 {
+		err = a.wal.SuppressTaskNotification(ctx, task.ID, ErrUndeliverable)
+		if err != nil {
+			return err
+		}
+		a.metrics.NotificationSuppressed(task.Kind, SuppressUndeliverable)
+
+		return nil
+	}
+
+	msg := Message{
+		TaskID:   task.ID,
+		TenantID: task.TenantID,
+		Kind:     task.Kind,
+		Content:  payload.Content(),
+	}
+
+	if !task.Kind.IsMandatory() {
+		user, err := a.userRepo.UserByEmail(ctx, task.TenantID, task.Email)
+		switch {
+		case errors.Is(err, ErrNotFound) && task.Kind.forGuests():
+		case errors.Is(err, ErrNotFound):
+			return a.wal.DeleteTaskNotification(ctx, task.ID)
+		case err != nil:
+			return err
+		default:
+			enabled, err := a.notificationEnabled(ctx, user.ID, task.Kind)
+			if err != nil {
+				return err
+			}
+			if !enabled {
+				return a.wal.DeleteTaskNotification(ctx, task.ID)
+			}
+
+			msg.UnsubscribeToken, err = a.auth.UnsubscribeToken(user.PublicID, task.Kind)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// The whole task is retried if any channel fails, so the inbox goes first
+	// as it deduplicates messages by TaskID and email and SMS do not.
+	for _, r := range recipients {
+		err = a.channel(r.channel).Notification(r.contact, msg)
+		if err != nil {
+			errSave := a.wal.SaveTaskNotificationError(ctx, task.ID, err)
+			if errSave != nil {
+				return fmt.Errorf("%w: save task error: %s", err, errSave)
+			}
+
+			return err
+		}
+	}
 
 	return a.wal.DeleteTaskNotification(ctx, task.ID)
 }
 
-const (
-	welcomeMsg     = `Welcome`
-	changeEmailMsg = `Change email successful`
-)
+// recipient is the contact the message is sent to through the channel.
+type recipient struct {
+	channel Channel
+	contact string
+}
 
-func (a *Application) sendRecoveryCode(ctx context.Context, contact string) error {
-	info, err := a.codeRepo.Code(ctx, contact)
-	if err != nil {
-		return err
+// recipients returns contacts of the task recipient for channels of the task kind, the email is skipped
+// if it's undeliverable and SMS is skipped if it's disabled or the recipient has no phone.
+func (a *Application) recipients(ctx context.Context, task TaskNotification, payload MessagePayload) ([]recipient, error) {
+	recipients := make([]recipient, 0, len(task.Kind.Channels()))
+	for _, channel := range task.Kind.Channels() {
+		contact := task.Email
+
+		switch channel {
+		case ChannelEmail:
+			undeliverable, err := a.emailUndeliverable(ctx, task.Email)
+			if err != nil {
+				return nil, err
+			}
+			if undeliverable {
+				continue
+			}
+		case ChannelSMS:
+			if a.sms == nil {
+				continue
+			}
+
+			phone, err := a.recipientPhone(ctx, task, payload)
+			if err != nil {
+				return nil, err
+			}
+			if phone == "" {
+				continue
+			}
+			contact = phone
+		}
+
+		recipients = append(recipients, recipient{channel: channel, contact: contact})
 	}
 
-	return a.sendNotification(PassRecovery, contact, info.Code)
+	return recipients, nil
 }
 
-func (a *Application) sendNotification(kind MessageKind, contact, content string) error {
-	return a.notification.Notification(contact, Message{
-		Kind:    kind,
-		Content: content,
-	})
+// recipientPhone returns the phone to send SMS to, it's empty if the user has no verified phone.
+func (a *Application) recipientPhone(ctx context.Context, task TaskNotification, payload MessagePayload) (string, error) {
+	if p, ok := payload.(*PhoneVerificationPayload); ok {
+		return p.Phone, nil
+	}
+
+	user, err := a.userRepo.UserByEmail(ctx, task.TenantID, task.Email)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+
+	return user.Phone, nil
+}
+
+func (a *Application) channel(channel Channel) Notification {
+	switch channel {
+	case ChannelEmail:
+		return a.notification
+	case ChannelInbox:
+		return a.inbox
+	case ChannelSMS:
+		return a.sms
+	default:
+		panic(fmt.Sprintf("unknown channel %d", channel))
+	}
 }
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// NotificationAdminApp implements the business logic for inspecting and replaying notification tasks.
+	NotificationAdminApp interface {
+		// ListNotificationTasks returns tasks matching the filter, latest first.
+		// Errors: unknown.
+		ListNotificationTasks(ctx context.Context, filter TaskFilter, page Page) ([]TaskNotificationInfo, int, error)
+		// ResendNotificationTask creates a new pending task with the same recipient, kind and payload.
+		// The new task is executed as soon as possible even if the original one was scheduled.
+		// Errors: ErrNotFound, unknown.
+		ResendNotificationTask(ctx context.Context, id int) (*TaskNotificationInfo, error)
+		// CancelNotificationTask cancels the pending task.
+		// Errors: ErrNotFound, ErrTaskNotPending, unknown.
+		CancelNotificationTask(ctx context.Context, id int) error
+	}
+)
+
+// ListNotificationTasks for implemented NotificationAdminApp.
+func (a *Application) ListNotificationTasks(ctx context.Context, filter TaskFilter, page Page) ([]TaskNotificationInfo, int, error) {
+	return a.wal.ListTaskNotification(ctx, filter, page)
+}
+
+// ResendNotificationTask for implemented NotificationAdminApp.
+func (a *Application) ResendNotificationTask(ctx context.Context, id int) (*TaskNotificationInfo, error) {
+	task, err := a.wal.TaskNotificationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	newTask := task.TaskNotification
+	newTask.RunAt = time.Time{}
+	newTask.ID, err = a.wal.CreateTaskNotification(ctx, newTask)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.wal.TaskNotificationByID(ctx, newTask.ID)
+}
+
+// CancelNotificationTask for implemented NotificationAdminApp.
+func (a *Application) CancelNotificationTask(ctx context.Context, id int) error {
+	task, err := a.wal.TaskNotificationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if task.Status != TaskPending {
+		return ErrTaskNotPending
+	}
+
+	return a.wal.CancelTaskNotification(ctx, id)
+}
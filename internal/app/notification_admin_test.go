@@ -0,0 +1,141 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_ListNotificationTasks(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	filter := app.TaskFilter{Email: userEmail, Kind: app.PassRecovery, Status: app.TaskPending}
+	page := app.Page{Limit: 10}
+	tasks := []app.TaskNotificationInfo{{
+		TaskNotification: app.TaskNotification{
+			ID:      1,
+			Email:   userEmail,
+			Kind:    app.PassRecovery,
+			Payload: &app.PassRecoveryPayload{Code: recoveryCode},
+		},
+		Status:    app.TaskPending,
+		Error:     errAny.Error(),
+		CreatedAt: time.Now(),
+	}}
+
+	mocks.wal.EXPECT().ListTaskNotification(ctx, filter, page).Return(tasks, 1, nil)
+	mocks.wal.EXPECT().ListTaskNotification(ctx, filter, page).Return(nil, 0, errAny)
+
+	testCases := []struct {
+		name      string
+		want      []app.TaskNotificationInfo
+		wantTotal int
+		wantErr   error
+	}{
+		{"success", tasks, 1, nil},
+		{"err any", nil, 0, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, total, err := application.ListNotificationTasks(ctx, filter, page)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, tc.wantTotal, total)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestApp_ResendNotificationTask(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	task := app.TaskNotificationInfo{
+		TaskNotification: app.TaskNotification{
+			ID:      1,
+			Email:   userEmail,
+			Kind:    app.PassRecovery,
+			Payload: &app.PassRecoveryPayload{Code: recoveryCode},
+		},
+		Status: app.TaskDone,
+	}
+	newTask := task
+	newTask.ID = 2
+	newTask.Status = app.TaskPending
+
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, task.ID).Return(&task, nil).Times(2)
+	mocks.wal.EXPECT().CreateTaskNotification(ctx, task.TaskNotification).Return(newTask.ID, nil)
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, newTask.ID).Return(&newTask, nil)
+	mocks.wal.EXPECT().CreateTaskNotification(ctx, task.TaskNotification).Return(0, errAny)
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, 3).Return(nil, app.ErrNotFound)
+
+	testCases := []struct {
+		name    string
+		id      int
+		want    *app.TaskNotificationInfo
+		wantErr error
+	}{
+		{"success", task.ID, &newTask, nil},
+		{"err create", task.ID, nil, errAny},
+		{"not found", 3, nil, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.ResendNotificationTask(ctx, tc.id)
+			assert.Equal(t, tc.want, res)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestApp_CancelNotificationTask(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	pending := app.TaskNotificationInfo{
+		TaskNotification: app.TaskNotification{ID: 1, Email: userEmail, Kind: app.Welcome},
+		Status:           app.TaskPending,
+	}
+	done := app.TaskNotificationInfo{
+		TaskNotification: app.TaskNotification{ID: 2, Email: userEmail, Kind: app.Welcome},
+		Status:           app.TaskDone,
+	}
+
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, pending.ID).Return(&pending, nil).Times(2)
+	mocks.wal.EXPECT().CancelTaskNotification(ctx, pending.ID).Return(nil)
+	mocks.wal.EXPECT().CancelTaskNotification(ctx, pending.ID).Return(app.ErrNotFound)
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, done.ID).Return(&done, nil)
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, 3).Return(nil, app.ErrNotFound)
+
+	testCases := []struct {
+		name string
+		id   int
+		want error
+	}{
+		{"success", pending.ID, nil},
+		{"executed concurrently", pending.ID, app.ErrNotFound},
+		{"not pending", done.ID, app.ErrTaskNotPending},
+		{"not found", 3, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.CancelNotificationTask(ctx, tc.id)
+			assert.True(t, errors.Is(err, tc.want))
+		})
+	}
+}
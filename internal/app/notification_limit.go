@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// NotificationMetrics module for collecting statistics of the notification pipeline.
+	NotificationMetrics interface {
+		// NotificationSuppressed counts the task that was not sent for the reason.
+		NotificationSuppressed(kind MessageKind, reason SuppressReason)
+	}
+	// SuppressReason explains why the task was not sent.
+	SuppressReason string
+	// RateLimit is the max number of messages of the kind sent to the recipient within the period.
+	RateLimit struct {
+		Count  int
+		Period time.Duration
+	}
+)
+
+// Suppress reasons.
+const (
+	SuppressDuplicate     SuppressReason = "duplicate"
+	SuppressRateLimit     SuppressReason = "rate_limit"
+	SuppressUndeliverable SuppressReason = "undeliverable"
+)
+
+// ParseRateLimit returns RateLimit by its text form count/period, e.g. 3/1h.
+// Errors: ErrInvalidRateLimit.
+func ParseRateLimit(s string) (RateLimit, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return RateLimit{}, fmt.Errorf("%w: %s", ErrInvalidRateLimit, s)
+	}
+
+	count, err := strconv.Atoi(parts[0])
+	if err != nil || count <= 0 {
+		return RateLimit{}, fmt.Errorf("%w: %s", ErrInvalidRateLimit, s)
+	}
+
+	period, err := time.ParseDuration(parts[1])
+	if err != nil || period <= 0 {
+		return RateLimit{}, fmt.Errorf("%w: %s", ErrInvalidRateLimit, s)
+	}
+
+	return RateLimit{Count: count, Period: period}, nil
+}
+
+// suppressNotification cancels the task instead of sending if a newer task of the same kind
+// for the same recipient is pending or the recipient has exceeded the rate limit for this kind.
+func (a *Application) suppressNotification(ctx context.Context, task TaskNotification) (bool, error) {
+	collapsed, err := a.wal.CollapseTaskNotifications(ctx, task)
+	if err != nil {
+		return false, err
+	}
+
+	suppressed := false
+	for _, id := range collapsed {
+		a.metrics.NotificationSuppressed(task.Kind, SuppressDuplicate)
+		if id == task.ID {
+			suppressed = true
+		}
+	}
+	if suppressed {
+		return true, nil
+	}
+
+	limit, ok := a.notificationLimits[task.Kind]
+	if !ok {
+		return false, nil
+	}
+
+	sent, err := a.wal.ExecutedTaskNotificationCount(ctx, task.TenantID, task.Email, task.Kind, time.Now().Add(-limit.Period))
+	if err != nil {
+		return false, err
+	}
+	if sent < limit.Count {
+		return false, nil
+	}
+
+	err = a.wal.SuppressTaskNotification(ctx, task.ID, ErrNotificationRateLimit)
+	if err != nil {
+		return false, err
+	}
+	a.metrics.NotificationSuppressed(task.Kind, SuppressRateLimit)
+
+	return true, nil
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type (
+	// NotificationScheduleApp implements the business logic for delayed notifications, e.g. reminders.
+	NotificationScheduleApp interface {
+		// ScheduleNotification creates a task executed not earlier than task.RunAt.
+		// Errors: ErrNotUnknownKindTask, unknown.
+		ScheduleNotification(ctx context.Context, task TaskNotification) (*TaskNotificationInfo, error)
+		// ScheduleUserNotification schedules the notification for the user with the delay
+		// since the latest user event of the type, e.g. 24 hours after registration.
+		// If the time has already come, the task is executed as soon as possible.
+		// Errors: ErrNotFound, ErrUnknownEventType, ErrNotUnknownKindTask, unknown.
+		ScheduleUserNotification(ctx context.Context, userID UserID, kind MessageKind, payload MessagePayload, since EventType, delay time.Duration) (*TaskNotificationInfo, error)
+		// CancelUserNotifications cancels all pending tasks of the kind for the user,
+		// e.g. a reminder that is no longer relevant. Returns the number of cancelled tasks.
+		// Errors: ErrNotFound, unknown.
+		CancelUserNotifications(ctx context.Context, userID UserID, kind MessageKind) (int, error)
+	}
+)
+
+// ScheduleNotification for implemented NotificationScheduleApp.
+func (a *Application) ScheduleNotification(ctx context.Context, task TaskNotification) (*TaskNotificationInfo, error) {
+	payload, err := task.Kind.NewPayload()
+	if err != nil {
+		return nil, err
+	}
+	if task.Payload == nil {
+		task.Payload = payload
+	}
+
+	task.ID, err = a.wal.CreateTaskNotification(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.wal.TaskNotificationByID(ctx, task.ID)
+}
+
+// ScheduleUserNotification for implemented NotificationScheduleApp.
+func (a *Application) ScheduleUserNotification(ctx context.Context, userID UserID, kind MessageKind, payload MessagePayload, since EventType, delay time.Duration) (*TaskNotificationInfo, error) {
+	if !since.Valid() {
+		return nil, ErrUnknownEventType
+	}
+
+	user, err := a.userRepo.UserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventTime time.Time
+	event, err := a.eventRepo.LastUserEvent(ctx, userID, since)
+	switch {
+	case err == nil:
+		eventTime = event.CreatedAt
+	// Users registered before the events outbox have no such event.
+	case errors.Is(err, ErrNotFound) && since == EventUserCreated:
+		eventTime = user.CreatedAt
+	default:
+		return nil, err
+	}
+
+	return a.ScheduleNotification(ctx, TaskNotification{
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     kind,
+		Payload:  payload,
+		RunAt:    eventTime.Add(delay),
+	})
+}
+
+// CancelUserNotifications for implemented NotificationScheduleApp.
+func (a *Application) CancelUserNotifications(ctx context.Context, userID UserID, kind MessageKind) (int, error) {
+	user, err := a.userRepo.UserByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.wal.CancelTaskNotifications(ctx, user.TenantID, user.Email, kind)
+}
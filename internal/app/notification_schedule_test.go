@@ -0,0 +1,164 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_ScheduleNotification(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	runAt := time.Now().Add(time.Hour)
+	task := app.TaskNotification{
+		TenantID: tenantID,
+		Email:    userEmail,
+		Kind:     app.Welcome,
+		RunAt:    runAt,
+	}
+	taskWithPayload := task
+	taskWithPayload.Payload = &app.WelcomePayload{}
+	info := app.TaskNotificationInfo{
+		TaskNotification: taskWithPayload,
+		Status:           app.TaskPending,
+	}
+	info.ID = 1
+
+	mocks.wal.EXPECT().CreateTaskNotification(ctx, taskWithPayload).Return(info.ID, nil)
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, info.ID).Return(&info, nil)
+	mocks.wal.EXPECT().CreateTaskNotification(ctx, taskWithPayload).Return(0, errAny)
+
+	testCases := []struct {
+		name    string
+		task    app.TaskNotification
+		want    *app.TaskNotificationInfo
+		wantErr error
+	}{
+		{"success", task, &info, nil},
+		{"err any", task, nil, errAny},
+		{"err unknown kind", app.TaskNotification{Email: userEmail}, nil, app.ErrNotUnknownKindTask},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.ScheduleNotification(ctx, tc.task)
+			assert.Equal(t, tc.want, res)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestApp_ScheduleUserNotification(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const delay = 24 * time.Hour
+	user := userGen(t)
+	legacyUser := userGen(t)
+	event := app.Event{
+		ID:        1,
+		Type:      app.EventUserEmailChanged,
+		UserID:    user.ID,
+		Email:     user.Email,
+		CreatedAt: time.Now(),
+	}
+	afterEvent := app.TaskNotification{
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.ChangeEmail,
+		Payload:  &app.ChangeEmailPayload{},
+		RunAt:    event.CreatedAt.Add(delay),
+	}
+	afterRegistration := app.TaskNotification{
+		TenantID: legacyUser.TenantID,
+		Email:    legacyUser.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+		RunAt:    legacyUser.CreatedAt.Add(delay),
+	}
+	info := app.TaskNotificationInfo{TaskNotification: afterEvent, Status: app.TaskPending}
+	info.ID = 1
+	legacyInfo := app.TaskNotificationInfo{TaskNotification: afterRegistration, Status: app.TaskPending}
+	legacyInfo.ID = 2
+
+	mocks.userRepo.EXPECT().UserByID(ctx, user.ID).Return(&user, nil).Times(3)
+	mocks.eventRepo.EXPECT().LastUserEvent(ctx, user.ID, app.EventUserEmailChanged).Return(&event, nil)
+	mocks.wal.EXPECT().CreateTaskNotification(ctx, afterEvent).Return(info.ID, nil)
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, info.ID).Return(&info, nil)
+	mocks.userRepo.EXPECT().UserByID(ctx, legacyUser.ID).Return(&legacyUser, nil)
+	mocks.eventRepo.EXPECT().LastUserEvent(ctx, legacyUser.ID, app.EventUserCreated).Return(nil, app.ErrNotFound)
+	mocks.wal.EXPECT().CreateTaskNotification(ctx, afterRegistration).Return(legacyInfo.ID, nil)
+	mocks.wal.EXPECT().TaskNotificationByID(ctx, legacyInfo.ID).Return(&legacyInfo, nil)
+	mocks.eventRepo.EXPECT().LastUserEvent(ctx, user.ID, app.EventUserDeleted).Return(nil, app.ErrNotFound)
+	mocks.eventRepo.EXPECT().LastUserEvent(ctx, user.ID, app.EventUserCreated).Return(nil, errAny)
+	mocks.userRepo.EXPECT().UserByID(ctx, app.UserID(0)).Return(nil, app.ErrNotFound)
+
+	testCases := []struct {
+		name    string
+		userID  app.UserID
+		kind    app.MessageKind
+		payload app.MessagePayload
+		since   app.EventType
+		want    *app.TaskNotificationInfo
+		wantErr error
+	}{
+		{"success", user.ID, app.ChangeEmail, nil, app.EventUserEmailChanged, &info, nil},
+		{"success without event", legacyUser.ID, app.Welcome, &app.WelcomePayload{}, app.EventUserCreated, &legacyInfo, nil},
+		{"err event not found", user.ID, app.Welcome, nil, app.EventUserDeleted, nil, app.ErrNotFound},
+		{"err any", user.ID, app.Welcome, nil, app.EventUserCreated, nil, errAny},
+		{"err user not found", 0, app.Welcome, nil, app.EventUserCreated, nil, app.ErrNotFound},
+		{"err unknown event", user.ID, app.Welcome, nil, app.EventType("unknown"), nil, app.ErrUnknownEventType},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.ScheduleUserNotification(ctx, tc.userID, tc.kind, tc.payload, tc.since, delay)
+			assert.Equal(t, tc.want, res)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestApp_CancelUserNotifications(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+
+	mocks.userRepo.EXPECT().UserByID(ctx, user.ID).Return(&user, nil).Times(2)
+	mocks.wal.EXPECT().CancelTaskNotifications(ctx, user.TenantID, user.Email, app.Welcome).Return(2, nil)
+	mocks.wal.EXPECT().CancelTaskNotifications(ctx, user.TenantID, user.Email, app.Welcome).Return(0, errAny)
+	mocks.userRepo.EXPECT().UserByID(ctx, app.UserID(0)).Return(nil, app.ErrNotFound)
+
+	testCases := []struct {
+		name    string
+		userID  app.UserID
+		want    int
+		wantErr error
+	}{
+		{"success", user.ID, 2, nil},
+		{"err any", user.ID, 0, errAny},
+		{"err not found", 0, 0, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.CancelUserNotifications(ctx, tc.userID, app.Welcome)
+			assert.Equal(t, tc.want, res)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
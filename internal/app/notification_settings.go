@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"errors"
+)
+
+type (
+	// NotificationSettingsApp implements the business logic for managing notification preferences.
+	NotificationSettingsApp interface {
+		// NotificationSettings returns user preferences for every known kind of message.
+		// Errors: unknown.
+		NotificationSettings(context.Context, AuthUser) ([]NotificationSetting, error)
+		// UpdateNotificationSetting subscribes or unsubscribes the user from the kind of message.
+		// Errors: ErrNotUnknownKindTask, ErrNotificationMandatory, unknown.
+		UpdateNotificationSetting(context.Context, AuthUser, NotificationSetting) error
+		// UnsubscribeKind returns the kind of message the one-click unsubscribe token unsubscribes from,
+		// the preference isn't changed.
+		// Errors: ErrInvalidToken, ErrNotUnknownKindTask, ErrNotificationMandatory.
+		UnsubscribeKind(ctx context.Context, token string) (MessageKind, error)
+		// Unsubscribe unsubscribes the user from the kind of message by one-click unsubscribe token.
+		// Errors: ErrInvalidToken, ErrNotUnknownKindTask, ErrNotificationMandatory, unknown.
+		Unsubscribe(ctx context.Context, token string) error
+	}
+	// NotificationSettingsRepo interface for notification preferences repository.
+	NotificationSettingsRepo interface {
+		// NotificationSettings returns saved user preferences.
+		// Kinds of message the user did not change are not returned.
+		// Errors: unknown.
+		NotificationSettings(context.Context, UserID) ([]NotificationSetting, error)
+		// SaveNotificationSetting creates or replaces user preference for the kind of message.
+		// Errors: unknown.
+		SaveNotificationSetting(context.Context, UserID, NotificationSetting) error
+	}
+	// NotificationSetting contains user preference for the kind of message.
+	NotificationSetting struct {
+		Kind    MessageKind
+		Enabled bool
+	}
+)
+
+// NotificationSettings for implemented NotificationSettingsApp.
+func (a *Application) NotificationSettings(ctx context.Context, authUser AuthUser) ([]NotificationSetting, error) {
+	saved, err := a.settingsRepo.NotificationSettings(ctx, authUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[MessageKind]bool, len(saved))
+	for i := range saved {
+		enabled[saved[i].Kind] = saved[i].Enabled
+	}
+
+	kinds := MessageKinds()
+	settings := make([]NotificationSetting, len(kinds))
+	for i, kind := range kinds {
+		isEnabled, ok := enabled[kind]
+		settings[i] = NotificationSetting{
+			Kind:    kind,
+			Enabled: !ok || isEnabled || kind.IsMandatory(),
+		}
+	}
+
+	return settings, nil
+}
+
+// UpdateNotificationSetting for implemented NotificationSettingsApp.
+func (a *Application) UpdateNotificationSetting(ctx context.Context, authUser AuthUser, setting NotificationSetting) error {
+	return a.saveNotificationSetting(ctx, authUser.ID, setting)
+}
+
+// UnsubscribeKind for implemented NotificationSettingsApp.
+func (a *Application) UnsubscribeKind(_ context.Context, token string) (MessageKind, error) {
+	_, kind, err := a.auth.ParseUnsubscribeToken(token)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = kind.NewPayload()
+	if err != nil {
+		return 0, err
+	}
+
+	if kind.IsMandatory() {
+		return 0, ErrNotificationMandatory
+	}
+
+	return kind, nil
+}
+
+// Unsubscribe for implemented NotificationSettingsApp.
+func (a *Application) Unsubscribe(ctx context.Context, token string) error {
+	publicID, kind, err := a.auth.ParseUnsubscribeToken(token)
+	if err != nil {
+		return err
+	}
+
+	// Links of deleted users aren't valid anymore.
+	user, err := a.userRepo.UserByPublicID(ctx, publicID)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidToken
+	}
+	if err != nil {
+		return err
+	}
+
+	return a.saveNotificationSetting(ctx, user.ID, NotificationSetting{Kind: kind})
+}
+
+func (a *Application) saveNotificationSetting(ctx context.Context, userID UserID, setting NotificationSetting) error {
+	_, err := setting.Kind.NewPayload()
+	if err != nil {
+		return err
+	}
+
+	if !setting.Enabled && setting.Kind.IsMandatory() {
+		return ErrNotificationMandatory
+	}
+
+	return a.settingsRepo.SaveNotificationSetting(ctx, userID, setting)
+}
+
+func (a *Application) notificationEnabled(ctx context.Context, userID UserID, kind MessageKind) (bool, error) {
+	if kind.IsMandatory() {
+		return true, nil
+	}
+
+	settings, err := a.settingsRepo.NotificationSettings(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := range settings {
+		if settings[i].Kind == kind {
+			return settings[i].Enabled, nil
+		}
+	}
+
+	return true, nil
+}
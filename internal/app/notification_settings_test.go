@@ -0,0 +1,163 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_NotificationSettings(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	authUser := app.AuthUser{User: user}
+	saved := []app.NotificationSetting{{Kind: app.Welcome, Enabled: false}}
+	expected := []app.NotificationSetting{
+		{Kind: app.Welcome, Enabled: false},
+		{Kind: app.ChangeEmail, Enabled: true},
+		{Kind: app.PassRecovery, Enabled: true},
+		{Kind: app.PassRecoverySMS, Enabled: true},
+		{Kind: app.PhoneVerification, Enabled: true},
+		{Kind: app.OrgInvite, Enabled: true},
+	}
+
+	mocks.settingsRepo.EXPECT().NotificationSettings(ctx, user.ID).Return(saved, nil)
+	mocks.settingsRepo.EXPECT().NotificationSettings(ctx, user.ID).Return(nil, errAny)
+
+	testCases := []struct {
+		name    string
+		want    []app.NotificationSetting
+		wantErr error
+	}{
+		{"success", expected, nil},
+		{"any error", nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.NotificationSettings(ctx, authUser)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestApp_UpdateNotificationSetting(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	authUser := app.AuthUser{User: user}
+	optOut := app.NotificationSetting{Kind: app.Welcome, Enabled: false}
+
+	mocks.settingsRepo.EXPECT().SaveNotificationSetting(ctx, user.ID, optOut).Return(nil)
+
+	testCases := map[string]struct {
+		setting app.NotificationSetting
+		want    error
+	}{
+		"success":      {optOut, nil},
+		"mandatory":    {app.NotificationSetting{Kind: app.PassRecovery}, app.ErrNotificationMandatory},
+		"unknown kind": {app.NotificationSetting{Kind: app.MessageKind(0)}, app.ErrNotUnknownKindTask},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			err := application.UpdateNotificationSetting(ctx, authUser, tc.setting)
+			assert.True(t, errors.Is(err, tc.want))
+		})
+	}
+}
+
+func TestApp_UnsubscribeKind(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const (
+		validToken     = "validToken"
+		mandatoryToken = "mandatoryToken"
+		notValidToken  = "notValidToken"
+	)
+	user := userGen(t)
+
+	mocks.auth.EXPECT().ParseUnsubscribeToken(validToken).Return(user.PublicID, app.Welcome, nil)
+	mocks.auth.EXPECT().ParseUnsubscribeToken(mandatoryToken).Return(user.PublicID, app.PassRecovery, nil)
+	mocks.auth.EXPECT().ParseUnsubscribeToken(notValidToken).Return(app.PublicID(""), app.MessageKind(0), app.ErrInvalidToken)
+
+	testCases := map[string]struct {
+		token   string
+		want    app.MessageKind
+		wantErr error
+	}{
+		"success":   {validToken, app.Welcome, nil},
+		"mandatory": {mandatoryToken, 0, app.ErrNotificationMandatory},
+		"not valid": {notValidToken, 0, app.ErrInvalidToken},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			res, err := application.UnsubscribeKind(ctx, tc.token)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestApp_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const (
+		validToken       = "validToken"
+		mandatoryToken   = "mandatoryToken"
+		notValidToken    = "notValidToken"
+		deletedUserToken = "deletedUserToken"
+		errUserToken     = "errUserToken"
+	)
+	user := userGen(t)
+	const deletedPublicID app.PublicID = "deleted"
+	const errPublicID app.PublicID = "err"
+
+	mocks.auth.EXPECT().ParseUnsubscribeToken(validToken).Return(user.PublicID, app.Welcome, nil)
+	mocks.auth.EXPECT().ParseUnsubscribeToken(mandatoryToken).Return(user.PublicID, app.PassRecovery, nil)
+	mocks.userRepo.EXPECT().UserByPublicID(ctx, user.PublicID).Return(&user, nil).Times(2)
+	mocks.settingsRepo.EXPECT().SaveNotificationSetting(ctx, user.ID, app.NotificationSetting{Kind: app.Welcome}).Return(nil)
+	mocks.auth.EXPECT().ParseUnsubscribeToken(notValidToken).Return(app.PublicID(""), app.MessageKind(0), app.ErrInvalidToken)
+	mocks.auth.EXPECT().ParseUnsubscribeToken(deletedUserToken).Return(deletedPublicID, app.Welcome, nil)
+	mocks.userRepo.EXPECT().UserByPublicID(ctx, deletedPublicID).Return(nil, app.ErrNotFound)
+	mocks.auth.EXPECT().ParseUnsubscribeToken(errUserToken).Return(errPublicID, app.Welcome, nil)
+	mocks.userRepo.EXPECT().UserByPublicID(ctx, errPublicID).Return(nil, errAny)
+
+	testCases := map[string]struct {
+		token string
+		want  error
+	}{
+		"success":      {validToken, nil},
+		"mandatory":    {mandatoryToken, app.ErrNotificationMandatory},
+		"not valid":    {notValidToken, app.ErrInvalidToken},
+		"deleted user": {deletedUserToken, app.ErrInvalidToken},
+		"err user":     {errUserToken, errAny},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			err := application.Unsubscribe(ctx, tc.token)
+			assert.Equal(t, tc.want, err)
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type (
+	// OrgApp implements the business logic for organizations of users.
+	// Every organization has one owner, admins and members, users join it by invitations.
+	OrgApp interface {
+		// CreateOrg creates the organization owned by the user.
+		// Errors: unknown.
+		CreateOrg(ctx context.Context, authUser AuthUser, name string) (*Org, error)
+		// ListOrgs returns organizations of the user with the role of the user.
+		// Errors: unknown.
+		ListOrgs(ctx context.Context, authUser AuthUser) ([]OrgMembership, error)
+		// InviteOrgMember sends the invitation with the accept link to the email, the previous invitation
+		// of the email is replaced. The email may have no user yet, the invitation is accepted after signing up,
+		// so the response doesn't reveal registered emails. Admins invite members, the owner invites admins too.
+		// Errors: ErrNotFound, ErrInvalidOrgRole, ErrOrgPermission, ErrOrgMemberExist, unknown.
+		InviteOrgMember(ctx context.Context, authUser AuthUser, orgID OrgID, email string, role OrgRole) error
+		// AcceptOrgInvitation adds the user to the organization of the invitation sent to the user's email.
+		// Errors: ErrNotFound, ErrInvitationExpired, ErrOrgMemberExist, unknown.
+		AcceptOrgInvitation(ctx context.Context, authUser AuthUser, token string) (*OrgMembership, error)
+		// ListOrgMembers returns members of the organization, the user must be a member.
+		// Errors: ErrNotFound, unknown.
+		ListOrgMembers(ctx context.Context, authUser AuthUser, orgID OrgID, page Page) ([]OrgMember, int, error)
+		// RemoveOrgMember removes the member, users may leave organizations by removing themselves.
+		// The owner removes anyone, admins remove members, the owner can't leave without transferring ownership.
+		// Errors: ErrNotFound, ErrOrgPermission, ErrOrgOwner, unknown.
+		RemoveOrgMember(ctx context.Context, authUser AuthUser, orgID OrgID, publicID PublicID) error
+		// TransferOrgOwnership makes the member the owner, the previous owner becomes an admin.
+		// Errors: ErrNotFound, ErrOrgPermission, unknown.
+		TransferOrgOwnership(ctx context.Context, authUser AuthUser, orgID OrgID, publicID PublicID) error
+		// OrgMember returns the membership of the user, services check access to organizations by it.
+		// Errors: ErrNotFound, unknown.
+		OrgMember(ctx context.Context, orgID OrgID, publicID PublicID) (*OrgMember, error)
+	}
+	// OrgRepo interface for organizations repository.
+	OrgRepo interface {
+		// CreateOrg adds a new organization with the owner.
+		// Errors: unknown.
+		CreateOrg(ctx context.Context, org Org, ownerID UserID) (OrgID, error)
+		// OrgByID returns the organization by id.
+		// Errors: ErrNotFound, unknown.
+		OrgByID(context.Context, OrgID) (*Org, error)
+		// UserOrgs returns organizations of the user ordered by id.
+		// Errors: unknown.
+		UserOrgs(context.Context, UserID) ([]OrgMembership, error)
+		// OrgMember returns the member of the organization.
+		// Errors: ErrNotFound, unknown.
+		OrgMember(context.Context, OrgID, UserID) (*OrgMember, error)
+		// OrgMembers returns members of the organization in order of joining.
+		// Errors: unknown.
+		OrgMembers(context.Context, OrgID, Page) ([]OrgMember, int, error)
+		// DeleteOrgMember removes the member.
+		// Errors: ErrNotFound, unknown.
+		DeleteOrgMember(context.Context, OrgID, UserID) error
+		// TransferOrgOwnership makes the member the owner and the owner an admin.
+		// Errors: ErrNotFound, unknown.
+		TransferOrgOwnership(ctx context.Context, orgID OrgID, ownerID, userID UserID) error
+		// CreateOrgInvitation replaces invitations of the email to the organization
+		// and creates the task for sending the invitation.
+		// Errors: unknown.
+		CreateOrgInvitation(context.Context, OrgInvitation, TaskNotification) error
+		// OrgInvitationByToken returns the invitation by token.
+		// Errors: ErrNotFound, unknown.
+		OrgInvitationByToken(ctx context.Context, token string) (*OrgInvitation, error)
+		// AcceptOrgInvitation adds the user with role of the invitation and removes the invitation.
+		// Errors: ErrOrgMemberExist, unknown.
+		AcceptOrgInvitation(ctx context.Context, invitationID int, userID UserID) error
+	}
+	// OrgID contains organization id.
+	OrgID int
+	// OrgRole is a role of the member in the organization.
+	OrgRole string
+	// Org contains information about organization.
+	Org struct {
+		ID OrgID
+		// TenantID is the tenant of the owner, only users of the tenant can join the organization.
+		TenantID  TenantID
+		Name      string
+		CreatedAt time.Time
+	}
+	// OrgMembership contains the organization of the user with the role of the user.
+	OrgMembership struct {
+		Org  Org
+		Role OrgRole
+	}
+	// OrgMember contains information about the member of the organization.
+	OrgMember struct {
+		OrgID        OrgID
+		UserID       UserID
+		UserPublicID PublicID
+		Username     string
+		Email        string
+		Role         OrgRole
+		CreatedAt    time.Time
+	}
+	// OrgInvitation contains information about the invitation to the organization.
+	OrgInvitation struct {
+		ID        int
+		OrgID     OrgID
+		Email     string
+		Role      OrgRole
+		Token     string
+		InvitedBy UserID
+		CreatedAt time.Time
+	}
+)
+
+// Organization roles.
+const (
+	OrgRoleOwner  OrgRole = "owner"
+	OrgRoleAdmin  OrgRole = "admin"
+	OrgRoleMember OrgRole = "member"
+)
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var (
+	OrgInvitationLifetime = 7 * 24 * time.Hour
+)
+
+const orgInvitationTokenLength = 32
+
+// Valid reports whether the role is known.
+func (r OrgRole) Valid() bool {
+	switch r {
+	case OrgRoleOwner, OrgRoleAdmin, OrgRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// canManage reports whether the member with the role invites and removes members with the other role.
+func (r OrgRole) canManage(other OrgRole) bool {
+	switch r {
+	case OrgRoleOwner:
+		return other != OrgRoleOwner
+	case OrgRoleAdmin:
+		return other == OrgRoleMember
+	default:
+		return false
+	}
+}
+
+// CreateOrg for implemented OrgApp.
+func (a *Application) CreateOrg(ctx context.Context, authUser AuthUser, name string) (*Org, error) {
+	org := Org{
+		TenantID: authUser.TenantID,
+		Name:     name,
+	}
+
+	id, err := a.orgRepo.CreateOrg(ctx, org, authUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.orgRepo.OrgByID(ctx, id)
+}
+
+// ListOrgs for implemented OrgApp.
+func (a *Application) ListOrgs(ctx context.Context, authUser AuthUser) ([]OrgMembership, error) {
+	return a.orgRepo.UserOrgs(ctx, authUser.ID)
+}
+
+// InviteOrgMember for implemented OrgApp.
+func (a *Application) InviteOrgMember(ctx context.Context, authUser AuthUser, orgID OrgID, email string, role OrgRole) error {
+	if !role.Valid() || role == OrgRoleOwner {
+		return ErrInvalidOrgRole
+	}
+	email = strings.ToLower(email)
+
+	inviter, err := a.orgRepo.OrgMember(ctx, orgID, authUser.ID)
+	if err != nil {
+		return err
+	}
+	if !inviter.Role.canManage(role) {
+		return ErrOrgPermission
+	}
+
+	org, err := a.orgRepo.OrgByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	user, err := a.userRepo.UserByEmail(ctx, org.TenantID, email)
+	switch {
+	case err == nil:
+		email = user.Email
+
+		_, err = a.orgRepo.OrgMember(ctx, orgID, user.ID)
+		switch {
+		case err == nil:
+			return ErrOrgMemberExist
+		case !errors.Is(err, ErrNotFound):
+			return err
+		}
+	case !errors.Is(err, ErrNotFound):
+		return err
+	}
+
+	token, err := newOrgInvitationToken()
+	if err != nil {
+		return err
+	}
+
+	invitation := OrgInvitation{
+		OrgID:     orgID,
+		Email:     email,
+		Role:      role,
+		Token:     token,
+		InvitedBy: authUser.ID,
+	}
+	task := TaskNotification{
+		TenantID: org.TenantID,
+		Email:    email,
+		Kind:     OrgInvite,
+		Payload: &OrgInvitePayload{
+			Org:  org.Name,
+			Link: a.orgInvitationLink(token),
+		},
+	}
+
+	return a.orgRepo.CreateOrgInvitation(ctx, invitation, task)
+}
+
+func newOrgInvitationToken() (string, error) {
+	b := make([]byte, orgInvitationTokenLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// orgInvitationLink returns the accept link, it is the token itself if the address of the page isn't set.
+func (a *Application) orgInvitationLink(token string) string {
+	if a.orgInvitationURL == "" {
+		return token
+	}
+
+	return a.orgInvitationURL + "?" + url.Values{"token": {token}}.Encode()
+}
+
+// AcceptOrgInvitation for implemented OrgApp.
+func (a *Application) AcceptOrgInvitation(ctx context.Context, authUser AuthUser, token string) (*OrgMembership, error) {
+	invitation, err := a.orgRepo.OrgInvitationByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := a.orgRepo.OrgByID(ctx, invitation.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.userRepo.UserByID(ctx, authUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The invitation of other user is reported as unknown.
+	if org.TenantID != user.TenantID || invitation.Email != user.Email {
+		return nil, ErrNotFound
+	}
+
+	if time.Since(invitation.CreatedAt) > OrgInvitationLifetime {
+		return nil, ErrInvitationExpired
+	}
+
+	err = a.orgRepo.AcceptOrgInvitation(ctx, invitation.ID, authUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrgMembership{
+		Org:  *org,
+		Role: invitation.Role,
+	}, nil
+}
+
+// ListOrgMembers for implemented OrgApp.
+func (a *Application) ListOrgMembers(ctx context.Context, authUser AuthUser, orgID OrgID, page Page) ([]OrgMember, int, error) {
+	_, err := a.orgRepo.OrgMember(ctx, orgID, authUser.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return a.orgRepo.OrgMembers(ctx, orgID, page)
+}
+
+// RemoveOrgMember for implemented OrgApp.
+func (a *Application) RemoveOrgMember(ctx context.Context, authUser AuthUser, orgID OrgID, publicID PublicID) error {
+	remover, err := a.orgRepo.OrgMember(ctx, orgID, authUser.ID)
+	if err != nil {
+		return err
+	}
+
+	member := remover
+	if publicID != authUser.PublicID {
+		user, err := a.tenantUser(ctx, authUser.TenantID, publicID)
+		if err != nil {
+			return err
+		}
+
+		member, err = a.orgRepo.OrgMember(ctx, orgID, user.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case member.Role == OrgRoleOwner:
+		return ErrOrgOwner
+	case member != remover && !remover.Role.canManage(member.Role):
+		return ErrOrgPermission
+	}
+
+	return a.orgRepo.DeleteOrgMember(ctx, orgID, member.UserID)
+}
+
+// TransferOrgOwnership for implemented OrgApp.
+func (a *Application) TransferOrgOwnership(ctx context.Context, authUser AuthUser, orgID OrgID, publicID PublicID) error {
+	owner, err := a.orgRepo.OrgMember(ctx, orgID, authUser.ID)
+	if err != nil {
+		return err
+	}
+	if owner.Role != OrgRoleOwner {
+		return ErrOrgPermission
+	}
+	if publicID == authUser.PublicID {
+		return nil
+	}
+
+	user, err := a.tenantUser(ctx, authUser.TenantID, publicID)
+	if err != nil {
+		return err
+	}
+
+	return a.orgRepo.TransferOrgOwnership(ctx, orgID, authUser.ID, user.ID)
+}
+
+// OrgMember for implemented OrgApp.
+func (a *Application) OrgMember(ctx context.Context, orgID OrgID, publicID PublicID) (*OrgMember, error) {
+	user, err := a.userRepo.UserByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.orgRepo.OrgMember(ctx, orgID, user.ID)
+}
@@ -0,0 +1,249 @@
+package app_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+const orgID app.OrgID = 1
+
+func TestApp_InviteOrgMember(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	owner, admin, invitee, member := userGen(t), userGen(t), userGen(t), userGen(t)
+	org := &app.Org{ID: orgID, TenantID: tenantID, Name: "org"}
+
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, owner.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: owner.ID, Role: app.OrgRoleOwner}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, admin.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: admin.ID, Role: app.OrgRoleAdmin}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, invitee.ID).Return(nil, app.ErrNotFound).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, member.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: member.ID, Role: app.OrgRoleMember}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgByID(ctx, orgID).Return(org, nil).AnyTimes()
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, invitee.Email).Return(&invitee, nil).AnyTimes()
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, member.Email).Return(&member, nil).AnyTimes()
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, strings.ToLower(notExistEmail)).Return(nil, app.ErrNotFound).AnyTimes()
+
+	gomock.InOrder(
+		mocks.orgRepo.EXPECT().CreateOrgInvitation(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, invitation app.OrgInvitation, task app.TaskNotification) error {
+				assert.Equal(t, app.OrgInvitation{
+					OrgID:     orgID,
+					Email:     invitee.Email,
+					Role:      app.OrgRoleAdmin,
+					Token:     invitation.Token,
+					InvitedBy: owner.ID,
+				}, invitation)
+				assert.NotEmpty(t, invitation.Token)
+				assert.Equal(t, app.TaskNotification{
+					TenantID: tenantID,
+					Email:    invitee.Email,
+					Kind:     app.OrgInvite,
+					Payload:  &app.OrgInvitePayload{Org: org.Name, Link: invitation.Token},
+				}, task)
+
+				return nil
+			}),
+		mocks.orgRepo.EXPECT().CreateOrgInvitation(ctx, gomock.Any(), gomock.Any()).Return(errAny),
+		mocks.orgRepo.EXPECT().CreateOrgInvitation(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, invitation app.OrgInvitation, task app.TaskNotification) error {
+				assert.Equal(t, strings.ToLower(notExistEmail), invitation.Email)
+				assert.Equal(t, strings.ToLower(notExistEmail), task.Email)
+
+				return nil
+			}),
+	)
+
+	testCases := []struct {
+		name    string
+		inviter app.User
+		email   string
+		role    app.OrgRole
+		want    error
+	}{
+		{"success", owner, invitee.Email, app.OrgRoleAdmin, nil},
+		{"any error", admin, invitee.Email, app.OrgRoleMember, errAny},
+		{"invalid role", owner, invitee.Email, "root", app.ErrInvalidOrgRole},
+		{"owner role", owner, invitee.Email, app.OrgRoleOwner, app.ErrInvalidOrgRole},
+		{"admin invites admin", admin, invitee.Email, app.OrgRoleAdmin, app.ErrOrgPermission},
+		{"member invites", member, invitee.Email, app.OrgRoleMember, app.ErrOrgPermission},
+		{"not member invites", invitee, member.Email, app.OrgRoleMember, app.ErrNotFound},
+		{"not registered", owner, notExistEmail, app.OrgRoleMember, nil},
+		{"already member", owner, member.Email, app.OrgRoleMember, app.ErrOrgMemberExist},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.InviteOrgMember(ctx, app.AuthUser{User: tc.inviter}, orgID, tc.email, tc.role)
+			assert.Equal(t, tc.want, err)
+		})
+	}
+}
+
+func TestApp_AcceptOrgInvitation(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const invitationToken = "invitationToken"
+	user, other := userGen(t), userGen(t)
+	org := &app.Org{ID: orgID, TenantID: tenantID, Name: "org"}
+	invitation := &app.OrgInvitation{
+		ID:        1,
+		OrgID:     orgID,
+		Email:     user.Email,
+		Role:      app.OrgRoleMember,
+		Token:     invitationToken,
+		CreatedAt: time.Now(),
+	}
+	expiredInvitation := *invitation
+	expiredInvitation.CreatedAt = time.Now().Add(-app.OrgInvitationLifetime - time.Minute)
+
+	gomock.InOrder(
+		mocks.orgRepo.EXPECT().OrgInvitationByToken(ctx, invitationToken).Return(invitation, nil),
+		mocks.orgRepo.EXPECT().OrgByID(ctx, orgID).Return(org, nil),
+		mocks.orgRepo.EXPECT().AcceptOrgInvitation(ctx, invitation.ID, user.ID).Return(nil),
+		mocks.orgRepo.EXPECT().OrgInvitationByToken(ctx, invitationToken).Return(invitation, nil),
+		mocks.orgRepo.EXPECT().OrgByID(ctx, orgID).Return(org, nil),
+		mocks.orgRepo.EXPECT().AcceptOrgInvitation(ctx, invitation.ID, user.ID).Return(app.ErrOrgMemberExist),
+		mocks.orgRepo.EXPECT().OrgInvitationByToken(ctx, invitationToken).Return(invitation, nil),
+		mocks.orgRepo.EXPECT().OrgByID(ctx, orgID).Return(org, nil),
+		mocks.orgRepo.EXPECT().OrgInvitationByToken(ctx, invitationToken).Return(&expiredInvitation, nil),
+		mocks.orgRepo.EXPECT().OrgByID(ctx, orgID).Return(org, nil),
+		mocks.orgRepo.EXPECT().OrgInvitationByToken(ctx, invitationToken).Return(nil, app.ErrNotFound),
+	)
+
+	authUser, otherAuthUser := cachedAuthUser(mocks, user), cachedAuthUser(mocks, other)
+
+	testCases := []struct {
+		name     string
+		authUser app.AuthUser
+		want     *app.OrgMembership
+		wantErr  error
+	}{
+		{"success", authUser, &app.OrgMembership{Org: *org, Role: app.OrgRoleMember}, nil},
+		{"already member", authUser, nil, app.ErrOrgMemberExist},
+		{"other user", otherAuthUser, nil, app.ErrNotFound},
+		{"expired", authUser, nil, app.ErrInvitationExpired},
+		{"not found", authUser, nil, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.AcceptOrgInvitation(ctx, tc.authUser, invitationToken)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestApp_RemoveOrgMember(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	owner, admin, member, stranger := userGen(t), userGen(t), userGen(t), userGen(t)
+
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, owner.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: owner.ID, Role: app.OrgRoleOwner}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, admin.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: admin.ID, Role: app.OrgRoleAdmin}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, member.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: member.ID, Role: app.OrgRoleMember}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, stranger.ID).Return(nil, app.ErrNotFound).AnyTimes()
+	expectUsersByPublicID(mocks, owner, admin, member, stranger)
+	mocks.userRepo.EXPECT().UserByPublicID(ctx, app.PublicID("unknown")).Return(nil, app.ErrNotFound)
+
+	gomock.InOrder(
+		mocks.orgRepo.EXPECT().DeleteOrgMember(ctx, orgID, admin.ID).Return(nil),
+		mocks.orgRepo.EXPECT().DeleteOrgMember(ctx, orgID, member.ID).Return(nil),
+		mocks.orgRepo.EXPECT().DeleteOrgMember(ctx, orgID, admin.ID).Return(errAny),
+	)
+
+	testCases := []struct {
+		name     string
+		remover  app.User
+		publicID app.PublicID
+		want     error
+	}{
+		{"owner removes admin", owner, admin.PublicID, nil},
+		{"admin removes member", admin, member.PublicID, nil},
+		{"admin leaves", admin, admin.PublicID, errAny},
+		{"owner leaves", owner, owner.PublicID, app.ErrOrgOwner},
+		{"admin removes owner", admin, owner.PublicID, app.ErrOrgOwner},
+		{"member removes admin", member, admin.PublicID, app.ErrOrgPermission},
+		{"not member", owner, stranger.PublicID, app.ErrNotFound},
+		{"unknown user", owner, "unknown", app.ErrNotFound},
+		{"not member removes", stranger, member.PublicID, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.RemoveOrgMember(ctx, app.AuthUser{User: tc.remover}, orgID, tc.publicID)
+			assert.Equal(t, tc.want, err)
+		})
+	}
+}
+
+func TestApp_TransferOrgOwnership(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	owner, admin, stranger := userGen(t), userGen(t), userGen(t)
+
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, owner.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: owner.ID, Role: app.OrgRoleOwner}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, admin.ID).
+		Return(&app.OrgMember{OrgID: orgID, UserID: admin.ID, Role: app.OrgRoleAdmin}, nil).AnyTimes()
+	mocks.orgRepo.EXPECT().OrgMember(ctx, orgID, stranger.ID).Return(nil, app.ErrNotFound).AnyTimes()
+	expectUsersByPublicID(mocks, owner, admin, stranger)
+
+	gomock.InOrder(
+		mocks.orgRepo.EXPECT().TransferOrgOwnership(ctx, orgID, owner.ID, admin.ID).Return(nil),
+		mocks.orgRepo.EXPECT().TransferOrgOwnership(ctx, orgID, owner.ID, stranger.ID).Return(app.ErrNotFound),
+	)
+
+	testCases := []struct {
+		name     string
+		user     app.User
+		publicID app.PublicID
+		want     error
+	}{
+		{"success", owner, admin.PublicID, nil},
+		{"not member", owner, stranger.PublicID, app.ErrNotFound},
+		{"to self", owner, owner.PublicID, nil},
+		{"admin transfers", admin, owner.PublicID, app.ErrOrgPermission},
+		{"not member transfers", stranger, admin.PublicID, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.TransferOrgOwnership(ctx, app.AuthUser{User: tc.user}, orgID, tc.publicID)
+			assert.Equal(t, tc.want, err)
+		})
+	}
+}
+
+func expectUsersByPublicID(mocks *Mocks, users ...app.User) {
+	for i := range users {
+		mocks.userRepo.EXPECT().UserByPublicID(ctx, users[i].PublicID).Return(&users[i], nil).AnyTimes()
+	}
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// PhoneApp implements the business logic for the phone of the user.
+type PhoneApp interface {
+	// RequestPhoneVerification creates and sends by SMS a code to verify the phone,
+	// the phone becomes the user's one after VerifyPhone.
+	// Errors: ErrInvalidPhone, ErrPhoneExist, ErrSMSDisabled, unknown.
+	RequestPhoneVerification(ctx context.Context, authUser AuthUser, phone string) error
+	// VerifyPhone sets the phone of the latest verification code to the user.
+	// Errors: ErrNotFound, ErrNotValidCode, ErrCodeExpired, ErrPhoneExist, unknown.
+	VerifyPhone(ctx context.Context, authUser AuthUser, code string) error
+	// DeletePhone removes the phone of the user.
+	// Errors: unknown.
+	DeletePhone(ctx context.Context, authUser AuthUser) error
+}
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var (
+	PhoneCodeLifetime = 15 * time.Minute
+)
+
+// rePhone matches phones in E.164 format, e.g. +15551234567.
+// nolint:gochecknoglobals
+var rePhone = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+
+// NormalizePhone returns the phone without spaces, dashes and brackets.
+// Errors: ErrInvalidPhone.
+func NormalizePhone(phone string) (string, error) {
+	phone = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(phone)
+	if !rePhone.MatchString(phone) {
+		return "", ErrInvalidPhone
+	}
+
+	return phone, nil
+}
+
+// RequestPhoneVerification for implemented PhoneApp.
+func (a *Application) RequestPhoneVerification(ctx context.Context, authUser AuthUser, phone string) error {
+	if a.sms == nil {
+		return ErrSMSDisabled
+	}
+
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		return err
+	}
+
+	user, err := a.userRepo.UserByID(ctx, authUser.ID)
+	if err != nil {
+		return err
+	}
+	if user.Phone == phone {
+		return ErrPhoneExist
+	}
+
+	code := a.code.Generate(codeLength)
+
+	task := TaskNotification{
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     PhoneVerification,
+		Payload:  &PhoneVerificationPayload{Code: code, Phone: phone},
+	}
+
+	return a.codeRepo.SavePhoneCode(ctx, user.TenantID, user.Email, phone, code, task)
+}
+
+// VerifyPhone for implemented PhoneApp.
+func (a *Application) VerifyPhone(ctx context.Context, authUser AuthUser, code string) error {
+	user, err := a.userRepo.UserByID(ctx, authUser.ID)
+	if err != nil {
+		return err
+	}
+
+	info, err := a.codeRepo.PhoneCode(ctx, user.TenantID, user.Email)
+	if err != nil {
+		return err
+	}
+
+	if info.Code != code {
+		return ErrNotValidCode
+	}
+
+	if time.Since(info.CreatedAt) > PhoneCodeLifetime {
+		return ErrCodeExpired
+	}
+
+	err = a.userRepo.UpdatePhone(ctx, authUser.ID, info.Phone)
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthUser(ctx, authUser.ID)
+}
+
+// DeletePhone for implemented PhoneApp.
+func (a *Application) DeletePhone(ctx context.Context, authUser AuthUser) error {
+	err := a.userRepo.UpdatePhone(ctx, authUser.ID, "")
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthUser(ctx, authUser.ID)
+}
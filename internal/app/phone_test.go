@@ -0,0 +1,227 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		phone   string
+		want    string
+		wantErr error
+	}{
+		{phone, phone, nil},
+		{"+1 (555) 123-45-67", phone, nil},
+		{"15551234567", "", app.ErrInvalidPhone},
+		{"+0551234567", "", app.ErrInvalidPhone},
+		{"+1555", "", app.ErrInvalidPhone},
+		{"+1555123456a", "", app.ErrInvalidPhone},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.phone, func(t *testing.T) {
+			res, err := app.NormalizePhone(tc.phone)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestApp_RequestPhoneVerification(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const codeLength = 6
+	user := userGen(t)
+	userWithPhone := userGen(t)
+	userWithPhone.Phone = phone
+	task := app.TaskNotification{
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.PhoneVerification,
+		Payload:  &app.PhoneVerificationPayload{Code: recoveryCode, Phone: phone},
+	}
+
+	gomock.InOrder(
+		mocks.code.EXPECT().Generate(codeLength).Return(recoveryCode),
+		mocks.codeRepo.EXPECT().SavePhoneCode(ctx, user.TenantID, user.Email, phone, recoveryCode, task).Return(nil),
+		mocks.code.EXPECT().Generate(codeLength).Return(recoveryCode),
+		mocks.codeRepo.EXPECT().SavePhoneCode(ctx, user.TenantID, user.Email, phone, recoveryCode, task).Return(errAny),
+	)
+
+	testCases := []struct {
+		name     string
+		authUser app.AuthUser
+		phone    string
+		want     error
+	}{
+		{"success", cachedAuthUser(mocks, user), "+1 555 123-45-67", nil},
+		{"any error", cachedAuthUser(mocks, user), phone, errAny},
+		{"invalid phone", cachedAuthUser(mocks, user), "555", app.ErrInvalidPhone},
+		{"same phone", cachedAuthUser(mocks, userWithPhone), phone, app.ErrPhoneExist},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.RequestPhoneVerification(ctx, tc.authUser, tc.phone)
+			assert.Equal(t, tc.want, err)
+		})
+	}
+
+	err := app.New(app.Config{}).RequestPhoneVerification(ctx, app.AuthUser{User: user}, phone)
+	assert.Equal(t, app.ErrSMSDisabled, err)
+}
+
+func TestApp_VerifyPhone(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	authUser := cachedAuthUser(mocks, user)
+	codeInfo := app.CodeInfo{
+		Code:      recoveryCode,
+		Email:     user.Email,
+		Phone:     phone,
+		CreatedAt: time.Now(),
+	}
+	expiredCodeInfo := codeInfo
+	expiredCodeInfo.CreatedAt = time.Now().Add(-app.PhoneCodeLifetime - time.Minute)
+
+	gomock.InOrder(
+		mocks.codeRepo.EXPECT().PhoneCode(ctx, user.TenantID, user.Email).Return(&codeInfo, nil),
+		mocks.userRepo.EXPECT().UpdatePhone(ctx, user.ID, phone).Return(nil),
+		mocks.codeRepo.EXPECT().PhoneCode(ctx, user.TenantID, user.Email).Return(&codeInfo, nil),
+		mocks.userRepo.EXPECT().UpdatePhone(ctx, user.ID, phone).Return(app.ErrPhoneExist),
+		mocks.codeRepo.EXPECT().PhoneCode(ctx, user.TenantID, user.Email).Return(&codeInfo, nil),
+		mocks.codeRepo.EXPECT().PhoneCode(ctx, user.TenantID, user.Email).Return(&expiredCodeInfo, nil),
+		mocks.codeRepo.EXPECT().PhoneCode(ctx, user.TenantID, user.Email).Return(nil, app.ErrNotFound),
+	)
+
+	testCases := []struct {
+		name string
+		code string
+		want error
+	}{
+		{"success", recoveryCode, nil},
+		{"phone exist", recoveryCode, app.ErrPhoneExist},
+		{"not valid code", "any code", app.ErrNotValidCode},
+		{"code expired", recoveryCode, app.ErrCodeExpired},
+		{"not found", recoveryCode, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.VerifyPhone(ctx, authUser, tc.code)
+			assert.Equal(t, tc.want, err)
+		})
+	}
+}
+
+func TestApp_DeletePhone(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	mocks.userRepo.EXPECT().UpdatePhone(ctx, user.ID, "").Return(nil)
+
+	err := application.DeletePhone(ctx, app.AuthUser{User: user})
+	assert.Nil(t, err)
+}
+
+func TestApp_RunNotificationJobSMS(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	userWithPhone := userGen(t)
+	userWithPhone.Phone = phone
+	verificationTask := app.TaskNotification{
+		ID:       1,
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.PhoneVerification,
+		Payload:  &app.PhoneVerificationPayload{Code: recoveryCode, Phone: phone},
+	}
+	recoveryTask := app.TaskNotification{
+		ID:       2,
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.PassRecoverySMS,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	}
+	changeEmailTask := app.TaskNotification{
+		ID:       3,
+		TenantID: userWithPhone.TenantID,
+		Email:    userWithPhone.Email,
+		Kind:     app.ChangeEmail,
+		Payload:  &app.ChangeEmailPayload{},
+	}
+	verificationJob := app.NewNotificationJob(verificationTask.ID, verificationTask)
+	verificationJob.ID = 1
+	recoveryJob := app.NewNotificationJob(recoveryTask.ID, recoveryTask)
+	recoveryJob.ID = 2
+	changeEmailJob := app.NewNotificationJob(changeEmailTask.ID, changeEmailTask)
+	changeEmailJob.ID = 3
+	changeEmailMsg := app.Message{
+		TaskID:   changeEmailTask.ID,
+		TenantID: changeEmailTask.TenantID,
+		Kind:     app.ChangeEmail,
+		Content:  "Change email successful",
+	}
+
+	gomock.InOrder(
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&verificationJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), verificationTask.ID).Return(pending(verificationTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), verificationTask).Return(nil, nil),
+		mocks.sms.EXPECT().Notification(phone, app.Message{
+			TaskID:   verificationTask.ID,
+			TenantID: verificationTask.TenantID,
+			Kind:     app.PhoneVerification,
+			Content:  recoveryCode,
+		}).Return(nil),
+		mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), verificationTask.ID).Return(nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), verificationJob.ID).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return(nil, nil),
+		mocks.userRepo.EXPECT().UserByEmail(gomock.Any(), user.TenantID, user.Email).Return(&user, nil),
+		mocks.wal.EXPECT().SuppressTaskNotification(gomock.Any(), recoveryTask.ID, app.ErrUndeliverable).Return(nil),
+		mocks.metrics.EXPECT().NotificationSuppressed(app.PassRecoverySMS, app.SuppressUndeliverable),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), recoveryJob.ID).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&changeEmailJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), changeEmailTask.ID).Return(pending(changeEmailTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), changeEmailTask).Return(nil, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), userWithPhone.Email).Return(nil, app.ErrNotFound),
+		mocks.userRepo.EXPECT().UserByEmail(gomock.Any(), userWithPhone.TenantID, userWithPhone.Email).Return(&userWithPhone, nil),
+		mocks.inbox.EXPECT().Notification(userWithPhone.Email, changeEmailMsg).Return(nil),
+		mocks.notification.EXPECT().Notification(userWithPhone.Email, changeEmailMsg).Return(nil),
+		mocks.sms.EXPECT().Notification(phone, changeEmailMsg).Return(errAny),
+		mocks.wal.EXPECT().SaveTaskNotificationError(gomock.Any(), changeEmailTask.ID, errAny).Return(nil),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), changeEmailJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(nil, errAny),
+	)
+
+	err := application.StartJobs(ctx)
+	assert.Equal(t, errAny, err)
+}
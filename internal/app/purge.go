@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type (
+	// PurgeRepo interface for removing expired data.
+	// Every method removes at most limit rows, so the tables aren't locked for long.
+	PurgeRepo interface {
+		// PurgeSessions removes sessions logged out before the time.
+		// Returns the number of removed rows.
+		// Errors: unknown.
+		PurgeSessions(ctx context.Context, before time.Time, limit int) (int, error)
+		// PurgeRecoveryCodes removes recovery and phone verification codes created before the time.
+		// Returns the number of removed rows.
+		// Errors: unknown.
+		PurgeRecoveryCodes(ctx context.Context, before time.Time, limit int) (int, error)
+		// PurgeNotifications removes done and cancelled notification tasks finished before the time.
+		// Returns the number of removed rows.
+		// Errors: unknown.
+		PurgeNotifications(ctx context.Context, before time.Time, limit int) (int, error)
+		// PurgeJobs removes done and failed jobs finished before the time.
+		// Returns the number of removed rows.
+		// Errors: unknown.
+		PurgeJobs(ctx context.Context, before time.Time, limit int) (int, error)
+	}
+	// PurgeMetrics module for collecting statistics of purge jobs.
+	PurgeMetrics interface {
+		// Purged counts rows removed by the run of the purge job and observes its duration.
+		Purged(kind JobKind, rows int, duration time.Duration)
+	}
+	// PurgeJobPayload payload for purge jobs.
+	PurgeJobPayload struct {
+		// Retention is the age of the data to be removed.
+		Retention time.Duration `json:"retention"`
+		// BatchSize is the max number of rows removed by one query.
+		BatchSize int `json:"batch_size"`
+	}
+)
+
+// NewPurgeJob returns the job of the kind removing data older than the retention.
+func NewPurgeJob(kind JobKind, retention time.Duration, batchSize int) Job {
+	return Job{
+		Kind:     kind,
+		Payload:  &PurgeJobPayload{Retention: retention, BatchSize: batchSize},
+		Priority: JobPriorityLow,
+	}
+}
+
+func newPurgeJobPayload() JobPayload { return &PurgeJobPayload{} }
+
+type purgeFunc func(repo PurgeRepo, ctx context.Context, before time.Time, limit int) (int, error)
+
+// purgeJobHandler returns the handler removing expired data by batches until a batch isn't full.
+func purgeJobHandler(kind JobKind, purge purgeFunc) func(*Application, context.Context, JobPayload) error {
+	return func(a *Application, ctx context.Context, payload JobPayload) error {
+		p, ok := payload.(*PurgeJobPayload)
+		if !ok || p.Retention <= 0 || p.BatchSize <= 0 {
+			return fmt.Errorf("%w: %+v", ErrInvalidJobPayload, payload)
+		}
+
+		start := time.Now()
+		before := start.Add(-p.Retention)
+		total := 0
+		defer func() { a.purgeMetrics.Purged(kind, total, time.Since(start)) }()
+
+		for ctx.Err() == nil {
+			n, err := purge(a.purgeRepo, ctx, before, p.BatchSize)
+			total += n
+			if err != nil {
+				return err
+			}
+			if n < p.BatchSize {
+				return nil
+			}
+		}
+
+		return ctx.Err()
+	}
+}
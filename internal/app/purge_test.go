@@ -0,0 +1,63 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_RunPurgeJob(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const batchSize = 2
+	sessionsJob := app.NewPurgeJob(app.JobPurgeSessions, time.Hour, batchSize)
+	sessionsJob.ID = 1
+	codesJob := app.NewPurgeJob(app.JobPurgeRecoveryCodes, time.Hour, batchSize)
+	codesJob.ID = 2
+	notificationsJob := app.NewPurgeJob(app.JobPurgeNotifications, time.Hour, batchSize)
+	notificationsJob.ID = 3
+	jobsJob := app.NewPurgeJob(app.JobPurgeJobs, time.Hour, batchSize)
+	jobsJob.ID = 4
+	invalidJob := app.NewPurgeJob(app.JobPurgeJobs, 0, batchSize)
+	invalidJob.ID = 5
+	before := timeMatcher{time.Now().Add(-time.Hour)}
+
+	gomock.InOrder(
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.SingletonJobKinds(), app.JobLease).Return(&sessionsJob, nil),
+		mocks.purgeRepo.EXPECT().PurgeSessions(gomock.Any(), before, batchSize).Return(batchSize, nil),
+		mocks.purgeRepo.EXPECT().PurgeSessions(gomock.Any(), before, batchSize).Return(batchSize, nil),
+		mocks.purgeRepo.EXPECT().PurgeSessions(gomock.Any(), before, batchSize).Return(1, nil),
+		mocks.purgeMetrics.EXPECT().Purged(app.JobPurgeSessions, 2*batchSize+1, gomock.Any()),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), sessionsJob.ID).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.SingletonJobKinds(), app.JobLease).Return(&codesJob, nil),
+		mocks.purgeRepo.EXPECT().PurgeRecoveryCodes(gomock.Any(), before, batchSize).Return(0, nil),
+		mocks.purgeMetrics.EXPECT().Purged(app.JobPurgeRecoveryCodes, 0, gomock.Any()),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), codesJob.ID).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.SingletonJobKinds(), app.JobLease).Return(&notificationsJob, nil),
+		mocks.purgeRepo.EXPECT().PurgeNotifications(gomock.Any(), before, batchSize).Return(batchSize, nil),
+		mocks.purgeRepo.EXPECT().PurgeNotifications(gomock.Any(), before, batchSize).Return(0, errAny),
+		mocks.purgeMetrics.EXPECT().Purged(app.JobPurgeNotifications, batchSize, gomock.Any()),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), notificationsJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.SingletonJobKinds(), app.JobLease).Return(&jobsJob, nil),
+		mocks.purgeRepo.EXPECT().PurgeJobs(gomock.Any(), before, batchSize).Return(1, nil),
+		mocks.purgeMetrics.EXPECT().Purged(app.JobPurgeJobs, 1, gomock.Any()),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), jobsJob.ID).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.SingletonJobKinds(), app.JobLease).Return(&invalidJob, nil),
+		mocks.jobRepo.EXPECT().FailJob(gomock.Any(), invalidJob.ID, errMatcher{app.ErrInvalidJobPayload}).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.SingletonJobKinds(), app.JobLease).Return(nil, errAny),
+	)
+
+	err := application.StartSingletonJobs(ctx)
+	assert.Equal(t, errAny, err)
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+)
+
+type (
+	// TenantApp implements the business logic for tenants.
+	// Every tenant has its own pool of users, emails and usernames are unique per tenant.
+	TenantApp interface {
+		// Tenant returns the tenant by its name, or by the host if the name is empty.
+		// The host may contain the port, requests of unknown hosts belong to the DefaultTenant.
+		// Errors: ErrNotFound, unknown.
+		Tenant(ctx context.Context, name, host string) (*Tenant, error)
+		// CreateTenant adds a new tenant, the empty host isn't bound to the tenant.
+		// Errors: ErrTenantExist, unknown.
+		CreateTenant(ctx context.Context, name, host string) (*Tenant, error)
+		// ListTenants returns all tenants.
+		// Errors: unknown.
+		ListTenants(context.Context) ([]Tenant, error)
+	}
+	// TenantRepo interface for tenants repository.
+	TenantRepo interface {
+		// CreateTenant adds a new tenant.
+		// Errors: ErrTenantExist, unknown.
+		CreateTenant(context.Context, Tenant) (TenantID, error)
+		// TenantByID returns the tenant by id.
+		// Errors: ErrNotFound, unknown.
+		TenantByID(context.Context, TenantID) (*Tenant, error)
+		// TenantByName returns the tenant by name.
+		// Errors: ErrNotFound, unknown.
+		TenantByName(ctx context.Context, name string) (*Tenant, error)
+		// TenantByHost returns the tenant bound to the host.
+		// Errors: ErrNotFound, unknown.
+		TenantByHost(ctx context.Context, host string) (*Tenant, error)
+		// Tenants returns all tenants ordered by id.
+		// Errors: unknown.
+		Tenants(context.Context) ([]Tenant, error)
+	}
+	// TenantID contains tenant id.
+	TenantID int
+	// Tenant is an isolated pool of users, e.g. a product sharing the deployment.
+	Tenant struct {
+		ID   TenantID
+		Name string
+		// Host is empty if requests are bound to the tenant only by its name.
+		Host      string
+		CreatedAt time.Time
+	}
+)
+
+// DefaultTenant is created by migrations, it owns users registered before tenants.
+const DefaultTenant TenantID = 1
+
+// Tenant for implemented TenantApp.
+func (a *Application) Tenant(ctx context.Context, name, host string) (*Tenant, error) {
+	if name != "" {
+		return a.tenantRepo.TenantByName(ctx, name)
+	}
+
+	host = tenantHost(host)
+	if host == "" {
+		return a.tenantRepo.TenantByID(ctx, DefaultTenant)
+	}
+
+	tenant, err := a.tenantRepo.TenantByHost(ctx, host)
+	if errors.Is(err, ErrNotFound) {
+		return a.tenantRepo.TenantByID(ctx, DefaultTenant)
+	}
+
+	return tenant, err
+}
+
+// CreateTenant for implemented TenantApp.
+func (a *Application) CreateTenant(ctx context.Context, name, host string) (*Tenant, error) {
+	tenant := Tenant{
+		Name: name,
+		Host: tenantHost(host),
+	}
+
+	id, err := a.tenantRepo.CreateTenant(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.tenantRepo.TenantByID(ctx, id)
+}
+
+// ListTenants for implemented TenantApp.
+func (a *Application) ListTenants(ctx context.Context) ([]Tenant, error) {
+	return a.tenantRepo.Tenants(ctx)
+}
+
+// tenantHost returns the lower-cased host without the port.
+func tenantHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return strings.ToLower(host)
+}
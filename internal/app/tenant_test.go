@@ -0,0 +1,82 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_Tenant(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const name, host = "tenant", "tenant.example.com"
+	tenant := &app.Tenant{ID: tenantID, Name: name, Host: host}
+	defaultTenant := &app.Tenant{ID: app.DefaultTenant, Name: "default"}
+
+	mocks.tenantRepo.EXPECT().TenantByName(ctx, name).Return(tenant, nil)
+	mocks.tenantRepo.EXPECT().TenantByName(ctx, notExistUsername).Return(nil, app.ErrNotFound)
+	mocks.tenantRepo.EXPECT().TenantByHost(ctx, host).Return(tenant, nil).Times(2)
+	mocks.tenantRepo.EXPECT().TenantByHost(ctx, "localhost").Return(nil, app.ErrNotFound)
+	mocks.tenantRepo.EXPECT().TenantByHost(ctx, "error.example.com").Return(nil, errAny)
+	mocks.tenantRepo.EXPECT().TenantByID(ctx, app.DefaultTenant).Return(defaultTenant, nil).Times(2)
+
+	testCases := map[string]struct {
+		name    string
+		host    string
+		want    *app.Tenant
+		wantErr error
+	}{
+		"by name":           {name, "localhost", tenant, nil},
+		"unknown name":      {notExistUsername, host, nil, app.ErrNotFound},
+		"by host":           {"", host, tenant, nil},
+		"by host with port": {"", "Tenant.Example.com:8080", tenant, nil},
+		"unknown host":      {"", "localhost:8080", defaultTenant, nil},
+		"without host":      {"", "", defaultTenant, nil},
+		"any error":         {"", "error.example.com", nil, errAny},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			tenant, err := application.Tenant(ctx, tc.name, tc.host)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, tenant)
+		})
+	}
+}
+
+func TestApp_CreateTenant(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const name = "tenant"
+	tenant := &app.Tenant{ID: tenantID, Name: name, Host: "tenant.example.com"}
+
+	mocks.tenantRepo.EXPECT().CreateTenant(ctx, app.Tenant{Name: name, Host: tenant.Host}).Return(tenant.ID, nil)
+	mocks.tenantRepo.EXPECT().TenantByID(ctx, tenant.ID).Return(tenant, nil)
+	mocks.tenantRepo.EXPECT().CreateTenant(ctx, app.Tenant{Name: name}).Return(app.TenantID(0), app.ErrTenantExist)
+
+	testCases := map[string]struct {
+		host    string
+		want    *app.Tenant
+		wantErr error
+	}{
+		"success": {"Tenant.Example.com", tenant, nil},
+		"exist":   {"", nil, app.ErrTenantExist},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			tenant, err := application.CreateTenant(ctx, "tenant", tc.host)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, tenant)
+		})
+	}
+}
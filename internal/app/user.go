@@ -2,121 +2,168 @@ package app
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"fmt"
 	"net"
 	"strings"
 	"time"
+
+	"github.com/zergslaw/boilerplate/internal/consistency"
 )
 
 type (
 	// UserApp implements the business logic for user methods.
 	UserApp interface {
-		// VerificationEmail check if the user is registered with this email.
+		// VerificationEmail check if the user of the tenant is registered with this email.
 		// Errors: ErrEmailExist, unknown.
-		VerificationEmail(ctx context.Context, email string) error
-		// VerificationUsername check if the user is registered with this username.
+		VerificationEmail(ctx context.Context, tenantID TenantID, email string) error
+		// VerificationUsername check if the user of the tenant is registered with this username.
 		// Errors: ErrUsernameExist, unknown.
-		VerificationUsername(ctx context.Context, username string) error
-		// Login authorizes the user to the system.
+		VerificationUsername(ctx context.Context, tenantID TenantID, username string) error
+		// Login authorizes the user of the tenant to the system.
 		// Errors: ErrNotFound, ErrNotValidPassword, unknown.
-		Login(ctx context.Context, email, password string, origin Origin) (*User, AuthToken, error)
+		Login(ctx context.Context, tenantID TenantID, email, password string, origin Origin) (*User, AuthToken, error)
 		// Logout remove user Session.
 		// Errors: unknown.
 		Logout(context.Context, AuthUser) error
-		// CreateUser creates a new user to the system, the password is hashed with bcrypt.
+		// CreateUser creates a new user of the tenant to the system, the password is hashed with bcrypt.
 		// Errors: ErrEmailExist, ErrUsernameExist, unknown.
-		CreateUser(ctx context.Context, email, username, password string, origin Origin) (*User, AuthToken, error)
+		CreateUser(ctx context.Context, tenantID TenantID, email, username, password string, origin Origin) (*User, AuthToken, error)
 		// DeleteUser deleting user profile.
 		// Errors: unknown.
 		DeleteUser(context.Context, AuthUser) error
-		// User returning user profile.
+		// User returning user profile by the public id, users of other tenants aren't found.
 		// Errors: ErrNotFound, unknown.
-		User(context.Context, AuthUser, UserID) (*User, error)
+		User(context.Context, AuthUser, PublicID) (*User, error)
 		// UserByAuthToken returns user by authToken.
+		// The token is bound to the tenant of the user, the API must reject it for other tenants.
 		// Errors: ErrNotFound, unknown.
 		UserByAuthToken(ctx context.Context, token AuthToken) (*AuthUser, error)
 		// UpdateUsername refresh the username.
-		// Errors: ErrUsernameExist, ErrUsernameNeedDifferentiate, unknown.
-		UpdateUsername(context.Context, AuthUser, string) error
+		// The user is updated only if it has the version, the zero version updates any.
+		// Errors: ErrUsernameExist, ErrUsernameNeedDifferentiate, ErrVersionMismatch, unknown.
+		UpdateUsername(ctx context.Context, authUser AuthUser, username string, version int) error
 		// UpdateEmail refresh the email.
-		// Errors: ErrEmailExist, ErrEmailNeedDifferentiate, unknown.
-		UpdateEmail(context.Context, AuthUser, string) error
+		// The user is updated only if it has the version, the zero version updates any.
+		// Errors: ErrEmailExist, ErrEmailNeedDifferentiate, ErrVersionMismatch, unknown.
+		UpdateEmail(ctx context.Context, authUser AuthUser, email string, version int) error
 		// UpdateUsername refresh user password.
-		// Errors: ErrNotValidPassword, unknown.
-		UpdatePassword(ctx context.Context, authUser AuthUser, oldPass, newPass string) error
-		// ListUserByUsername returns list user by username.
+		// The user is updated only if it has the version, the zero version updates any.
+		// Errors: ErrNotValidPassword, ErrVersionMismatch, unknown.
+		UpdatePassword(ctx context.Context, authUser AuthUser, oldPass, newPass string, version int) error
+		// ListUserByUsername returns list user of the tenant of the authUser by username.
 		// Errors: unknown.
 		ListUserByUsername(context.Context, AuthUser, string, Page) ([]User, int, error)
-		// CreateRecoveryCode creates and sends a password recovery code to the user's email.
-		// Errors: ErrNotFound, unknown.
-		CreateRecoveryCode(ctx context.Context, email string) error
-		// RecoveryPassword replaces the password with a new one from the user who owns this recovery code.
+		// CreateRecoveryCode creates and sends a password recovery code to the email of the user of the tenant
+		// or by SMS to the user's verified phone.
+		// Errors: ErrNotFound, ErrPhoneNotVerified, ErrSMSDisabled, ErrUnknownChannel, unknown.
+		CreateRecoveryCode(ctx context.Context, tenantID TenantID, email string, channel Channel) error
+		// RecoveryPassword replaces the password with a new one from the user of the tenant who owns this recovery code.
 		// Errors: ErrCodeExpired, ErrNotFound, unknown.
-		RecoveryPassword(ctx context.Context, email, code, newPassword string) error
+		RecoveryPassword(ctx context.Context, tenantID TenantID, email, code, newPassword string) error
 	}
 	// UserRepo interface for user data repository.
 	UserRepo interface {
-		// CreateUser adds to the new user in repository.
+		// CreateUser adds to the new user of the tenant in repository.
 		// This method is also required to create a notifying hoard.
 		// Errors: ErrEmailExist, ErrUsernameExist, unknown.
 		CreateUser(context.Context, User, TaskNotification) (UserID, error)
-		// DeleteUser removes user from repository.
+		// DeleteUser removes user from repository with organizations owned by the user.
 		// Errors: unknown.
 		DeleteUser(context.Context, UserID) error
 		// UpdateUsername changes username if he's not busy.
-		// Errors: ErrUsernameExist, unknown.
-		UpdateUsername(context.Context, UserID, string) error
+		// Updates increment the version of the user, the user is updated only if it has the version,
+		// the zero version updates any.
+		// Errors: ErrUsernameExist, ErrVersionMismatch, unknown.
+		UpdateUsername(ctx context.Context, userID UserID, username string, version int) error
 		// UpdateEmail changes email if he's not busy.
 		// This method is also required to create a notifying hoard.
-		// Errors: ErrEmailExist, unknown.
-		UpdateEmail(context.Context, UserID, string, TaskNotification) error
+		// Errors: ErrEmailExist, ErrVersionMismatch, unknown.
+		UpdateEmail(ctx context.Context, userID UserID, email string, task TaskNotification, version int) error
 		// UpdatePassword changes password.
 		// Resets all codes to reset the password.
-		// Errors: unknown.
-		UpdatePassword(context.Context, UserID, []byte) error
+		// Errors: ErrVersionMismatch, unknown.
+		UpdatePassword(ctx context.Context, userID UserID, passHash []byte, version int) error
+		// UpdatePhone changes the phone if it isn't busy, the empty phone removes it.
+		// Resets all phone verification codes of the user.
+		// Errors: ErrPhoneExist, unknown.
+		UpdatePhone(context.Context, UserID, string) error
 		// UserByID returning user info by id.
 		// Errors: ErrNotFound, unknown.
 		UserByID(context.Context, UserID) (*User, error)
-		// UserByEmail returning user info by email.
+		// UserByPublicID returning user info by public id.
+		// Errors: ErrNotFound, unknown.
+		UserByPublicID(context.Context, PublicID) (*User, error)
+		// UserByEmail returning info of the user of the tenant by email.
 		// Errors: ErrNotFound, unknown.
-		UserByEmail(context.Context, string) (*User, error)
-		// UserByUsername returning user info by id.
+		UserByEmail(context.Context, TenantID, string) (*User, error)
+		// UserByUsername returning info of the user of the tenant by username.
 		// Errors: ErrNotFound, unknown.
-		UserByUsername(context.Context, string) (*User, error)
-		// ListUserByUsername returning list user info.
+		UserByUsername(context.Context, TenantID, string) (*User, error)
+		// ListUserByUsername returning list info of users of the tenant.
 		// Errors: unknown.
-		ListUserByUsername(context.Context, string, Page) ([]User, int, error)
+		ListUserByUsername(context.Context, TenantID, string, Page) ([]User, int, error)
 	}
 	// SessionRepo interface for session data repository.
 	SessionRepo interface {
 		// SaveSession saves the new user Session in a database.
 		// Errors: unknown.
 		SaveSession(context.Context, UserID, TokenID, Origin) error
-		// Session returns user Session.
+		// AuthUserByTokenID returns the user with the active Session of the token.
 		// Errors: ErrNotFound, unknown.
-		SessionByTokenID(context.Context, TokenID) (*Session, error)
-		// UserByAuthToken returning user info by authToken.
-		// Errors: ErrNotFound, unknown.
-		UserByTokenID(context.Context, TokenID) (*User, error)
+		AuthUserByTokenID(context.Context, TokenID) (*AuthUser, error)
 		// DeleteSession removes user Session.
 		// Errors: unknown.
 		DeleteSession(context.Context, TokenID) error
 	}
+	// AuthCache caches users of auth tokens, so authorization doesn't query the repository.
+	// The user read before the concurrent invalidation may be cached, so TTL must be short.
+	AuthCache interface {
+		// AuthUser returns the cached user of the token.
+		// Errors: ErrNotFound, unknown.
+		AuthUser(context.Context, TokenID) (*AuthUser, error)
+		// SaveAuthUser caches the user of the Session token.
+		// Credentials and personal data aren't cached, PassHash, Email and Phone of the cached user are empty.
+		// Errors: unknown.
+		SaveAuthUser(context.Context, AuthUser) error
+		// DeleteAuthToken removes the token from the cache.
+		// Errors: unknown.
+		DeleteAuthToken(context.Context, TokenID) error
+		// DeleteAuthUser removes all tokens of the user from the cache.
+		// Errors: unknown.
+		DeleteAuthUser(context.Context, UserID) error
+	}
+	// AuthCacheMetrics module for collecting statistics of the auth cache.
+	AuthCacheMetrics interface {
+		// AuthCacheLookup is called on every lookup of the token in the cache.
+		AuthCacheLookup(hit bool)
+	}
 	// CodeRepo interface for recover code repository.
 	CodeRepo interface {
 		// SaveCode the code to restore the password to the repository.
-		// Removes all recovery codes from this email before adding a new one.
+		// Removes all recovery codes from this email of the tenant before adding a new one.
 		// Creates a task to send the recovery code to the user's mail.
 		// Errors: unknown.
-		SaveCode(ctx context.Context, email, code string, task TaskNotification) error
-		// Code returns recovery code for recovery password by user email.
+		SaveCode(ctx context.Context, tenantID TenantID, email, code string, task TaskNotification) error
+		// Code returns recovery code for recovery password by email of the user of the tenant.
+		// Errors: ErrNotFound, unknown.
+		Code(ctx context.Context, tenantID TenantID, email string) (codeInfo *CodeInfo, err error)
+		// SavePhoneCode the code to verify the phone to the repository.
+		// Removes all phone verification codes from this email of the tenant before adding a new one.
+		// Creates a task to send the code to the phone.
+		// Errors: unknown.
+		SavePhoneCode(ctx context.Context, tenantID TenantID, email, phone, code string, task TaskNotification) error
+		// PhoneCode returns the phone verification code by email of the user of the tenant.
 		// Errors: ErrNotFound, unknown.
-		Code(ctx context.Context, email string) (codeInfo *CodeInfo, err error)
+		PhoneCode(ctx context.Context, tenantID TenantID, email string) (codeInfo *CodeInfo, err error)
 	}
 	// CodeInfo contains information for recovery code.
 	CodeInfo struct {
-		Code      string
-		Email     string
+		Code  string
+		Email string
+		// Phone is set for the phone verification code.
+		Phone     string
 		CreatedAt time.Time
 	}
 	// Code module for generated random code.
@@ -141,6 +188,14 @@ type (
 		// Parse and validates the auth and checks that it's expired.
 		// Errors: ErrInvalidToken, ErrExpiredToken, unknown.
 		Parse(token AuthToken) (TokenID, error)
+		// UnsubscribeToken generates a signed token without lifetime
+		// for one-click unsubscribe the user from the kind of message.
+		// The token keeps the public id, so links don't reveal sequential ids of users.
+		// Errors: unknown.
+		UnsubscribeToken(PublicID, MessageKind) (string, error)
+		// ParseUnsubscribeToken validates the unsubscribe token.
+		// Errors: ErrInvalidToken, unknown.
+		ParseUnsubscribeToken(token string) (PublicID, MessageKind, error)
 	}
 	// OAuth module responsible for working with social network.
 	OAuth interface {
@@ -148,8 +203,11 @@ type (
 		// Errors: unknown.
 		Account(context.Context, string) (*OAuthAccount, error)
 	}
-	// UserID contains user id.
+	// UserID contains user id, it's sequential and used only internally.
 	UserID int
+	// PublicID contains the random user id, external APIs identify users by it,
+	// so they don't disclose the number of users.
+	PublicID string
 	// SessionID contains Session id.
 	SessionID int
 	// SocialID contains id from social network with OAuth.
@@ -178,14 +236,26 @@ type (
 	// User contains user information.
 	User struct {
 		ID       UserID
+		PublicID PublicID
+		TenantID TenantID
 		Email    string
 		Name     string
 		PassHash []byte
+		// Phone is verified, it's empty if the user has no phone.
+		Phone string
+		// Version is incremented by every update of the user.
+		Version int
 
 		CreatedAt time.Time
 		UpdatedAt time.Time
+
+		// EmailUndeliverable is set by Login if mail to the email bounces
+		// or is reported as spam, so the user has to change the email.
+		EmailUndeliverable bool
 	}
 	// AuthUser contains auth information.
+	// PassHash, Email and Phone are empty if the user is read from the AuthCache,
+	// they are read by UserByID when needed.
 	AuthUser struct {
 		User
 		Session Session
@@ -193,8 +263,8 @@ type (
 )
 
 // VerificationEmail for implemented UserApp.
-func (a *Application) VerificationEmail(ctx context.Context, email string) error {
-	_, err := a.userRepo.UserByEmail(ctx, email)
+func (a *Application) VerificationEmail(ctx context.Context, tenantID TenantID, email string) error {
+	_, err := a.userRepo.UserByEmail(ctx, tenantID, email)
 	switch {
 	case errors.Is(err, ErrNotFound):
 		return nil
@@ -206,8 +276,8 @@ func (a *Application) VerificationEmail(ctx context.Context, email string) error
 }
 
 // VerificationUsername for implemented UserApp.
-func (a *Application) VerificationUsername(ctx context.Context, username string) error {
-	_, err := a.userRepo.UserByUsername(ctx, username)
+func (a *Application) VerificationUsername(ctx context.Context, tenantID TenantID, username string) error {
+	_, err := a.userRepo.UserByUsername(ctx, tenantID, username)
 	switch {
 	case errors.Is(err, ErrNotFound):
 		return nil
@@ -224,11 +294,14 @@ var (
 	TokenExpire = 24 * 7 * time.Hour
 )
 
+// codeLength is the length of recovery and phone verification codes.
+const codeLength = 6
+
 // Login for implemented UserApp.
-func (a *Application) Login(ctx context.Context, email, password string, origin Origin) (*User, AuthToken, error) {
+func (a *Application) Login(ctx context.Context, tenantID TenantID, email, password string, origin Origin) (*User, AuthToken, error) {
 	email = strings.ToLower(email)
 
-	user, err := a.userRepo.UserByEmail(ctx, email)
+	user, err := a.userRepo.UserByEmail(ctx, tenantID, email)
 	if err != nil {
 		return nil, "", err
 	}
@@ -247,31 +320,50 @@ func (a *Application) Login(ctx context.Context, email, password string, origin
 		return nil, "", err
 	}
 
+	user.EmailUndeliverable, err = a.emailUndeliverable(ctx, user.Email)
+	if err != nil {
+		return nil, "", err
+	}
+
 	return user, token, nil
 }
 
 // Logout for implemented UserApp.
 func (a *Application) Logout(ctx context.Context, authUser AuthUser) error {
-	return a.sessionRepo.DeleteSession(ctx, authUser.Session.TokenID)
+	err := a.sessionRepo.DeleteSession(ctx, authUser.Session.TokenID)
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthToken(ctx, authUser.Session.TokenID)
 }
 
 // CreateUser for implemented UserApp.
-func (a *Application) CreateUser(ctx context.Context, email, username, password string, origin Origin) (*User, AuthToken, error) {
+func (a *Application) CreateUser(ctx context.Context, tenantID TenantID, email, username, password string, origin Origin) (*User, AuthToken, error) {
 	passHash, err := a.password.Hashing(password)
 	if err != nil {
 		return nil, "", err
 	}
 	email = strings.ToLower(email)
 
+	publicID, err := newPublicID()
+	if err != nil {
+		return nil, "", err
+	}
+
 	newUser := User{
+		PublicID: publicID,
+		TenantID: tenantID,
 		Email:    email,
 		Name:     username,
 		PassHash: passHash,
 	}
 
 	task := TaskNotification{
-		Email: email,
-		Kind:  Welcome,
+		TenantID: tenantID,
+		Email:    email,
+		Kind:     Welcome,
+		Payload:  &WelcomePayload{},
 	}
 
 	_, err = a.userRepo.CreateUser(ctx, newUser, task)
@@ -279,46 +371,98 @@ func (a *Application) CreateUser(ctx context.Context, email, username, password
 		return nil, "", err
 	}
 
-	return a.Login(ctx, email, password, origin)
+	return a.Login(ctx, tenantID, email, password, origin)
 }
 
 // User for implemented UserApp.
-func (a *Application) User(ctx context.Context, _ AuthUser, userID UserID) (*User, error) {
-	return a.userRepo.UserByID(ctx, userID)
+func (a *Application) User(ctx context.Context, authUser AuthUser, publicID PublicID) (*User, error) {
+	return a.tenantUser(ctx, authUser.TenantID, publicID)
+}
+
+// tenantUser returns the user by the public id, users of other tenants aren't found.
+func (a *Application) tenantUser(ctx context.Context, tenantID TenantID, publicID PublicID) (*User, error) {
+	user, err := a.userRepo.UserByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TenantID != tenantID {
+		return nil, ErrNotFound
+	}
+
+	return user, nil
+}
+
+// newPublicID returns a random UUID of version 4.
+func newPublicID() (PublicID, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("generate public id: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return PublicID(fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])), nil
 }
 
 // DeleteUser for implemented UserApp.
 func (a *Application) DeleteUser(ctx context.Context, authUser AuthUser) error {
-	return a.userRepo.DeleteUser(ctx, authUser.ID)
+	err := a.userRepo.DeleteUser(ctx, authUser.ID)
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthUser(ctx, authUser.ID)
 }
 
 // UpdateUsername for implemented UserApp.
-func (a *Application) UpdateUsername(ctx context.Context, authUser AuthUser, username string) error {
+func (a *Application) UpdateUsername(ctx context.Context, authUser AuthUser, username string, version int) error {
 	if authUser.Name == username {
 		return ErrUsernameNeedDifferentiate
 	}
 
-	return a.userRepo.UpdateUsername(ctx, authUser.ID, username)
+	err := a.userRepo.UpdateUsername(ctx, authUser.ID, username, version)
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthUser(ctx, authUser.ID)
 }
 
 // UpdateEmail for implemented UserApp.
-func (a *Application) UpdateEmail(ctx context.Context, authUser AuthUser, email string) error {
+func (a *Application) UpdateEmail(ctx context.Context, authUser AuthUser, email string, version int) error {
 	email = strings.ToLower(email)
-	if authUser.Email == email {
+	user, err := a.userRepo.UserByID(ctx, authUser.ID)
+	if err != nil {
+		return err
+	}
+	if user.Email == email {
 		return ErrEmailNeedDifferentiate
 	}
 
 	task := TaskNotification{
-		Email: email,
-		Kind:  ChangeEmail,
+		TenantID: authUser.TenantID,
+		Email:    email,
+		Kind:     ChangeEmail,
+		Payload:  &ChangeEmailPayload{},
 	}
 
-	return a.userRepo.UpdateEmail(ctx, authUser.ID, email, task)
+	err = a.userRepo.UpdateEmail(ctx, authUser.ID, email, task, version)
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthUser(ctx, authUser.ID)
 }
 
 // UpdatePassword for implemented UserApp.
-func (a *Application) UpdatePassword(ctx context.Context, authUser AuthUser, oldPass, newPass string) error {
-	if !a.password.Compare(authUser.PassHash, []byte(oldPass)) {
+func (a *Application) UpdatePassword(ctx context.Context, authUser AuthUser, oldPass, newPass string, version int) error {
+	user, err := a.userRepo.UserByID(ctx, authUser.ID)
+	if err != nil {
+		return err
+	}
+	if !a.password.Compare(user.PassHash, []byte(oldPass)) {
 		return ErrNotValidPassword
 	}
 
@@ -327,42 +471,63 @@ func (a *Application) UpdatePassword(ctx context.Context, authUser AuthUser, old
 		return err
 	}
 
-	return a.userRepo.UpdatePassword(ctx, authUser.ID, passHash)
+	err = a.userRepo.UpdatePassword(ctx, authUser.ID, passHash, version)
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthUser(ctx, authUser.ID)
 }
 
 // ListUserByUsername for implemented UserApp.
-func (a *Application) ListUserByUsername(ctx context.Context, _ AuthUser, username string, page Page) ([]User, int, error) {
-	return a.userRepo.ListUserByUsername(ctx, username, page)
+func (a *Application) ListUserByUsername(ctx context.Context, authUser AuthUser, username string, page Page) ([]User, int, error) {
+	return a.userRepo.ListUserByUsername(ctx, authUser.TenantID, username, page)
 }
 
 // CreateRecoveryCode for implemented UserApp.
-func (a *Application) CreateRecoveryCode(ctx context.Context, email string) error {
-	const codeLength = 6
+func (a *Application) CreateRecoveryCode(ctx context.Context, tenantID TenantID, email string, channel Channel) error {
 	email = strings.ToLower(email)
 
-	user, err := a.userRepo.UserByEmail(ctx, email)
+	user, err := a.userRepo.UserByEmail(ctx, tenantID, email)
 	if err != nil {
 		return err
 	}
 
+	kind := PassRecovery
+	switch channel {
+	case ChannelEmail:
+	case ChannelSMS:
+		if a.sms == nil {
+			return ErrSMSDisabled
+		}
+		if user.Phone == "" {
+			return ErrPhoneNotVerified
+		}
+		kind = PassRecoverySMS
+	default:
+		return fmt.Errorf("%w: %d", ErrUnknownChannel, channel)
+	}
+
 	code := a.code.Generate(codeLength)
 
 	task := TaskNotification{
-		Email: user.Email,
-		Kind:  PassRecovery,
+		TenantID: tenantID,
+		Email:    user.Email,
+		Kind:     kind,
+		Payload:  &PassRecoveryPayload{Code: code},
 	}
 
-	return a.codeRepo.SaveCode(ctx, user.Email, code, task)
+	return a.codeRepo.SaveCode(ctx, tenantID, user.Email, code, task)
 }
 
 // RecoveryPassword for implemented UserApp.
-func (a *Application) RecoveryPassword(ctx context.Context, email, code, newPassword string) error {
-	user, err := a.userRepo.UserByEmail(ctx, email)
+func (a *Application) RecoveryPassword(ctx context.Context, tenantID TenantID, email, code, newPassword string) error {
+	user, err := a.userRepo.UserByEmail(ctx, tenantID, email)
 	if err != nil {
 		return err
 	}
 
-	info, err := a.codeRepo.Code(ctx, email)
+	info, err := a.codeRepo.Code(ctx, tenantID, email)
 	if err != nil {
 		return err
 	}
@@ -381,7 +546,12 @@ func (a *Application) RecoveryPassword(ctx context.Context, email, code, newPass
 		return err
 	}
 
-	return a.userRepo.UpdatePassword(ctx, user.ID, passHash)
+	err = a.userRepo.UpdatePassword(ctx, user.ID, passHash, 0)
+	if err != nil {
+		return err
+	}
+
+	return a.invalidateAuthUser(ctx, user.ID)
 }
 
 // UserByAuthToken for implemented UserApp.
@@ -395,15 +565,52 @@ func (a *Application) UserByAuthToken(ctx context.Context, token AuthToken) (*Au
 		return nil, err
 	}
 
-	user, err := a.sessionRepo.UserByTokenID(ctx, tokenID)
+	if a.authCache == nil {
+		return a.sessionRepo.AuthUserByTokenID(ctx, tokenID)
+	}
+
+	// Failures of the cache don't break authorization, the user is read from the repository.
+	authUser, err := a.authCache.AuthUser(ctx, tokenID)
+	a.authCacheMetrics.AuthCacheLookup(err == nil)
+	if err == nil {
+		return authUser, nil
+	}
+
+	// The cached user outlives the request, so it isn't read from the lagging replica.
+	authUser, err = a.sessionRepo.AuthUserByTokenID(consistency.ReadPrimary(ctx), tokenID)
 	if err != nil {
 		return nil, err
 	}
 
-	session, err := a.sessionRepo.SessionByTokenID(ctx, tokenID)
+	_ = a.authCache.SaveAuthUser(ctx, *authUser)
+
+	return authUser, nil
+}
+
+// invalidateAuthToken removes the token from the auth cache after logout.
+func (a *Application) invalidateAuthToken(ctx context.Context, tokenID TokenID) error {
+	if a.authCache == nil {
+		return nil
+	}
+
+	err := a.authCache.DeleteAuthToken(ctx, tokenID)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("invalidate auth token: %w", err)
+	}
+
+	return nil
+}
+
+// invalidateAuthUser removes all tokens of the user from the auth cache after the user has been changed.
+func (a *Application) invalidateAuthUser(ctx context.Context, userID UserID) error {
+	if a.authCache == nil {
+		return nil
+	}
+
+	err := a.authCache.DeleteAuthUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("invalidate auth user: %w", err)
 	}
 
-	return &AuthUser{User: *user, Session: *session}, nil
+	return nil
 }
@@ -1,12 +1,20 @@
 package app_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/consistency"
+	"github.com/zergslaw/boilerplate/internal/mock"
 )
 
 func TestApp_VerificationEmail(t *testing.T) {
@@ -18,9 +26,9 @@ func TestApp_VerificationEmail(t *testing.T) {
 	user := userGen(t)
 	notExistEmail := notExistEmail
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, notExistEmail).Return(nil, app.ErrNotFound)
-	mocks.userRepo.EXPECT().UserByEmail(ctx, user.Email).Return(&user, nil)
-	mocks.userRepo.EXPECT().UserByEmail(ctx, "").Return(nil, errAny)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, notExistEmail).Return(nil, app.ErrNotFound)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, user.TenantID, user.Email).Return(&user, nil)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, "").Return(nil, errAny)
 
 	testCases := map[string]struct {
 		email string
@@ -34,7 +42,7 @@ func TestApp_VerificationEmail(t *testing.T) {
 	for name, tc := range testCases {
 		name, tc := name, tc
 		t.Run(name, func(t *testing.T) {
-			err := application.VerificationEmail(ctx, tc.email)
+			err := application.VerificationEmail(ctx, tenantID, tc.email)
 			assert.Equal(t, tc.want, err)
 		})
 	}
@@ -47,9 +55,9 @@ func TestApp_VerificationUsername(t *testing.T) {
 	defer shutdown()
 
 	user := userGen(t)
-	mocks.userRepo.EXPECT().UserByUsername(ctx, notExistUsername).Return(nil, app.ErrNotFound)
-	mocks.userRepo.EXPECT().UserByUsername(ctx, user.Name).Return(&user, nil)
-	mocks.userRepo.EXPECT().UserByUsername(ctx, "").Return(nil, errAny)
+	mocks.userRepo.EXPECT().UserByUsername(ctx, tenantID, notExistUsername).Return(nil, app.ErrNotFound)
+	mocks.userRepo.EXPECT().UserByUsername(ctx, tenantID, user.Name).Return(&user, nil)
+	mocks.userRepo.EXPECT().UserByUsername(ctx, tenantID, "").Return(nil, errAny)
 
 	testCases := map[string]struct {
 		username string
@@ -63,7 +71,7 @@ func TestApp_VerificationUsername(t *testing.T) {
 	for name, tc := range testCases {
 		name, tc := name, tc
 		t.Run(name, func(t *testing.T) {
-			err := application.VerificationUsername(ctx, tc.username)
+			err := application.VerificationUsername(ctx, tenantID, tc.username)
 			assert.Equal(t, tc.want, err)
 		})
 	}
@@ -87,16 +95,22 @@ func TestApp_Login(t *testing.T) {
 	notValidTokenExpireForGenerateNotValidTokenID := time.Second
 	notValidTokenExpired := time.Second * 2
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, strings.ToLower(user.Email)).Return(&user, nil).Times(4)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, strings.ToLower(user.Email)).Return(&user, nil).Times(4)
 	mocks.password.EXPECT().Compare(user.PassHash, []byte(password)).Return(true).Times(3)
 	mocks.auth.EXPECT().Token(app.TokenExpire).Return(token, tokenID, nil)
 	mocks.sessionRepo.EXPECT().SaveSession(ctx, user.ID, tokenID, origin).Return(nil)
+	mocks.deliverRepo.EXPECT().LastEmailEvent(ctx, user.Email).Return(&app.EmailEvent{
+		Email: user.Email,
+		Kind:  app.EmailBounce,
+	}, nil)
+	undeliverable := user
+	undeliverable.EmailUndeliverable = true
 
 	mocks.auth.EXPECT().Token(notValidTokenExpireForGenerateNotValidTokenID).Return(token, notValidTokenID, nil)
 	mocks.sessionRepo.EXPECT().SaveSession(ctx, user.ID, notValidTokenID, origin).Return(errAny)
 	mocks.auth.EXPECT().Token(notValidTokenExpired).Return(app.AuthToken(""), app.TokenID(""), errAny)
 	mocks.password.EXPECT().Compare(user.PassHash, []byte(notValidPass)).Return(false)
-	mocks.userRepo.EXPECT().UserByEmail(ctx, strings.ToLower(notExistEmail)).Return(nil, app.ErrNotFound)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, strings.ToLower(notExistEmail)).Return(nil, app.ErrNotFound)
 
 	testCases := map[string]struct {
 		email       string
@@ -106,7 +120,7 @@ func TestApp_Login(t *testing.T) {
 		wantToken   app.AuthToken
 		wantErr     error
 	}{
-		"success":               {user.Email, password, app.TokenExpire, &user, token, nil},
+		"success":               {user.Email, password, app.TokenExpire, &undeliverable, token, nil},
 		"err from save session": {user.Email, password, notValidTokenExpireForGenerateNotValidTokenID, nil, "", errAny},
 		"err from gen token":    {user.Email, password, notValidTokenExpired, nil, "", errAny},
 		"err from compare pass": {user.Email, notValidPass, app.TokenExpire, nil, "", app.ErrNotValidPassword},
@@ -118,7 +132,7 @@ func TestApp_Login(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			app.TokenExpire = tc.tokenExpire
 
-			user, token, err := application.Login(ctx, tc.email, tc.password, origin)
+			user, token, err := application.Login(ctx, tenantID, tc.email, tc.password, origin)
 			if tc.wantErr == nil {
 				assert.Nil(t, err)
 				assert.Equal(t, tc.want, user)
@@ -146,31 +160,38 @@ func TestApp_CreateUser(t *testing.T) {
 	user := userGen(t)
 	origin := newOrigin()
 	task := app.TaskNotification{
-		Email: user.Email,
-		Kind:  app.Welcome,
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
 	}
 	notValidTask := app.TaskNotification{
-		Email: strings.ToLower(notValidEmail),
-		Kind:  app.Welcome,
+		TenantID: tenantID,
+		Email:    strings.ToLower(notValidEmail),
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
 	}
 	tokenExpire := 24 * 7 * time.Hour
 
 	mocks.password.EXPECT().Hashing(password).Return([]byte(password), nil).Times(2)
-	mocks.userRepo.EXPECT().CreateUser(ctx, app.User{
+	mocks.userRepo.EXPECT().CreateUser(ctx, newUserMatcher{app.User{
+		TenantID: tenantID,
 		Email:    user.Email,
 		Name:     user.Name,
 		PassHash: []byte(password),
-	}, task).Return(user.ID, nil)
+	}}, task).Return(user.ID, nil)
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, user.Email).Return(&user, nil)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, user.TenantID, user.Email).Return(&user, nil)
 	mocks.password.EXPECT().Compare(user.PassHash, []byte(password)).Return(true)
 	mocks.auth.EXPECT().Token(tokenExpire).Return(token, tokenID, nil)
 	mocks.sessionRepo.EXPECT().SaveSession(ctx, user.ID, tokenID, origin).Return(nil)
-	mocks.userRepo.EXPECT().CreateUser(ctx, app.User{
+	mocks.deliverRepo.EXPECT().LastEmailEvent(ctx, user.Email).Return(nil, app.ErrNotFound)
+	mocks.userRepo.EXPECT().CreateUser(ctx, newUserMatcher{app.User{
+		TenantID: tenantID,
 		Email:    strings.ToLower(notValidEmail),
 		Name:     user.Name,
 		PassHash: []byte(password),
-	}, notValidTask).Return(app.UserID(0), errAny)
+	}}, notValidTask).Return(app.UserID(0), errAny)
 	mocks.password.EXPECT().Hashing(notCorrectPass).Return(nil, errAny)
 
 	testCases := map[string]struct {
@@ -191,7 +212,7 @@ func TestApp_CreateUser(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			app.TokenExpire = tokenExpire
 
-			user, token, err := application.CreateUser(ctx, tc.email, tc.username, tc.password, origin)
+			user, token, err := application.CreateUser(ctx, tenantID, tc.email, tc.username, tc.password, origin)
 			if tc.wantErr == nil {
 				assert.Nil(t, err)
 				assert.Equal(t, tc.want, user)
@@ -205,6 +226,40 @@ func TestApp_CreateUser(t *testing.T) {
 	}
 }
 
+func TestApp_User(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	otherTenantUser := userGen(t)
+	otherTenantUser.TenantID = app.DefaultTenant
+
+	mocks.userRepo.EXPECT().UserByPublicID(ctx, user.PublicID).Return(&user, nil)
+	mocks.userRepo.EXPECT().UserByPublicID(ctx, otherTenantUser.PublicID).Return(&otherTenantUser, nil)
+	mocks.userRepo.EXPECT().UserByPublicID(ctx, app.PublicID("")).Return(nil, errAny)
+
+	testCases := map[string]struct {
+		publicID app.PublicID
+		want     *app.User
+		wantErr  error
+	}{
+		"success":      {user.PublicID, &user, nil},
+		"other tenant": {otherTenantUser.PublicID, nil, app.ErrNotFound},
+		"any error":    {"", nil, errAny},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			res, err := application.User(ctx, app.AuthUser{User: user}, tc.publicID)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
 func TestApp_UpdateUsername(t *testing.T) {
 	t.Parallel()
 
@@ -212,20 +267,22 @@ func TestApp_UpdateUsername(t *testing.T) {
 	defer shutdown()
 
 	user := userGen(t)
-	mocks.userRepo.EXPECT().UpdateUsername(ctx, user.ID, notExistUsername).Return(nil)
+	mocks.userRepo.EXPECT().UpdateUsername(ctx, user.ID, notExistUsername, user.Version).Return(nil)
+	mocks.userRepo.EXPECT().UpdateUsername(ctx, user.ID, username, user.Version).Return(app.ErrVersionMismatch)
 
 	testCases := map[string]struct {
 		username string
 		want     error
 	}{
-		"success":         {notExistUsername, nil},
-		"usernames equal": {user.Name, app.ErrUsernameNeedDifferentiate},
+		"success":          {notExistUsername, nil},
+		"usernames equal":  {user.Name, app.ErrUsernameNeedDifferentiate},
+		"version mismatch": {username, app.ErrVersionMismatch},
 	}
 
 	for name, tc := range testCases {
 		name, tc := name, tc
 		t.Run(name, func(t *testing.T) {
-			err := application.UpdateUsername(ctx, app.AuthUser{User: user}, tc.username)
+			err := application.UpdateUsername(ctx, app.AuthUser{User: user}, tc.username, user.Version)
 			assert.Equal(t, tc.want, err)
 		})
 	}
@@ -240,10 +297,13 @@ func TestApp_UpdateEmail(t *testing.T) {
 	user := userGen(t)
 	notExistEmail := notExistEmail
 	task := app.TaskNotification{
-		Email: strings.ToLower(notExistEmail),
-		Kind:  app.ChangeEmail,
+		TenantID: tenantID,
+		Email:    strings.ToLower(notExistEmail),
+		Kind:     app.ChangeEmail,
+		Payload:  &app.ChangeEmailPayload{},
 	}
-	mocks.userRepo.EXPECT().UpdateEmail(ctx, user.ID, strings.ToLower(notExistEmail), task).Return(nil)
+	authUser := cachedAuthUser(mocks, user)
+	mocks.userRepo.EXPECT().UpdateEmail(ctx, user.ID, strings.ToLower(notExistEmail), task, user.Version).Return(nil)
 
 	testCases := map[string]struct {
 		email string
@@ -256,7 +316,7 @@ func TestApp_UpdateEmail(t *testing.T) {
 	for name, tc := range testCases {
 		name, tc := name, tc
 		t.Run(name, func(t *testing.T) {
-			err := application.UpdateEmail(ctx, app.AuthUser{User: user}, tc.email)
+			err := application.UpdateEmail(ctx, authUser, tc.email, user.Version)
 			assert.Equal(t, tc.want, err)
 		})
 	}
@@ -271,7 +331,8 @@ func TestApp_UpdatePassword(t *testing.T) {
 	const notValidPass = "notValidPass"
 
 	user := userGen(t)
-	mocks.userRepo.EXPECT().UpdatePassword(ctx, user.ID, []byte(password)).Return(nil)
+	authUser := cachedAuthUser(mocks, user)
+	mocks.userRepo.EXPECT().UpdatePassword(ctx, user.ID, []byte(password), user.Version).Return(nil)
 	mocks.password.EXPECT().Compare(user.PassHash, []byte(password)).Return(true).Times(2)
 	mocks.password.EXPECT().Compare(user.PassHash, []byte(notValidPass)).Return(false).Times(1)
 	mocks.password.EXPECT().Hashing(password).Return([]byte(password), nil)
@@ -289,7 +350,7 @@ func TestApp_UpdatePassword(t *testing.T) {
 	for name, tc := range testCases {
 		name, tc := name, tc
 		t.Run(name, func(t *testing.T) {
-			err := application.UpdatePassword(ctx, app.AuthUser{User: user}, tc.oldPass, tc.newPass)
+			err := application.UpdatePassword(ctx, authUser, tc.oldPass, tc.newPass, user.Version)
 			assert.Equal(t, tc.want, err)
 		})
 	}
@@ -303,35 +364,73 @@ func TestApp_CreateRecoveryCode(t *testing.T) {
 
 	const codeLength = 6
 	user := userGen(t)
+	userWithPhone := userGen(t)
+	userWithPhone.Phone = phone
 	recoveryCode := recoveryCode
 	notExistEmail := notExistEmail
 	task := app.TaskNotification{
-		Email: user.Email,
-		Kind:  app.PassRecovery,
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	}
+	smsTask := app.TaskNotification{
+		TenantID: userWithPhone.TenantID,
+		Email:    userWithPhone.Email,
+		Kind:     app.PassRecoverySMS,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
 	}
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, user.Email).Return(&user, nil)
-	mocks.code.EXPECT().Generate(codeLength).Return(recoveryCode)
-	mocks.codeRepo.EXPECT().SaveCode(ctx, user.Email, recoveryCode, task).Return(nil)
-	mocks.userRepo.EXPECT().UserByEmail(ctx, strings.ToLower(notExistEmail)).Return(nil, app.ErrNotFound)
+	gomock.InOrder(
+		mocks.userRepo.EXPECT().UserByEmail(ctx, user.TenantID, user.Email).Return(&user, nil),
+		mocks.code.EXPECT().Generate(codeLength).Return(recoveryCode),
+		mocks.codeRepo.EXPECT().SaveCode(ctx, tenantID, user.Email, recoveryCode, task).Return(nil),
+		mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, strings.ToLower(notExistEmail)).Return(nil, app.ErrNotFound),
+		mocks.userRepo.EXPECT().UserByEmail(ctx, userWithPhone.TenantID, userWithPhone.Email).Return(&userWithPhone, nil),
+		mocks.code.EXPECT().Generate(codeLength).Return(recoveryCode),
+		mocks.codeRepo.EXPECT().SaveCode(ctx, tenantID, userWithPhone.Email, recoveryCode, smsTask).Return(nil),
+		mocks.userRepo.EXPECT().UserByEmail(ctx, user.TenantID, user.Email).Return(&user, nil),
+		mocks.userRepo.EXPECT().UserByEmail(ctx, user.TenantID, user.Email).Return(&user, nil),
+	)
 
-	testCases := map[string]struct {
-		email string
-		want  error
+	testCases := []struct {
+		name    string
+		email   string
+		channel app.Channel
+		want    error
 	}{
-		"success":        {user.Email, nil},
-		"user not found": {notExistEmail, app.ErrNotFound},
+		{"success", user.Email, app.ChannelEmail, nil},
+		{"user not found", notExistEmail, app.ChannelEmail, app.ErrNotFound},
+		{"success sms", userWithPhone.Email, app.ChannelSMS, nil},
+		{"phone not verified", user.Email, app.ChannelSMS, app.ErrPhoneNotVerified},
+		{"unknown channel", user.Email, app.ChannelInbox, app.ErrUnknownChannel},
 	}
 
-	for name, tc := range testCases {
-		name, tc := name, tc
-		t.Run(name, func(t *testing.T) {
-			err := application.CreateRecoveryCode(ctx, tc.email)
-			assert.Equal(t, tc.want, err)
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := application.CreateRecoveryCode(ctx, tenantID, tc.email, tc.channel)
+			assert.True(t, errors.Is(err, tc.want))
 		})
 	}
 }
 
+func TestApp_CreateRecoveryCodeSMSDisabled(t *testing.T) {
+	t.Parallel()
+
+	_, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	user.Phone = phone
+	application := app.New(app.Config{UserRepo: mocks.userRepo})
+
+	mocks.userRepo.EXPECT().UserByEmail(ctx, user.TenantID, user.Email).Return(&user, nil)
+
+	err := application.CreateRecoveryCode(ctx, tenantID, user.Email, app.ChannelSMS)
+	assert.Equal(t, app.ErrSMSDisabled, err)
+}
+
 func TestApp_RecoveryPassword(t *testing.T) {
 	t.Parallel()
 
@@ -350,30 +449,30 @@ func TestApp_RecoveryPassword(t *testing.T) {
 	emailForNotValidCode := "notValidCode@test.test"
 	emailForNotExistCode := "notExistCode@test.test"
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, user.Email).Return(&user, nil).Times(2)
-	mocks.codeRepo.EXPECT().Code(ctx, user.Email).Return(&codeInfo, nil).Times(2)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, user.TenantID, user.Email).Return(&user, nil).Times(2)
+	mocks.codeRepo.EXPECT().Code(ctx, tenantID, user.Email).Return(&codeInfo, nil).Times(2)
 	mocks.password.EXPECT().Hashing(newPassword).Return([]byte(newPassword), nil)
-	mocks.userRepo.EXPECT().UpdatePassword(ctx, user.ID, []byte(newPassword)).Return(nil)
+	mocks.userRepo.EXPECT().UpdatePassword(ctx, user.ID, []byte(newPassword), 0).Return(nil)
 
 	mocks.password.EXPECT().Hashing(notValidPass).Return(nil, errAny)
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, emailForExpiredCode).Return(&user, nil)
-	mocks.codeRepo.EXPECT().Code(ctx, emailForExpiredCode).Return(&app.CodeInfo{
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, emailForExpiredCode).Return(&user, nil)
+	mocks.codeRepo.EXPECT().Code(ctx, tenantID, emailForExpiredCode).Return(&app.CodeInfo{
 		Code:      codeInfo.Code,
 		Email:     codeInfo.Email,
 		CreatedAt: time.Time{},
 	}, nil)
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, emailForNotValidCode).Return(&user, nil)
-	mocks.codeRepo.EXPECT().Code(ctx, emailForNotValidCode).Return(&app.CodeInfo{
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, emailForNotValidCode).Return(&user, nil)
+	mocks.codeRepo.EXPECT().Code(ctx, tenantID, emailForNotValidCode).Return(&app.CodeInfo{
 		Code:      "any code",
 		Email:     codeInfo.Email,
 		CreatedAt: codeInfo.CreatedAt,
 	}, nil)
 
-	mocks.userRepo.EXPECT().UserByEmail(ctx, emailForNotExistCode).Return(&user, nil)
-	mocks.codeRepo.EXPECT().Code(ctx, emailForNotExistCode).Return(nil, app.ErrNotFound)
-	mocks.userRepo.EXPECT().UserByEmail(ctx, notExistEmail).Return(nil, app.ErrNotFound)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, emailForNotExistCode).Return(&user, nil)
+	mocks.codeRepo.EXPECT().Code(ctx, tenantID, emailForNotExistCode).Return(nil, app.ErrNotFound)
+	mocks.userRepo.EXPECT().UserByEmail(ctx, tenantID, notExistEmail).Return(nil, app.ErrNotFound)
 
 	testCases := map[string]struct {
 		email   string
@@ -391,7 +490,7 @@ func TestApp_RecoveryPassword(t *testing.T) {
 	for name, tc := range testCases {
 		name, tc := name, tc
 		t.Run(name, func(t *testing.T) {
-			err := application.RecoveryPassword(ctx, tc.email, recoveryCode, tc.newPass)
+			err := application.RecoveryPassword(ctx, tenantID, tc.email, recoveryCode, tc.newPass)
 			assert.Equal(t, tc.want, err)
 		})
 	}
@@ -414,10 +513,11 @@ func TestApp_UserByAuthToken(t *testing.T) {
 
 	mocks.auth.EXPECT().Parse(token).Return(tokenID, nil).Times(3)
 	mocks.auth.EXPECT().Parse(expiredToken).Return(app.TokenID(""), app.ErrExpiredToken)
-	mocks.sessionRepo.EXPECT().UserByTokenID(ctx, tokenID).Return(&user, nil).Times(2)
-	mocks.sessionRepo.EXPECT().UserByTokenID(ctx, tokenID).Return(nil, app.ErrNotFound)
-	mocks.sessionRepo.EXPECT().SessionByTokenID(ctx, tokenID).Return(&session, nil)
-	mocks.sessionRepo.EXPECT().SessionByTokenID(ctx, tokenID).Return(nil, errAny)
+	gomock.InOrder(
+		mocks.sessionRepo.EXPECT().AuthUserByTokenID(ctx, tokenID).Return(&auth, nil),
+		mocks.sessionRepo.EXPECT().AuthUserByTokenID(ctx, tokenID).Return(nil, errAny),
+		mocks.sessionRepo.EXPECT().AuthUserByTokenID(ctx, tokenID).Return(nil, app.ErrNotFound),
+	)
 
 	testCases := []struct {
 		name    string
@@ -427,8 +527,8 @@ func TestApp_UserByAuthToken(t *testing.T) {
 	}{
 		{"success", token, &auth, nil},
 		{"invalid token", "", nil, app.ErrInvalidToken},
-		{"err session by auth", token, nil, errAny},
-		{"not found user by auth", token, nil, app.ErrNotFound},
+		{"err auth user by token", token, nil, errAny},
+		{"not found auth user", token, nil, app.ErrNotFound},
 		{"not valid auth", expiredToken, nil, app.ErrExpiredToken},
 	}
 
@@ -446,3 +546,139 @@ func TestApp_UserByAuthToken(t *testing.T) {
 		})
 	}
 }
+
+func initAuthCacheTest(t *testing.T) (*app.Application, *Mocks, *mock.MockAuthCache, *mock.MockAuthCacheMetrics, func()) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := &Mocks{
+		userRepo:    mock.NewMockUserRepo(ctrl),
+		sessionRepo: mock.NewMockSessionRepo(ctrl),
+		auth:        mock.NewMockAuth(ctrl),
+	}
+	mockAuthCache := mock.NewMockAuthCache(ctrl)
+	mockAuthCacheMetrics := mock.NewMockAuthCacheMetrics(ctrl)
+	application := app.New(app.Config{
+		UserRepo:         mocks.userRepo,
+		SessionRepo:      mocks.sessionRepo,
+		Auth:             mocks.auth,
+		AuthCache:        mockAuthCache,
+		AuthCacheMetrics: mockAuthCacheMetrics,
+	})
+
+	return application, mocks, mockAuthCache, mockAuthCacheMetrics, ctrl.Finish
+}
+
+func TestApp_UserByAuthTokenCached(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, mockAuthCache, mockAuthCacheMetrics, shutdown := initAuthCacheTest(t)
+	defer shutdown()
+
+	auth := app.AuthUser{
+		User:    userGen(t),
+		Session: sessionGen(t),
+	}
+
+	mocks.auth.EXPECT().Parse(token).Return(tokenID, nil).Times(4)
+	gomock.InOrder(
+		mockAuthCache.EXPECT().AuthUser(ctx, tokenID).Return(&auth, nil),
+		mockAuthCacheMetrics.EXPECT().AuthCacheLookup(true),
+
+		mockAuthCache.EXPECT().AuthUser(ctx, tokenID).Return(nil, app.ErrNotFound),
+		mockAuthCacheMetrics.EXPECT().AuthCacheLookup(false),
+		mocks.sessionRepo.EXPECT().AuthUserByTokenID(gomock.Any(), tokenID).Do(readsPrimary(t)).Return(&auth, nil),
+		mockAuthCache.EXPECT().SaveAuthUser(ctx, auth).Return(nil),
+
+		mockAuthCache.EXPECT().AuthUser(ctx, tokenID).Return(nil, errAny),
+		mockAuthCacheMetrics.EXPECT().AuthCacheLookup(false),
+		mocks.sessionRepo.EXPECT().AuthUserByTokenID(gomock.Any(), tokenID).Do(readsPrimary(t)).Return(&auth, nil),
+		mockAuthCache.EXPECT().SaveAuthUser(ctx, auth).Return(errAny),
+
+		mockAuthCache.EXPECT().AuthUser(ctx, tokenID).Return(nil, app.ErrNotFound),
+		mockAuthCacheMetrics.EXPECT().AuthCacheLookup(false),
+		mocks.sessionRepo.EXPECT().AuthUserByTokenID(gomock.Any(), tokenID).Return(nil, app.ErrNotFound),
+	)
+
+	testCases := []struct {
+		name    string
+		want    *app.AuthUser
+		wantErr error
+	}{
+		{"hit", &auth, nil},
+		{"miss", &auth, nil},
+		{"err cache", &auth, nil},
+		{"not found", nil, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			user, err := application.UserByAuthToken(ctx, token)
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, user)
+			} else {
+				assert.Nil(t, user)
+				assert.True(t, errors.Is(err, tc.wantErr))
+			}
+		})
+	}
+}
+
+// readsPrimary checks that the repository is called with the context reading the primary.
+func readsPrimary(t *testing.T) func(context.Context, app.TokenID) {
+	t.Helper()
+
+	return func(ctx context.Context, _ app.TokenID) {
+		assert.True(t, consistency.Written(ctx), "the cache is refilled from the primary")
+	}
+}
+
+func TestApp_AuthCacheInvalidation(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, mockAuthCache, _, shutdown := initAuthCacheTest(t)
+	defer shutdown()
+
+	authUser := app.AuthUser{
+		User:    userGen(t),
+		Session: sessionGen(t),
+	}
+
+	gomock.InOrder(
+		mocks.sessionRepo.EXPECT().DeleteSession(ctx, authUser.Session.TokenID).Return(nil),
+		mockAuthCache.EXPECT().DeleteAuthToken(ctx, authUser.Session.TokenID).Return(nil),
+		mocks.sessionRepo.EXPECT().DeleteSession(ctx, authUser.Session.TokenID).Return(errAny),
+		mocks.userRepo.EXPECT().DeleteUser(ctx, authUser.ID).Return(nil),
+		mockAuthCache.EXPECT().DeleteAuthUser(ctx, authUser.ID).Return(errAny),
+		mocks.userRepo.EXPECT().UpdateUsername(ctx, authUser.ID, username, 0).Return(nil),
+		mockAuthCache.EXPECT().DeleteAuthUser(ctx, authUser.ID).Return(nil),
+	)
+
+	err := application.Logout(ctx, authUser)
+	assert.Nil(t, err)
+	err = application.Logout(ctx, authUser)
+	assert.Equal(t, errAny, err)
+	err = application.DeleteUser(ctx, authUser)
+	assert.True(t, errors.Is(err, errAny))
+	err = application.UpdateUsername(ctx, authUser, username, 0)
+	assert.Nil(t, err)
+}
+
+// newUserMatcher matches the new user with a random public id.
+type newUserMatcher struct{ want app.User }
+
+var uuidV4 = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func (m newUserMatcher) Matches(x interface{}) bool {
+	u, ok := x.(app.User)
+	if !ok || !uuidV4.MatchString(string(u.PublicID)) {
+		return false
+	}
+	u.PublicID = ""
+
+	return reflect.DeepEqual(m.want, u)
+}
+
+func (m newUserMatcher) String() string { return fmt.Sprintf("is %v with a random public id", m.want) }
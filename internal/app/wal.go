@@ -3,41 +3,104 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 )
 
 type (
-	// WALApplication a provider to run tasks.
-	WALApplication interface {
-		// StartWALNotification starts the task of notifying users.
-		StartWALNotification(ctx context.Context) error
-	}
 	// WAL module returning tasks and also closing them.
+	// A JobNotification job is created in the same transaction as the task, the job executes it.
 	WAL interface {
-		// NotificationTask returns the earliest task that has not been completed.
-		// Errors: ErrNotFound, unknown.
-		NotificationTask(ctx context.Context) (task *TaskNotification, err error)
 		// DeleteTaskNotification removes the task performed.
 		// Errors: unknown.
 		DeleteTaskNotification(ctx context.Context, id int) error
+		// SaveTaskNotificationError saves the error of the last task execution, the task stays pending.
+		// Errors: unknown.
+		SaveTaskNotificationError(ctx context.Context, id int, taskErr error) error
+		// CreateTaskNotification adds a new pending task with the job executing it.
+		// Errors: unknown.
+		CreateTaskNotification(ctx context.Context, task TaskNotification) (id int, err error)
+		// CancelTaskNotification cancels the pending task.
+		// Errors: ErrNotFound, unknown.
+		CancelTaskNotification(ctx context.Context, id int) error
+		// CancelTaskNotifications cancels all pending tasks of the kind for the recipient of the tenant.
+		// Returns the number of cancelled tasks.
+		// Errors: unknown.
+		CancelTaskNotifications(ctx context.Context, tenantID TenantID, email string, kind MessageKind) (int, error)
+		// CollapseTaskNotifications cancels pending tasks of the same kind and recipient as the task
+		// which run time has come, except the latest one. Returns ids of cancelled tasks.
+		// Errors: unknown.
+		CollapseTaskNotifications(ctx context.Context, task TaskNotification) ([]int, error)
+		// SuppressTaskNotification cancels the task saving the reason as its error.
+		// Errors: unknown.
+		SuppressTaskNotification(ctx context.Context, id int, reason error) error
+		// ExecutedTaskNotificationCount returns the number of tasks of the kind executed for the recipient
+		// of the tenant since the time.
+		// Errors: unknown.
+		ExecutedTaskNotificationCount(ctx context.Context, tenantID TenantID, email string, kind MessageKind, since time.Time) (int, error)
+		// TaskNotificationByID returns the task with any status.
+		// Errors: ErrNotFound, unknown.
+		TaskNotificationByID(ctx context.Context, id int) (*TaskNotificationInfo, error)
+		// ListTaskNotification returns tasks matching the filter, latest first.
+		// Errors: unknown.
+		ListTaskNotification(ctx context.Context, filter TaskFilter, page Page) ([]TaskNotificationInfo, int, error)
+	}
+	// TaskNotificationInfo contains the task with its execution state.
+	TaskNotificationInfo struct {
+		TaskNotification
+		Status    TaskStatus
+		Error     string
+		CreatedAt time.Time
+		// ExecTime is zero if the task has not been executed.
+		ExecTime time.Time
+		// Provider is the name of the provider which delivered the email, empty if none did.
+		Provider string
+	}
+	// TaskFilter contains the conditions for searching tasks, zero values match any task.
+	TaskFilter struct {
+		Email  string
+		Kind   MessageKind
+		Status TaskStatus
 	}
+	// TaskStatus is a state of the notification task.
+	TaskStatus string
+)
+
+// Task statuses.
+const (
+	TaskPending   TaskStatus = "pending"
+	TaskDone      TaskStatus = "done"
+	TaskCancelled TaskStatus = "cancelled"
 )
 
-// StartWALNotification for implemented WALApplication.
-func (a *Application) StartWALNotification(ctx context.Context) error {
-	for ctx.Err() == nil {
-		task, err := a.wal.NotificationTask(ctx)
-		switch {
-		case err == nil:
-			err := a.execNotification(ctx, *task)
-			if err != nil {
-				return err
-			}
-		case errors.Is(err, ErrNotFound):
-			wait(ctx)
-		default:
-			return err
-		}
+// ParseTaskStatus returns TaskStatus by its name.
+// Errors: ErrUnknownTaskStatus.
+func ParseTaskStatus(name string) (TaskStatus, error) {
+	switch status := TaskStatus(name); status {
+	case TaskPending, TaskDone, TaskCancelled:
+		return status, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownTaskStatus, name)
+	}
+}
+
+// runNotificationJob executes the notification task of the job,
+// tasks which are not pending anymore are skipped.
+func (a *Application) runNotificationJob(ctx context.Context, payload JobPayload) error {
+	p, ok := payload.(*NotificationJobPayload)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrInvalidJobPayload, payload)
+	}
+
+	task, err := a.wal.TaskNotificationByID(ctx, p.TaskID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case task.Status != TaskPending:
+		return nil
 	}
 
-	return ctx.Err()
+	return a.execNotification(ctx, task.TaskNotification)
 }
@@ -1,39 +1,316 @@
 package app_test
 
-//func TestApp_StartWALNotification(t *testing.T) {
-//	t.Parallel()
-//
-//	application, mocks, shutdown := initTest(t)
-//	defer shutdown()
-//
-//	msg := app.Message{
-//		Kind:    app.Welcome,
-//		Content: "Welcome",
-//	}
-//
-//	mocks.userRepo.EXPECT().UserByID(gomock.Any(), taskNotification.UserID).Return(&user1, nil).Times(3)
-//	mocks.wal.EXPECT().NotificationTask(gomock.Any()).Return(&taskNotification, nil).Times(3)
-//	mocks.notification.EXPECT().Notification(user1.Email, msg).Return(nil).Times(2)
-//	mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), taskNotification.ID).Return(nil)
-//	mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), taskNotification.ID).Return(errAny)
-//	mocks.notification.EXPECT().Notification(user1.Email, msg).Return(errAny)
-//	mocks.wal.EXPECT().NotificationTask(gomock.Any()).Return(nil, app.ErrNotFound)
-//	mocks.wal.EXPECT().NotificationTask(gomock.Any()).Return(nil, errAny)
-//
-//	testCases := []struct {
-//		name string
-//		want error
-//	}{
-//		{"err delete task", errAny},
-//		{"err send notification", errAny},
-//		{"err get notification task", errAny},
-//	}
-//
-//	for _, tc := range testCases {
-//		tc := tc
-//		t.Run(tc.name, func(t *testing.T) {
-//			err := application.StartWALNotification(ctx)
-//			assert.Equal(t, tc.want, err)
-//		})
-//	}
-//}
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_RunNotificationJob(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	user := userGen(t)
+	welcomeTask := app.TaskNotification{
+		ID:       1,
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	}
+	recoveryTask := app.TaskNotification{
+		ID:       2,
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	}
+	unknownTask := app.TaskNotification{
+		ID:       3,
+		TenantID: user.TenantID,
+		Email:    user.Email,
+		Kind:     app.MessageKind(0),
+	}
+	invitePayload := &app.OrgInvitePayload{Org: "org", Link: "link"}
+	inviteTask := app.TaskNotification{
+		ID:       4,
+		TenantID: user.TenantID,
+		Email:    notExistEmail,
+		Kind:     app.OrgInvite,
+		Payload:  invitePayload,
+	}
+	inviteMsg := app.Message{
+		TaskID:   inviteTask.ID,
+		TenantID: inviteTask.TenantID,
+		Kind:     app.OrgInvite,
+		Content:  invitePayload.Content(),
+	}
+	const unsubscribeToken = "unsubscribeToken"
+	welcomeMsg := app.Message{
+		TaskID:           welcomeTask.ID,
+		TenantID:         welcomeTask.TenantID,
+		Kind:             app.Welcome,
+		Content:          "Welcome",
+		UnsubscribeToken: unsubscribeToken,
+	}
+
+	bounce := app.EmailEvent{
+		Email: user.Email,
+		Kind:  app.EmailBounce,
+	}
+
+	welcomeJob := app.NewNotificationJob(welcomeTask.ID, welcomeTask)
+	welcomeJob.ID = 1
+	recoveryJob := app.NewNotificationJob(recoveryTask.ID, recoveryTask)
+	recoveryJob.ID = 2
+	unknownJob := app.NewNotificationJob(unknownTask.ID, unknownTask)
+	unknownJob.ID = 3
+	cancelled := pending(welcomeTask)
+	cancelled.Status = app.TaskCancelled
+	invalidJob := app.Job{ID: 4, Kind: app.JobNotification, Payload: &app.WebhookDeliveryJobPayload{}}
+	inviteJob := app.NewNotificationJob(inviteTask.ID, inviteTask)
+	inviteJob.ID = 5
+
+	gomock.InOrder(
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(cancelled, nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), welcomeJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(nil, app.ErrNotFound),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), welcomeJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&invalidJob, nil),
+		mocks.jobRepo.EXPECT().FailJob(gomock.Any(), invalidJob.ID, errMatcher{app.ErrInvalidJobPayload}).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(nil, errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), welcomeJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(pending(welcomeTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), welcomeTask).Return(nil, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(nil, app.ErrNotFound),
+		mocks.userRepo.EXPECT().UserByEmail(gomock.Any(), user.TenantID, user.Email).Return(&user, nil),
+		mocks.settingsRepo.EXPECT().NotificationSettings(gomock.Any(), user.ID).Return(nil, nil),
+		mocks.auth.EXPECT().UnsubscribeToken(user.PublicID, app.Welcome).Return(unsubscribeToken, nil),
+		mocks.inbox.EXPECT().Notification(user.Email, welcomeMsg).Return(nil),
+		mocks.notification.EXPECT().Notification(user.Email, welcomeMsg).Return(nil),
+		mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), welcomeTask.ID).Return(nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), welcomeJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(pending(welcomeTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), welcomeTask).Return(nil, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(nil, app.ErrNotFound),
+		mocks.userRepo.EXPECT().UserByEmail(gomock.Any(), user.TenantID, user.Email).Return(&user, nil),
+		mocks.settingsRepo.EXPECT().NotificationSettings(gomock.Any(), user.ID).Return([]app.NotificationSetting{
+			{Kind: app.Welcome, Enabled: false},
+		}, nil),
+		mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), welcomeTask.ID).Return(nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), welcomeJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return([]int{recoveryTask.ID}, nil),
+		mocks.metrics.EXPECT().NotificationSuppressed(app.PassRecovery, app.SuppressDuplicate),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), recoveryJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return([]int{recoveryTask.ID - 1}, nil),
+		mocks.metrics.EXPECT().NotificationSuppressed(app.PassRecovery, app.SuppressDuplicate),
+		mocks.wal.EXPECT().ExecutedTaskNotificationCount(gomock.Any(), user.TenantID, user.Email, app.PassRecovery, gomock.Any()).
+			Return(recoveryLimit, nil),
+		mocks.wal.EXPECT().SuppressTaskNotification(gomock.Any(), recoveryTask.ID, app.ErrNotificationRateLimit).Return(nil),
+		mocks.metrics.EXPECT().NotificationSuppressed(app.PassRecovery, app.SuppressRateLimit),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), recoveryJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return(nil, nil),
+		mocks.wal.EXPECT().ExecutedTaskNotificationCount(gomock.Any(), user.TenantID, user.Email, app.PassRecovery, gomock.Any()).
+			Return(recoveryLimit-1, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(nil, app.ErrNotFound),
+		mocks.notification.EXPECT().Notification(user.Email, app.Message{
+			TaskID:   recoveryTask.ID,
+			TenantID: recoveryTask.TenantID,
+			Kind:     app.PassRecovery,
+			Content:  recoveryCode,
+		}).Return(nil),
+		mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), recoveryTask.ID).Return(errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), recoveryJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return(nil, nil),
+		mocks.wal.EXPECT().ExecutedTaskNotificationCount(gomock.Any(), user.TenantID, user.Email, app.PassRecovery, gomock.Any()).Return(0, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(nil, app.ErrNotFound),
+		mocks.notification.EXPECT().Notification(user.Email, gomock.Any()).Return(errAny),
+		mocks.wal.EXPECT().SaveTaskNotificationError(gomock.Any(), recoveryTask.ID, errAny).Return(nil),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), recoveryJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(pending(welcomeTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), welcomeTask).Return(nil, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(nil, app.ErrNotFound),
+		mocks.userRepo.EXPECT().UserByEmail(gomock.Any(), user.TenantID, user.Email).Return(&user, nil),
+		mocks.settingsRepo.EXPECT().NotificationSettings(gomock.Any(), user.ID).Return(nil, nil),
+		mocks.auth.EXPECT().UnsubscribeToken(user.PublicID, app.Welcome).Return(unsubscribeToken, nil),
+		mocks.inbox.EXPECT().Notification(user.Email, welcomeMsg).Return(errAny),
+		mocks.wal.EXPECT().SaveTaskNotificationError(gomock.Any(), welcomeTask.ID, errAny).Return(nil),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), welcomeJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&unknownJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), unknownTask.ID).Return(pending(unknownTask), nil),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), unknownJob.ID, errMatcher{app.ErrNotUnknownKindTask}, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(pending(welcomeTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), welcomeTask).Return(nil, errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), welcomeJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return(nil, nil),
+		mocks.wal.EXPECT().ExecutedTaskNotificationCount(gomock.Any(), user.TenantID, user.Email, app.PassRecovery, gomock.Any()).Return(0, errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), recoveryJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return(nil, nil),
+		mocks.wal.EXPECT().ExecutedTaskNotificationCount(gomock.Any(), user.TenantID, user.Email, app.PassRecovery, gomock.Any()).
+			Return(recoveryLimit, nil),
+		mocks.wal.EXPECT().SuppressTaskNotification(gomock.Any(), recoveryTask.ID, app.ErrNotificationRateLimit).Return(errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), recoveryJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(pending(welcomeTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), welcomeTask).Return(nil, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(&bounce, nil),
+		mocks.userRepo.EXPECT().UserByEmail(gomock.Any(), user.TenantID, user.Email).Return(&user, nil),
+		mocks.settingsRepo.EXPECT().NotificationSettings(gomock.Any(), user.ID).Return(nil, nil),
+		mocks.auth.EXPECT().UnsubscribeToken(user.PublicID, app.Welcome).Return(unsubscribeToken, nil),
+		mocks.inbox.EXPECT().Notification(user.Email, welcomeMsg).Return(nil),
+		mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), welcomeTask.ID).Return(nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), welcomeJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return(nil, nil),
+		mocks.wal.EXPECT().ExecutedTaskNotificationCount(gomock.Any(), user.TenantID, user.Email, app.PassRecovery, gomock.Any()).Return(0, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(&bounce, nil),
+		mocks.wal.EXPECT().SuppressTaskNotification(gomock.Any(), recoveryTask.ID, app.ErrUndeliverable).Return(nil),
+		mocks.metrics.EXPECT().NotificationSuppressed(app.PassRecovery, app.SuppressUndeliverable),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), recoveryJob.ID).Return(nil),
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&welcomeJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), welcomeTask.ID).Return(pending(welcomeTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), welcomeTask).Return(nil, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(nil, errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), welcomeJob.ID, errAny, gomock.Any()).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&recoveryJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), recoveryTask.ID).Return(pending(recoveryTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), recoveryTask).Return(nil, nil),
+		mocks.wal.EXPECT().ExecutedTaskNotificationCount(gomock.Any(), user.TenantID, user.Email, app.PassRecovery, gomock.Any()).Return(0, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), user.Email).Return(&bounce, nil),
+		mocks.wal.EXPECT().SuppressTaskNotification(gomock.Any(), recoveryTask.ID, app.ErrUndeliverable).Return(errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), recoveryJob.ID, errAny, gomock.Any()).Return(nil),
+
+		// Invitations are sent to emails without users.
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&inviteJob, nil),
+		mocks.wal.EXPECT().TaskNotificationByID(gomock.Any(), inviteTask.ID).Return(pending(inviteTask), nil),
+		mocks.wal.EXPECT().CollapseTaskNotifications(gomock.Any(), inviteTask).Return(nil, nil),
+		mocks.deliverRepo.EXPECT().LastEmailEvent(gomock.Any(), notExistEmail).Return(nil, app.ErrNotFound),
+		mocks.userRepo.EXPECT().UserByEmail(gomock.Any(), user.TenantID, notExistEmail).Return(nil, app.ErrNotFound),
+		mocks.inbox.EXPECT().Notification(notExistEmail, inviteMsg).Return(nil),
+		mocks.notification.EXPECT().Notification(notExistEmail, inviteMsg).Return(nil),
+		mocks.wal.EXPECT().DeleteTaskNotification(gomock.Any(), inviteTask.ID).Return(nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), inviteJob.ID).Return(nil),
+
+		mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(nil, errAny),
+	)
+
+	err := application.StartJobs(ctx)
+	assert.Equal(t, errAny, err)
+}
+
+func pending(task app.TaskNotification) *app.TaskNotificationInfo {
+	return &app.TaskNotificationInfo{
+		TaskNotification: task,
+		Status:           app.TaskPending,
+	}
+}
+
+func TestParseMessageKind(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		want    app.MessageKind
+		wantErr error
+	}{
+		{app.Welcome.String(), app.Welcome, nil},
+		{app.ChangeEmail.String(), app.ChangeEmail, nil},
+		{app.PassRecovery.String(), app.PassRecovery, nil},
+		{app.PhoneVerification.String(), app.PhoneVerification, nil},
+		{"LoginAlert", 0, app.ErrNotUnknownKindTask},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			kind, err := app.ParseMessageKind(tc.name)
+			assert.Equal(t, tc.want, kind)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestParseTaskStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		want    app.TaskStatus
+		wantErr error
+	}{
+		{"pending", app.TaskPending, nil},
+		{"done", app.TaskDone, nil},
+		{"cancelled", app.TaskCancelled, nil},
+		{"failed", "", app.ErrUnknownTaskStatus},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := app.ParseTaskStatus(tc.name)
+			assert.Equal(t, tc.want, status)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		want    app.RateLimit
+		wantErr error
+	}{
+		{"3/1h", app.RateLimit{Count: 3, Period: time.Hour}, nil},
+		{"10/30s", app.RateLimit{Count: 10, Period: 30 * time.Second}, nil},
+		{"3", app.RateLimit{}, app.ErrInvalidRateLimit},
+		{"0/1h", app.RateLimit{}, app.ErrInvalidRateLimit},
+		{"3/hour", app.RateLimit{}, app.ErrInvalidRateLimit},
+		{"3/-1h", app.RateLimit{}, app.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			limit, err := app.ParseRateLimit(tc.name)
+			assert.Equal(t, tc.want, limit)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
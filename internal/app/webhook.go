@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type (
+	// WebhookApp implements the business logic for managing outbound webhooks.
+	WebhookApp interface {
+		// CreateWebhook registers a new endpoint subscribed to event types.
+		// The secret for signing requests is generated if it is empty.
+		// Errors: ErrUnknownEventType, unknown.
+		CreateWebhook(ctx context.Context, url, secret string, events []EventType) (*Webhook, error)
+		// ListWebhooks returns all registered endpoints.
+		// Errors: unknown.
+		ListWebhooks(context.Context) ([]Webhook, error)
+		// DeleteWebhook removes endpoint and its delivery log.
+		// Errors: ErrNotFound, unknown.
+		DeleteWebhook(context.Context, WebhookID) error
+		// WebhookDeliveries returns the delivery log of the endpoint, latest first.
+		// Errors: unknown.
+		WebhookDeliveries(context.Context, WebhookID, Page) ([]WebhookDelivery, int, error)
+	}
+	// WebhookRepo interface for webhooks repository.
+	// A delivery with the JobWebhookDelivery job is created in the same transaction as the event
+	// for every webhook subscribed to its type.
+	WebhookRepo interface {
+		// CreateWebhook adds a new webhook.
+		// Errors: unknown.
+		CreateWebhook(context.Context, Webhook) (WebhookID, error)
+		// Webhooks returns all webhooks.
+		// Errors: unknown.
+		Webhooks(context.Context) ([]Webhook, error)
+		// DeleteWebhook removes webhook with its deliveries.
+		// Errors: ErrNotFound, unknown.
+		DeleteWebhook(context.Context, WebhookID) error
+		// WebhookDeliveryByID returns the delivery with any status.
+		// Errors: ErrNotFound, unknown.
+		WebhookDeliveryByID(ctx context.Context, id int) (*WebhookDelivery, error)
+		// WebhookDeliveries returns deliveries of the webhook.
+		// Errors: unknown.
+		WebhookDeliveries(context.Context, WebhookID, Page) ([]WebhookDelivery, int, error)
+		// UpdateWebhookDelivery saves the result of the delivery attempt.
+		// Errors: unknown.
+		UpdateWebhookDelivery(context.Context, WebhookDelivery) error
+	}
+	// WebhookSender module for sending events to webhook endpoints.
+	WebhookSender interface {
+		// Send posts the event to the webhook endpoint, the request is signed by webhook secret.
+		// Returns the response status code, an error is returned for non 2xx codes as well.
+		// Errors: unknown.
+		Send(ctx context.Context, webhook Webhook, event Event) (statusCode int, err error)
+	}
+	// WebhookID contains webhook id.
+	WebhookID int
+	// Webhook contains information about registered endpoint.
+	Webhook struct {
+		ID        WebhookID
+		URL       string
+		Secret    string
+		Events    []EventType
+		CreatedAt time.Time
+	}
+	// WebhookDelivery contains information about delivering the event to the webhook.
+	WebhookDelivery struct {
+		ID            int
+		Webhook       Webhook
+		Event         Event
+		Status        DeliveryStatus
+		Attempts      int
+		StatusCode    int
+		Error         string
+		NextAttemptAt time.Time
+		DeliveredAt   time.Time
+	}
+	// DeliveryStatus is a state of the webhook delivery.
+	DeliveryStatus string
+)
+
+// Delivery statuses.
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var (
+	WebhookMaxAttempts = 10
+	WebhookRetryDelay  = time.Minute
+)
+
+const (
+	webhookSecretLength = 32
+	webhookMaxDelay     = 12 * time.Hour
+)
+
+// CreateWebhook for implemented WebhookApp.
+func (a *Application) CreateWebhook(ctx context.Context, url, secret string, events []EventType) (*Webhook, error) {
+	for _, eventType := range events {
+		if !eventType.Valid() {
+			return nil, ErrUnknownEventType
+		}
+	}
+
+	if secret == "" {
+		var err error
+		secret, err = newWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	webhook := Webhook{
+		URL:    url,
+		Secret: secret,
+		Events: events,
+	}
+
+	var err error
+	webhook.ID, err = a.webhookRepo.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// newWebhookSecret returns a random secret, it must be unpredictable unlike the recovery codes.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// ListWebhooks for implemented WebhookApp.
+func (a *Application) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	return a.webhookRepo.Webhooks(ctx)
+}
+
+// DeleteWebhook for implemented WebhookApp.
+func (a *Application) DeleteWebhook(ctx context.Context, id WebhookID) error {
+	return a.webhookRepo.DeleteWebhook(ctx, id)
+}
+
+// WebhookDeliveries for implemented WebhookApp.
+func (a *Application) WebhookDeliveries(ctx context.Context, id WebhookID, page Page) ([]WebhookDelivery, int, error) {
+	return a.webhookRepo.WebhookDeliveries(ctx, id, page)
+}
+
+// runWebhookDeliveryJob makes the attempt of the delivery of the job,
+// the error is returned while the delivery stays pending, so the job is retried.
+func (a *Application) runWebhookDeliveryJob(ctx context.Context, payload JobPayload) error {
+	p, ok := payload.(*WebhookDeliveryJobPayload)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrInvalidJobPayload, payload)
+	}
+
+	delivery, err := a.webhookRepo.WebhookDeliveryByID(ctx, p.DeliveryID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case delivery.Status != DeliveryPending:
+		return nil
+	}
+
+	return a.deliverWebhook(ctx, *delivery)
+}
+
+func (a *Application) deliverWebhook(ctx context.Context, delivery WebhookDelivery) error {
+	statusCode, errSend := a.webhook.Send(ctx, delivery.Webhook, delivery.Event)
+
+	delivery.Attempts++
+	delivery.StatusCode = statusCode
+	delivery.Error = ""
+
+	switch {
+	case errSend == nil:
+		delivery.Status = DeliveryDelivered
+		delivery.DeliveredAt = time.Now()
+	case delivery.Attempts >= WebhookMaxAttempts:
+		delivery.Status = DeliveryFailed
+		delivery.Error = errSend.Error()
+	default:
+		delivery.Error = errSend.Error()
+		delivery.NextAttemptAt = time.Now().Add(webhookRetryDelay(delivery.Attempts))
+	}
+
+	err := a.webhookRepo.UpdateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		return err
+	}
+
+	if delivery.Status == DeliveryPending {
+		return errSend
+	}
+
+	return nil
+}
+
+// webhookRetryDelay returns exponential delay before the next attempt.
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := WebhookRetryDelay
+	for i := 1; i < attempts && delay < webhookMaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > webhookMaxDelay {
+		return webhookMaxDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,134 @@
+package app_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestApp_CreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	const (
+		url    = "https://example.com/hook"
+		secret = "secret"
+	)
+	events := []app.EventType{app.EventUserCreated, app.EventUserDeleted}
+	webhook := app.Webhook{URL: url, Secret: secret, Events: events}
+
+	mocks.webhookRepo.EXPECT().CreateWebhook(ctx, webhook).Return(app.WebhookID(1), nil)
+	mocks.webhookRepo.EXPECT().CreateWebhook(ctx, gomock.Any()).Return(app.WebhookID(0), errAny)
+
+	testCases := []struct {
+		name    string
+		secret  string
+		events  []app.EventType
+		want    *app.Webhook
+		wantErr error
+	}{
+		{"success", secret, events, &app.Webhook{ID: 1, URL: url, Secret: secret, Events: events}, nil},
+		{"unknown event", secret, []app.EventType{"user.unknown"}, nil, app.ErrUnknownEventType},
+		{"err any", "", events, nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := application.CreateWebhook(ctx, url, tc.secret, tc.events)
+			assert.Equal(t, tc.want, res)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestApp_RunWebhookDeliveryJob(t *testing.T) {
+	t.Parallel()
+
+	application, mocks, shutdown := initTest(t)
+	defer shutdown()
+
+	delivery := app.WebhookDelivery{
+		ID:      1,
+		Webhook: app.Webhook{ID: 1, URL: "https://example.com/hook", Secret: "secret"},
+		Event:   app.Event{ID: 1, Type: app.EventUserCreated, UserID: 1, Email: userEmail},
+		Status:  app.DeliveryPending,
+	}
+	lastAttempt := delivery
+	lastAttempt.Attempts = app.WebhookMaxAttempts - 1
+	delivered := delivery
+	delivered.Status = app.DeliveryDelivered
+	job := app.NewWebhookDeliveryJob(delivery.ID)
+	job.ID = 1
+	job.Attempts = 1
+
+	isDelivered := func(d app.WebhookDelivery) bool {
+		return d.Status == app.DeliveryDelivered && d.Attempts == 1 &&
+			d.StatusCode == http.StatusOK && !d.DeliveredAt.IsZero()
+	}
+	isRetried := func(d app.WebhookDelivery) bool {
+		return d.Status == app.DeliveryPending && d.Attempts == 1 && d.Error == errAny.Error() &&
+			d.NextAttemptAt.After(time.Now())
+	}
+	isFailed := func(d app.WebhookDelivery) bool {
+		return d.Status == app.DeliveryFailed && d.Attempts == app.WebhookMaxAttempts && d.Error == errAny.Error()
+	}
+	nextJob := func() *gomock.Call {
+		return mocks.jobRepo.EXPECT().NextJob(gomock.Any(), app.JobKinds(), app.JobLease).Return(&job, nil)
+	}
+
+	gomock.InOrder(
+		nextJob(),
+		mocks.webhookRepo.EXPECT().WebhookDeliveryByID(gomock.Any(), delivery.ID).Return(&delivery, nil),
+		mocks.webhook.EXPECT().Send(gomock.Any(), delivery.Webhook, delivery.Event).Return(http.StatusOK, nil),
+		mocks.webhookRepo.EXPECT().UpdateWebhookDelivery(gomock.Any(), deliveryMatcher(isDelivered)).Return(nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), job.ID).Return(nil),
+
+		nextJob(),
+		mocks.webhookRepo.EXPECT().WebhookDeliveryByID(gomock.Any(), delivery.ID).Return(&delivery, nil),
+		mocks.webhook.EXPECT().Send(gomock.Any(), delivery.Webhook, delivery.Event).
+			Return(http.StatusInternalServerError, errAny),
+		mocks.webhookRepo.EXPECT().UpdateWebhookDelivery(gomock.Any(), deliveryMatcher(isRetried)).Return(nil),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), job.ID, errAny, timeMatcher{time.Now().Add(app.WebhookRetryDelay)}).
+			Return(nil),
+
+		nextJob(),
+		mocks.webhookRepo.EXPECT().WebhookDeliveryByID(gomock.Any(), delivery.ID).Return(&lastAttempt, nil),
+		mocks.webhook.EXPECT().Send(gomock.Any(), delivery.Webhook, delivery.Event).Return(0, errAny),
+		mocks.webhookRepo.EXPECT().UpdateWebhookDelivery(gomock.Any(), deliveryMatcher(isFailed)).Return(nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), job.ID).Return(nil),
+
+		nextJob(),
+		mocks.webhookRepo.EXPECT().WebhookDeliveryByID(gomock.Any(), delivery.ID).Return(&delivered, nil),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), job.ID).Return(nil),
+
+		nextJob(),
+		mocks.webhookRepo.EXPECT().WebhookDeliveryByID(gomock.Any(), delivery.ID).Return(nil, app.ErrNotFound),
+		mocks.jobRepo.EXPECT().CompleteJob(gomock.Any(), job.ID).Return(nil),
+
+		nextJob(),
+		mocks.webhookRepo.EXPECT().WebhookDeliveryByID(gomock.Any(), delivery.ID).Return(&delivery, nil),
+		mocks.webhook.EXPECT().Send(gomock.Any(), delivery.Webhook, delivery.Event).Return(http.StatusOK, nil),
+		mocks.webhookRepo.EXPECT().UpdateWebhookDelivery(gomock.Any(), deliveryMatcher(isDelivered)).Return(errAny),
+		mocks.jobRepo.EXPECT().RetryJob(gomock.Any(), job.ID, errAny, gomock.Any()).Return(errAny),
+	)
+
+	err := application.StartJobs(ctx)
+	assert.Equal(t, errAny, err)
+}
+
+type deliveryMatcher func(app.WebhookDelivery) bool
+
+func (m deliveryMatcher) Matches(x interface{}) bool {
+	d, ok := x.(app.WebhookDelivery)
+	return ok && m(d)
+}
+
+func (m deliveryMatcher) String() string { return "matches webhook delivery" }
@@ -21,8 +21,15 @@ type (
 	}
 	// Option for building auth struct.
 	Option func(*Auth)
+
+	unsubscribeClaims struct {
+		jwt.StandardClaims
+		Kind string `json:"kind"`
+	}
 )
 
+const unsubscribeAudience = "unsubscribe"
+
 // Errors.
 var (
 	ErrValidateAlg = errors.New("unexpected signing method")
@@ -97,3 +104,43 @@ func (t *Auth) Parse(authToken app.AuthToken) (app.TokenID, error) {
 
 	return app.TokenID(claims.Subject), nil
 }
+
+// UnsubscribeToken need for implements app.Auth.
+func (t *Auth) UnsubscribeToken(publicID app.PublicID, kind app.MessageKind) (string, error) {
+	claims := &unsubscribeClaims{
+		StandardClaims: jwt.StandardClaims{
+			Audience: unsubscribeAudience,
+			Subject:  string(publicID),
+		},
+		Kind: kind.String(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(t.jwtKey)
+}
+
+// ParseUnsubscribeToken need for implements app.Auth.
+func (t *Auth) ParseUnsubscribeToken(unsubscribeToken string) (app.PublicID, app.MessageKind, error) {
+	token, err := jwt.ParseWithClaims(unsubscribeToken, &unsubscribeClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrValidateAlg
+		}
+		return t.jwtKey, nil
+	})
+
+	if err != nil || !token.Valid {
+		return "", 0, app.ErrInvalidToken
+	}
+
+	claims := token.Claims.(*unsubscribeClaims)
+	if !claims.VerifyAudience(unsubscribeAudience, true) || claims.Subject == "" {
+		return "", 0, app.ErrInvalidToken
+	}
+
+	kind, err := app.ParseMessageKind(claims.Kind)
+	if err != nil {
+		return "", 0, app.ErrInvalidToken
+	}
+
+	return app.PublicID(claims.Subject), kind, nil
+}
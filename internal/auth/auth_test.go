@@ -32,3 +32,27 @@ func TestAuthSmoke(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, appTokenID, tokenID)
 }
+
+func TestAuthUnsubscribeSmoke(t *testing.T) {
+	t.Parallel()
+
+	tokenizer := auth.New("super-duper-secret-key")
+	const publicID app.PublicID = "9a3c1b4e-5f6d-4e7a-8b9c-0d1e2f3a4b5c"
+
+	token, err := tokenizer.UnsubscribeToken(publicID, app.Welcome)
+	assert.NoError(t, err)
+	assert.NotZero(t, token)
+
+	id, kind, err := tokenizer.ParseUnsubscribeToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, publicID, id)
+	assert.Equal(t, app.Welcome, kind)
+
+	authToken, _, err := tokenizer.Token(expired)
+	assert.NoError(t, err)
+	_, _, err = tokenizer.ParseUnsubscribeToken(string(authToken))
+	assert.Equal(t, app.ErrInvalidToken, err)
+
+	_, _, err = auth.New("other-key").ParseUnsubscribeToken(token)
+	assert.Equal(t, app.ErrInvalidToken, err)
+}
@@ -0,0 +1,18 @@
+// Package authcache contains implementations of app.AuthCache.
+package authcache
+
+import (
+	"net"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// cachedAuthUser returns the copy without credentials and personal data which doesn't share slices with authUser.
+func cachedAuthUser(authUser app.AuthUser) app.AuthUser {
+	authUser.PassHash = nil
+	authUser.Email = ""
+	authUser.Phone = ""
+	authUser.Session.IP = append(net.IP(nil), authUser.Session.IP...)
+
+	return authUser
+}
@@ -0,0 +1,70 @@
+package authcache_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var ctx = context.Background()
+
+func authUser(userID app.UserID, tokenID app.TokenID) app.AuthUser {
+	return app.AuthUser{
+		User: app.User{
+			ID:        userID,
+			Email:     "email@email.com",
+			Name:      "username",
+			PassHash:  []byte("hash"),
+			Phone:     "+15551234567",
+			CreatedAt: time.Now().UTC().Round(time.Millisecond),
+			UpdatedAt: time.Now().UTC().Round(time.Millisecond),
+		},
+		Session: app.Session{
+			Origin:  app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: "UserAgent"},
+			ID:      1,
+			TokenID: tokenID,
+		},
+	}
+}
+
+// testAuthCache checks the behavior common for all implementations.
+func testAuthCache(t *testing.T, cache app.AuthCache) {
+	t.Helper()
+
+	user, otherSession, otherUser := authUser(1, "token"), authUser(1, "otherToken"), authUser(2, "otherUserToken")
+	for _, u := range []app.AuthUser{user, otherSession, otherUser} {
+		require.Nil(t, cache.SaveAuthUser(ctx, u))
+	}
+
+	res, err := cache.AuthUser(ctx, user.Session.TokenID)
+	require.Nil(t, err)
+	require.Equal(t, user.ID, res.ID)
+	require.Equal(t, user.Name, res.Name)
+	require.True(t, user.Session.IP.Equal(res.Session.IP))
+	require.Empty(t, res.PassHash, "credentials aren't cached")
+	require.Empty(t, res.Email, "personal data isn't cached")
+	require.Empty(t, res.Phone, "personal data isn't cached")
+
+	_, err = cache.AuthUser(ctx, "unknown")
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	require.Nil(t, cache.DeleteAuthToken(ctx, user.Session.TokenID))
+	_, err = cache.AuthUser(ctx, user.Session.TokenID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+	_, err = cache.AuthUser(ctx, otherSession.Session.TokenID)
+	require.Nil(t, err, "other sessions are kept")
+
+	require.Nil(t, cache.SaveAuthUser(ctx, user))
+	require.Nil(t, cache.DeleteAuthUser(ctx, user.ID))
+	for _, tokenID := range []app.TokenID{user.Session.TokenID, otherSession.Session.TokenID} {
+		_, err = cache.AuthUser(ctx, tokenID)
+		require.True(t, errors.Is(err, app.ErrNotFound))
+	}
+	_, err = cache.AuthUser(ctx, otherUser.Session.TokenID)
+	require.Nil(t, err, "other users are kept")
+}
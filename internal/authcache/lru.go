@@ -0,0 +1,125 @@
+package authcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var _ app.AuthCache = &LRU{}
+
+type lruEntry struct {
+	authUser  app.AuthUser
+	expiresAt time.Time
+}
+
+// LRU keeps users of tokens in memory of the process, the least recently used are evicted.
+// Invalidation is visible only to the process, so it must not be used by several instances.
+type LRU struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	order *list.List
+	items map[app.TokenID]*list.Element
+	users map[app.UserID]map[app.TokenID]struct{}
+}
+
+// NewLRU creates and returns new cache keeping at most size users for ttl.
+func NewLRU(size int, ttl time.Duration) *LRU {
+	return &LRU{
+		size:  size,
+		ttl:   ttl,
+		order: list.New(),
+		items: make(map[app.TokenID]*list.Element),
+		users: make(map[app.UserID]map[app.TokenID]struct{}),
+	}
+}
+
+// AuthUser for implemented app.AuthCache.
+func (c *LRU) AuthUser(_ context.Context, tokenID app.TokenID) (*app.AuthUser, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[tokenID]
+	if !ok {
+		return nil, app.ErrNotFound
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(elem)
+		return nil, app.ErrNotFound
+	}
+	c.order.MoveToFront(elem)
+
+	authUser := cachedAuthUser(entry.authUser)
+
+	return &authUser, nil
+}
+
+// SaveAuthUser for implemented app.AuthCache.
+func (c *LRU) SaveAuthUser(_ context.Context, authUser app.AuthUser) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tokenID := authUser.Session.TokenID
+	elem, ok := c.items[tokenID]
+	if ok {
+		c.remove(elem)
+	}
+
+	c.items[tokenID] = c.order.PushFront(&lruEntry{
+		authUser:  cachedAuthUser(authUser),
+		expiresAt: time.Now().Add(c.ttl),
+	})
+	if c.users[authUser.ID] == nil {
+		c.users[authUser.ID] = make(map[app.TokenID]struct{})
+	}
+	c.users[authUser.ID][tokenID] = struct{}{}
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+
+	return nil
+}
+
+// DeleteAuthToken for implemented app.AuthCache.
+func (c *LRU) DeleteAuthToken(_ context.Context, tokenID app.TokenID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[tokenID]
+	if ok {
+		c.remove(elem)
+	}
+
+	return nil
+}
+
+// DeleteAuthUser for implemented app.AuthCache.
+func (c *LRU) DeleteAuthUser(_ context.Context, userID app.UserID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for tokenID := range c.users[userID] {
+		c.remove(c.items[tokenID])
+	}
+
+	return nil
+}
+
+// remove is called with the locked mutex.
+func (c *LRU) remove(elem *list.Element) {
+	entry := c.order.Remove(elem).(*lruEntry)
+	tokenID, userID := entry.authUser.Session.TokenID, entry.authUser.ID
+
+	delete(c.items, tokenID)
+	delete(c.users[userID], tokenID)
+	if len(c.users[userID]) == 0 {
+		delete(c.users, userID)
+	}
+}
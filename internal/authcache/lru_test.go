@@ -0,0 +1,70 @@
+package authcache_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/authcache"
+)
+
+func TestLRU(t *testing.T) {
+	t.Parallel()
+
+	testAuthCache(t, authcache.NewLRU(10, time.Minute))
+}
+
+func TestLRU_Evict(t *testing.T) {
+	t.Parallel()
+
+	cache := authcache.NewLRU(2, time.Minute)
+
+	require.Nil(t, cache.SaveAuthUser(ctx, authUser(1, "first")))
+	require.Nil(t, cache.SaveAuthUser(ctx, authUser(1, "second")))
+	_, err := cache.AuthUser(ctx, "first")
+	require.Nil(t, err)
+	require.Nil(t, cache.SaveAuthUser(ctx, authUser(2, "third")))
+
+	_, err = cache.AuthUser(ctx, "second")
+	require.True(t, errors.Is(err, app.ErrNotFound), "the least recently used is evicted")
+	_, err = cache.AuthUser(ctx, "first")
+	require.Nil(t, err)
+	_, err = cache.AuthUser(ctx, "third")
+	require.Nil(t, err)
+}
+
+func TestLRU_Expire(t *testing.T) {
+	t.Parallel()
+
+	cache := authcache.NewLRU(10, time.Millisecond)
+
+	require.Nil(t, cache.SaveAuthUser(ctx, authUser(1, "token")))
+	time.Sleep(5 * time.Millisecond)
+
+	_, err := cache.AuthUser(ctx, "token")
+	require.True(t, errors.Is(err, app.ErrNotFound))
+}
+
+func TestLRU_Copy(t *testing.T) {
+	t.Parallel()
+
+	cache := authcache.NewLRU(10, time.Minute)
+	user := authUser(1, "token")
+
+	ip := append(net.IP(nil), user.Session.IP...)
+
+	require.Nil(t, cache.SaveAuthUser(ctx, user))
+	user.Session.IP[0] = 1
+
+	res, err := cache.AuthUser(ctx, "token")
+	require.Nil(t, err)
+	require.Equal(t, ip, res.Session.IP)
+	res.Session.IP[0] = 1
+
+	res, err = cache.AuthUser(ctx, "token")
+	require.Nil(t, err)
+	require.Equal(t, ip, res.Session.IP)
+}
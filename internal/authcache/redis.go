@@ -0,0 +1,111 @@
+package authcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var _ app.AuthCache = &Redis{}
+
+// Redis keeps users of tokens in Redis, so it's shared by all instances.
+// The key of the token keeps the user as JSON, the set of the user keeps its tokens.
+type Redis struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewRedis creates and returns new cache keeping users for ttl.
+func NewRedis(client *redis.Client, ttl time.Duration) *Redis {
+	return &Redis{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func tokenKey(tokenID app.TokenID) string {
+	return "auth:token:" + string(tokenID)
+}
+
+func userKey(userID app.UserID) string {
+	return "auth:user:" + strconv.Itoa(int(userID))
+}
+
+// AuthUser for implemented app.AuthCache.
+func (c *Redis) AuthUser(ctx context.Context, tokenID app.TokenID) (*app.AuthUser, error) {
+	val, err := c.client.WithContext(ctx).Get(tokenKey(tokenID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, app.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+
+	authUser := &app.AuthUser{}
+	err = json.Unmarshal(val, authUser)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	return authUser, nil
+}
+
+// SaveAuthUser for implemented app.AuthCache.
+// The set of the user lives while any of its tokens, its expiration is extended by every token.
+func (c *Redis) SaveAuthUser(ctx context.Context, authUser app.AuthUser) error {
+	val, err := json.Marshal(cachedAuthUser(authUser))
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	_, err = c.client.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Set(tokenKey(authUser.Session.TokenID), val, c.ttl)
+		pipe.SAdd(userKey(authUser.ID), string(authUser.Session.TokenID))
+		pipe.Expire(userKey(authUser.ID), c.ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("save: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAuthToken for implemented app.AuthCache.
+// The token is left in the set of the user, it's removed with the set.
+func (c *Redis) DeleteAuthToken(ctx context.Context, tokenID app.TokenID) error {
+	err := c.client.WithContext(ctx).Del(tokenKey(tokenID)).Err()
+	if err != nil {
+		return fmt.Errorf("del: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAuthUser for implemented app.AuthCache.
+func (c *Redis) DeleteAuthUser(ctx context.Context, userID app.UserID) error {
+	client := c.client.WithContext(ctx)
+
+	tokens, err := client.SMembers(userKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("members: %w", err)
+	}
+
+	keys := []string{userKey(userID)}
+	for _, tokenID := range tokens {
+		keys = append(keys, tokenKey(app.TokenID(tokenID)))
+	}
+
+	err = client.Del(keys...).Err()
+	if err != nil {
+		return fmt.Errorf("del: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package authcache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/authcache"
+)
+
+func initRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	server, err := miniredis.Run()
+	require.Nil(t, err)
+	t.Cleanup(server.Close)
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return server, client
+}
+
+func TestRedis(t *testing.T) {
+	t.Parallel()
+
+	_, client := initRedis(t)
+
+	testAuthCache(t, authcache.NewRedis(client, time.Minute))
+}
+
+func TestRedis_Expire(t *testing.T) {
+	t.Parallel()
+
+	server, client := initRedis(t)
+	cache := authcache.NewRedis(client, time.Minute)
+
+	require.Nil(t, cache.SaveAuthUser(ctx, authUser(1, "token")))
+	server.FastForward(time.Minute)
+
+	_, err := cache.AuthUser(ctx, "token")
+	require.True(t, errors.Is(err, app.ErrNotFound))
+	require.False(t, server.Exists("auth:user:1"))
+}
@@ -0,0 +1,108 @@
+// Package broker contains implementations of publishing domain events to message brokers.
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+const (
+	natsClientName = "boilerplate"
+	natsAckTimeout = 5 * time.Second
+)
+
+type (
+	// NATS is an implements app.Broker.
+	// Publishes events to the subject "<prefix>.<event type>" of the JetStream stream,
+	// the stream capturing these subjects must exist.
+	// The connection is re-established by the client after any error.
+	NATS struct {
+		conn   *nats.Conn
+		js     nats.JetStreamContext
+		prefix string
+	}
+
+	natsEvent struct {
+		ID        int       `json:"id"`
+		Type      string    `json:"type"`
+		UserID    string    `json:"user_id"`
+		Email     string    `json:"email"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+)
+
+var _ app.Broker = &NATS{}
+
+// NewNATS creates a new instance of the app.Broker object.
+// The rawURL has a format nats://[user:pass@]host[:port].
+// The server may be unavailable at start, events are published once it is connected.
+func NewNATS(rawURL, prefix string) (*NATS, error) {
+	conn, err := nats.Connect(rawURL,
+		nats.Name(natsClientName),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("jetstream: %w", err)
+	}
+
+	return &NATS{conn: conn, js: js, prefix: prefix}, nil
+}
+
+// Subject returns the subject for the event type.
+func (n *NATS) Subject(eventType app.EventType) string {
+	return n.prefix + "." + string(eventType)
+}
+
+// Publish need for implements app.Broker.
+// Events are published one by one, every event is acknowledged by the stream before the next one,
+// so the order is kept. The event ID is the message ID, the stream drops events published again
+// within its duplicates window.
+func (n *NATS) Publish(ctx context.Context, events []app.Event) error {
+	for i := range events {
+		payload, err := json.Marshal(natsEvent{
+			ID:        events[i].ID,
+			Type:      string(events[i].Type),
+			UserID:    string(events[i].UserPublicID),
+			Email:     events[i].Email,
+			CreatedAt: events[i].CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+
+		err = n.publish(ctx, n.Subject(events[i].Type), strconv.Itoa(events[i].ID), payload)
+		if err != nil {
+			return fmt.Errorf("publish event %d: %w", events[i].ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the connection to the server.
+func (n *NATS) Close() {
+	n.conn.Close()
+}
+
+// publish sends the message and waits for its acknowledgement at most natsAckTimeout.
+func (n *NATS) publish(ctx context.Context, subject, id string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, natsAckTimeout)
+	defer cancel()
+
+	_, err := n.js.Publish(subject, payload, nats.MsgId(id), nats.Context(ctx))
+
+	return err
+}
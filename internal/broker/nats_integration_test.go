@@ -0,0 +1,102 @@
+//go:build integration
+// +build integration
+
+package broker_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/broker"
+)
+
+const (
+	streamName    = "BOILERPLATE_TEST"
+	subjectPrefix = "boilerplate_test"
+)
+
+// natsURL returns the address of the NATS server with enabled JetStream, e.g. started by `nats-server -js`.
+func natsURL() string {
+	if url := os.Getenv("NATS_URL"); url != "" {
+		return url
+	}
+
+	return nats.DefaultURL
+}
+
+func TestNATS_Publish(t *testing.T) {
+	conn, err := nats.Connect(natsURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	js, err := conn.JetStream()
+	require.NoError(t, err)
+
+	_ = js.DeleteStream(streamName)
+	_, err = js.AddStream(&nats.StreamConfig{Name: streamName, Subjects: []string{subjectPrefix + ".>"}})
+	require.NoError(t, err)
+	defer js.DeleteStream(streamName)
+
+	n, err := broker.NewNATS(natsURL(), subjectPrefix)
+	require.NoError(t, err)
+	defer n.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	const publicID app.PublicID = "public id"
+	events := []app.Event{
+		{ID: 1, Type: app.EventUserCreated, UserID: 1, UserPublicID: publicID, Email: "email@mail.com", CreatedAt: now},
+		{ID: 2, Type: app.EventUserEmailChanged, UserID: 1, UserPublicID: publicID, Email: "new@mail.com", CreatedAt: now},
+		{ID: 3, Type: app.EventUserDeleted, UserID: 1, UserPublicID: publicID, Email: "new@mail.com", CreatedAt: now},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err = n.Publish(ctx, events[:2])
+	require.NoError(t, err)
+	// The batch is published again if marking it as published failed, the stream drops duplicates.
+	err = n.Publish(ctx, events)
+	require.NoError(t, err)
+
+	info, err := js.StreamInfo(streamName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(events)), info.State.Msgs)
+
+	for i := range events {
+		msg, err := js.GetMsg(streamName, uint64(i+1))
+		require.NoError(t, err)
+		assert.Equal(t, n.Subject(events[i].Type), msg.Subject)
+
+		var payload struct {
+			ID        int       `json:"id"`
+			Type      string    `json:"type"`
+			UserID    string    `json:"user_id"`
+			Email     string    `json:"email"`
+			CreatedAt time.Time `json:"created_at"`
+		}
+		require.NoError(t, json.Unmarshal(msg.Data, &payload))
+		assert.Equal(t, events[i].ID, payload.ID)
+		assert.Equal(t, string(events[i].Type), payload.Type)
+		assert.Equal(t, string(events[i].UserPublicID), payload.UserID)
+		assert.Equal(t, events[i].Email, payload.Email)
+		assert.True(t, events[i].CreatedAt.Equal(payload.CreatedAt))
+	}
+}
+
+func TestNATS_PublishErr(t *testing.T) {
+	n, err := broker.NewNATS(natsURL(), "subject_without_stream")
+	require.NoError(t, err)
+	defer n.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err = n.Publish(ctx, []app.Event{{ID: 1, Type: app.EventUserCreated, UserID: 1}})
+	assert.Error(t, err, "not acknowledged without the stream")
+}
@@ -0,0 +1,50 @@
+// Package consistency tracks writes made while serving a request, so the following reads
+// of the request are routed to the primary database instead of a lagging replica.
+package consistency
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type scopeKey struct{}
+
+type scope struct {
+	written int32
+}
+
+// NewContext returns the context of the request tracking its writes.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &scope{})
+}
+
+// MarkWritten records that the request has written to the primary.
+// It does nothing if the context doesn't track writes.
+func MarkWritten(ctx context.Context) {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if ok {
+		atomic.StoreInt32(&s.written, 1)
+	}
+}
+
+// Tracked reports whether the context is the request tracking its writes.
+// Background work like jobs has no such context.
+func Tracked(ctx context.Context) bool {
+	_, ok := ctx.Value(scopeKey{}).(*scope)
+
+	return ok
+}
+
+// Written reports whether the request has written to the primary.
+func Written(ctx context.Context) bool {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+
+	return ok && atomic.LoadInt32(&s.written) == 1
+}
+
+// ReadPrimary returns the context which reads go to the primary like after a write of the request,
+// it's used for reads which results outlive the request. Writes under the context
+// aren't recorded in the scope of the request.
+func ReadPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &scope{written: 1})
+}
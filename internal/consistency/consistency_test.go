@@ -0,0 +1,36 @@
+package consistency_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/consistency"
+)
+
+func TestWritten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	consistency.MarkWritten(ctx)
+	assert.False(t, consistency.Written(ctx), "the context doesn't track writes")
+	assert.False(t, consistency.Tracked(ctx))
+
+	ctx = consistency.NewContext(ctx)
+	assert.True(t, consistency.Tracked(ctx))
+	assert.False(t, consistency.Written(ctx))
+
+	consistency.MarkWritten(context.WithValue(ctx, struct{}{}, nil))
+	assert.True(t, consistency.Written(ctx), "derived contexts share the scope")
+}
+
+func TestReadPrimary(t *testing.T) {
+	t.Parallel()
+
+	ctx := consistency.ReadPrimary(context.Background())
+	assert.True(t, consistency.Written(ctx))
+
+	request := consistency.NewContext(context.Background())
+	assert.True(t, consistency.Written(consistency.ReadPrimary(request)))
+	assert.False(t, consistency.Written(request), "the scope of the request isn't changed")
+}
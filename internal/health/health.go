@@ -0,0 +1,203 @@
+// Package health contains checks of the service dependencies for liveness and readiness probes,
+// results are served over HTTP and by the standard gRPC health checking service.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type (
+	// Check returns the error if the dependency doesn't work.
+	Check func(ctx context.Context) error
+
+	// Checker runs checks periodically and keeps their last results, so probes
+	// don't load dependencies. The service is live while liveness checks pass,
+	// it is ready while all checks pass and the shutdown hasn't begun.
+	// It is safe for concurrent use.
+	Checker struct {
+		liveness  map[string]Check
+		readiness map[string]Check
+		grpc      *grpchealth.Server
+
+		mu       sync.RWMutex
+		results  map[string]error
+		shutdown bool
+	}
+
+	report struct {
+		Status string            `json:"status"`
+		Checks map[string]string `json:"checks"`
+	}
+)
+
+// Report statuses.
+const (
+	statusOK       = "ok"
+	statusFail     = "fail"
+	statusShutdown = "shutdown"
+)
+
+var errNotChecked = errors.New("not checked yet")
+
+// New creates and returns new Checker, the service is not ready until checks run.
+func New() *Checker {
+	c := &Checker{
+		liveness:  make(map[string]Check),
+		readiness: make(map[string]Check),
+		grpc:      grpchealth.NewServer(),
+		results:   make(map[string]error),
+	}
+	c.grpc.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return c
+}
+
+// AddLivenessCheck adds the check failing both probes, it must be added before Run.
+// Only failures fixed by restart of the process belong here.
+func (c *Checker) AddLivenessCheck(name string, check Check) {
+	c.liveness[name] = check
+	c.AddReadinessCheck(name, check)
+}
+
+// AddReadinessCheck adds the check failing the readiness probe, it must be added before Run.
+func (c *Checker) AddReadinessCheck(name string, check Check) {
+	c.readiness[name] = check
+	c.results[name] = errNotChecked
+}
+
+// Register adds the gRPC health checking service to the server,
+// the status of the service "" is the readiness of the process.
+func (c *Checker) Register(server *grpc.Server) {
+	grpc_health_v1.RegisterHealthServer(server, c.grpc)
+}
+
+// Run runs checks right away and then every interval until the context is cancelled,
+// every check has the interval to finish. The cancellation begins the shutdown.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx, interval)
+
+		select {
+		case <-ctx.Done():
+			c.Shutdown()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks the service not ready for good, so it's removed from the load balancing
+// while servers finish in-flight requests.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shutdown = true
+	c.grpc.Shutdown()
+}
+
+// check runs all checks concurrently and saves their results.
+func (c *Checker) check(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		resultsMu sync.Mutex
+		results   = make(map[string]error, len(c.readiness))
+	)
+	for name, check := range c.readiness {
+		name, check := name, check
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := check(ctx)
+
+			resultsMu.Lock()
+			results[name] = err
+			resultsMu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results = results
+	if c.shutdown {
+		return
+	}
+
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	if failed(results, c.readiness) {
+		status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	c.grpc.SetServingStatus("", status)
+}
+
+// LivenessHandler serves the liveness probe.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		c.serve(w, c.liveness, false)
+	})
+}
+
+// ReadinessHandler serves the readiness probe.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		c.serve(w, c.readiness, c.shutdown)
+	})
+}
+
+// serve writes results of checks with 503 status if any check failed.
+// The caller must hold the lock.
+func (c *Checker) serve(w http.ResponseWriter, checks map[string]Check, shutdown bool) {
+	res := report{Status: statusOK, Checks: make(map[string]string, len(checks))}
+	for name := range checks {
+		res.Checks[name] = statusOK
+		if err := c.results[name]; err != nil {
+			res.Checks[name] = err.Error()
+		}
+	}
+
+	code := http.StatusOK
+	switch {
+	case shutdown:
+		res.Status, code = statusShutdown, http.StatusServiceUnavailable
+	case failed(c.results, checks):
+		res.Status, code = statusFail, http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// failed reports whether any of checks failed.
+func failed(results map[string]error, checks map[string]Check) bool {
+	for name := range checks {
+		if results[name] != nil {
+			return true
+		}
+	}
+
+	return false
+}
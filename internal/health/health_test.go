@@ -0,0 +1,133 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/health"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const interval = 10 * time.Millisecond
+
+var errAny = errors.New("any err")
+
+type report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func probe(t *testing.T, h http.Handler) (int, report) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	var res report
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&res))
+
+	return w.Code, res
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	var fail int32 = 1
+	checker := health.New()
+	checker.AddLivenessCheck("process", func(context.Context) error { return nil })
+	checker.AddReadinessCheck("backlog", func(context.Context) error {
+		if atomic.LoadInt32(&fail) == 1 {
+			return errAny
+		}
+		return nil
+	})
+
+	code, res := probe(t, checker.ReadinessHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code, "not ready until checks run")
+	assert.Equal(t, "fail", res.Status)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- checker.Run(ctx, interval) }()
+
+	assert.Eventually(t, func() bool {
+		code, res = probe(t, checker.LivenessHandler())
+		return code == http.StatusOK
+	}, time.Second, interval)
+	assert.Equal(t, report{Status: "ok", Checks: map[string]string{"process": "ok"}}, res)
+
+	code, res = probe(t, checker.ReadinessHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, report{Status: "fail", Checks: map[string]string{"process": "ok", "backlog": errAny.Error()}}, res)
+
+	atomic.StoreInt32(&fail, 0)
+	assert.Eventually(t, func() bool {
+		code, _ = probe(t, checker.ReadinessHandler())
+		return code == http.StatusOK
+	}, time.Second, interval)
+
+	cancel()
+	assert.Nil(t, <-done)
+
+	code, res = probe(t, checker.ReadinessHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code, "not ready since the shutdown")
+	assert.Equal(t, "shutdown", res.Status)
+	code, _ = probe(t, checker.LivenessHandler())
+	assert.Equal(t, http.StatusOK, code, "live during the shutdown")
+}
+
+func TestChecker_GRPC(t *testing.T) {
+	t.Parallel()
+
+	var fail int32 = 1
+	checker := health.New()
+	checker.AddReadinessCheck("backlog", func(context.Context) error {
+		if atomic.LoadInt32(&fail) == 1 {
+			return errAny
+		}
+		return nil
+	})
+
+	server := grpc.NewServer()
+	checker.Register(server)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go func() { _ = server.Serve(ln) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(ln.Addr().String(), grpc.WithInsecure())
+	require.Nil(t, err)
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	status := func() grpc_health_v1.HealthCheckResponse_ServingStatus {
+		res, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.Nil(t, err)
+		return res.Status
+	}
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, status())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- checker.Run(ctx, interval) }()
+
+	atomic.StoreInt32(&fail, 0)
+	assert.Eventually(t, func() bool {
+		return status() == grpc_health_v1.HealthCheckResponse_SERVING
+	}, time.Second, interval)
+
+	cancel()
+	assert.Nil(t, <-done)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, status())
+}
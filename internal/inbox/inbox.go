@@ -0,0 +1,35 @@
+// Package inbox delivers notifications to the in-app inbox of the user.
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+type inbox struct {
+	repo app.InboxRepo
+}
+
+// New creates a new instance of the app.Notification saving messages to the user inbox.
+func New(repo app.InboxRepo) app.Notification {
+	return &inbox{repo: repo}
+}
+
+const saveTimeout = 5 * time.Second
+
+// Notification need for implemented app.Notification.
+// The contact is the email of the user.
+func (i *inbox) Notification(contact string, msg app.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), saveTimeout)
+	defer cancel()
+
+	err := i.repo.CreateUserNotification(ctx, contact, msg)
+	if err != nil {
+		return fmt.Errorf("save to inbox: %w", err)
+	}
+
+	return nil
+}
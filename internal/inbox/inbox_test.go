@@ -0,0 +1,45 @@
+package inbox_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/inbox"
+	"github.com/zergslaw/boilerplate/internal/mock"
+)
+
+func TestInbox_Notification(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const email = "email@email.com"
+	errAny := errors.New("any error")
+	msg := app.Message{TaskID: 1, Kind: app.Welcome, Content: "Welcome"}
+
+	repo := mock.NewMockInboxRepo(ctrl)
+	repo.EXPECT().CreateUserNotification(gomock.Any(), email, msg).Return(nil)
+	repo.EXPECT().CreateUserNotification(gomock.Any(), email, msg).Return(errAny)
+
+	n := inbox.New(repo)
+
+	testCases := []struct {
+		name    string
+		wantErr error
+	}{
+		{"success", nil},
+		{"err any", errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := n.Notification(email, msg)
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
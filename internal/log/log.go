@@ -22,6 +22,8 @@ const (
 	API        = "api"
 	GRPCCode   = "grpcCode"
 	Version    = "version"
+	Election   = "election"
+	Term       = "term"
 )
 
 type loggerKey uint8
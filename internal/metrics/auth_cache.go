@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// AuthCache collects statistics of the auth cache.
+type AuthCache struct{}
+
+var _ app.AuthCacheMetrics = AuthCache{}
+
+// AuthCacheLookup for implemented app.AuthCacheMetrics.
+func (AuthCache) AuthCacheLookup(hit bool) {
+	if hit {
+		AuthCacheLookupsTotal.WithLabelValues("hit").Inc()
+		return
+	}
+
+	AuthCacheLookupsTotal.WithLabelValues("miss").Inc()
+}
@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Event collects statistics of the event relay.
+type Event struct{}
+
+var _ app.EventMetrics = Event{}
+
+// PublishFailed for implemented app.EventMetrics.
+func (Event) PublishFailed([]app.Event, error) {
+	EventPublishFailuresTotal.Inc()
+}
@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Leader collects statistics of leader elections.
+type Leader struct{}
+
+var _ app.LeaderMetrics = Leader{}
+
+// Leader for implemented app.LeaderMetrics.
+func (Leader) Leader(lease app.Lease, isLeader bool) {
+	if !isLeader {
+		IsLeader.WithLabelValues(lease.Election).Set(0)
+		return
+	}
+
+	IsLeader.WithLabelValues(lease.Election).Set(1)
+	LeaderTerm.WithLabelValues(lease.Election).Set(float64(lease.Term))
+}
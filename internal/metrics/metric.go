@@ -9,6 +9,28 @@ import (
 var (
 	// PanicsTotal contains metrics for rates of panic.
 	PanicsTotal struct{ prometheus.Counter }
+	// NotificationsSuppressedTotal contains metrics for rates of notifications that were not sent.
+	NotificationsSuppressedTotal struct{ *prometheus.CounterVec }
+	// RowsPurgedTotal contains metrics for rates of rows removed by purge jobs.
+	RowsPurgedTotal struct{ *prometheus.CounterVec }
+	// PurgeDuration contains metrics for durations of purge job runs.
+	PurgeDuration struct{ *prometheus.HistogramVec }
+	// IsLeader contains metrics for leadership of the process, 1 if it is the leader of the election.
+	IsLeader struct{ *prometheus.GaugeVec }
+	// LeaderTerm contains metrics for the term of the latest leadership of the process.
+	LeaderTerm struct{ *prometheus.GaugeVec }
+	// AuthCacheLookupsTotal contains metrics for rates of lookups in the auth cache by result.
+	AuthCacheLookupsTotal struct{ *prometheus.CounterVec }
+	// EventPublishFailuresTotal contains metrics for rates of batches of events not accepted by the broker.
+	EventPublishFailuresTotal struct{ prometheus.Counter }
+)
+
+const (
+	kindLabel     = "kind"
+	reasonLabel   = "reason"
+	jobLabel      = "job"
+	electionLabel = "election"
+	resultLabel   = "result"
 )
 
 // InitMetrics must be called once before using this package.
@@ -20,4 +42,53 @@ func InitMetrics() {
 			Help: "Amount of recovered panics.",
 		},
 	)
+	NotificationsSuppressedTotal.CounterVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "notifications_suppressed_total",
+			Help: "Amount of notifications suppressed as duplicates or by rate limit.",
+		},
+		[]string{kindLabel, reasonLabel},
+	)
+	RowsPurgedTotal.CounterVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "purged_rows_total",
+			Help: "Amount of expired rows removed by purge jobs.",
+		},
+		[]string{jobLabel},
+	)
+	PurgeDuration.HistogramVec = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "purge_duration_seconds",
+			Help:    "Duration of purge job runs.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+		},
+		[]string{jobLabel},
+	)
+	IsLeader.GaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "leader",
+			Help: "Whether the process is the leader of the election.",
+		},
+		[]string{electionLabel},
+	)
+	LeaderTerm.GaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "leader_term",
+			Help: "Term of the latest leadership of the process.",
+		},
+		[]string{electionLabel},
+	)
+	AuthCacheLookupsTotal.CounterVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_cache_lookups_total",
+			Help: "Amount of lookups of auth tokens in the cache.",
+		},
+		[]string{resultLabel},
+	)
+	EventPublishFailuresTotal.Counter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "event_publish_failures_total",
+			Help: "Amount of failed attempts to publish events to the broker.",
+		},
+	)
 }
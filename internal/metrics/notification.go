@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Notification collects statistics of the notification pipeline.
+type Notification struct{}
+
+var _ app.NotificationMetrics = Notification{}
+
+// NotificationSuppressed for implemented app.NotificationMetrics.
+func (Notification) NotificationSuppressed(kind app.MessageKind, reason app.SuppressReason) {
+	NotificationsSuppressedTotal.WithLabelValues(kind.String(), string(reason)).Inc()
+}
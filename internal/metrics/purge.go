@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Purge collects statistics of purge jobs.
+type Purge struct{}
+
+var _ app.PurgeMetrics = Purge{}
+
+// Purged for implemented app.PurgeMetrics.
+func (Purge) Purged(kind app.JobKind, rows int, duration time.Duration) {
+	RowsPurgedTotal.WithLabelValues(string(kind)).Add(float64(rows))
+	PurgeDuration.WithLabelValues(string(kind)).Observe(duration.Seconds())
+}
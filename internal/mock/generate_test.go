@@ -1,6 +1,20 @@
 package mock
 
-//go:generate mockgen -source=../app/app.go -aux_files github.com/zergslaw/boilerplate/internal/app=../app/user.go -destination mock.app.contracts.go -package mock
+//go:generate mockgen -source=../app/app.go -aux_files github.com/zergslaw/boilerplate/internal/app=../app/user.go,github.com/zergslaw/boilerplate/internal/app=../app/notification_settings.go,github.com/zergslaw/boilerplate/internal/app=../app/notification_admin.go,github.com/zergslaw/boilerplate/internal/app=../app/notification_schedule.go,github.com/zergslaw/boilerplate/internal/app=../app/inbox.go,github.com/zergslaw/boilerplate/internal/app=../app/email_event.go,github.com/zergslaw/boilerplate/internal/app=../app/phone.go,github.com/zergslaw/boilerplate/internal/app=../app/tenant.go,github.com/zergslaw/boilerplate/internal/app=../app/org.go -destination mock.app.contracts.go -package mock
 //go:generate mockgen -source=../app/user.go -destination=mock.user.contracts.go -package mock
 //go:generate mockgen -source=../app/notification.go -destination=mock.notification.contracts.go -package mock
 //go:generate mockgen -source=../app/wal.go -destination=mock.wal.contracts.go -package mock
+//go:generate mockgen -source=../app/notification_settings.go -destination=mock.notification_settings.contracts.go -package mock
+//go:generate mockgen -source=../app/webhook.go -destination=mock.webhook.contracts.go -package mock
+//go:generate mockgen -source=../app/event.go -destination=mock.event.contracts.go -package mock
+//go:generate mockgen -source=../app/notification_admin.go -destination=mock.notification_admin.contracts.go -package mock
+//go:generate mockgen -source=../app/notification_schedule.go -destination=mock.notification_schedule.contracts.go -package mock
+//go:generate mockgen -source=../app/inbox.go -destination=mock.inbox.contracts.go -package mock
+//go:generate mockgen -source=../app/notification_limit.go -destination=mock.notification_limit.contracts.go -package mock
+//go:generate mockgen -source=../app/email_event.go -destination=mock.email_event.contracts.go -package mock
+//go:generate mockgen -source=../app/phone.go -destination=mock.phone.contracts.go -package mock
+//go:generate mockgen -source=../app/job.go -destination=mock.job.contracts.go -package mock
+//go:generate mockgen -source=../app/purge.go -destination=mock.purge.contracts.go -package mock
+//go:generate mockgen -source=../app/leader.go -destination=mock.leader.contracts.go -package mock
+//go:generate mockgen -source=../app/tenant.go -destination=mock.tenant.contracts.go -package mock
+//go:generate mockgen -source=../app/org.go -destination=mock.org.contracts.go -package mock
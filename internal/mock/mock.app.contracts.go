@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	app "github.com/zergslaw/boilerplate/internal/app"
@@ -36,37 +37,37 @@ func (m *MockApp) EXPECT() *MockAppMockRecorder {
 }
 
 // VerificationEmail mocks base method
-func (m *MockApp) VerificationEmail(ctx context.Context, email string) error {
+func (m *MockApp) VerificationEmail(ctx context.Context, tenantID app.TenantID, email string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerificationEmail", ctx, email)
+	ret := m.ctrl.Call(m, "VerificationEmail", ctx, tenantID, email)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VerificationEmail indicates an expected call of VerificationEmail
-func (mr *MockAppMockRecorder) VerificationEmail(ctx, email interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) VerificationEmail(ctx, tenantID, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationEmail", reflect.TypeOf((*MockApp)(nil).VerificationEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationEmail", reflect.TypeOf((*MockApp)(nil).VerificationEmail), ctx, tenantID, email)
 }
 
 // VerificationUsername mocks base method
-func (m *MockApp) VerificationUsername(ctx context.Context, username string) error {
+func (m *MockApp) VerificationUsername(ctx context.Context, tenantID app.TenantID, username string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerificationUsername", ctx, username)
+	ret := m.ctrl.Call(m, "VerificationUsername", ctx, tenantID, username)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VerificationUsername indicates an expected call of VerificationUsername
-func (mr *MockAppMockRecorder) VerificationUsername(ctx, username interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) VerificationUsername(ctx, tenantID, username interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationUsername", reflect.TypeOf((*MockApp)(nil).VerificationUsername), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationUsername", reflect.TypeOf((*MockApp)(nil).VerificationUsername), ctx, tenantID, username)
 }
 
 // Login mocks base method
-func (m *MockApp) Login(ctx context.Context, email, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
+func (m *MockApp) Login(ctx context.Context, tenantID app.TenantID, email, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, email, password, origin)
+	ret := m.ctrl.Call(m, "Login", ctx, tenantID, email, password, origin)
 	ret0, _ := ret[0].(*app.User)
 	ret1, _ := ret[1].(app.AuthToken)
 	ret2, _ := ret[2].(error)
@@ -74,9 +75,9 @@ func (m *MockApp) Login(ctx context.Context, email, password string, origin app.
 }
 
 // Login indicates an expected call of Login
-func (mr *MockAppMockRecorder) Login(ctx, email, password, origin interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) Login(ctx, tenantID, email, password, origin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockApp)(nil).Login), ctx, email, password, origin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockApp)(nil).Login), ctx, tenantID, email, password, origin)
 }
 
 // Logout mocks base method
@@ -94,9 +95,9 @@ func (mr *MockAppMockRecorder) Logout(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // CreateUser mocks base method
-func (m *MockApp) CreateUser(ctx context.Context, email, username, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
+func (m *MockApp) CreateUser(ctx context.Context, tenantID app.TenantID, email, username, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, email, username, password, origin)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, tenantID, email, username, password, origin)
 	ret0, _ := ret[0].(*app.User)
 	ret1, _ := ret[1].(app.AuthToken)
 	ret2, _ := ret[2].(error)
@@ -104,9 +105,9 @@ func (m *MockApp) CreateUser(ctx context.Context, email, username, password stri
 }
 
 // CreateUser indicates an expected call of CreateUser
-func (mr *MockAppMockRecorder) CreateUser(ctx, email, username, password, origin interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) CreateUser(ctx, tenantID, email, username, password, origin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockApp)(nil).CreateUser), ctx, email, username, password, origin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockApp)(nil).CreateUser), ctx, tenantID, email, username, password, origin)
 }
 
 // DeleteUser mocks base method
@@ -124,7 +125,7 @@ func (mr *MockAppMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // User mocks base method
-func (m *MockApp) User(arg0 context.Context, arg1 app.AuthUser, arg2 app.UserID) (*app.User, error) {
+func (m *MockApp) User(arg0 context.Context, arg1 app.AuthUser, arg2 app.PublicID) (*app.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "User", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*app.User)
@@ -154,45 +155,45 @@ func (mr *MockAppMockRecorder) UserByAuthToken(ctx, token interface{}) *gomock.C
 }
 
 // UpdateUsername mocks base method
-func (m *MockApp) UpdateUsername(arg0 context.Context, arg1 app.AuthUser, arg2 string) error {
+func (m *MockApp) UpdateUsername(ctx context.Context, authUser app.AuthUser, username string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUsername", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateUsername", ctx, authUser, username, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUsername indicates an expected call of UpdateUsername
-func (mr *MockAppMockRecorder) UpdateUsername(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) UpdateUsername(ctx, authUser, username, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockApp)(nil).UpdateUsername), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockApp)(nil).UpdateUsername), ctx, authUser, username, version)
 }
 
 // UpdateEmail mocks base method
-func (m *MockApp) UpdateEmail(arg0 context.Context, arg1 app.AuthUser, arg2 string) error {
+func (m *MockApp) UpdateEmail(ctx context.Context, authUser app.AuthUser, email string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEmail", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, authUser, email, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEmail indicates an expected call of UpdateEmail
-func (mr *MockAppMockRecorder) UpdateEmail(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) UpdateEmail(ctx, authUser, email, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockApp)(nil).UpdateEmail), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockApp)(nil).UpdateEmail), ctx, authUser, email, version)
 }
 
 // UpdatePassword mocks base method
-func (m *MockApp) UpdatePassword(ctx context.Context, authUser app.AuthUser, oldPass, newPass string) error {
+func (m *MockApp) UpdatePassword(ctx context.Context, authUser app.AuthUser, oldPass, newPass string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", ctx, authUser, oldPass, newPass)
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, authUser, oldPass, newPass, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePassword indicates an expected call of UpdatePassword
-func (mr *MockAppMockRecorder) UpdatePassword(ctx, authUser, oldPass, newPass interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) UpdatePassword(ctx, authUser, oldPass, newPass, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockApp)(nil).UpdatePassword), ctx, authUser, oldPass, newPass)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockApp)(nil).UpdatePassword), ctx, authUser, oldPass, newPass, version)
 }
 
 // ListUserByUsername mocks base method
@@ -212,29 +213,455 @@ func (mr *MockAppMockRecorder) ListUserByUsername(arg0, arg1, arg2, arg3 interfa
 }
 
 // CreateRecoveryCode mocks base method
-func (m *MockApp) CreateRecoveryCode(ctx context.Context, email string) error {
+func (m *MockApp) CreateRecoveryCode(ctx context.Context, tenantID app.TenantID, email string, channel app.Channel) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRecoveryCode", ctx, email)
+	ret := m.ctrl.Call(m, "CreateRecoveryCode", ctx, tenantID, email, channel)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateRecoveryCode indicates an expected call of CreateRecoveryCode
-func (mr *MockAppMockRecorder) CreateRecoveryCode(ctx, email interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) CreateRecoveryCode(ctx, tenantID, email, channel interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockApp)(nil).CreateRecoveryCode), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockApp)(nil).CreateRecoveryCode), ctx, tenantID, email, channel)
 }
 
 // RecoveryPassword mocks base method
-func (m *MockApp) RecoveryPassword(ctx context.Context, email, code, newPassword string) error {
+func (m *MockApp) RecoveryPassword(ctx context.Context, tenantID app.TenantID, email, code, newPassword string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecoveryPassword", ctx, email, code, newPassword)
+	ret := m.ctrl.Call(m, "RecoveryPassword", ctx, tenantID, email, code, newPassword)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RecoveryPassword indicates an expected call of RecoveryPassword
-func (mr *MockAppMockRecorder) RecoveryPassword(ctx, email, code, newPassword interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) RecoveryPassword(ctx, tenantID, email, code, newPassword interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoveryPassword", reflect.TypeOf((*MockApp)(nil).RecoveryPassword), ctx, email, code, newPassword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoveryPassword", reflect.TypeOf((*MockApp)(nil).RecoveryPassword), ctx, tenantID, email, code, newPassword)
+}
+
+// NotificationSettings mocks base method
+func (m *MockApp) NotificationSettings(arg0 context.Context, arg1 app.AuthUser) ([]app.NotificationSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationSettings", arg0, arg1)
+	ret0, _ := ret[0].([]app.NotificationSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationSettings indicates an expected call of NotificationSettings
+func (mr *MockAppMockRecorder) NotificationSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSettings", reflect.TypeOf((*MockApp)(nil).NotificationSettings), arg0, arg1)
+}
+
+// UpdateNotificationSetting mocks base method
+func (m *MockApp) UpdateNotificationSetting(arg0 context.Context, arg1 app.AuthUser, arg2 app.NotificationSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationSetting", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotificationSetting indicates an expected call of UpdateNotificationSetting
+func (mr *MockAppMockRecorder) UpdateNotificationSetting(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationSetting", reflect.TypeOf((*MockApp)(nil).UpdateNotificationSetting), arg0, arg1, arg2)
+}
+
+// UnsubscribeKind mocks base method
+func (m *MockApp) UnsubscribeKind(ctx context.Context, token string) (app.MessageKind, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeKind", ctx, token)
+	ret0, _ := ret[0].(app.MessageKind)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeKind indicates an expected call of UnsubscribeKind
+func (mr *MockAppMockRecorder) UnsubscribeKind(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeKind", reflect.TypeOf((*MockApp)(nil).UnsubscribeKind), ctx, token)
+}
+
+// Unsubscribe mocks base method
+func (m *MockApp) Unsubscribe(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe
+func (mr *MockAppMockRecorder) Unsubscribe(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockApp)(nil).Unsubscribe), ctx, token)
+}
+
+// ListNotificationTasks mocks base method
+func (m *MockApp) ListNotificationTasks(ctx context.Context, filter app.TaskFilter, page app.Page) ([]app.TaskNotificationInfo, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationTasks", ctx, filter, page)
+	ret0, _ := ret[0].([]app.TaskNotificationInfo)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNotificationTasks indicates an expected call of ListNotificationTasks
+func (mr *MockAppMockRecorder) ListNotificationTasks(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationTasks", reflect.TypeOf((*MockApp)(nil).ListNotificationTasks), ctx, filter, page)
+}
+
+// ResendNotificationTask mocks base method
+func (m *MockApp) ResendNotificationTask(ctx context.Context, id int) (*app.TaskNotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendNotificationTask", ctx, id)
+	ret0, _ := ret[0].(*app.TaskNotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendNotificationTask indicates an expected call of ResendNotificationTask
+func (mr *MockAppMockRecorder) ResendNotificationTask(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendNotificationTask", reflect.TypeOf((*MockApp)(nil).ResendNotificationTask), ctx, id)
+}
+
+// CancelNotificationTask mocks base method
+func (m *MockApp) CancelNotificationTask(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNotificationTask", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelNotificationTask indicates an expected call of CancelNotificationTask
+func (mr *MockAppMockRecorder) CancelNotificationTask(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNotificationTask", reflect.TypeOf((*MockApp)(nil).CancelNotificationTask), ctx, id)
+}
+
+// ScheduleNotification mocks base method
+func (m *MockApp) ScheduleNotification(ctx context.Context, task app.TaskNotification) (*app.TaskNotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleNotification", ctx, task)
+	ret0, _ := ret[0].(*app.TaskNotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleNotification indicates an expected call of ScheduleNotification
+func (mr *MockAppMockRecorder) ScheduleNotification(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleNotification", reflect.TypeOf((*MockApp)(nil).ScheduleNotification), ctx, task)
+}
+
+// ScheduleUserNotification mocks base method
+func (m *MockApp) ScheduleUserNotification(ctx context.Context, userID app.UserID, kind app.MessageKind, payload app.MessagePayload, since app.EventType, delay time.Duration) (*app.TaskNotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleUserNotification", ctx, userID, kind, payload, since, delay)
+	ret0, _ := ret[0].(*app.TaskNotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleUserNotification indicates an expected call of ScheduleUserNotification
+func (mr *MockAppMockRecorder) ScheduleUserNotification(ctx, userID, kind, payload, since, delay interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUserNotification", reflect.TypeOf((*MockApp)(nil).ScheduleUserNotification), ctx, userID, kind, payload, since, delay)
+}
+
+// CancelUserNotifications mocks base method
+func (m *MockApp) CancelUserNotifications(ctx context.Context, userID app.UserID, kind app.MessageKind) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUserNotifications", ctx, userID, kind)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUserNotifications indicates an expected call of CancelUserNotifications
+func (mr *MockAppMockRecorder) CancelUserNotifications(ctx, userID, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserNotifications", reflect.TypeOf((*MockApp)(nil).CancelUserNotifications), ctx, userID, kind)
+}
+
+// UserNotifications mocks base method
+func (m *MockApp) UserNotifications(ctx context.Context, authUser app.AuthUser, page app.Page) ([]app.UserNotification, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserNotifications", ctx, authUser, page)
+	ret0, _ := ret[0].([]app.UserNotification)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UserNotifications indicates an expected call of UserNotifications
+func (mr *MockAppMockRecorder) UserNotifications(ctx, authUser, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserNotifications", reflect.TypeOf((*MockApp)(nil).UserNotifications), ctx, authUser, page)
+}
+
+// MarkNotificationsRead mocks base method
+func (m *MockApp) MarkNotificationsRead(ctx context.Context, authUser app.AuthUser, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, authUser, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead
+func (mr *MockAppMockRecorder) MarkNotificationsRead(ctx, authUser, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockApp)(nil).MarkNotificationsRead), ctx, authUser, ids)
+}
+
+// UnreadNotificationCount mocks base method
+func (m *MockApp) UnreadNotificationCount(ctx context.Context, authUser app.AuthUser) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadNotificationCount", ctx, authUser)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadNotificationCount indicates an expected call of UnreadNotificationCount
+func (mr *MockAppMockRecorder) UnreadNotificationCount(ctx, authUser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadNotificationCount", reflect.TypeOf((*MockApp)(nil).UnreadNotificationCount), ctx, authUser)
+}
+
+// WatchUserNotifications mocks base method
+func (m *MockApp) WatchUserNotifications(ctx context.Context, authUser app.AuthUser, afterID int, fn func(app.UserNotification) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUserNotifications", ctx, authUser, afterID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchUserNotifications indicates an expected call of WatchUserNotifications
+func (mr *MockAppMockRecorder) WatchUserNotifications(ctx, authUser, afterID, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserNotifications", reflect.TypeOf((*MockApp)(nil).WatchUserNotifications), ctx, authUser, afterID, fn)
+}
+
+// HandleEmailEvents mocks base method
+func (m *MockApp) HandleEmailEvents(ctx context.Context, events []app.EmailEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleEmailEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleEmailEvents indicates an expected call of HandleEmailEvents
+func (mr *MockAppMockRecorder) HandleEmailEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEmailEvents", reflect.TypeOf((*MockApp)(nil).HandleEmailEvents), ctx, events)
+}
+
+// RequestPhoneVerification mocks base method
+func (m *MockApp) RequestPhoneVerification(ctx context.Context, authUser app.AuthUser, phone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPhoneVerification", ctx, authUser, phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPhoneVerification indicates an expected call of RequestPhoneVerification
+func (mr *MockAppMockRecorder) RequestPhoneVerification(ctx, authUser, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPhoneVerification", reflect.TypeOf((*MockApp)(nil).RequestPhoneVerification), ctx, authUser, phone)
+}
+
+// VerifyPhone mocks base method
+func (m *MockApp) VerifyPhone(ctx context.Context, authUser app.AuthUser, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPhone", ctx, authUser, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyPhone indicates an expected call of VerifyPhone
+func (mr *MockAppMockRecorder) VerifyPhone(ctx, authUser, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPhone", reflect.TypeOf((*MockApp)(nil).VerifyPhone), ctx, authUser, code)
+}
+
+// DeletePhone mocks base method
+func (m *MockApp) DeletePhone(ctx context.Context, authUser app.AuthUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhone", ctx, authUser)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePhone indicates an expected call of DeletePhone
+func (mr *MockAppMockRecorder) DeletePhone(ctx, authUser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhone", reflect.TypeOf((*MockApp)(nil).DeletePhone), ctx, authUser)
+}
+
+// Tenant mocks base method
+func (m *MockApp) Tenant(ctx context.Context, name, host string) (*app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tenant", ctx, name, host)
+	ret0, _ := ret[0].(*app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tenant indicates an expected call of Tenant
+func (mr *MockAppMockRecorder) Tenant(ctx, name, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tenant", reflect.TypeOf((*MockApp)(nil).Tenant), ctx, name, host)
+}
+
+// CreateTenant mocks base method
+func (m *MockApp) CreateTenant(ctx context.Context, name, host string) (*app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenant", ctx, name, host)
+	ret0, _ := ret[0].(*app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTenant indicates an expected call of CreateTenant
+func (mr *MockAppMockRecorder) CreateTenant(ctx, name, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockApp)(nil).CreateTenant), ctx, name, host)
+}
+
+// ListTenants mocks base method
+func (m *MockApp) ListTenants(arg0 context.Context) ([]app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenants", arg0)
+	ret0, _ := ret[0].([]app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenants indicates an expected call of ListTenants
+func (mr *MockAppMockRecorder) ListTenants(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenants", reflect.TypeOf((*MockApp)(nil).ListTenants), arg0)
+}
+
+// CreateOrg mocks base method
+func (m *MockApp) CreateOrg(ctx context.Context, authUser app.AuthUser, name string) (*app.Org, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrg", ctx, authUser, name)
+	ret0, _ := ret[0].(*app.Org)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg
+func (mr *MockAppMockRecorder) CreateOrg(ctx, authUser, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockApp)(nil).CreateOrg), ctx, authUser, name)
+}
+
+// ListOrgs mocks base method
+func (m *MockApp) ListOrgs(ctx context.Context, authUser app.AuthUser) ([]app.OrgMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgs", ctx, authUser)
+	ret0, _ := ret[0].([]app.OrgMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgs indicates an expected call of ListOrgs
+func (mr *MockAppMockRecorder) ListOrgs(ctx, authUser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgs", reflect.TypeOf((*MockApp)(nil).ListOrgs), ctx, authUser)
+}
+
+// InviteOrgMember mocks base method
+func (m *MockApp) InviteOrgMember(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, email string, role app.OrgRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteOrgMember", ctx, authUser, orgID, email, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InviteOrgMember indicates an expected call of InviteOrgMember
+func (mr *MockAppMockRecorder) InviteOrgMember(ctx, authUser, orgID, email, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteOrgMember", reflect.TypeOf((*MockApp)(nil).InviteOrgMember), ctx, authUser, orgID, email, role)
+}
+
+// AcceptOrgInvitation mocks base method
+func (m *MockApp) AcceptOrgInvitation(ctx context.Context, authUser app.AuthUser, token string) (*app.OrgMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrgInvitation", ctx, authUser, token)
+	ret0, _ := ret[0].(*app.OrgMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrgInvitation indicates an expected call of AcceptOrgInvitation
+func (mr *MockAppMockRecorder) AcceptOrgInvitation(ctx, authUser, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrgInvitation", reflect.TypeOf((*MockApp)(nil).AcceptOrgInvitation), ctx, authUser, token)
+}
+
+// ListOrgMembers mocks base method
+func (m *MockApp) ListOrgMembers(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, page app.Page) ([]app.OrgMember, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgMembers", ctx, authUser, orgID, page)
+	ret0, _ := ret[0].([]app.OrgMember)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListOrgMembers indicates an expected call of ListOrgMembers
+func (mr *MockAppMockRecorder) ListOrgMembers(ctx, authUser, orgID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgMembers", reflect.TypeOf((*MockApp)(nil).ListOrgMembers), ctx, authUser, orgID, page)
+}
+
+// RemoveOrgMember mocks base method
+func (m *MockApp) RemoveOrgMember(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, publicID app.PublicID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrgMember", ctx, authUser, orgID, publicID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrgMember indicates an expected call of RemoveOrgMember
+func (mr *MockAppMockRecorder) RemoveOrgMember(ctx, authUser, orgID, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrgMember", reflect.TypeOf((*MockApp)(nil).RemoveOrgMember), ctx, authUser, orgID, publicID)
+}
+
+// TransferOrgOwnership mocks base method
+func (m *MockApp) TransferOrgOwnership(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, publicID app.PublicID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOrgOwnership", ctx, authUser, orgID, publicID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOrgOwnership indicates an expected call of TransferOrgOwnership
+func (mr *MockAppMockRecorder) TransferOrgOwnership(ctx, authUser, orgID, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOrgOwnership", reflect.TypeOf((*MockApp)(nil).TransferOrgOwnership), ctx, authUser, orgID, publicID)
+}
+
+// OrgMember mocks base method
+func (m *MockApp) OrgMember(ctx context.Context, orgID app.OrgID, publicID app.PublicID) (*app.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgMember", ctx, orgID, publicID)
+	ret0, _ := ret[0].(*app.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgMember indicates an expected call of OrgMember
+func (mr *MockAppMockRecorder) OrgMember(ctx, orgID, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgMember", reflect.TypeOf((*MockApp)(nil).OrgMember), ctx, orgID, publicID)
 }
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/email_event.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockDeliverabilityApp is a mock of DeliverabilityApp interface
+type MockDeliverabilityApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliverabilityAppMockRecorder
+}
+
+// MockDeliverabilityAppMockRecorder is the mock recorder for MockDeliverabilityApp
+type MockDeliverabilityAppMockRecorder struct {
+	mock *MockDeliverabilityApp
+}
+
+// NewMockDeliverabilityApp creates a new mock instance
+func NewMockDeliverabilityApp(ctrl *gomock.Controller) *MockDeliverabilityApp {
+	mock := &MockDeliverabilityApp{ctrl: ctrl}
+	mock.recorder = &MockDeliverabilityAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeliverabilityApp) EXPECT() *MockDeliverabilityAppMockRecorder {
+	return m.recorder
+}
+
+// HandleEmailEvents mocks base method
+func (m *MockDeliverabilityApp) HandleEmailEvents(ctx context.Context, events []app.EmailEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleEmailEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleEmailEvents indicates an expected call of HandleEmailEvents
+func (mr *MockDeliverabilityAppMockRecorder) HandleEmailEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEmailEvents", reflect.TypeOf((*MockDeliverabilityApp)(nil).HandleEmailEvents), ctx, events)
+}
+
+// MockDeliverabilityRepo is a mock of DeliverabilityRepo interface
+type MockDeliverabilityRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliverabilityRepoMockRecorder
+}
+
+// MockDeliverabilityRepoMockRecorder is the mock recorder for MockDeliverabilityRepo
+type MockDeliverabilityRepoMockRecorder struct {
+	mock *MockDeliverabilityRepo
+}
+
+// NewMockDeliverabilityRepo creates a new mock instance
+func NewMockDeliverabilityRepo(ctrl *gomock.Controller) *MockDeliverabilityRepo {
+	mock := &MockDeliverabilityRepo{ctrl: ctrl}
+	mock.recorder = &MockDeliverabilityRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeliverabilityRepo) EXPECT() *MockDeliverabilityRepoMockRecorder {
+	return m.recorder
+}
+
+// SaveEmailEvent mocks base method
+func (m *MockDeliverabilityRepo) SaveEmailEvent(ctx context.Context, event app.EmailEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEmailEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEmailEvent indicates an expected call of SaveEmailEvent
+func (mr *MockDeliverabilityRepoMockRecorder) SaveEmailEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEmailEvent", reflect.TypeOf((*MockDeliverabilityRepo)(nil).SaveEmailEvent), ctx, event)
+}
+
+// LastEmailEvent mocks base method
+func (m *MockDeliverabilityRepo) LastEmailEvent(ctx context.Context, email string) (*app.EmailEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastEmailEvent", ctx, email)
+	ret0, _ := ret[0].(*app.EmailEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastEmailEvent indicates an expected call of LastEmailEvent
+func (mr *MockDeliverabilityRepoMockRecorder) LastEmailEvent(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastEmailEvent", reflect.TypeOf((*MockDeliverabilityRepo)(nil).LastEmailEvent), ctx, email)
+}
@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/event.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockEventApplication is a mock of EventApplication interface
+type MockEventApplication struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventApplicationMockRecorder
+}
+
+// MockEventApplicationMockRecorder is the mock recorder for MockEventApplication
+type MockEventApplicationMockRecorder struct {
+	mock *MockEventApplication
+}
+
+// NewMockEventApplication creates a new mock instance
+func NewMockEventApplication(ctrl *gomock.Controller) *MockEventApplication {
+	mock := &MockEventApplication{ctrl: ctrl}
+	mock.recorder = &MockEventApplicationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventApplication) EXPECT() *MockEventApplicationMockRecorder {
+	return m.recorder
+}
+
+// StartEventRelay mocks base method
+func (m *MockEventApplication) StartEventRelay(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartEventRelay", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartEventRelay indicates an expected call of StartEventRelay
+func (mr *MockEventApplicationMockRecorder) StartEventRelay(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartEventRelay", reflect.TypeOf((*MockEventApplication)(nil).StartEventRelay), ctx)
+}
+
+// MockEventRepo is a mock of EventRepo interface
+type MockEventRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepoMockRecorder
+}
+
+// MockEventRepoMockRecorder is the mock recorder for MockEventRepo
+type MockEventRepoMockRecorder struct {
+	mock *MockEventRepo
+}
+
+// NewMockEventRepo creates a new mock instance
+func NewMockEventRepo(ctrl *gomock.Controller) *MockEventRepo {
+	mock := &MockEventRepo{ctrl: ctrl}
+	mock.recorder = &MockEventRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventRepo) EXPECT() *MockEventRepoMockRecorder {
+	return m.recorder
+}
+
+// UnpublishedEvents mocks base method
+func (m *MockEventRepo) UnpublishedEvents(ctx context.Context, limit int) ([]app.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishedEvents", ctx, limit)
+	ret0, _ := ret[0].([]app.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishedEvents indicates an expected call of UnpublishedEvents
+func (mr *MockEventRepoMockRecorder) UnpublishedEvents(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishedEvents", reflect.TypeOf((*MockEventRepo)(nil).UnpublishedEvents), ctx, limit)
+}
+
+// EventsPublished mocks base method
+func (m *MockEventRepo) EventsPublished(ctx context.Context, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventsPublished", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventsPublished indicates an expected call of EventsPublished
+func (mr *MockEventRepoMockRecorder) EventsPublished(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventsPublished", reflect.TypeOf((*MockEventRepo)(nil).EventsPublished), ctx, ids)
+}
+
+// LastUserEvent mocks base method
+func (m *MockEventRepo) LastUserEvent(ctx context.Context, userID app.UserID, eventType app.EventType) (*app.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastUserEvent", ctx, userID, eventType)
+	ret0, _ := ret[0].(*app.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastUserEvent indicates an expected call of LastUserEvent
+func (mr *MockEventRepoMockRecorder) LastUserEvent(ctx, userID, eventType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastUserEvent", reflect.TypeOf((*MockEventRepo)(nil).LastUserEvent), ctx, userID, eventType)
+}
+
+// MockBroker is a mock of Broker interface
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method
+func (m *MockBroker) Publish(ctx context.Context, events []app.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockBrokerMockRecorder) Publish(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBroker)(nil).Publish), ctx, events)
+}
+
+// MockEventMetrics is a mock of EventMetrics interface
+type MockEventMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventMetricsMockRecorder
+}
+
+// MockEventMetricsMockRecorder is the mock recorder for MockEventMetrics
+type MockEventMetricsMockRecorder struct {
+	mock *MockEventMetrics
+}
+
+// NewMockEventMetrics creates a new mock instance
+func NewMockEventMetrics(ctrl *gomock.Controller) *MockEventMetrics {
+	mock := &MockEventMetrics{ctrl: ctrl}
+	mock.recorder = &MockEventMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventMetrics) EXPECT() *MockEventMetricsMockRecorder {
+	return m.recorder
+}
+
+// PublishFailed mocks base method
+func (m *MockEventMetrics) PublishFailed(events []app.Event, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PublishFailed", events, err)
+}
+
+// PublishFailed indicates an expected call of PublishFailed
+func (mr *MockEventMetricsMockRecorder) PublishFailed(events, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishFailed", reflect.TypeOf((*MockEventMetrics)(nil).PublishFailed), events, err)
+}
@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/inbox.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockInboxApp is a mock of InboxApp interface
+type MockInboxApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxAppMockRecorder
+}
+
+// MockInboxAppMockRecorder is the mock recorder for MockInboxApp
+type MockInboxAppMockRecorder struct {
+	mock *MockInboxApp
+}
+
+// NewMockInboxApp creates a new mock instance
+func NewMockInboxApp(ctrl *gomock.Controller) *MockInboxApp {
+	mock := &MockInboxApp{ctrl: ctrl}
+	mock.recorder = &MockInboxAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInboxApp) EXPECT() *MockInboxAppMockRecorder {
+	return m.recorder
+}
+
+// UserNotifications mocks base method
+func (m *MockInboxApp) UserNotifications(ctx context.Context, authUser app.AuthUser, page app.Page) ([]app.UserNotification, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserNotifications", ctx, authUser, page)
+	ret0, _ := ret[0].([]app.UserNotification)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UserNotifications indicates an expected call of UserNotifications
+func (mr *MockInboxAppMockRecorder) UserNotifications(ctx, authUser, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserNotifications", reflect.TypeOf((*MockInboxApp)(nil).UserNotifications), ctx, authUser, page)
+}
+
+// MarkNotificationsRead mocks base method
+func (m *MockInboxApp) MarkNotificationsRead(ctx context.Context, authUser app.AuthUser, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, authUser, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead
+func (mr *MockInboxAppMockRecorder) MarkNotificationsRead(ctx, authUser, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockInboxApp)(nil).MarkNotificationsRead), ctx, authUser, ids)
+}
+
+// UnreadNotificationCount mocks base method
+func (m *MockInboxApp) UnreadNotificationCount(ctx context.Context, authUser app.AuthUser) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadNotificationCount", ctx, authUser)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadNotificationCount indicates an expected call of UnreadNotificationCount
+func (mr *MockInboxAppMockRecorder) UnreadNotificationCount(ctx, authUser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadNotificationCount", reflect.TypeOf((*MockInboxApp)(nil).UnreadNotificationCount), ctx, authUser)
+}
+
+// WatchUserNotifications mocks base method
+func (m *MockInboxApp) WatchUserNotifications(ctx context.Context, authUser app.AuthUser, afterID int, fn func(app.UserNotification) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUserNotifications", ctx, authUser, afterID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchUserNotifications indicates an expected call of WatchUserNotifications
+func (mr *MockInboxAppMockRecorder) WatchUserNotifications(ctx, authUser, afterID, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserNotifications", reflect.TypeOf((*MockInboxApp)(nil).WatchUserNotifications), ctx, authUser, afterID, fn)
+}
+
+// MockInboxRepo is a mock of InboxRepo interface
+type MockInboxRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxRepoMockRecorder
+}
+
+// MockInboxRepoMockRecorder is the mock recorder for MockInboxRepo
+type MockInboxRepoMockRecorder struct {
+	mock *MockInboxRepo
+}
+
+// NewMockInboxRepo creates a new mock instance
+func NewMockInboxRepo(ctrl *gomock.Controller) *MockInboxRepo {
+	mock := &MockInboxRepo{ctrl: ctrl}
+	mock.recorder = &MockInboxRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInboxRepo) EXPECT() *MockInboxRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateUserNotification mocks base method
+func (m *MockInboxRepo) CreateUserNotification(ctx context.Context, email string, msg app.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserNotification", ctx, email, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserNotification indicates an expected call of CreateUserNotification
+func (mr *MockInboxRepoMockRecorder) CreateUserNotification(ctx, email, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserNotification", reflect.TypeOf((*MockInboxRepo)(nil).CreateUserNotification), ctx, email, msg)
+}
+
+// UserNotifications mocks base method
+func (m *MockInboxRepo) UserNotifications(ctx context.Context, userID app.UserID, page app.Page) ([]app.UserNotification, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserNotifications", ctx, userID, page)
+	ret0, _ := ret[0].([]app.UserNotification)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UserNotifications indicates an expected call of UserNotifications
+func (mr *MockInboxRepoMockRecorder) UserNotifications(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserNotifications", reflect.TypeOf((*MockInboxRepo)(nil).UserNotifications), ctx, userID, page)
+}
+
+// UserNotificationsAfter mocks base method
+func (m *MockInboxRepo) UserNotificationsAfter(ctx context.Context, userID app.UserID, afterID, limit int) ([]app.UserNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserNotificationsAfter", ctx, userID, afterID, limit)
+	ret0, _ := ret[0].([]app.UserNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserNotificationsAfter indicates an expected call of UserNotificationsAfter
+func (mr *MockInboxRepoMockRecorder) UserNotificationsAfter(ctx, userID, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserNotificationsAfter", reflect.TypeOf((*MockInboxRepo)(nil).UserNotificationsAfter), ctx, userID, afterID, limit)
+}
+
+// MarkUserNotificationsRead mocks base method
+func (m *MockInboxRepo) MarkUserNotificationsRead(ctx context.Context, userID app.UserID, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUserNotificationsRead", ctx, userID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUserNotificationsRead indicates an expected call of MarkUserNotificationsRead
+func (mr *MockInboxRepoMockRecorder) MarkUserNotificationsRead(ctx, userID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUserNotificationsRead", reflect.TypeOf((*MockInboxRepo)(nil).MarkUserNotificationsRead), ctx, userID, ids)
+}
+
+// UnreadUserNotificationCount mocks base method
+func (m *MockInboxRepo) UnreadUserNotificationCount(ctx context.Context, userID app.UserID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadUserNotificationCount", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadUserNotificationCount indicates an expected call of UnreadUserNotificationCount
+func (mr *MockInboxRepoMockRecorder) UnreadUserNotificationCount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadUserNotificationCount", reflect.TypeOf((*MockInboxRepo)(nil).UnreadUserNotificationCount), ctx, userID)
+}
@@ -0,0 +1,234 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/job.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockJobApplication is a mock of JobApplication interface
+type MockJobApplication struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobApplicationMockRecorder
+}
+
+// MockJobApplicationMockRecorder is the mock recorder for MockJobApplication
+type MockJobApplicationMockRecorder struct {
+	mock *MockJobApplication
+}
+
+// NewMockJobApplication creates a new mock instance
+func NewMockJobApplication(ctrl *gomock.Controller) *MockJobApplication {
+	mock := &MockJobApplication{ctrl: ctrl}
+	mock.recorder = &MockJobApplicationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJobApplication) EXPECT() *MockJobApplicationMockRecorder {
+	return m.recorder
+}
+
+// StartJobs mocks base method
+func (m *MockJobApplication) StartJobs(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartJobs", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartJobs indicates an expected call of StartJobs
+func (mr *MockJobApplicationMockRecorder) StartJobs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJobs", reflect.TypeOf((*MockJobApplication)(nil).StartJobs), ctx)
+}
+
+// StartSingletonJobs mocks base method
+func (m *MockJobApplication) StartSingletonJobs(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSingletonJobs", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSingletonJobs indicates an expected call of StartSingletonJobs
+func (mr *MockJobApplicationMockRecorder) StartSingletonJobs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSingletonJobs", reflect.TypeOf((*MockJobApplication)(nil).StartSingletonJobs), ctx)
+}
+
+// StartScheduler mocks base method
+func (m *MockJobApplication) StartScheduler(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartScheduler", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartScheduler indicates an expected call of StartScheduler
+func (mr *MockJobApplicationMockRecorder) StartScheduler(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartScheduler", reflect.TypeOf((*MockJobApplication)(nil).StartScheduler), ctx)
+}
+
+// MockJobRepo is a mock of JobRepo interface
+type MockJobRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepoMockRecorder
+}
+
+// MockJobRepoMockRecorder is the mock recorder for MockJobRepo
+type MockJobRepoMockRecorder struct {
+	mock *MockJobRepo
+}
+
+// NewMockJobRepo creates a new mock instance
+func NewMockJobRepo(ctrl *gomock.Controller) *MockJobRepo {
+	mock := &MockJobRepo{ctrl: ctrl}
+	mock.recorder = &MockJobRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJobRepo) EXPECT() *MockJobRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateJob mocks base method
+func (m *MockJobRepo) CreateJob(ctx context.Context, job app.Job) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, job)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob
+func (mr *MockJobRepoMockRecorder) CreateJob(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockJobRepo)(nil).CreateJob), ctx, job)
+}
+
+// NextJob mocks base method
+func (m *MockJobRepo) NextJob(ctx context.Context, kinds []app.JobKind, lease time.Duration) (*app.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextJob", ctx, kinds, lease)
+	ret0, _ := ret[0].(*app.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextJob indicates an expected call of NextJob
+func (mr *MockJobRepoMockRecorder) NextJob(ctx, kinds, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextJob", reflect.TypeOf((*MockJobRepo)(nil).NextJob), ctx, kinds, lease)
+}
+
+// CompleteJob mocks base method
+func (m *MockJobRepo) CompleteJob(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteJob", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteJob indicates an expected call of CompleteJob
+func (mr *MockJobRepoMockRecorder) CompleteJob(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteJob", reflect.TypeOf((*MockJobRepo)(nil).CompleteJob), ctx, id)
+}
+
+// RetryJob mocks base method
+func (m *MockJobRepo) RetryJob(ctx context.Context, id int, jobErr error, runAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", ctx, id, jobErr, runAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryJob indicates an expected call of RetryJob
+func (mr *MockJobRepoMockRecorder) RetryJob(ctx, id, jobErr, runAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockJobRepo)(nil).RetryJob), ctx, id, jobErr, runAt)
+}
+
+// FailJob mocks base method
+func (m *MockJobRepo) FailJob(ctx context.Context, id int, jobErr error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailJob", ctx, id, jobErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailJob indicates an expected call of FailJob
+func (mr *MockJobRepoMockRecorder) FailJob(ctx, id, jobErr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailJob", reflect.TypeOf((*MockJobRepo)(nil).FailJob), ctx, id, jobErr)
+}
+
+// MockSchedule is a mock of Schedule interface
+type MockSchedule struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleMockRecorder
+}
+
+// MockScheduleMockRecorder is the mock recorder for MockSchedule
+type MockScheduleMockRecorder struct {
+	mock *MockSchedule
+}
+
+// NewMockSchedule creates a new mock instance
+func NewMockSchedule(ctrl *gomock.Controller) *MockSchedule {
+	mock := &MockSchedule{ctrl: ctrl}
+	mock.recorder = &MockScheduleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSchedule) EXPECT() *MockScheduleMockRecorder {
+	return m.recorder
+}
+
+// Next mocks base method
+func (m *MockSchedule) Next(arg0 time.Time) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", arg0)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Next indicates an expected call of Next
+func (mr *MockScheduleMockRecorder) Next(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockSchedule)(nil).Next), arg0)
+}
+
+// MockJobPayload is a mock of JobPayload interface
+type MockJobPayload struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobPayloadMockRecorder
+}
+
+// MockJobPayloadMockRecorder is the mock recorder for MockJobPayload
+type MockJobPayloadMockRecorder struct {
+	mock *MockJobPayload
+}
+
+// NewMockJobPayload creates a new mock instance
+func NewMockJobPayload(ctrl *gomock.Controller) *MockJobPayload {
+	mock := &MockJobPayload{ctrl: ctrl}
+	mock.recorder = &MockJobPayloadMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJobPayload) EXPECT() *MockJobPayloadMockRecorder {
+	return m.recorder
+}
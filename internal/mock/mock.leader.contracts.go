@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/leader.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockLeaderApplication is a mock of LeaderApplication interface
+type MockLeaderApplication struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderApplicationMockRecorder
+}
+
+// MockLeaderApplicationMockRecorder is the mock recorder for MockLeaderApplication
+type MockLeaderApplicationMockRecorder struct {
+	mock *MockLeaderApplication
+}
+
+// NewMockLeaderApplication creates a new mock instance
+func NewMockLeaderApplication(ctrl *gomock.Controller) *MockLeaderApplication {
+	mock := &MockLeaderApplication{ctrl: ctrl}
+	mock.recorder = &MockLeaderApplicationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLeaderApplication) EXPECT() *MockLeaderApplicationMockRecorder {
+	return m.recorder
+}
+
+// RunAsLeader mocks base method
+func (m *MockLeaderApplication) RunAsLeader(ctx context.Context, election string, start func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunAsLeader", ctx, election, start)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunAsLeader indicates an expected call of RunAsLeader
+func (mr *MockLeaderApplicationMockRecorder) RunAsLeader(ctx, election, start interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAsLeader", reflect.TypeOf((*MockLeaderApplication)(nil).RunAsLeader), ctx, election, start)
+}
+
+// MockLeaderRepo is a mock of LeaderRepo interface
+type MockLeaderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderRepoMockRecorder
+}
+
+// MockLeaderRepoMockRecorder is the mock recorder for MockLeaderRepo
+type MockLeaderRepoMockRecorder struct {
+	mock *MockLeaderRepo
+}
+
+// NewMockLeaderRepo creates a new mock instance
+func NewMockLeaderRepo(ctrl *gomock.Controller) *MockLeaderRepo {
+	mock := &MockLeaderRepo{ctrl: ctrl}
+	mock.recorder = &MockLeaderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLeaderRepo) EXPECT() *MockLeaderRepoMockRecorder {
+	return m.recorder
+}
+
+// AcquireLeadership mocks base method
+func (m *MockLeaderRepo) AcquireLeadership(ctx context.Context, election, holder string, ttl time.Duration) (*app.Lease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireLeadership", ctx, election, holder, ttl)
+	ret0, _ := ret[0].(*app.Lease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireLeadership indicates an expected call of AcquireLeadership
+func (mr *MockLeaderRepoMockRecorder) AcquireLeadership(ctx, election, holder, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireLeadership", reflect.TypeOf((*MockLeaderRepo)(nil).AcquireLeadership), ctx, election, holder, ttl)
+}
+
+// RenewLeadership mocks base method
+func (m *MockLeaderRepo) RenewLeadership(ctx context.Context, lease app.Lease, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewLeadership", ctx, lease, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenewLeadership indicates an expected call of RenewLeadership
+func (mr *MockLeaderRepoMockRecorder) RenewLeadership(ctx, lease, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewLeadership", reflect.TypeOf((*MockLeaderRepo)(nil).RenewLeadership), ctx, lease, ttl)
+}
+
+// ReleaseLeadership mocks base method
+func (m *MockLeaderRepo) ReleaseLeadership(ctx context.Context, lease app.Lease) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLeadership", ctx, lease)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLeadership indicates an expected call of ReleaseLeadership
+func (mr *MockLeaderRepoMockRecorder) ReleaseLeadership(ctx, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLeadership", reflect.TypeOf((*MockLeaderRepo)(nil).ReleaseLeadership), ctx, lease)
+}
+
+// MockLeaderMetrics is a mock of LeaderMetrics interface
+type MockLeaderMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderMetricsMockRecorder
+}
+
+// MockLeaderMetricsMockRecorder is the mock recorder for MockLeaderMetrics
+type MockLeaderMetricsMockRecorder struct {
+	mock *MockLeaderMetrics
+}
+
+// NewMockLeaderMetrics creates a new mock instance
+func NewMockLeaderMetrics(ctrl *gomock.Controller) *MockLeaderMetrics {
+	mock := &MockLeaderMetrics{ctrl: ctrl}
+	mock.recorder = &MockLeaderMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLeaderMetrics) EXPECT() *MockLeaderMetricsMockRecorder {
+	return m.recorder
+}
+
+// Leader mocks base method
+func (m *MockLeaderMetrics) Leader(lease app.Lease, isLeader bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Leader", lease, isLeader)
+}
+
+// Leader indicates an expected call of Leader
+func (mr *MockLeaderMetricsMockRecorder) Leader(lease, isLeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leader", reflect.TypeOf((*MockLeaderMetrics)(nil).Leader), lease, isLeader)
+}
@@ -5,6 +5,7 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -47,3 +48,77 @@ func (mr *MockNotificationMockRecorder) Notification(contact, msg interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notification", reflect.TypeOf((*MockNotification)(nil).Notification), contact, msg)
 }
+
+// MockProviderRepo is a mock of ProviderRepo interface
+type MockProviderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderRepoMockRecorder
+}
+
+// MockProviderRepoMockRecorder is the mock recorder for MockProviderRepo
+type MockProviderRepoMockRecorder struct {
+	mock *MockProviderRepo
+}
+
+// NewMockProviderRepo creates a new mock instance
+func NewMockProviderRepo(ctrl *gomock.Controller) *MockProviderRepo {
+	mock := &MockProviderRepo{ctrl: ctrl}
+	mock.recorder = &MockProviderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProviderRepo) EXPECT() *MockProviderRepoMockRecorder {
+	return m.recorder
+}
+
+// SaveTaskNotificationProvider mocks base method
+func (m *MockProviderRepo) SaveTaskNotificationProvider(ctx context.Context, taskID int, provider string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTaskNotificationProvider", ctx, taskID, provider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTaskNotificationProvider indicates an expected call of SaveTaskNotificationProvider
+func (mr *MockProviderRepoMockRecorder) SaveTaskNotificationProvider(ctx, taskID, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaskNotificationProvider", reflect.TypeOf((*MockProviderRepo)(nil).SaveTaskNotificationProvider), ctx, taskID, provider)
+}
+
+// MockMessagePayload is a mock of MessagePayload interface
+type MockMessagePayload struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessagePayloadMockRecorder
+}
+
+// MockMessagePayloadMockRecorder is the mock recorder for MockMessagePayload
+type MockMessagePayloadMockRecorder struct {
+	mock *MockMessagePayload
+}
+
+// NewMockMessagePayload creates a new mock instance
+func NewMockMessagePayload(ctrl *gomock.Controller) *MockMessagePayload {
+	mock := &MockMessagePayload{ctrl: ctrl}
+	mock.recorder = &MockMessagePayloadMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockMessagePayload) EXPECT() *MockMessagePayloadMockRecorder {
+	return m.recorder
+}
+
+// Content mocks base method
+func (m *MockMessagePayload) Content() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Content")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Content indicates an expected call of Content
+func (mr *MockMessagePayloadMockRecorder) Content() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Content", reflect.TypeOf((*MockMessagePayload)(nil).Content))
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/notification_admin.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockNotificationAdminApp is a mock of NotificationAdminApp interface
+type MockNotificationAdminApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationAdminAppMockRecorder
+}
+
+// MockNotificationAdminAppMockRecorder is the mock recorder for MockNotificationAdminApp
+type MockNotificationAdminAppMockRecorder struct {
+	mock *MockNotificationAdminApp
+}
+
+// NewMockNotificationAdminApp creates a new mock instance
+func NewMockNotificationAdminApp(ctrl *gomock.Controller) *MockNotificationAdminApp {
+	mock := &MockNotificationAdminApp{ctrl: ctrl}
+	mock.recorder = &MockNotificationAdminAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotificationAdminApp) EXPECT() *MockNotificationAdminAppMockRecorder {
+	return m.recorder
+}
+
+// ListNotificationTasks mocks base method
+func (m *MockNotificationAdminApp) ListNotificationTasks(ctx context.Context, filter app.TaskFilter, page app.Page) ([]app.TaskNotificationInfo, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationTasks", ctx, filter, page)
+	ret0, _ := ret[0].([]app.TaskNotificationInfo)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNotificationTasks indicates an expected call of ListNotificationTasks
+func (mr *MockNotificationAdminAppMockRecorder) ListNotificationTasks(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationTasks", reflect.TypeOf((*MockNotificationAdminApp)(nil).ListNotificationTasks), ctx, filter, page)
+}
+
+// ResendNotificationTask mocks base method
+func (m *MockNotificationAdminApp) ResendNotificationTask(ctx context.Context, id int) (*app.TaskNotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendNotificationTask", ctx, id)
+	ret0, _ := ret[0].(*app.TaskNotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendNotificationTask indicates an expected call of ResendNotificationTask
+func (mr *MockNotificationAdminAppMockRecorder) ResendNotificationTask(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendNotificationTask", reflect.TypeOf((*MockNotificationAdminApp)(nil).ResendNotificationTask), ctx, id)
+}
+
+// CancelNotificationTask mocks base method
+func (m *MockNotificationAdminApp) CancelNotificationTask(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNotificationTask", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelNotificationTask indicates an expected call of CancelNotificationTask
+func (mr *MockNotificationAdminAppMockRecorder) CancelNotificationTask(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNotificationTask", reflect.TypeOf((*MockNotificationAdminApp)(nil).CancelNotificationTask), ctx, id)
+}
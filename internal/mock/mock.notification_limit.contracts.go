@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/notification_limit.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockNotificationMetrics is a mock of NotificationMetrics interface
+type MockNotificationMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationMetricsMockRecorder
+}
+
+// MockNotificationMetricsMockRecorder is the mock recorder for MockNotificationMetrics
+type MockNotificationMetricsMockRecorder struct {
+	mock *MockNotificationMetrics
+}
+
+// NewMockNotificationMetrics creates a new mock instance
+func NewMockNotificationMetrics(ctrl *gomock.Controller) *MockNotificationMetrics {
+	mock := &MockNotificationMetrics{ctrl: ctrl}
+	mock.recorder = &MockNotificationMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotificationMetrics) EXPECT() *MockNotificationMetricsMockRecorder {
+	return m.recorder
+}
+
+// NotificationSuppressed mocks base method
+func (m *MockNotificationMetrics) NotificationSuppressed(kind app.MessageKind, reason app.SuppressReason) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotificationSuppressed", kind, reason)
+}
+
+// NotificationSuppressed indicates an expected call of NotificationSuppressed
+func (mr *MockNotificationMetricsMockRecorder) NotificationSuppressed(kind, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSuppressed", reflect.TypeOf((*MockNotificationMetrics)(nil).NotificationSuppressed), kind, reason)
+}
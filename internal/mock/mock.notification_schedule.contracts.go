@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/notification_schedule.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockNotificationScheduleApp is a mock of NotificationScheduleApp interface
+type MockNotificationScheduleApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationScheduleAppMockRecorder
+}
+
+// MockNotificationScheduleAppMockRecorder is the mock recorder for MockNotificationScheduleApp
+type MockNotificationScheduleAppMockRecorder struct {
+	mock *MockNotificationScheduleApp
+}
+
+// NewMockNotificationScheduleApp creates a new mock instance
+func NewMockNotificationScheduleApp(ctrl *gomock.Controller) *MockNotificationScheduleApp {
+	mock := &MockNotificationScheduleApp{ctrl: ctrl}
+	mock.recorder = &MockNotificationScheduleAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotificationScheduleApp) EXPECT() *MockNotificationScheduleAppMockRecorder {
+	return m.recorder
+}
+
+// ScheduleNotification mocks base method
+func (m *MockNotificationScheduleApp) ScheduleNotification(ctx context.Context, task app.TaskNotification) (*app.TaskNotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleNotification", ctx, task)
+	ret0, _ := ret[0].(*app.TaskNotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleNotification indicates an expected call of ScheduleNotification
+func (mr *MockNotificationScheduleAppMockRecorder) ScheduleNotification(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleNotification", reflect.TypeOf((*MockNotificationScheduleApp)(nil).ScheduleNotification), ctx, task)
+}
+
+// ScheduleUserNotification mocks base method
+func (m *MockNotificationScheduleApp) ScheduleUserNotification(ctx context.Context, userID app.UserID, kind app.MessageKind, payload app.MessagePayload, since app.EventType, delay time.Duration) (*app.TaskNotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleUserNotification", ctx, userID, kind, payload, since, delay)
+	ret0, _ := ret[0].(*app.TaskNotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleUserNotification indicates an expected call of ScheduleUserNotification
+func (mr *MockNotificationScheduleAppMockRecorder) ScheduleUserNotification(ctx, userID, kind, payload, since, delay interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUserNotification", reflect.TypeOf((*MockNotificationScheduleApp)(nil).ScheduleUserNotification), ctx, userID, kind, payload, since, delay)
+}
+
+// CancelUserNotifications mocks base method
+func (m *MockNotificationScheduleApp) CancelUserNotifications(ctx context.Context, userID app.UserID, kind app.MessageKind) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUserNotifications", ctx, userID, kind)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUserNotifications indicates an expected call of CancelUserNotifications
+func (mr *MockNotificationScheduleAppMockRecorder) CancelUserNotifications(ctx, userID, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserNotifications", reflect.TypeOf((*MockNotificationScheduleApp)(nil).CancelUserNotifications), ctx, userID, kind)
+}
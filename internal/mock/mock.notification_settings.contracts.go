@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/notification_settings.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockNotificationSettingsApp is a mock of NotificationSettingsApp interface
+type MockNotificationSettingsApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationSettingsAppMockRecorder
+}
+
+// MockNotificationSettingsAppMockRecorder is the mock recorder for MockNotificationSettingsApp
+type MockNotificationSettingsAppMockRecorder struct {
+	mock *MockNotificationSettingsApp
+}
+
+// NewMockNotificationSettingsApp creates a new mock instance
+func NewMockNotificationSettingsApp(ctrl *gomock.Controller) *MockNotificationSettingsApp {
+	mock := &MockNotificationSettingsApp{ctrl: ctrl}
+	mock.recorder = &MockNotificationSettingsAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotificationSettingsApp) EXPECT() *MockNotificationSettingsAppMockRecorder {
+	return m.recorder
+}
+
+// NotificationSettings mocks base method
+func (m *MockNotificationSettingsApp) NotificationSettings(arg0 context.Context, arg1 app.AuthUser) ([]app.NotificationSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationSettings", arg0, arg1)
+	ret0, _ := ret[0].([]app.NotificationSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationSettings indicates an expected call of NotificationSettings
+func (mr *MockNotificationSettingsAppMockRecorder) NotificationSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSettings", reflect.TypeOf((*MockNotificationSettingsApp)(nil).NotificationSettings), arg0, arg1)
+}
+
+// UpdateNotificationSetting mocks base method
+func (m *MockNotificationSettingsApp) UpdateNotificationSetting(arg0 context.Context, arg1 app.AuthUser, arg2 app.NotificationSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationSetting", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotificationSetting indicates an expected call of UpdateNotificationSetting
+func (mr *MockNotificationSettingsAppMockRecorder) UpdateNotificationSetting(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationSetting", reflect.TypeOf((*MockNotificationSettingsApp)(nil).UpdateNotificationSetting), arg0, arg1, arg2)
+}
+
+// UnsubscribeKind mocks base method
+func (m *MockNotificationSettingsApp) UnsubscribeKind(ctx context.Context, token string) (app.MessageKind, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeKind", ctx, token)
+	ret0, _ := ret[0].(app.MessageKind)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeKind indicates an expected call of UnsubscribeKind
+func (mr *MockNotificationSettingsAppMockRecorder) UnsubscribeKind(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeKind", reflect.TypeOf((*MockNotificationSettingsApp)(nil).UnsubscribeKind), ctx, token)
+}
+
+// Unsubscribe mocks base method
+func (m *MockNotificationSettingsApp) Unsubscribe(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe
+func (mr *MockNotificationSettingsAppMockRecorder) Unsubscribe(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockNotificationSettingsApp)(nil).Unsubscribe), ctx, token)
+}
+
+// MockNotificationSettingsRepo is a mock of NotificationSettingsRepo interface
+type MockNotificationSettingsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationSettingsRepoMockRecorder
+}
+
+// MockNotificationSettingsRepoMockRecorder is the mock recorder for MockNotificationSettingsRepo
+type MockNotificationSettingsRepoMockRecorder struct {
+	mock *MockNotificationSettingsRepo
+}
+
+// NewMockNotificationSettingsRepo creates a new mock instance
+func NewMockNotificationSettingsRepo(ctrl *gomock.Controller) *MockNotificationSettingsRepo {
+	mock := &MockNotificationSettingsRepo{ctrl: ctrl}
+	mock.recorder = &MockNotificationSettingsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotificationSettingsRepo) EXPECT() *MockNotificationSettingsRepoMockRecorder {
+	return m.recorder
+}
+
+// NotificationSettings mocks base method
+func (m *MockNotificationSettingsRepo) NotificationSettings(arg0 context.Context, arg1 app.UserID) ([]app.NotificationSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationSettings", arg0, arg1)
+	ret0, _ := ret[0].([]app.NotificationSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationSettings indicates an expected call of NotificationSettings
+func (mr *MockNotificationSettingsRepoMockRecorder) NotificationSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSettings", reflect.TypeOf((*MockNotificationSettingsRepo)(nil).NotificationSettings), arg0, arg1)
+}
+
+// SaveNotificationSetting mocks base method
+func (m *MockNotificationSettingsRepo) SaveNotificationSetting(arg0 context.Context, arg1 app.UserID, arg2 app.NotificationSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNotificationSetting", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveNotificationSetting indicates an expected call of SaveNotificationSetting
+func (mr *MockNotificationSettingsRepoMockRecorder) SaveNotificationSetting(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNotificationSetting", reflect.TypeOf((*MockNotificationSettingsRepo)(nil).SaveNotificationSetting), arg0, arg1, arg2)
+}
@@ -0,0 +1,324 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/org.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockOrgApp is a mock of OrgApp interface
+type MockOrgApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrgAppMockRecorder
+}
+
+// MockOrgAppMockRecorder is the mock recorder for MockOrgApp
+type MockOrgAppMockRecorder struct {
+	mock *MockOrgApp
+}
+
+// NewMockOrgApp creates a new mock instance
+func NewMockOrgApp(ctrl *gomock.Controller) *MockOrgApp {
+	mock := &MockOrgApp{ctrl: ctrl}
+	mock.recorder = &MockOrgAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOrgApp) EXPECT() *MockOrgAppMockRecorder {
+	return m.recorder
+}
+
+// CreateOrg mocks base method
+func (m *MockOrgApp) CreateOrg(ctx context.Context, authUser app.AuthUser, name string) (*app.Org, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrg", ctx, authUser, name)
+	ret0, _ := ret[0].(*app.Org)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg
+func (mr *MockOrgAppMockRecorder) CreateOrg(ctx, authUser, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockOrgApp)(nil).CreateOrg), ctx, authUser, name)
+}
+
+// ListOrgs mocks base method
+func (m *MockOrgApp) ListOrgs(ctx context.Context, authUser app.AuthUser) ([]app.OrgMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgs", ctx, authUser)
+	ret0, _ := ret[0].([]app.OrgMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgs indicates an expected call of ListOrgs
+func (mr *MockOrgAppMockRecorder) ListOrgs(ctx, authUser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgs", reflect.TypeOf((*MockOrgApp)(nil).ListOrgs), ctx, authUser)
+}
+
+// InviteOrgMember mocks base method
+func (m *MockOrgApp) InviteOrgMember(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, email string, role app.OrgRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteOrgMember", ctx, authUser, orgID, email, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InviteOrgMember indicates an expected call of InviteOrgMember
+func (mr *MockOrgAppMockRecorder) InviteOrgMember(ctx, authUser, orgID, email, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteOrgMember", reflect.TypeOf((*MockOrgApp)(nil).InviteOrgMember), ctx, authUser, orgID, email, role)
+}
+
+// AcceptOrgInvitation mocks base method
+func (m *MockOrgApp) AcceptOrgInvitation(ctx context.Context, authUser app.AuthUser, token string) (*app.OrgMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrgInvitation", ctx, authUser, token)
+	ret0, _ := ret[0].(*app.OrgMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrgInvitation indicates an expected call of AcceptOrgInvitation
+func (mr *MockOrgAppMockRecorder) AcceptOrgInvitation(ctx, authUser, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrgInvitation", reflect.TypeOf((*MockOrgApp)(nil).AcceptOrgInvitation), ctx, authUser, token)
+}
+
+// ListOrgMembers mocks base method
+func (m *MockOrgApp) ListOrgMembers(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, page app.Page) ([]app.OrgMember, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgMembers", ctx, authUser, orgID, page)
+	ret0, _ := ret[0].([]app.OrgMember)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListOrgMembers indicates an expected call of ListOrgMembers
+func (mr *MockOrgAppMockRecorder) ListOrgMembers(ctx, authUser, orgID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgMembers", reflect.TypeOf((*MockOrgApp)(nil).ListOrgMembers), ctx, authUser, orgID, page)
+}
+
+// RemoveOrgMember mocks base method
+func (m *MockOrgApp) RemoveOrgMember(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, publicID app.PublicID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrgMember", ctx, authUser, orgID, publicID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrgMember indicates an expected call of RemoveOrgMember
+func (mr *MockOrgAppMockRecorder) RemoveOrgMember(ctx, authUser, orgID, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrgMember", reflect.TypeOf((*MockOrgApp)(nil).RemoveOrgMember), ctx, authUser, orgID, publicID)
+}
+
+// TransferOrgOwnership mocks base method
+func (m *MockOrgApp) TransferOrgOwnership(ctx context.Context, authUser app.AuthUser, orgID app.OrgID, publicID app.PublicID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOrgOwnership", ctx, authUser, orgID, publicID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOrgOwnership indicates an expected call of TransferOrgOwnership
+func (mr *MockOrgAppMockRecorder) TransferOrgOwnership(ctx, authUser, orgID, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOrgOwnership", reflect.TypeOf((*MockOrgApp)(nil).TransferOrgOwnership), ctx, authUser, orgID, publicID)
+}
+
+// OrgMember mocks base method
+func (m *MockOrgApp) OrgMember(ctx context.Context, orgID app.OrgID, publicID app.PublicID) (*app.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgMember", ctx, orgID, publicID)
+	ret0, _ := ret[0].(*app.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgMember indicates an expected call of OrgMember
+func (mr *MockOrgAppMockRecorder) OrgMember(ctx, orgID, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgMember", reflect.TypeOf((*MockOrgApp)(nil).OrgMember), ctx, orgID, publicID)
+}
+
+// MockOrgRepo is a mock of OrgRepo interface
+type MockOrgRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrgRepoMockRecorder
+}
+
+// MockOrgRepoMockRecorder is the mock recorder for MockOrgRepo
+type MockOrgRepoMockRecorder struct {
+	mock *MockOrgRepo
+}
+
+// NewMockOrgRepo creates a new mock instance
+func NewMockOrgRepo(ctrl *gomock.Controller) *MockOrgRepo {
+	mock := &MockOrgRepo{ctrl: ctrl}
+	mock.recorder = &MockOrgRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOrgRepo) EXPECT() *MockOrgRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateOrg mocks base method
+func (m *MockOrgRepo) CreateOrg(ctx context.Context, org app.Org, ownerID app.UserID) (app.OrgID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrg", ctx, org, ownerID)
+	ret0, _ := ret[0].(app.OrgID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg
+func (mr *MockOrgRepoMockRecorder) CreateOrg(ctx, org, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockOrgRepo)(nil).CreateOrg), ctx, org, ownerID)
+}
+
+// OrgByID mocks base method
+func (m *MockOrgRepo) OrgByID(arg0 context.Context, arg1 app.OrgID) (*app.Org, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgByID", arg0, arg1)
+	ret0, _ := ret[0].(*app.Org)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgByID indicates an expected call of OrgByID
+func (mr *MockOrgRepoMockRecorder) OrgByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgByID", reflect.TypeOf((*MockOrgRepo)(nil).OrgByID), arg0, arg1)
+}
+
+// UserOrgs mocks base method
+func (m *MockOrgRepo) UserOrgs(arg0 context.Context, arg1 app.UserID) ([]app.OrgMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserOrgs", arg0, arg1)
+	ret0, _ := ret[0].([]app.OrgMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserOrgs indicates an expected call of UserOrgs
+func (mr *MockOrgRepoMockRecorder) UserOrgs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserOrgs", reflect.TypeOf((*MockOrgRepo)(nil).UserOrgs), arg0, arg1)
+}
+
+// OrgMember mocks base method
+func (m *MockOrgRepo) OrgMember(arg0 context.Context, arg1 app.OrgID, arg2 app.UserID) (*app.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*app.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgMember indicates an expected call of OrgMember
+func (mr *MockOrgRepoMockRecorder) OrgMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgMember", reflect.TypeOf((*MockOrgRepo)(nil).OrgMember), arg0, arg1, arg2)
+}
+
+// OrgMembers mocks base method
+func (m *MockOrgRepo) OrgMembers(arg0 context.Context, arg1 app.OrgID, arg2 app.Page) ([]app.OrgMember, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgMembers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.OrgMember)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OrgMembers indicates an expected call of OrgMembers
+func (mr *MockOrgRepoMockRecorder) OrgMembers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgMembers", reflect.TypeOf((*MockOrgRepo)(nil).OrgMembers), arg0, arg1, arg2)
+}
+
+// DeleteOrgMember mocks base method
+func (m *MockOrgRepo) DeleteOrgMember(arg0 context.Context, arg1 app.OrgID, arg2 app.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrgMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrgMember indicates an expected call of DeleteOrgMember
+func (mr *MockOrgRepoMockRecorder) DeleteOrgMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrgMember", reflect.TypeOf((*MockOrgRepo)(nil).DeleteOrgMember), arg0, arg1, arg2)
+}
+
+// TransferOrgOwnership mocks base method
+func (m *MockOrgRepo) TransferOrgOwnership(ctx context.Context, orgID app.OrgID, ownerID, userID app.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOrgOwnership", ctx, orgID, ownerID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOrgOwnership indicates an expected call of TransferOrgOwnership
+func (mr *MockOrgRepoMockRecorder) TransferOrgOwnership(ctx, orgID, ownerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOrgOwnership", reflect.TypeOf((*MockOrgRepo)(nil).TransferOrgOwnership), ctx, orgID, ownerID, userID)
+}
+
+// CreateOrgInvitation mocks base method
+func (m *MockOrgRepo) CreateOrgInvitation(arg0 context.Context, arg1 app.OrgInvitation, arg2 app.TaskNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrgInvitation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrgInvitation indicates an expected call of CreateOrgInvitation
+func (mr *MockOrgRepoMockRecorder) CreateOrgInvitation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrgInvitation", reflect.TypeOf((*MockOrgRepo)(nil).CreateOrgInvitation), arg0, arg1, arg2)
+}
+
+// OrgInvitationByToken mocks base method
+func (m *MockOrgRepo) OrgInvitationByToken(ctx context.Context, token string) (*app.OrgInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgInvitationByToken", ctx, token)
+	ret0, _ := ret[0].(*app.OrgInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgInvitationByToken indicates an expected call of OrgInvitationByToken
+func (mr *MockOrgRepoMockRecorder) OrgInvitationByToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgInvitationByToken", reflect.TypeOf((*MockOrgRepo)(nil).OrgInvitationByToken), ctx, token)
+}
+
+// AcceptOrgInvitation mocks base method
+func (m *MockOrgRepo) AcceptOrgInvitation(ctx context.Context, invitationID int, userID app.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrgInvitation", ctx, invitationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptOrgInvitation indicates an expected call of AcceptOrgInvitation
+func (mr *MockOrgRepoMockRecorder) AcceptOrgInvitation(ctx, invitationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrgInvitation", reflect.TypeOf((*MockOrgRepo)(nil).AcceptOrgInvitation), ctx, invitationID, userID)
+}
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/phone.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockPhoneApp is a mock of PhoneApp interface
+type MockPhoneApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhoneAppMockRecorder
+}
+
+// MockPhoneAppMockRecorder is the mock recorder for MockPhoneApp
+type MockPhoneAppMockRecorder struct {
+	mock *MockPhoneApp
+}
+
+// NewMockPhoneApp creates a new mock instance
+func NewMockPhoneApp(ctrl *gomock.Controller) *MockPhoneApp {
+	mock := &MockPhoneApp{ctrl: ctrl}
+	mock.recorder = &MockPhoneAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPhoneApp) EXPECT() *MockPhoneAppMockRecorder {
+	return m.recorder
+}
+
+// RequestPhoneVerification mocks base method
+func (m *MockPhoneApp) RequestPhoneVerification(ctx context.Context, authUser app.AuthUser, phone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPhoneVerification", ctx, authUser, phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPhoneVerification indicates an expected call of RequestPhoneVerification
+func (mr *MockPhoneAppMockRecorder) RequestPhoneVerification(ctx, authUser, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPhoneVerification", reflect.TypeOf((*MockPhoneApp)(nil).RequestPhoneVerification), ctx, authUser, phone)
+}
+
+// VerifyPhone mocks base method
+func (m *MockPhoneApp) VerifyPhone(ctx context.Context, authUser app.AuthUser, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPhone", ctx, authUser, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyPhone indicates an expected call of VerifyPhone
+func (mr *MockPhoneAppMockRecorder) VerifyPhone(ctx, authUser, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPhone", reflect.TypeOf((*MockPhoneApp)(nil).VerifyPhone), ctx, authUser, code)
+}
+
+// DeletePhone mocks base method
+func (m *MockPhoneApp) DeletePhone(ctx context.Context, authUser app.AuthUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhone", ctx, authUser)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePhone indicates an expected call of DeletePhone
+func (mr *MockPhoneAppMockRecorder) DeletePhone(ctx, authUser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhone", reflect.TypeOf((*MockPhoneApp)(nil).DeletePhone), ctx, authUser)
+}
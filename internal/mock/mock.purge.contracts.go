@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/purge.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockPurgeRepo is a mock of PurgeRepo interface
+type MockPurgeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgeRepoMockRecorder
+}
+
+// MockPurgeRepoMockRecorder is the mock recorder for MockPurgeRepo
+type MockPurgeRepoMockRecorder struct {
+	mock *MockPurgeRepo
+}
+
+// NewMockPurgeRepo creates a new mock instance
+func NewMockPurgeRepo(ctrl *gomock.Controller) *MockPurgeRepo {
+	mock := &MockPurgeRepo{ctrl: ctrl}
+	mock.recorder = &MockPurgeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPurgeRepo) EXPECT() *MockPurgeRepoMockRecorder {
+	return m.recorder
+}
+
+// PurgeSessions mocks base method
+func (m *MockPurgeRepo) PurgeSessions(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeSessions", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeSessions indicates an expected call of PurgeSessions
+func (mr *MockPurgeRepoMockRecorder) PurgeSessions(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeSessions", reflect.TypeOf((*MockPurgeRepo)(nil).PurgeSessions), ctx, before, limit)
+}
+
+// PurgeRecoveryCodes mocks base method
+func (m *MockPurgeRepo) PurgeRecoveryCodes(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRecoveryCodes", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeRecoveryCodes indicates an expected call of PurgeRecoveryCodes
+func (mr *MockPurgeRepoMockRecorder) PurgeRecoveryCodes(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRecoveryCodes", reflect.TypeOf((*MockPurgeRepo)(nil).PurgeRecoveryCodes), ctx, before, limit)
+}
+
+// PurgeNotifications mocks base method
+func (m *MockPurgeRepo) PurgeNotifications(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeNotifications", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeNotifications indicates an expected call of PurgeNotifications
+func (mr *MockPurgeRepoMockRecorder) PurgeNotifications(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeNotifications", reflect.TypeOf((*MockPurgeRepo)(nil).PurgeNotifications), ctx, before, limit)
+}
+
+// PurgeJobs mocks base method
+func (m *MockPurgeRepo) PurgeJobs(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeJobs", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeJobs indicates an expected call of PurgeJobs
+func (mr *MockPurgeRepoMockRecorder) PurgeJobs(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeJobs", reflect.TypeOf((*MockPurgeRepo)(nil).PurgeJobs), ctx, before, limit)
+}
+
+// MockPurgeMetrics is a mock of PurgeMetrics interface
+type MockPurgeMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgeMetricsMockRecorder
+}
+
+// MockPurgeMetricsMockRecorder is the mock recorder for MockPurgeMetrics
+type MockPurgeMetricsMockRecorder struct {
+	mock *MockPurgeMetrics
+}
+
+// NewMockPurgeMetrics creates a new mock instance
+func NewMockPurgeMetrics(ctrl *gomock.Controller) *MockPurgeMetrics {
+	mock := &MockPurgeMetrics{ctrl: ctrl}
+	mock.recorder = &MockPurgeMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPurgeMetrics) EXPECT() *MockPurgeMetricsMockRecorder {
+	return m.recorder
+}
+
+// Purged mocks base method
+func (m *MockPurgeMetrics) Purged(kind app.JobKind, rows int, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Purged", kind, rows, duration)
+}
+
+// Purged indicates an expected call of Purged
+func (mr *MockPurgeMetricsMockRecorder) Purged(kind, rows, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purged", reflect.TypeOf((*MockPurgeMetrics)(nil).Purged), kind, rows, duration)
+}
@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/tenant.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockTenantApp is a mock of TenantApp interface
+type MockTenantApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantAppMockRecorder
+}
+
+// MockTenantAppMockRecorder is the mock recorder for MockTenantApp
+type MockTenantAppMockRecorder struct {
+	mock *MockTenantApp
+}
+
+// NewMockTenantApp creates a new mock instance
+func NewMockTenantApp(ctrl *gomock.Controller) *MockTenantApp {
+	mock := &MockTenantApp{ctrl: ctrl}
+	mock.recorder = &MockTenantAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTenantApp) EXPECT() *MockTenantAppMockRecorder {
+	return m.recorder
+}
+
+// Tenant mocks base method
+func (m *MockTenantApp) Tenant(ctx context.Context, name, host string) (*app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tenant", ctx, name, host)
+	ret0, _ := ret[0].(*app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tenant indicates an expected call of Tenant
+func (mr *MockTenantAppMockRecorder) Tenant(ctx, name, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tenant", reflect.TypeOf((*MockTenantApp)(nil).Tenant), ctx, name, host)
+}
+
+// CreateTenant mocks base method
+func (m *MockTenantApp) CreateTenant(ctx context.Context, name, host string) (*app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenant", ctx, name, host)
+	ret0, _ := ret[0].(*app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTenant indicates an expected call of CreateTenant
+func (mr *MockTenantAppMockRecorder) CreateTenant(ctx, name, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTenantApp)(nil).CreateTenant), ctx, name, host)
+}
+
+// ListTenants mocks base method
+func (m *MockTenantApp) ListTenants(arg0 context.Context) ([]app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenants", arg0)
+	ret0, _ := ret[0].([]app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenants indicates an expected call of ListTenants
+func (mr *MockTenantAppMockRecorder) ListTenants(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenants", reflect.TypeOf((*MockTenantApp)(nil).ListTenants), arg0)
+}
+
+// MockTenantRepo is a mock of TenantRepo interface
+type MockTenantRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantRepoMockRecorder
+}
+
+// MockTenantRepoMockRecorder is the mock recorder for MockTenantRepo
+type MockTenantRepoMockRecorder struct {
+	mock *MockTenantRepo
+}
+
+// NewMockTenantRepo creates a new mock instance
+func NewMockTenantRepo(ctrl *gomock.Controller) *MockTenantRepo {
+	mock := &MockTenantRepo{ctrl: ctrl}
+	mock.recorder = &MockTenantRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTenantRepo) EXPECT() *MockTenantRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateTenant mocks base method
+func (m *MockTenantRepo) CreateTenant(arg0 context.Context, arg1 app.Tenant) (app.TenantID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenant", arg0, arg1)
+	ret0, _ := ret[0].(app.TenantID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTenant indicates an expected call of CreateTenant
+func (mr *MockTenantRepoMockRecorder) CreateTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTenantRepo)(nil).CreateTenant), arg0, arg1)
+}
+
+// TenantByID mocks base method
+func (m *MockTenantRepo) TenantByID(arg0 context.Context, arg1 app.TenantID) (*app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantByID", arg0, arg1)
+	ret0, _ := ret[0].(*app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantByID indicates an expected call of TenantByID
+func (mr *MockTenantRepoMockRecorder) TenantByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantByID", reflect.TypeOf((*MockTenantRepo)(nil).TenantByID), arg0, arg1)
+}
+
+// TenantByName mocks base method
+func (m *MockTenantRepo) TenantByName(ctx context.Context, name string) (*app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantByName", ctx, name)
+	ret0, _ := ret[0].(*app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantByName indicates an expected call of TenantByName
+func (mr *MockTenantRepoMockRecorder) TenantByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantByName", reflect.TypeOf((*MockTenantRepo)(nil).TenantByName), ctx, name)
+}
+
+// TenantByHost mocks base method
+func (m *MockTenantRepo) TenantByHost(ctx context.Context, host string) (*app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantByHost", ctx, host)
+	ret0, _ := ret[0].(*app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantByHost indicates an expected call of TenantByHost
+func (mr *MockTenantRepoMockRecorder) TenantByHost(ctx, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantByHost", reflect.TypeOf((*MockTenantRepo)(nil).TenantByHost), ctx, host)
+}
+
+// Tenants mocks base method
+func (m *MockTenantRepo) Tenants(arg0 context.Context) ([]app.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tenants", arg0)
+	ret0, _ := ret[0].([]app.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tenants indicates an expected call of Tenants
+func (mr *MockTenantRepoMockRecorder) Tenants(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tenants", reflect.TypeOf((*MockTenantRepo)(nil).Tenants), arg0)
+}
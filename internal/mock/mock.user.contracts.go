@@ -37,37 +37,37 @@ func (m *MockUserApp) EXPECT() *MockUserAppMockRecorder {
 }
 
 // VerificationEmail mocks base method
-func (m *MockUserApp) VerificationEmail(ctx context.Context, email string) error {
+func (m *MockUserApp) VerificationEmail(ctx context.Context, tenantID app.TenantID, email string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerificationEmail", ctx, email)
+	ret := m.ctrl.Call(m, "VerificationEmail", ctx, tenantID, email)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VerificationEmail indicates an expected call of VerificationEmail
-func (mr *MockUserAppMockRecorder) VerificationEmail(ctx, email interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) VerificationEmail(ctx, tenantID, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationEmail", reflect.TypeOf((*MockUserApp)(nil).VerificationEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationEmail", reflect.TypeOf((*MockUserApp)(nil).VerificationEmail), ctx, tenantID, email)
 }
 
 // VerificationUsername mocks base method
-func (m *MockUserApp) VerificationUsername(ctx context.Context, username string) error {
+func (m *MockUserApp) VerificationUsername(ctx context.Context, tenantID app.TenantID, username string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerificationUsername", ctx, username)
+	ret := m.ctrl.Call(m, "VerificationUsername", ctx, tenantID, username)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VerificationUsername indicates an expected call of VerificationUsername
-func (mr *MockUserAppMockRecorder) VerificationUsername(ctx, username interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) VerificationUsername(ctx, tenantID, username interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationUsername", reflect.TypeOf((*MockUserApp)(nil).VerificationUsername), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationUsername", reflect.TypeOf((*MockUserApp)(nil).VerificationUsername), ctx, tenantID, username)
 }
 
 // Login mocks base method
-func (m *MockUserApp) Login(ctx context.Context, email, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
+func (m *MockUserApp) Login(ctx context.Context, tenantID app.TenantID, email, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, email, password, origin)
+	ret := m.ctrl.Call(m, "Login", ctx, tenantID, email, password, origin)
 	ret0, _ := ret[0].(*app.User)
 	ret1, _ := ret[1].(app.AuthToken)
 	ret2, _ := ret[2].(error)
@@ -75,9 +75,9 @@ func (m *MockUserApp) Login(ctx context.Context, email, password string, origin
 }
 
 // Login indicates an expected call of Login
-func (mr *MockUserAppMockRecorder) Login(ctx, email, password, origin interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) Login(ctx, tenantID, email, password, origin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserApp)(nil).Login), ctx, email, password, origin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserApp)(nil).Login), ctx, tenantID, email, password, origin)
 }
 
 // Logout mocks base method
@@ -95,9 +95,9 @@ func (mr *MockUserAppMockRecorder) Logout(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // CreateUser mocks base method
-func (m *MockUserApp) CreateUser(ctx context.Context, email, username, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
+func (m *MockUserApp) CreateUser(ctx context.Context, tenantID app.TenantID, email, username, password string, origin app.Origin) (*app.User, app.AuthToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, email, username, password, origin)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, tenantID, email, username, password, origin)
 	ret0, _ := ret[0].(*app.User)
 	ret1, _ := ret[1].(app.AuthToken)
 	ret2, _ := ret[2].(error)
@@ -105,9 +105,9 @@ func (m *MockUserApp) CreateUser(ctx context.Context, email, username, password
 }
 
 // CreateUser indicates an expected call of CreateUser
-func (mr *MockUserAppMockRecorder) CreateUser(ctx, email, username, password, origin interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) CreateUser(ctx, tenantID, email, username, password, origin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserApp)(nil).CreateUser), ctx, email, username, password, origin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserApp)(nil).CreateUser), ctx, tenantID, email, username, password, origin)
 }
 
 // DeleteUser mocks base method
@@ -125,7 +125,7 @@ func (mr *MockUserAppMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Ca
 }
 
 // User mocks base method
-func (m *MockUserApp) User(arg0 context.Context, arg1 app.AuthUser, arg2 app.UserID) (*app.User, error) {
+func (m *MockUserApp) User(arg0 context.Context, arg1 app.AuthUser, arg2 app.PublicID) (*app.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "User", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*app.User)
@@ -155,45 +155,45 @@ func (mr *MockUserAppMockRecorder) UserByAuthToken(ctx, token interface{}) *gomo
 }
 
 // UpdateUsername mocks base method
-func (m *MockUserApp) UpdateUsername(arg0 context.Context, arg1 app.AuthUser, arg2 string) error {
+func (m *MockUserApp) UpdateUsername(ctx context.Context, authUser app.AuthUser, username string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUsername", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateUsername", ctx, authUser, username, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUsername indicates an expected call of UpdateUsername
-func (mr *MockUserAppMockRecorder) UpdateUsername(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) UpdateUsername(ctx, authUser, username, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockUserApp)(nil).UpdateUsername), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockUserApp)(nil).UpdateUsername), ctx, authUser, username, version)
 }
 
 // UpdateEmail mocks base method
-func (m *MockUserApp) UpdateEmail(arg0 context.Context, arg1 app.AuthUser, arg2 string) error {
+func (m *MockUserApp) UpdateEmail(ctx context.Context, authUser app.AuthUser, email string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEmail", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, authUser, email, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEmail indicates an expected call of UpdateEmail
-func (mr *MockUserAppMockRecorder) UpdateEmail(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) UpdateEmail(ctx, authUser, email, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserApp)(nil).UpdateEmail), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserApp)(nil).UpdateEmail), ctx, authUser, email, version)
 }
 
 // UpdatePassword mocks base method
-func (m *MockUserApp) UpdatePassword(ctx context.Context, authUser app.AuthUser, oldPass, newPass string) error {
+func (m *MockUserApp) UpdatePassword(ctx context.Context, authUser app.AuthUser, oldPass, newPass string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", ctx, authUser, oldPass, newPass)
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, authUser, oldPass, newPass, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePassword indicates an expected call of UpdatePassword
-func (mr *MockUserAppMockRecorder) UpdatePassword(ctx, authUser, oldPass, newPass interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) UpdatePassword(ctx, authUser, oldPass, newPass, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserApp)(nil).UpdatePassword), ctx, authUser, oldPass, newPass)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserApp)(nil).UpdatePassword), ctx, authUser, oldPass, newPass, version)
 }
 
 // ListUserByUsername mocks base method
@@ -213,31 +213,31 @@ func (mr *MockUserAppMockRecorder) ListUserByUsername(arg0, arg1, arg2, arg3 int
 }
 
 // CreateRecoveryCode mocks base method
-func (m *MockUserApp) CreateRecoveryCode(ctx context.Context, email string) error {
+func (m *MockUserApp) CreateRecoveryCode(ctx context.Context, tenantID app.TenantID, email string, channel app.Channel) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRecoveryCode", ctx, email)
+	ret := m.ctrl.Call(m, "CreateRecoveryCode", ctx, tenantID, email, channel)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateRecoveryCode indicates an expected call of CreateRecoveryCode
-func (mr *MockUserAppMockRecorder) CreateRecoveryCode(ctx, email interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) CreateRecoveryCode(ctx, tenantID, email, channel interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockUserApp)(nil).CreateRecoveryCode), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockUserApp)(nil).CreateRecoveryCode), ctx, tenantID, email, channel)
 }
 
 // RecoveryPassword mocks base method
-func (m *MockUserApp) RecoveryPassword(ctx context.Context, email, code, newPassword string) error {
+func (m *MockUserApp) RecoveryPassword(ctx context.Context, tenantID app.TenantID, email, code, newPassword string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecoveryPassword", ctx, email, code, newPassword)
+	ret := m.ctrl.Call(m, "RecoveryPassword", ctx, tenantID, email, code, newPassword)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RecoveryPassword indicates an expected call of RecoveryPassword
-func (mr *MockUserAppMockRecorder) RecoveryPassword(ctx, email, code, newPassword interface{}) *gomock.Call {
+func (mr *MockUserAppMockRecorder) RecoveryPassword(ctx, tenantID, email, code, newPassword interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoveryPassword", reflect.TypeOf((*MockUserApp)(nil).RecoveryPassword), ctx, email, code, newPassword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoveryPassword", reflect.TypeOf((*MockUserApp)(nil).RecoveryPassword), ctx, tenantID, email, code, newPassword)
 }
 
 // MockUserRepo is a mock of UserRepo interface
@@ -293,45 +293,59 @@ func (mr *MockUserRepoMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.C
 }
 
 // UpdateUsername mocks base method
-func (m *MockUserRepo) UpdateUsername(arg0 context.Context, arg1 app.UserID, arg2 string) error {
+func (m *MockUserRepo) UpdateUsername(ctx context.Context, userID app.UserID, username string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUsername", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateUsername", ctx, userID, username, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUsername indicates an expected call of UpdateUsername
-func (mr *MockUserRepoMockRecorder) UpdateUsername(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) UpdateUsername(ctx, userID, username, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockUserRepo)(nil).UpdateUsername), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockUserRepo)(nil).UpdateUsername), ctx, userID, username, version)
 }
 
 // UpdateEmail mocks base method
-func (m *MockUserRepo) UpdateEmail(arg0 context.Context, arg1 app.UserID, arg2 string, arg3 app.TaskNotification) error {
+func (m *MockUserRepo) UpdateEmail(ctx context.Context, userID app.UserID, email string, task app.TaskNotification, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEmail", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, userID, email, task, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEmail indicates an expected call of UpdateEmail
-func (mr *MockUserRepoMockRecorder) UpdateEmail(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) UpdateEmail(ctx, userID, email, task, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserRepo)(nil).UpdateEmail), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserRepo)(nil).UpdateEmail), ctx, userID, email, task, version)
 }
 
 // UpdatePassword mocks base method
-func (m *MockUserRepo) UpdatePassword(arg0 context.Context, arg1 app.UserID, arg2 []byte) error {
+func (m *MockUserRepo) UpdatePassword(ctx context.Context, userID app.UserID, passHash []byte, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, userID, passHash, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePassword indicates an expected call of UpdatePassword
-func (mr *MockUserRepoMockRecorder) UpdatePassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) UpdatePassword(ctx, userID, passHash, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepo)(nil).UpdatePassword), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepo)(nil).UpdatePassword), ctx, userID, passHash, version)
+}
+
+// UpdatePhone mocks base method
+func (m *MockUserRepo) UpdatePhone(arg0 context.Context, arg1 app.UserID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhone indicates an expected call of UpdatePhone
+func (mr *MockUserRepoMockRecorder) UpdatePhone(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhone", reflect.TypeOf((*MockUserRepo)(nil).UpdatePhone), arg0, arg1, arg2)
 }
 
 // UserByID mocks base method
@@ -349,40 +363,55 @@ func (mr *MockUserRepoMockRecorder) UserByID(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByID", reflect.TypeOf((*MockUserRepo)(nil).UserByID), arg0, arg1)
 }
 
+// UserByPublicID mocks base method
+func (m *MockUserRepo) UserByPublicID(arg0 context.Context, arg1 app.PublicID) (*app.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserByPublicID", arg0, arg1)
+	ret0, _ := ret[0].(*app.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserByPublicID indicates an expected call of UserByPublicID
+func (mr *MockUserRepoMockRecorder) UserByPublicID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByPublicID", reflect.TypeOf((*MockUserRepo)(nil).UserByPublicID), arg0, arg1)
+}
+
 // UserByEmail mocks base method
-func (m *MockUserRepo) UserByEmail(arg0 context.Context, arg1 string) (*app.User, error) {
+func (m *MockUserRepo) UserByEmail(arg0 context.Context, arg1 app.TenantID, arg2 string) (*app.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserByEmail", arg0, arg1)
+	ret := m.ctrl.Call(m, "UserByEmail", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*app.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UserByEmail indicates an expected call of UserByEmail
-func (mr *MockUserRepoMockRecorder) UserByEmail(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) UserByEmail(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByEmail", reflect.TypeOf((*MockUserRepo)(nil).UserByEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByEmail", reflect.TypeOf((*MockUserRepo)(nil).UserByEmail), arg0, arg1, arg2)
 }
 
 // UserByUsername mocks base method
-func (m *MockUserRepo) UserByUsername(arg0 context.Context, arg1 string) (*app.User, error) {
+func (m *MockUserRepo) UserByUsername(arg0 context.Context, arg1 app.TenantID, arg2 string) (*app.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserByUsername", arg0, arg1)
+	ret := m.ctrl.Call(m, "UserByUsername", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*app.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UserByUsername indicates an expected call of UserByUsername
-func (mr *MockUserRepoMockRecorder) UserByUsername(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) UserByUsername(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByUsername", reflect.TypeOf((*MockUserRepo)(nil).UserByUsername), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByUsername", reflect.TypeOf((*MockUserRepo)(nil).UserByUsername), arg0, arg1, arg2)
 }
 
 // ListUserByUsername mocks base method
-func (m *MockUserRepo) ListUserByUsername(arg0 context.Context, arg1 string, arg2 app.Page) ([]app.User, int, error) {
+func (m *MockUserRepo) ListUserByUsername(arg0 context.Context, arg1 app.TenantID, arg2 string, arg3 app.Page) ([]app.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUserByUsername", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListUserByUsername", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]app.User)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -390,9 +419,9 @@ func (m *MockUserRepo) ListUserByUsername(arg0 context.Context, arg1 string, arg
 }
 
 // ListUserByUsername indicates an expected call of ListUserByUsername
-func (mr *MockUserRepoMockRecorder) ListUserByUsername(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) ListUserByUsername(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserByUsername", reflect.TypeOf((*MockUserRepo)(nil).ListUserByUsername), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserByUsername", reflect.TypeOf((*MockUserRepo)(nil).ListUserByUsername), arg0, arg1, arg2, arg3)
 }
 
 // MockSessionRepo is a mock of SessionRepo interface
@@ -432,48 +461,148 @@ func (mr *MockSessionRepoMockRecorder) SaveSession(arg0, arg1, arg2, arg3 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSession", reflect.TypeOf((*MockSessionRepo)(nil).SaveSession), arg0, arg1, arg2, arg3)
 }
 
-// SessionByTokenID mocks base method
-func (m *MockSessionRepo) SessionByTokenID(arg0 context.Context, arg1 app.TokenID) (*app.Session, error) {
+// AuthUserByTokenID mocks base method
+func (m *MockSessionRepo) AuthUserByTokenID(arg0 context.Context, arg1 app.TokenID) (*app.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SessionByTokenID", arg0, arg1)
-	ret0, _ := ret[0].(*app.Session)
+	ret := m.ctrl.Call(m, "AuthUserByTokenID", arg0, arg1)
+	ret0, _ := ret[0].(*app.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SessionByTokenID indicates an expected call of SessionByTokenID
-func (mr *MockSessionRepoMockRecorder) SessionByTokenID(arg0, arg1 interface{}) *gomock.Call {
+// AuthUserByTokenID indicates an expected call of AuthUserByTokenID
+func (mr *MockSessionRepoMockRecorder) AuthUserByTokenID(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionByTokenID", reflect.TypeOf((*MockSessionRepo)(nil).SessionByTokenID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthUserByTokenID", reflect.TypeOf((*MockSessionRepo)(nil).AuthUserByTokenID), arg0, arg1)
 }
 
-// UserByTokenID mocks base method
-func (m *MockSessionRepo) UserByTokenID(arg0 context.Context, arg1 app.TokenID) (*app.User, error) {
+// DeleteSession mocks base method
+func (m *MockSessionRepo) DeleteSession(arg0 context.Context, arg1 app.TokenID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserByTokenID", arg0, arg1)
-	ret0, _ := ret[0].(*app.User)
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession
+func (mr *MockSessionRepoMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionRepo)(nil).DeleteSession), arg0, arg1)
+}
+
+// MockAuthCache is a mock of AuthCache interface
+type MockAuthCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthCacheMockRecorder
+}
+
+// MockAuthCacheMockRecorder is the mock recorder for MockAuthCache
+type MockAuthCacheMockRecorder struct {
+	mock *MockAuthCache
+}
+
+// NewMockAuthCache creates a new mock instance
+func NewMockAuthCache(ctrl *gomock.Controller) *MockAuthCache {
+	mock := &MockAuthCache{ctrl: ctrl}
+	mock.recorder = &MockAuthCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuthCache) EXPECT() *MockAuthCacheMockRecorder {
+	return m.recorder
+}
+
+// AuthUser mocks base method
+func (m *MockAuthCache) AuthUser(arg0 context.Context, arg1 app.TokenID) (*app.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthUser", arg0, arg1)
+	ret0, _ := ret[0].(*app.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UserByTokenID indicates an expected call of UserByTokenID
-func (mr *MockSessionRepoMockRecorder) UserByTokenID(arg0, arg1 interface{}) *gomock.Call {
+// AuthUser indicates an expected call of AuthUser
+func (mr *MockAuthCacheMockRecorder) AuthUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByTokenID", reflect.TypeOf((*MockSessionRepo)(nil).UserByTokenID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthUser", reflect.TypeOf((*MockAuthCache)(nil).AuthUser), arg0, arg1)
 }
 
-// DeleteSession mocks base method
-func (m *MockSessionRepo) DeleteSession(arg0 context.Context, arg1 app.TokenID) error {
+// SaveAuthUser mocks base method
+func (m *MockAuthCache) SaveAuthUser(arg0 context.Context, arg1 app.AuthUser) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret := m.ctrl.Call(m, "SaveAuthUser", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSession indicates an expected call of DeleteSession
-func (mr *MockSessionRepoMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+// SaveAuthUser indicates an expected call of SaveAuthUser
+func (mr *MockAuthCacheMockRecorder) SaveAuthUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionRepo)(nil).DeleteSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuthUser", reflect.TypeOf((*MockAuthCache)(nil).SaveAuthUser), arg0, arg1)
+}
+
+// DeleteAuthToken mocks base method
+func (m *MockAuthCache) DeleteAuthToken(arg0 context.Context, arg1 app.TokenID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthToken indicates an expected call of DeleteAuthToken
+func (mr *MockAuthCacheMockRecorder) DeleteAuthToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthToken", reflect.TypeOf((*MockAuthCache)(nil).DeleteAuthToken), arg0, arg1)
+}
+
+// DeleteAuthUser mocks base method
+func (m *MockAuthCache) DeleteAuthUser(arg0 context.Context, arg1 app.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthUser indicates an expected call of DeleteAuthUser
+func (mr *MockAuthCacheMockRecorder) DeleteAuthUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthUser", reflect.TypeOf((*MockAuthCache)(nil).DeleteAuthUser), arg0, arg1)
+}
+
+// MockAuthCacheMetrics is a mock of AuthCacheMetrics interface
+type MockAuthCacheMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthCacheMetricsMockRecorder
+}
+
+// MockAuthCacheMetricsMockRecorder is the mock recorder for MockAuthCacheMetrics
+type MockAuthCacheMetricsMockRecorder struct {
+	mock *MockAuthCacheMetrics
+}
+
+// NewMockAuthCacheMetrics creates a new mock instance
+func NewMockAuthCacheMetrics(ctrl *gomock.Controller) *MockAuthCacheMetrics {
+	mock := &MockAuthCacheMetrics{ctrl: ctrl}
+	mock.recorder = &MockAuthCacheMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuthCacheMetrics) EXPECT() *MockAuthCacheMetricsMockRecorder {
+	return m.recorder
+}
+
+// AuthCacheLookup mocks base method
+func (m *MockAuthCacheMetrics) AuthCacheLookup(hit bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AuthCacheLookup", hit)
+}
+
+// AuthCacheLookup indicates an expected call of AuthCacheLookup
+func (mr *MockAuthCacheMetricsMockRecorder) AuthCacheLookup(hit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCacheLookup", reflect.TypeOf((*MockAuthCacheMetrics)(nil).AuthCacheLookup), hit)
 }
 
 // MockCodeRepo is a mock of CodeRepo interface
@@ -500,32 +629,61 @@ func (m *MockCodeRepo) EXPECT() *MockCodeRepoMockRecorder {
 }
 
 // SaveCode mocks base method
-func (m *MockCodeRepo) SaveCode(ctx context.Context, email, code string, task app.TaskNotification) error {
+func (m *MockCodeRepo) SaveCode(ctx context.Context, tenantID app.TenantID, email, code string, task app.TaskNotification) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveCode", ctx, email, code, task)
+	ret := m.ctrl.Call(m, "SaveCode", ctx, tenantID, email, code, task)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SaveCode indicates an expected call of SaveCode
-func (mr *MockCodeRepoMockRecorder) SaveCode(ctx, email, code, task interface{}) *gomock.Call {
+func (mr *MockCodeRepoMockRecorder) SaveCode(ctx, tenantID, email, code, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCode", reflect.TypeOf((*MockCodeRepo)(nil).SaveCode), ctx, email, code, task)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCode", reflect.TypeOf((*MockCodeRepo)(nil).SaveCode), ctx, tenantID, email, code, task)
 }
 
 // Code mocks base method
-func (m *MockCodeRepo) Code(ctx context.Context, email string) (*app.CodeInfo, error) {
+func (m *MockCodeRepo) Code(ctx context.Context, tenantID app.TenantID, email string) (*app.CodeInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Code", ctx, email)
+	ret := m.ctrl.Call(m, "Code", ctx, tenantID, email)
 	ret0, _ := ret[0].(*app.CodeInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Code indicates an expected call of Code
-func (mr *MockCodeRepoMockRecorder) Code(ctx, email interface{}) *gomock.Call {
+func (mr *MockCodeRepoMockRecorder) Code(ctx, tenantID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Code", reflect.TypeOf((*MockCodeRepo)(nil).Code), ctx, tenantID, email)
+}
+
+// SavePhoneCode mocks base method
+func (m *MockCodeRepo) SavePhoneCode(ctx context.Context, tenantID app.TenantID, email, phone, code string, task app.TaskNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePhoneCode", ctx, tenantID, email, phone, code, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePhoneCode indicates an expected call of SavePhoneCode
+func (mr *MockCodeRepoMockRecorder) SavePhoneCode(ctx, tenantID, email, phone, code, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Code", reflect.TypeOf((*MockCodeRepo)(nil).Code), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePhoneCode", reflect.TypeOf((*MockCodeRepo)(nil).SavePhoneCode), ctx, tenantID, email, phone, code, task)
+}
+
+// PhoneCode mocks base method
+func (m *MockCodeRepo) PhoneCode(ctx context.Context, tenantID app.TenantID, email string) (*app.CodeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PhoneCode", ctx, tenantID, email)
+	ret0, _ := ret[0].(*app.CodeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PhoneCode indicates an expected call of PhoneCode
+func (mr *MockCodeRepoMockRecorder) PhoneCode(ctx, tenantID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PhoneCode", reflect.TypeOf((*MockCodeRepo)(nil).PhoneCode), ctx, tenantID, email)
 }
 
 // MockCode is a mock of Code interface
@@ -671,6 +829,37 @@ func (mr *MockAuthMockRecorder) Parse(token interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockAuth)(nil).Parse), token)
 }
 
+// UnsubscribeToken mocks base method
+func (m *MockAuth) UnsubscribeToken(arg0 app.PublicID, arg1 app.MessageKind) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeToken indicates an expected call of UnsubscribeToken
+func (mr *MockAuthMockRecorder) UnsubscribeToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeToken", reflect.TypeOf((*MockAuth)(nil).UnsubscribeToken), arg0, arg1)
+}
+
+// ParseUnsubscribeToken mocks base method
+func (m *MockAuth) ParseUnsubscribeToken(token string) (app.PublicID, app.MessageKind, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseUnsubscribeToken", token)
+	ret0, _ := ret[0].(app.PublicID)
+	ret1, _ := ret[1].(app.MessageKind)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ParseUnsubscribeToken indicates an expected call of ParseUnsubscribeToken
+func (mr *MockAuthMockRecorder) ParseUnsubscribeToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseUnsubscribeToken", reflect.TypeOf((*MockAuth)(nil).ParseUnsubscribeToken), token)
+}
+
 // MockOAuth is a mock of OAuth interface
 type MockOAuth struct {
 	ctrl     *gomock.Controller
@@ -7,96 +7,178 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	app "github.com/zergslaw/boilerplate/internal/app"
 )
 
-// MockWALApplication is a mock of WALApplication interface
-type MockWALApplication struct {
+// MockWAL is a mock of WAL interface
+type MockWAL struct {
 	ctrl     *gomock.Controller
-	recorder *MockWALApplicationMockRecorder
+	recorder *MockWALMockRecorder
 }
 
-// MockWALApplicationMockRecorder is the mock recorder for MockWALApplication
-type MockWALApplicationMockRecorder struct {
-	mock *MockWALApplication
+// MockWALMockRecorder is the mock recorder for MockWAL
+type MockWALMockRecorder struct {
+	mock *MockWAL
 }
 
-// NewMockWALApplication creates a new mock instance
-func NewMockWALApplication(ctrl *gomock.Controller) *MockWALApplication {
-	mock := &MockWALApplication{ctrl: ctrl}
-	mock.recorder = &MockWALApplicationMockRecorder{mock}
+// NewMockWAL creates a new mock instance
+func NewMockWAL(ctrl *gomock.Controller) *MockWAL {
+	mock := &MockWAL{ctrl: ctrl}
+	mock.recorder = &MockWALMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockWALApplication) EXPECT() *MockWALApplicationMockRecorder {
+func (m *MockWAL) EXPECT() *MockWALMockRecorder {
 	return m.recorder
 }
 
-// StartWALNotification mocks base method
-func (m *MockWALApplication) StartWALNotification(ctx context.Context) error {
+// DeleteTaskNotification mocks base method
+func (m *MockWAL) DeleteTaskNotification(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartWALNotification", ctx)
+	ret := m.ctrl.Call(m, "DeleteTaskNotification", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StartWALNotification indicates an expected call of StartWALNotification
-func (mr *MockWALApplicationMockRecorder) StartWALNotification(ctx interface{}) *gomock.Call {
+// DeleteTaskNotification indicates an expected call of DeleteTaskNotification
+func (mr *MockWALMockRecorder) DeleteTaskNotification(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWALNotification", reflect.TypeOf((*MockWALApplication)(nil).StartWALNotification), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskNotification", reflect.TypeOf((*MockWAL)(nil).DeleteTaskNotification), ctx, id)
 }
 
-// MockWAL is a mock of WAL interface
-type MockWAL struct {
-	ctrl     *gomock.Controller
-	recorder *MockWALMockRecorder
+// SaveTaskNotificationError mocks base method
+func (m *MockWAL) SaveTaskNotificationError(ctx context.Context, id int, taskErr error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTaskNotificationError", ctx, id, taskErr)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockWALMockRecorder is the mock recorder for MockWAL
-type MockWALMockRecorder struct {
-	mock *MockWAL
+// SaveTaskNotificationError indicates an expected call of SaveTaskNotificationError
+func (mr *MockWALMockRecorder) SaveTaskNotificationError(ctx, id, taskErr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaskNotificationError", reflect.TypeOf((*MockWAL)(nil).SaveTaskNotificationError), ctx, id, taskErr)
 }
 
-// NewMockWAL creates a new mock instance
-func NewMockWAL(ctrl *gomock.Controller) *MockWAL {
-	mock := &MockWAL{ctrl: ctrl}
-	mock.recorder = &MockWALMockRecorder{mock}
-	return mock
+// CreateTaskNotification mocks base method
+func (m *MockWAL) CreateTaskNotification(ctx context.Context, task app.TaskNotification) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskNotification", ctx, task)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockWAL) EXPECT() *MockWALMockRecorder {
-	return m.recorder
+// CreateTaskNotification indicates an expected call of CreateTaskNotification
+func (mr *MockWALMockRecorder) CreateTaskNotification(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskNotification", reflect.TypeOf((*MockWAL)(nil).CreateTaskNotification), ctx, task)
+}
+
+// CancelTaskNotification mocks base method
+func (m *MockWAL) CancelTaskNotification(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTaskNotification", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelTaskNotification indicates an expected call of CancelTaskNotification
+func (mr *MockWALMockRecorder) CancelTaskNotification(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTaskNotification", reflect.TypeOf((*MockWAL)(nil).CancelTaskNotification), ctx, id)
 }
 
-// NotificationTask mocks base method
-func (m *MockWAL) NotificationTask(ctx context.Context) (*app.TaskNotification, error) {
+// CancelTaskNotifications mocks base method
+func (m *MockWAL) CancelTaskNotifications(ctx context.Context, tenantID app.TenantID, email string, kind app.MessageKind) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NotificationTask", ctx)
-	ret0, _ := ret[0].(*app.TaskNotification)
+	ret := m.ctrl.Call(m, "CancelTaskNotifications", ctx, tenantID, email, kind)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// NotificationTask indicates an expected call of NotificationTask
-func (mr *MockWALMockRecorder) NotificationTask(ctx interface{}) *gomock.Call {
+// CancelTaskNotifications indicates an expected call of CancelTaskNotifications
+func (mr *MockWALMockRecorder) CancelTaskNotifications(ctx, tenantID, email, kind interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationTask", reflect.TypeOf((*MockWAL)(nil).NotificationTask), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTaskNotifications", reflect.TypeOf((*MockWAL)(nil).CancelTaskNotifications), ctx, tenantID, email, kind)
 }
 
-// DeleteTaskNotification mocks base method
-func (m *MockWAL) DeleteTaskNotification(ctx context.Context, id int) error {
+// CollapseTaskNotifications mocks base method
+func (m *MockWAL) CollapseTaskNotifications(ctx context.Context, task app.TaskNotification) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTaskNotification", ctx, id)
+	ret := m.ctrl.Call(m, "CollapseTaskNotifications", ctx, task)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollapseTaskNotifications indicates an expected call of CollapseTaskNotifications
+func (mr *MockWALMockRecorder) CollapseTaskNotifications(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollapseTaskNotifications", reflect.TypeOf((*MockWAL)(nil).CollapseTaskNotifications), ctx, task)
+}
+
+// SuppressTaskNotification mocks base method
+func (m *MockWAL) SuppressTaskNotification(ctx context.Context, id int, reason error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressTaskNotification", ctx, id, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTaskNotification indicates an expected call of DeleteTaskNotification
-func (mr *MockWALMockRecorder) DeleteTaskNotification(ctx, id interface{}) *gomock.Call {
+// SuppressTaskNotification indicates an expected call of SuppressTaskNotification
+func (mr *MockWALMockRecorder) SuppressTaskNotification(ctx, id, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskNotification", reflect.TypeOf((*MockWAL)(nil).DeleteTaskNotification), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressTaskNotification", reflect.TypeOf((*MockWAL)(nil).SuppressTaskNotification), ctx, id, reason)
+}
+
+// ExecutedTaskNotificationCount mocks base method
+func (m *MockWAL) ExecutedTaskNotificationCount(ctx context.Context, tenantID app.TenantID, email string, kind app.MessageKind, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutedTaskNotificationCount", ctx, tenantID, email, kind, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutedTaskNotificationCount indicates an expected call of ExecutedTaskNotificationCount
+func (mr *MockWALMockRecorder) ExecutedTaskNotificationCount(ctx, tenantID, email, kind, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutedTaskNotificationCount", reflect.TypeOf((*MockWAL)(nil).ExecutedTaskNotificationCount), ctx, tenantID, email, kind, since)
+}
+
+// TaskNotificationByID mocks base method
+func (m *MockWAL) TaskNotificationByID(ctx context.Context, id int) (*app.TaskNotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskNotificationByID", ctx, id)
+	ret0, _ := ret[0].(*app.TaskNotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskNotificationByID indicates an expected call of TaskNotificationByID
+func (mr *MockWALMockRecorder) TaskNotificationByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskNotificationByID", reflect.TypeOf((*MockWAL)(nil).TaskNotificationByID), ctx, id)
+}
+
+// ListTaskNotification mocks base method
+func (m *MockWAL) ListTaskNotification(ctx context.Context, filter app.TaskFilter, page app.Page) ([]app.TaskNotificationInfo, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskNotification", ctx, filter, page)
+	ret0, _ := ret[0].([]app.TaskNotificationInfo)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTaskNotification indicates an expected call of ListTaskNotification
+func (mr *MockWALMockRecorder) ListTaskNotification(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskNotification", reflect.TypeOf((*MockWAL)(nil).ListTaskNotification), ctx, filter, page)
 }
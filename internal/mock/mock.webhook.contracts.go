@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../app/webhook.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	app "github.com/zergslaw/boilerplate/internal/app"
+)
+
+// MockWebhookApp is a mock of WebhookApp interface
+type MockWebhookApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookAppMockRecorder
+}
+
+// MockWebhookAppMockRecorder is the mock recorder for MockWebhookApp
+type MockWebhookAppMockRecorder struct {
+	mock *MockWebhookApp
+}
+
+// NewMockWebhookApp creates a new mock instance
+func NewMockWebhookApp(ctrl *gomock.Controller) *MockWebhookApp {
+	mock := &MockWebhookApp{ctrl: ctrl}
+	mock.recorder = &MockWebhookAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookApp) EXPECT() *MockWebhookAppMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method
+func (m *MockWebhookApp) CreateWebhook(ctx context.Context, url, secret string, events []app.EventType) (*app.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, url, secret, events)
+	ret0, _ := ret[0].(*app.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook
+func (mr *MockWebhookAppMockRecorder) CreateWebhook(ctx, url, secret, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookApp)(nil).CreateWebhook), ctx, url, secret, events)
+}
+
+// ListWebhooks mocks base method
+func (m *MockWebhookApp) ListWebhooks(arg0 context.Context) ([]app.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", arg0)
+	ret0, _ := ret[0].([]app.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks
+func (mr *MockWebhookAppMockRecorder) ListWebhooks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookApp)(nil).ListWebhooks), arg0)
+}
+
+// DeleteWebhook mocks base method
+func (m *MockWebhookApp) DeleteWebhook(arg0 context.Context, arg1 app.WebhookID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook
+func (mr *MockWebhookAppMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookApp)(nil).DeleteWebhook), arg0, arg1)
+}
+
+// WebhookDeliveries mocks base method
+func (m *MockWebhookApp) WebhookDeliveries(arg0 context.Context, arg1 app.WebhookID, arg2 app.Page) ([]app.WebhookDelivery, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDeliveries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.WebhookDelivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WebhookDeliveries indicates an expected call of WebhookDeliveries
+func (mr *MockWebhookAppMockRecorder) WebhookDeliveries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDeliveries", reflect.TypeOf((*MockWebhookApp)(nil).WebhookDeliveries), arg0, arg1, arg2)
+}
+
+// MockWebhookRepo is a mock of WebhookRepo interface
+type MockWebhookRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepoMockRecorder
+}
+
+// MockWebhookRepoMockRecorder is the mock recorder for MockWebhookRepo
+type MockWebhookRepoMockRecorder struct {
+	mock *MockWebhookRepo
+}
+
+// NewMockWebhookRepo creates a new mock instance
+func NewMockWebhookRepo(ctrl *gomock.Controller) *MockWebhookRepo {
+	mock := &MockWebhookRepo{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookRepo) EXPECT() *MockWebhookRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method
+func (m *MockWebhookRepo) CreateWebhook(arg0 context.Context, arg1 app.Webhook) (app.WebhookID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(app.WebhookID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook
+func (mr *MockWebhookRepoMockRecorder) CreateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookRepo)(nil).CreateWebhook), arg0, arg1)
+}
+
+// Webhooks mocks base method
+func (m *MockWebhookRepo) Webhooks(arg0 context.Context) ([]app.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Webhooks", arg0)
+	ret0, _ := ret[0].([]app.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Webhooks indicates an expected call of Webhooks
+func (mr *MockWebhookRepoMockRecorder) Webhooks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Webhooks", reflect.TypeOf((*MockWebhookRepo)(nil).Webhooks), arg0)
+}
+
+// DeleteWebhook mocks base method
+func (m *MockWebhookRepo) DeleteWebhook(arg0 context.Context, arg1 app.WebhookID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook
+func (mr *MockWebhookRepoMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookRepo)(nil).DeleteWebhook), arg0, arg1)
+}
+
+// WebhookDeliveryByID mocks base method
+func (m *MockWebhookRepo) WebhookDeliveryByID(ctx context.Context, id int) (*app.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDeliveryByID", ctx, id)
+	ret0, _ := ret[0].(*app.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WebhookDeliveryByID indicates an expected call of WebhookDeliveryByID
+func (mr *MockWebhookRepoMockRecorder) WebhookDeliveryByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDeliveryByID", reflect.TypeOf((*MockWebhookRepo)(nil).WebhookDeliveryByID), ctx, id)
+}
+
+// WebhookDeliveries mocks base method
+func (m *MockWebhookRepo) WebhookDeliveries(arg0 context.Context, arg1 app.WebhookID, arg2 app.Page) ([]app.WebhookDelivery, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDeliveries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.WebhookDelivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WebhookDeliveries indicates an expected call of WebhookDeliveries
+func (mr *MockWebhookRepoMockRecorder) WebhookDeliveries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDeliveries", reflect.TypeOf((*MockWebhookRepo)(nil).WebhookDeliveries), arg0, arg1, arg2)
+}
+
+// UpdateWebhookDelivery mocks base method
+func (m *MockWebhookRepo) UpdateWebhookDelivery(arg0 context.Context, arg1 app.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookDelivery indicates an expected call of UpdateWebhookDelivery
+func (mr *MockWebhookRepoMockRecorder) UpdateWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDelivery", reflect.TypeOf((*MockWebhookRepo)(nil).UpdateWebhookDelivery), arg0, arg1)
+}
+
+// MockWebhookSender is a mock of WebhookSender interface
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockWebhookSender) Send(ctx context.Context, webhook app.Webhook, event app.Event) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, webhook, event)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, webhook, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, webhook, event)
+}
@@ -0,0 +1,78 @@
+package notification
+
+import (
+	"sync"
+	"time"
+)
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var (
+	// BreakerThreshold is the number of consecutive failures opening the circuit of the provider.
+	BreakerThreshold = 3
+	// BreakerCooldown is the time the circuit stays open before the provider is tried again.
+	BreakerCooldown = time.Minute
+)
+
+// breaker is a circuit breaker of the provider, it is safe for concurrent use.
+// The open circuit lets through a single trial after BreakerCooldown,
+// it is closed by the successful trial or opened again by the failed one.
+type breaker struct {
+	Provider
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+func newBreaker(provider Provider) *breaker {
+	metric.providerUp.WithLabelValues(provider.Name()).Set(1)
+
+	return &breaker{Provider: provider}
+}
+
+// allow reports whether the provider may be tried now.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < BreakerThreshold {
+		return true
+	}
+	if b.trial || time.Now().Before(b.openUntil) {
+		return false
+	}
+	b.trial = true
+
+	return true
+}
+
+// open reports whether the circuit is open and its cooldown hasn't passed.
+func (b *breaker) open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.failures >= BreakerThreshold && time.Now().Before(b.openUntil)
+}
+
+// done records the result of the allowed try.
+func (b *breaker) done(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+	if err == nil {
+		b.failures = 0
+		metric.providerSends.WithLabelValues(b.Name(), resultSuccess).Inc()
+		metric.providerUp.WithLabelValues(b.Name()).Set(1)
+		return
+	}
+
+	b.failures++
+	metric.providerSends.WithLabelValues(b.Name(), resultFailure).Inc()
+	if b.failures >= BreakerThreshold {
+		b.openUntil = time.Now().Add(BreakerCooldown)
+		metric.providerUp.WithLabelValues(b.Name()).Set(0)
+	}
+}
@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var metric struct {
+	providerUp    *prometheus.GaugeVec
+	providerSends *prometheus.CounterVec
+}
+
+const (
+	providerLabel = "provider"
+	resultLabel   = "result"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+// InitMetrics must be called once before using this package.
+// It registers and initializes metrics used by this package.
+func InitMetrics(namespace string) {
+	const subsystem = "notification"
+
+	metric.providerUp = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "provider_up",
+			Help:      "Whether the circuit of the email provider is closed.",
+		},
+		[]string{providerLabel},
+	)
+	metric.providerSends = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "provider_sends_total",
+			Help:      "Amount of emails sent by the provider.",
+		},
+		[]string{providerLabel, resultLabel},
+	)
+}
@@ -2,20 +2,26 @@
 package notification
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/matcornic/hermes/v2"
 
 	"github.com/sendgrid/sendgrid-go"
-	"github.com/sendgrid/sendgrid-go/helpers/mail"
 	"github.com/zergslaw/boilerplate/internal/app"
 )
 
 type (
 	client struct {
-		emailClient *sendgrid.Client
-		from        string
-		hermes      *hermes.Hermes
+		repo           app.ProviderRepo
+		providers      []*breaker
+		from           string
+		unsubscribeURL string
+		hermes         *hermes.Hermes
 	}
 
 	notification struct {
@@ -31,10 +37,20 @@ func Connect(apiKey string) (*sendgrid.Client, error) {
 }
 
 // New creates a new instance of the app.NotificationTask object.
-func New(emailClient *sendgrid.Client, from string) app.Notification {
+// The email is sent by the first provider in order of priority whose circuit is not open,
+// the provider which delivered the message of the task is saved to the repo.
+// The unsubscribeURL is a public address of the one-click unsubscribe endpoint.
+func New(repo app.ProviderRepo, from, unsubscribeURL string, providers ...Provider) app.Notification {
+	breakers := make([]*breaker, len(providers))
+	for i := range providers {
+		breakers[i] = newBreaker(providers[i])
+	}
+
 	return &client{
-		emailClient: emailClient,
-		from:        from,
+		repo:           repo,
+		providers:      breakers,
+		from:           from,
+		unsubscribeURL: unsubscribeURL,
 		hermes: &hermes.Hermes{
 			Theme:         nil,
 			TextDirection: "",
@@ -51,9 +67,12 @@ func New(emailClient *sendgrid.Client, from string) app.Notification {
 }
 
 const (
-	fromName = `boilerplate`
+	fromName    = `boilerplate`
+	saveTimeout = 5 * time.Second
 )
 
+var errNoProvider = errors.New("no available provider")
+
 // NotificationTask need for implemented app.NotificationTask.
 func (c *client) Notification(contact string, msg app.Message) error {
 	n := notification{
@@ -61,40 +80,119 @@ func (c *client) Notification(contact string, msg app.Message) error {
 		Content: msg.Content,
 	}
 
-	from := mail.NewEmail(fromName, c.from)
-	to := mail.NewEmail(n.Contact, n.Contact)
+	subject, err := subjectByKind(msg.Kind)
+	if err != nil {
+		return err
+	}
 
 	email := hermes.Email{
 		Body: hermes.Body{
-			Name:   subjectByKind(msg.Kind),
+			Name:   subject,
 			Intros: []string{n.Content},
 		},
 	}
 
+	unsubscribeLink := ""
+	if msg.UnsubscribeToken != "" {
+		unsubscribeLink = c.unsubscribeURL + "?" + url.Values{"token": {msg.UnsubscribeToken}}.Encode()
+		email.Body.Actions = []hermes.Action{{
+			Instructions: "If you no longer want to receive these emails:",
+			Button: hermes.Button{
+				Text: "Unsubscribe",
+				Link: unsubscribeLink,
+			},
+		}}
+	}
+
 	htmlContent, err := c.hermes.GenerateHTML(email)
 	if err != nil {
 		return fmt.Errorf("generated html: %w", err)
 	}
 
-	message := mail.NewSingleEmail(from, subjectByKind(msg.Kind), to, "", htmlContent)
+	message := Email{
+		FromName: fromName,
+		From:     c.from,
+		To:       n.Contact,
+		Subject:  subject,
+		HTML:     htmlContent,
+	}
+	if unsubscribeLink != "" {
+		// RFC 8058 one-click unsubscribe.
+		message.Headers = map[string]string{
+			"List-Unsubscribe":      "<" + unsubscribeLink + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		}
+	}
+
+	provider, err := c.send(message)
+	if err != nil {
+		return err
+	}
+
+	if msg.TaskID == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), saveTimeout)
+	defer cancel()
 
-	_, err = c.emailClient.Send(message)
+	err = c.repo.SaveTaskNotificationProvider(ctx, msg.TaskID, provider)
 	if err != nil {
-		return fmt.Errorf("email send: %w", err)
+		return fmt.Errorf("save provider: %w", err)
 	}
 
 	return nil
 }
 
-func subjectByKind(kind app.MessageKind) string {
+// Available returns the error if circuits of all providers are open,
+// so no email is sent until the cooldown passes.
+func (c *client) Available() error {
+	for _, provider := range c.providers {
+		if !provider.open() {
+			return nil
+		}
+	}
+
+	return errNoProvider
+}
+
+// send tries providers in order of priority and returns the name of the one which delivered the email.
+func (c *client) send(email Email) (string, error) {
+	var errs []string
+	for _, provider := range c.providers {
+		if !provider.allow() {
+			continue
+		}
+
+		err := provider.Send(email)
+		provider.done(err)
+		if err == nil {
+			return provider.Name(), nil
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", provider.Name(), err))
+	}
+
+	if len(errs) == 0 {
+		return "", errNoProvider
+	}
+
+	return "", fmt.Errorf("%w: %s", errNoProvider, strings.Join(errs, "; "))
+}
+
+// subjectByKind returns the subject of the email of the message kind.
+// Errors: app.ErrNotUnknownKindTask.
+func subjectByKind(kind app.MessageKind) (string, error) {
 	switch kind {
 	case app.Welcome:
-		return "Welcome to boilerplate."
+		return "Welcome to boilerplate.", nil
 	case app.ChangeEmail:
-		return "You have changed your mail."
+		return "You have changed your mail.", nil
 	case app.PassRecovery:
-		return "Recovery password."
+		return "Recovery password.", nil
+	case app.OrgInvite:
+		return "You are invited to the organization.", nil
 	default:
-		panic(fmt.Sprintf("unknown kind %s", kind))
+		return "", fmt.Errorf("%w: %s", app.ErrNotUnknownKindTask, kind)
 	}
 }
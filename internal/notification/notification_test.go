@@ -0,0 +1,161 @@
+package notification_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/mock"
+	"github.com/zergslaw/boilerplate/internal/notification"
+)
+
+func TestMain(m *testing.M) {
+	notification.InitMetrics("test")
+
+	os.Exit(m.Run())
+}
+
+// provider returns errors from the queue, nil if it is empty.
+type provider struct {
+	name string
+
+	mu     sync.Mutex
+	errs   []error
+	emails []notification.Email
+}
+
+func (p *provider) Name() string { return p.name }
+
+func (p *provider) Send(email notification.Email) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.emails = append(p.emails, email)
+	if len(p.errs) == 0 {
+		return nil
+	}
+	err := p.errs[0]
+	p.errs = p.errs[1:]
+
+	return err
+}
+
+func (p *provider) calls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.emails)
+}
+
+func TestClient_Notification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notification.BreakerThreshold = 2
+	notification.BreakerCooldown = 100 * time.Millisecond
+
+	const email = "email@email.com"
+	errAny := errors.New("any error")
+	msg := app.Message{
+		TaskID:           1,
+		Kind:             app.Welcome,
+		Content:          "Welcome",
+		UnsubscribeToken: "token",
+	}
+
+	primary := &provider{name: "primary", errs: []error{errAny, errAny}}
+	secondary := &provider{name: "secondary"}
+
+	repo := mock.NewMockProviderRepo(ctrl)
+	gomock.InOrder(
+		repo.EXPECT().SaveTaskNotificationProvider(gomock.Any(), msg.TaskID, secondary.name).Return(nil).Times(3),
+		repo.EXPECT().SaveTaskNotificationProvider(gomock.Any(), msg.TaskID, primary.name).Return(nil),
+		repo.EXPECT().SaveTaskNotificationProvider(gomock.Any(), msg.TaskID, primary.name).Return(errAny),
+	)
+
+	n := notification.New(repo, "from@email.com", "http://localhost/unsubscribe", primary, secondary)
+	available := n.(interface{ Available() error })
+
+	testCases := []struct {
+		name          string
+		wait          time.Duration
+		wantPrimary   int
+		wantSecondary int
+		wantErr       error
+	}{
+		{"failover", 0, 1, 1, nil},
+		{"open circuit", 0, 2, 2, nil},
+		{"skip open circuit", 0, 2, 3, nil},
+		{"close circuit after cooldown", notification.BreakerCooldown, 3, 3, nil},
+		{"err save provider", 0, 4, 3, errAny},
+	}
+
+	assert.Nil(t, available.Available())
+	for _, tc := range testCases {
+		time.Sleep(tc.wait)
+
+		err := n.Notification(email, msg)
+		assert.True(t, errors.Is(err, tc.wantErr), tc.name)
+		assert.Equal(t, tc.wantPrimary, primary.calls(), tc.name)
+		assert.Equal(t, tc.wantSecondary, secondary.calls(), tc.name)
+	}
+
+	sent := primary.emails[0]
+	assert.Equal(t, email, sent.To)
+	assert.Equal(t, "from@email.com", sent.From)
+	assert.Equal(t, "<http://localhost/unsubscribe?token=token>", sent.Headers["List-Unsubscribe"])
+	assert.Contains(t, sent.HTML, msg.Content)
+
+	primary.errs = []error{errAny, errAny}
+	secondary.errs = []error{errAny, errAny}
+	recovery := app.Message{Kind: app.PassRecovery, Content: "123456"}
+	for i := 0; i < notification.BreakerThreshold; i++ {
+		err := n.Notification(email, recovery)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "primary: any error"))
+		assert.True(t, strings.Contains(err.Error(), "secondary: any error"))
+	}
+
+	// Both circuits are open now.
+	assert.NotNil(t, available.Available())
+	err := n.Notification(email, recovery)
+	assert.NotNil(t, err)
+	assert.Equal(t, 6, primary.calls())
+	assert.Equal(t, 5, secondary.calls())
+
+	time.Sleep(notification.BreakerCooldown)
+	assert.Nil(t, available.Available(), "trials are allowed after cooldown")
+}
+
+func TestClient_NotificationKinds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const email = "email@email.com"
+	p := &provider{name: "primary"}
+	n := notification.New(mock.NewMockProviderRepo(ctrl), "from@email.com", "http://localhost/unsubscribe", p)
+
+	invite := &app.OrgInvitePayload{Org: "org", Link: "http://localhost/invitation"}
+	err := n.Notification(email, app.Message{Kind: app.OrgInvite, Content: invite.Content()})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, p.calls())
+	assert.Equal(t, "You are invited to the organization.", p.emails[0].Subject)
+	assert.Contains(t, p.emails[0].HTML, invite.Link)
+
+	for _, kind := range app.MessageKinds() {
+		for _, channel := range kind.Channels() {
+			if channel == app.ChannelEmail {
+				assert.Nil(t, n.Notification(email, app.Message{Kind: kind}), kind.String())
+			}
+		}
+	}
+
+	err = n.Notification(email, app.Message{Kind: app.PassRecoverySMS})
+	assert.True(t, errors.Is(err, app.ErrNotUnknownKindTask), "kinds without email aren't sent")
+}
@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/sendgrid/sendgrid-go"
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+)
+
+type (
+	// Provider delivers rendered emails.
+	Provider interface {
+		// Name returns the name of the provider, it is recorded for the delivered tasks.
+		Name() string
+		// Send delivers the email.
+		Send(email Email) error
+	}
+	// Email contains the rendered message.
+	Email struct {
+		FromName string
+		From     string
+		To       string
+		Subject  string
+		HTML     string
+		Headers  map[string]string
+	}
+
+	sendGridProvider struct {
+		client *sendgrid.Client
+	}
+)
+
+// Provider names.
+const (
+	SendGrid = "sendgrid"
+	SMTP     = "smtp"
+)
+
+// NewSendGrid creates a new instance of the Provider sending emails by SendGrid API.
+func NewSendGrid(client *sendgrid.Client) Provider {
+	return &sendGridProvider{client: client}
+}
+
+// Name need for implemented Provider.
+func (*sendGridProvider) Name() string {
+	return SendGrid
+}
+
+// Send need for implemented Provider.
+func (p *sendGridProvider) Send(email Email) error {
+	from := mail.NewEmail(email.FromName, email.From)
+	to := mail.NewEmail(email.To, email.To)
+
+	message := mail.NewSingleEmail(from, email.Subject, to, "", email.HTML)
+	for key, value := range email.Headers {
+		message.SetHeader(key, value)
+	}
+
+	res, err := p.client.Send(message)
+	if err != nil {
+		return fmt.Errorf("email send: %w", err)
+	}
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("email send: status %d: %s", res.StatusCode, res.Body)
+	}
+
+	return nil
+}
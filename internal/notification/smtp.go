@@ -0,0 +1,147 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"sort"
+	"time"
+)
+
+type smtpProvider struct {
+	addr     string
+	username string
+	password string
+}
+
+// It is not a constant for ease of testing.
+// nolint:gochecknoglobals
+var smtpTimeout = 10 * time.Second
+
+// NewSMTP creates a new instance of the Provider sending emails by the SMTP server with the addr host:port.
+// STARTTLS is used if the server supports it, the username is empty if the server does not require auth.
+func NewSMTP(addr, username, password string) Provider {
+	return &smtpProvider{
+		addr:     addr,
+		username: username,
+		password: password,
+	}
+}
+
+// Name need for implemented Provider.
+func (*smtpProvider) Name() string {
+	return SMTP
+}
+
+// Send need for implemented Provider.
+func (p *smtpProvider) Send(email Email) error {
+	msg, err := smtpMessage(email)
+	if err != nil {
+		return fmt.Errorf("build message: %w", err)
+	}
+
+	host, _, err := net.SplitHostPort(p.addr)
+	if err != nil {
+		return fmt.Errorf("split host port: %w", err)
+	}
+
+	conn, err := net.DialTimeout("tcp", p.addr, smtpTimeout)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	err = conn.SetDeadline(time.Now().Add(smtpTimeout))
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("set deadline: %w", err)
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("new client: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return fmt.Errorf("start tls: %w", err)
+		}
+	}
+
+	if p.username != "" {
+		err = c.Auth(smtp.PlainAuth("", p.username, p.password, host))
+		if err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+
+	err = c.Mail(email.From)
+	if err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	err = c.Rcpt(email.To)
+	if err != nil {
+		return fmt.Errorf("rcpt: %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("data: %w", err)
+	}
+	_, err = w.Write(msg)
+	if err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("close message: %w", err)
+	}
+
+	return c.Quit()
+}
+
+// smtpMessage renders the email in the RFC 5322 format with the quoted-printable HTML body.
+func smtpMessage(email Email) ([]byte, error) {
+	headers := map[string]string{
+		"From":                      (&mail.Address{Name: email.FromName, Address: email.From}).String(),
+		"To":                        (&mail.Address{Address: email.To}).String(),
+		"Subject":                   mime.QEncoding.Encode("utf-8", email.Subject),
+		"Date":                      time.Now().Format(time.RFC1123Z),
+		"MIME-Version":              "1.0",
+		"Content-Type":              `text/html; charset="utf-8"`,
+		"Content-Transfer-Encoding": "quoted-printable",
+	}
+	for key, value := range email.Headers {
+		headers[key] = value
+	}
+
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf := &bytes.Buffer{}
+	for _, key := range keys {
+		fmt.Fprintf(buf, "%s: %s\r\n", key, headers[key])
+	}
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(buf)
+	_, err := w.Write([]byte(email.HTML))
+	if err != nil {
+		return nil, err
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,142 @@
+package notification_test
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/notification"
+)
+
+// smtpServer is a minimal SMTP server without extensions which records the received message.
+type smtpServer struct {
+	ln       net.Listener
+	rcptCode int
+	received chan smtpMsg
+}
+
+type smtpMsg struct {
+	from, to, data string
+}
+
+func newSMTPServer(t *testing.T, rcptCode int) *smtpServer {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	s := &smtpServer{ln: ln, rcptCode: rcptCode, received: make(chan smtpMsg, 1)}
+	go s.serve()
+
+	return s
+}
+
+func (s *smtpServer) serve() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(format string, args ...interface{}) { fmt.Fprintf(conn, format+"\r\n", args...) }
+
+	msg := smtpMsg{}
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimSpace(line)
+
+		switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); {
+		case cmd == "EHLO" || cmd == "HELO":
+			reply("250 localhost")
+		case strings.HasPrefix(strings.ToUpper(line), "MAIL FROM:"):
+			msg.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			reply("250 OK")
+		case strings.HasPrefix(strings.ToUpper(line), "RCPT TO:"):
+			msg.to = strings.Trim(line[len("RCPT TO:"):], "<>")
+			reply("%d recipient", s.rcptCode)
+		case cmd == "DATA":
+			reply("354 go ahead")
+			data := &strings.Builder{}
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			msg.data = data.String()
+			s.received <- msg
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTP_Send(t *testing.T) {
+	t.Parallel()
+
+	server := newSMTPServer(t, 250)
+	defer server.ln.Close()
+
+	email := notification.Email{
+		FromName: "boilerplate",
+		From:     "from@email.com",
+		To:       "to@email.com",
+		Subject:  "Recovery password.",
+		HTML:     `<p class="code">123456</p>`,
+		Headers:  map[string]string{"List-Unsubscribe": "<http://localhost/unsubscribe>"},
+	}
+
+	p := notification.NewSMTP(server.ln.Addr().String(), "", "")
+	assert.Equal(t, notification.SMTP, p.Name())
+
+	err := p.Send(email)
+	require.Nil(t, err)
+
+	msg := <-server.received
+	assert.Equal(t, email.From, msg.from)
+	assert.Equal(t, email.To, msg.to)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(msg.data))
+	require.Nil(t, err)
+	assert.Equal(t, `"boilerplate" <from@email.com>`, parsed.Header.Get("From"))
+	assert.Equal(t, "<to@email.com>", parsed.Header.Get("To"))
+	assert.Equal(t, email.Subject, parsed.Header.Get("Subject"))
+	assert.Equal(t, email.Headers["List-Unsubscribe"], parsed.Header.Get("List-Unsubscribe"))
+
+	body, err := ioutil.ReadAll(quotedprintable.NewReader(parsed.Body))
+	require.Nil(t, err)
+	assert.Equal(t, email.HTML, strings.TrimSpace(string(body)))
+}
+
+func TestSMTP_SendRejected(t *testing.T) {
+	t.Parallel()
+
+	server := newSMTPServer(t, 550)
+	defer server.ln.Close()
+
+	err := notification.NewSMTP(server.ln.Addr().String(), "", "").Send(notification.Email{
+		From: "from@email.com",
+		To:   "to@email.com",
+	})
+	assert.NotNil(t, err)
+}
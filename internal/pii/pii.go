@@ -0,0 +1,168 @@
+// Package pii contains methods for encrypting personal data stored at rest
+// and for building blind indexes, so encrypted values are still found by equality.
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type (
+	// Keyring encrypts personal data. The primary key encrypts new data,
+	// the rest of keys only decrypt data encrypted before the rotation.
+	Keyring struct {
+		primary  string
+		keys     map[string]cipher.AEAD
+		indexKey []byte
+	}
+
+	// Key is the AES-256 key, its id is stored with the ciphertext.
+	Key struct {
+		ID     string
+		Secret []byte
+	}
+)
+
+const (
+	keySize = 32
+	// minSealedSize is the size of the GCM nonce and tag, the sealed empty value.
+	minSealedSize = 12 + 16
+)
+
+// Errors.
+var (
+	ErrUnknownKey        = errors.New("unknown key")
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+	ErrInvalidKey        = errors.New("invalid key")
+)
+
+// New creates and returns new Keyring, the first key is primary.
+// The index key builds blind indexes, it isn't rotated because
+// all indexes would have to be rebuilt at once.
+func New(indexKey []byte, keys ...Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys", ErrInvalidKey)
+	}
+	if len(indexKey) < keySize {
+		return nil, fmt.Errorf("%w: index key must have at least %d bytes", ErrInvalidKey, keySize)
+	}
+
+	k := &Keyring{
+		primary:  keys[0].ID,
+		keys:     make(map[string]cipher.AEAD, len(keys)),
+		indexKey: indexKey,
+	}
+	for _, key := range keys {
+		switch {
+		case key.ID == "" || strings.Contains(key.ID, ":"):
+			return nil, fmt.Errorf("%w: id %q must be non-empty and without colons", ErrInvalidKey, key.ID)
+		case len(key.Secret) != keySize:
+			return nil, fmt.Errorf("%w: key %s must have %d bytes", ErrInvalidKey, key.ID, keySize)
+		case k.keys[key.ID] != nil:
+			return nil, fmt.Errorf("%w: duplicate id %s", ErrInvalidKey, key.ID)
+		}
+
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("new cipher: %w", err)
+		}
+		k.keys[key.ID], err = cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("new gcm: %w", err)
+		}
+	}
+
+	return k, nil
+}
+
+// ParseKey parses the key like id:base64.
+func ParseKey(s string) (Key, error) {
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return Key{}, fmt.Errorf("%w: key must be like id:base64", ErrInvalidKey)
+	}
+
+	secret, err := base64.StdEncoding.DecodeString(s[i+1:])
+	if err != nil {
+		return Key{}, fmt.Errorf("%w: %s", ErrInvalidKey, err)
+	}
+
+	return Key{ID: s[:i], Secret: secret}, nil
+}
+
+// Primary returns the id of the primary key.
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// Encrypt returns the ciphertext like id:base64, where id is the primary key
+// and base64 is the random nonce followed by the sealed value.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	aead := k.keys[k.primary]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+
+	return k.primary + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plaintext of the ciphertext made by any key of the keyring.
+func (k *Keyring) Decrypt(ciphertext string) (string, error) {
+	i := strings.Index(ciphertext, ":")
+	if i < 0 {
+		return "", ErrInvalidCiphertext
+	}
+
+	aead := k.keys[ciphertext[:i]]
+	if aead == nil {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKey, ciphertext[:i])
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext[i+1:])
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	return string(plaintext), nil
+}
+
+// IsCiphertext reports whether the value has the format of the ciphertext made by Encrypt.
+// Emails and phone numbers in plaintext never have it, because they have no colon
+// followed by base64.
+func IsCiphertext(value string) bool {
+	i := strings.Index(value, ":")
+	if i <= 0 {
+		return false
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(value[i+1:])
+
+	return err == nil && len(sealed) >= minSealedSize
+}
+
+// BlindIndex returns the keyed hash of the value, equal values have equal indexes.
+func (k *Keyring) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, k.indexKey)
+	mac.Write([]byte(value)) // nolint:errcheck
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,120 @@
+package pii_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/pii"
+)
+
+var (
+	indexKey = bytes.Repeat([]byte{1}, 32)
+	oldKey   = pii.Key{ID: "old", Secret: bytes.Repeat([]byte{2}, 32)}
+	newKey   = pii.Key{ID: "new", Secret: bytes.Repeat([]byte{3}, 32)}
+)
+
+const email = "email@gmail.com"
+
+func TestKeyring(t *testing.T) {
+	t.Parallel()
+
+	old, err := pii.New(indexKey, oldKey)
+	require.Nil(t, err)
+
+	ciphertext, err := old.Encrypt(email)
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "old:"))
+	assert.NotContains(t, ciphertext, email)
+
+	other, err := old.Encrypt(email)
+	require.Nil(t, err)
+	assert.NotEqual(t, ciphertext, other, "nonces are random")
+
+	res, err := old.Decrypt(ciphertext)
+	require.Nil(t, err)
+	assert.Equal(t, email, res)
+
+	rotated, err := pii.New(indexKey, newKey, oldKey)
+	require.Nil(t, err)
+	assert.Equal(t, "new", rotated.Primary())
+
+	res, err = rotated.Decrypt(ciphertext)
+	require.Nil(t, err)
+	assert.Equal(t, email, res, "previous keys decrypt data")
+
+	ciphertext, err = rotated.Encrypt(email)
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "new:"))
+
+	_, err = old.Decrypt(ciphertext)
+	assert.True(t, errors.Is(err, pii.ErrUnknownKey))
+
+	assert.Equal(t, old.BlindIndex(email), rotated.BlindIndex(email), "indexes don't depend on keys")
+	assert.NotEqual(t, old.BlindIndex(email), old.BlindIndex("other@gmail.com"))
+	assert.NotContains(t, old.BlindIndex(email), email)
+
+	_, err = rotated.Decrypt(ciphertext[:len(ciphertext)-4] + "AAAA")
+	assert.True(t, errors.Is(err, pii.ErrInvalidCiphertext))
+	_, err = rotated.Decrypt(email)
+	assert.True(t, errors.Is(err, pii.ErrInvalidCiphertext))
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		indexKey []byte
+		keys     []pii.Key
+	}{
+		{"no keys", indexKey, nil},
+		{"short index key", indexKey[:16], []pii.Key{oldKey}},
+		{"short key", indexKey, []pii.Key{{ID: "short", Secret: indexKey[:16]}}},
+		{"empty id", indexKey, []pii.Key{{Secret: oldKey.Secret}}},
+		{"colon in id", indexKey, []pii.Key{{ID: "a:b", Secret: oldKey.Secret}}},
+		{"duplicate id", indexKey, []pii.Key{oldKey, oldKey}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := pii.New(tc.indexKey, tc.keys...)
+			assert.True(t, errors.Is(err, pii.ErrInvalidKey))
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := pii.ParseKey("old:" + base64.StdEncoding.EncodeToString(oldKey.Secret))
+	require.Nil(t, err)
+	assert.Equal(t, oldKey, key)
+
+	_, err = pii.ParseKey(base64.StdEncoding.EncodeToString(oldKey.Secret))
+	assert.True(t, errors.Is(err, pii.ErrInvalidKey))
+	_, err = pii.ParseKey("old:???")
+	assert.True(t, errors.Is(err, pii.ErrInvalidKey))
+}
+
+func TestIsCiphertext(t *testing.T) {
+	t.Parallel()
+
+	keyring, err := pii.New(indexKey, oldKey)
+	require.Nil(t, err)
+
+	for _, value := range []string{email, "+79001234567", ""} {
+		ciphertext, err := keyring.Encrypt(value)
+		require.Nil(t, err)
+		assert.True(t, pii.IsCiphertext(ciphertext))
+		assert.False(t, pii.IsCiphertext(value))
+	}
+
+	assert.False(t, pii.IsCiphertext(`"a:b"@gmail.com`))
+	assert.False(t, pii.IsCiphertext("old:short"))
+}
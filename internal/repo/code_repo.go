@@ -9,44 +9,131 @@ import (
 )
 
 // SaveCode need for implements app.CodeRepo.
-func (repo *Repo) SaveCode(ctx context.Context, email, code string, task app.TaskNotification) error {
-	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
-		err := cleanRecoveryCodes(ctx, tx, email)
+func (repo *Repo) SaveCode(ctx context.Context, tenantID app.TenantID, email, code string, task app.TaskNotification) error {
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		ciphertext, emailIndex, err := repo.encrypt(email)
 		if err != nil {
 			return err
 		}
 
-		const query = `INSERT INTO recovery_code(email, code) VALUES (:email, :code)`
+		err = cleanRecoveryCodes(ctx, tx, tenantID, emailIndex)
+		if err != nil {
+			return err
+		}
+
+		const query = `INSERT INTO recovery_code(tenant_id, email, email_index, code)
+		VALUES (:tenant_id, :email, :email_index, :code)`
 		type args struct {
-			Email string `db:"email"`
-			Code  string `db:"code"`
+			TenantID   app.TenantID `db:"tenant_id"`
+			Email      string       `db:"email"`
+			EmailIndex string       `db:"email_index"`
+			Code       string       `db:"code"`
 		}
 
 		_, err = tx.NamedExecContext(ctx, query, args{
-			Email: email,
-			Code:  code,
+			TenantID:   tenantID,
+			Email:      ciphertext,
+			EmailIndex: emailIndex,
+			Code:       code,
 		})
 		if err != nil {
 			return fmt.Errorf("insert code: %w", err)
 		}
 
-		return createTaskNotification(ctx, tx, task)
+		_, err = repo.createTaskNotification(ctx, tx, task)
+		if err != nil {
+			return err
+		}
+
+		const queryUserID = `SELECT id FROM users WHERE tenant_id = $1 AND email_index = $2`
+		userID := app.UserID(0)
+		err = tx.GetContext(ctx, &userID, queryUserID, tenantID, emailIndex)
+		if err != nil {
+			return fmt.Errorf("get user id: %w", err)
+		}
+
+		return repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventRecoveryCodeCreated,
+			UserID: userID,
+			Email:  email,
+		})
 	})
 }
 
 // Code need for implements app.CodeRepo.
-func (repo *Repo) Code(ctx context.Context, email string) (codeInfo *app.CodeInfo, err error) {
-	err = repo.db.Do(func(db *sqlx.DB) error {
-		const query = `SELECT * FROM recovery_code WHERE email = $1`
+func (repo *Repo) Code(ctx context.Context, tenantID app.TenantID, email string) (codeInfo *app.CodeInfo, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM recovery_code WHERE tenant_id = $1 AND email_index = $2 AND phone IS NULL`
+
+		codeInfo, err = repo.code(ctx, db, query, tenantID, email)
+		return err
+	})
+	return
+}
+
+// SavePhoneCode need for implements app.CodeRepo.
+func (repo *Repo) SavePhoneCode(
+	ctx context.Context, tenantID app.TenantID, email, phone, code string, task app.TaskNotification,
+) error {
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		ciphertext, emailIndex, err := repo.encrypt(email)
+		if err != nil {
+			return err
+		}
+
+		err = cleanPhoneCodes(ctx, tx, tenantID, emailIndex)
+		if err != nil {
+			return err
+		}
 
-		c := &codeInfoDBFormat{}
-		err = db.GetContext(ctx, c, query, email)
+		phoneCiphertext, _, err := repo.encrypt(phone)
 		if err != nil {
 			return err
 		}
 
-		codeInfo = c.toAppFormat()
-		return nil
+		const query = `INSERT INTO recovery_code(tenant_id, email, email_index, phone, code) VALUES ($1, $2, $3, $4, $5)`
+		_, err = tx.ExecContext(ctx, query, tenantID, ciphertext, emailIndex, phoneCiphertext, code)
+		if err != nil {
+			return fmt.Errorf("insert code: %w", err)
+		}
+
+		_, err = repo.createTaskNotification(ctx, tx, task)
+
+		return err
+	})
+}
+
+// PhoneCode need for implements app.CodeRepo.
+func (repo *Repo) PhoneCode(ctx context.Context, tenantID app.TenantID, email string) (codeInfo *app.CodeInfo, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM recovery_code WHERE tenant_id = $1 AND email_index = $2 AND phone IS NOT NULL`
+
+		codeInfo, err = repo.code(ctx, db, query, tenantID, email)
+		return err
 	})
 	return
 }
+
+// code returns the code found by the query with the tenant and the blind index of the email.
+func (repo *Repo) code(ctx context.Context, db *sqlx.DB, query string, tenantID app.TenantID, email string) (*app.CodeInfo, error) {
+	emailIndex, err := repo.blindIndex(email)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &codeInfoDBFormat{}
+	err = db.GetContext(ctx, c, query, tenantID, emailIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.decrypt(&c.Email)
+	if err == nil && c.Phone.Valid {
+		err = repo.decrypt(&c.Phone.String)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.toAppFormat(), nil
+}
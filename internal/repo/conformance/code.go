@@ -0,0 +1,108 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func testCodeRepoSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := userGenerator()
+	user.ID, err = r.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.NotZero(t, user.ID)
+
+	const recoveryCode = "123456"
+	err = r.SaveCode(ctx, user.TenantID, user.Email, recoveryCode, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	})
+	require.Nil(t, err)
+
+	codeInfo, err := r.Code(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	expected := &app.CodeInfo{
+		Code:      recoveryCode,
+		Email:     user.Email,
+		CreatedAt: codeInfo.CreatedAt,
+	}
+	require.Equal(t, expected, codeInfo)
+}
+
+func testCodeRepoPhoneSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := userGenerator()
+	user.ID, err = r.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+
+	const (
+		code  = "654321"
+		phone = "+15551234567"
+	)
+	err = r.SavePhoneCode(ctx, user.TenantID, user.Email, phone, code, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PhoneVerification,
+		Payload:  &app.PhoneVerificationPayload{Code: code, Phone: phone},
+	})
+	require.Nil(t, err)
+
+	_, err = r.Code(ctx, user.TenantID, user.Email)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	codeInfo, err := r.PhoneCode(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	expected := &app.CodeInfo{
+		Code:      code,
+		Email:     user.Email,
+		Phone:     phone,
+		CreatedAt: codeInfo.CreatedAt,
+	}
+	require.Equal(t, expected, codeInfo)
+
+	err = r.UpdatePhone(ctx, user.ID, phone)
+	require.Nil(t, err)
+
+	res, err := r.UserByID(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, phone, res.Phone)
+
+	_, err = r.PhoneCode(ctx, user.TenantID, user.Email)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	other := userGenerator()
+	other.ID, err = r.CreateUser(ctx, other, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    other.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+
+	err = r.UpdatePhone(ctx, other.ID, phone)
+	require.True(t, errors.Is(err, app.ErrPhoneExist))
+
+	err = r.UpdatePhone(ctx, user.ID, "")
+	require.Nil(t, err)
+
+	res, err = r.UserByID(ctx, user.ID)
+	require.Nil(t, err)
+	require.Empty(t, res.Phone)
+}
@@ -0,0 +1,138 @@
+// Package conformance contains tests which every implementation of the repository must pass,
+// so the backends are interchangeable.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Repo is the set of interfaces checked by the suite.
+// JobRepo is needed to pick notification tasks like the worker does.
+type Repo interface {
+	app.UserRepo
+	app.SessionRepo
+	app.CodeRepo
+	app.WAL
+	app.ProviderRepo
+	app.DeliverabilityRepo
+	app.JobRepo
+	app.TenantRepo
+	app.OrgRepo
+
+	// NotificationBacklog returns the number of pending tasks which run time has come,
+	// it is checked by the readiness probe.
+	NotificationBacklog(ctx context.Context) (int, error)
+}
+
+// Run runs the suite, newRepo must return the empty repository for each test.
+func Run(t *testing.T, newRepo func(t *testing.T) Repo) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, r Repo)
+	}{
+		{"UserRepoSmoke", testUserRepoSmoke},
+		{"UserRepoUnique", testUserRepoUnique},
+		{"UserRepoCascade", testUserRepoCascade},
+		{"SessionRepoSmoke", testSessionRepoSmoke},
+		{"CodeRepoSmoke", testCodeRepoSmoke},
+		{"CodeRepoPhoneSmoke", testCodeRepoPhoneSmoke},
+		{"WALRepoSmoke", testWALRepoSmoke},
+		{"WALRepoAdminSmoke", testWALRepoAdminSmoke},
+		{"WALRepoScheduleSmoke", testWALRepoScheduleSmoke},
+		{"WALRepoLimitSmoke", testWALRepoLimitSmoke},
+		{"TenantRepoSmoke", testTenantRepoSmoke},
+		{"TenantRepoIsolation", testTenantRepoIsolation},
+		{"OrgRepoSmoke", testOrgRepoSmoke},
+		{"OrgRepoCascade", testOrgRepoCascade},
+		{"OrgRepoGuest", testOrgRepoGuest},
+		{"DeliverabilityRepoUnique", testDeliverabilityRepoUnique},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.test(t, newRepo(t))
+		})
+	}
+}
+
+var (
+	userGenerator = generatorUser()
+	ctx           = context.Background()
+	origin        = app.Origin{
+		IP:        net.ParseIP("192.100.10.4"),
+		UserAgent: "UserAgent",
+	}
+)
+
+func generatorUser() func() app.User {
+	x := 0
+
+	return func() app.User {
+		x++
+		return app.User{
+			ID:        app.UserID(x),
+			PublicID:  app.PublicID(fmt.Sprintf("public%d", x)),
+			TenantID:  app.DefaultTenant,
+			Email:     fmt.Sprintf("email%d@gmail.com", x),
+			Name:      fmt.Sprintf("username%d", x),
+			PassHash:  []byte("pass"),
+			Version:   1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+	}
+}
+
+func welcome(email string) app.TaskNotification {
+	return app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	}
+}
+
+// createUser saves the new user, the welcome task is cancelled, so the notification queue stays empty.
+func createUser(t *testing.T, r Repo) app.User {
+	user := userGenerator()
+
+	var err error
+	user.ID, err = r.CreateUser(ctx, user, welcome(user.Email))
+	require.Nil(t, err)
+
+	_, err = r.CancelTaskNotifications(ctx, user.TenantID, user.Email, app.Welcome)
+	require.Nil(t, err)
+
+	return user
+}
+
+// nextNotificationTask returns the pending task of the next notification job, the job is completed.
+func nextNotificationTask(r Repo) (*app.TaskNotification, error) {
+	for {
+		job, err := r.NextJob(ctx, []app.JobKind{app.JobNotification}, time.Minute)
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.CompleteJob(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := r.TaskNotificationByID(ctx, job.Payload.(*app.NotificationJobPayload).TaskID)
+		if err != nil {
+			return nil, err
+		}
+		if info.Status == app.TaskPending {
+			return &info.TaskNotification, nil
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func testDeliverabilityRepoUnique(t *testing.T, r Repo) {
+	const email = "email@mail.com"
+	start := time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+
+	_, err := r.LastEmailEvent(ctx, email)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	bounce := app.EmailEvent{ExternalID: "bounce", Email: email, Kind: app.EmailBounce, Reason: "reason", CreatedAt: start}
+	err = r.SaveEmailEvent(ctx, bounce)
+	require.Nil(t, err)
+
+	// Events without the id of the provider are never duplicates.
+	err = r.SaveEmailEvent(ctx, app.EmailEvent{Email: email, Kind: app.EmailBounce, CreatedAt: start.Add(time.Minute)})
+	require.Nil(t, err)
+	complaint := app.EmailEvent{Email: email, Kind: app.EmailComplaint, CreatedAt: start.Add(2 * time.Minute)}
+	err = r.SaveEmailEvent(ctx, complaint)
+	require.Nil(t, err)
+
+	res, err := r.LastEmailEvent(ctx, email)
+	require.Nil(t, err)
+	require.Equal(t, complaint, *res)
+
+	// The retry of the event is ignored.
+	bounce.CreatedAt = start.Add(3 * time.Minute)
+	err = r.SaveEmailEvent(ctx, bounce)
+	require.Nil(t, err)
+
+	res, err = r.LastEmailEvent(ctx, email)
+	require.Nil(t, err)
+	require.Equal(t, complaint, *res)
+}
@@ -0,0 +1,214 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func testOrgRepoSmoke(t *testing.T, r Repo) {
+	owner, user := createUser(t, r), createUser(t, r)
+
+	orgID, err := r.CreateOrg(ctx, app.Org{TenantID: owner.TenantID, Name: "org"}, owner.ID)
+	require.Nil(t, err)
+	require.NotZero(t, orgID)
+
+	org, err := r.OrgByID(ctx, orgID)
+	require.Nil(t, err)
+	expected := &app.Org{
+		ID:        orgID,
+		TenantID:  owner.TenantID,
+		Name:      "org",
+		CreatedAt: org.CreatedAt,
+	}
+	require.Equal(t, expected, org)
+
+	orgs, err := r.UserOrgs(ctx, owner.ID)
+	require.Nil(t, err)
+	require.Equal(t, []app.OrgMembership{{Org: *expected, Role: app.OrgRoleOwner}}, orgs)
+
+	orgs, err = r.UserOrgs(ctx, user.ID)
+	require.Nil(t, err)
+	require.Empty(t, orgs)
+
+	_, err = r.OrgMember(ctx, orgID, user.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	invitation := app.OrgInvitation{
+		OrgID:     orgID,
+		Email:     user.Email,
+		Role:      app.OrgRoleAdmin,
+		Token:     "token",
+		InvitedBy: owner.ID,
+	}
+	err = r.CreateOrgInvitation(ctx, invitation, invite(user.Email))
+	require.Nil(t, err)
+
+	invitation.Token = "new token"
+	err = r.CreateOrgInvitation(ctx, invitation, invite(user.Email))
+	require.Nil(t, err)
+
+	_, err = r.OrgInvitationByToken(ctx, "token")
+	require.True(t, errors.Is(err, app.ErrNotFound), "the previous invitation is replaced")
+
+	res, err := r.OrgInvitationByToken(ctx, invitation.Token)
+	require.Nil(t, err)
+	invitation.ID = res.ID
+	invitation.CreatedAt = res.CreatedAt
+	require.Equal(t, &invitation, res)
+
+	task, err := nextNotificationTask(r)
+	require.Nil(t, err)
+	require.Equal(t, app.OrgInvite, task.Kind)
+	require.Equal(t, &app.OrgInvitePayload{Org: "org", Link: "link"}, task.Payload)
+
+	err = r.AcceptOrgInvitation(ctx, invitation.ID, user.ID)
+	require.Nil(t, err)
+
+	_, err = r.OrgInvitationByToken(ctx, invitation.Token)
+	require.True(t, errors.Is(err, app.ErrNotFound), "the invitation is removed")
+	err = r.AcceptOrgInvitation(ctx, invitation.ID, user.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	member, err := r.OrgMember(ctx, orgID, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, &app.OrgMember{
+		OrgID:        orgID,
+		UserID:       user.ID,
+		UserPublicID: user.PublicID,
+		Username:     user.Name,
+		Email:        user.Email,
+		Role:         app.OrgRoleAdmin,
+		CreatedAt:    member.CreatedAt,
+	}, member)
+
+	err = r.CreateOrgInvitation(ctx, invitation, invite(user.Email))
+	require.Nil(t, err)
+	res, err = r.OrgInvitationByToken(ctx, invitation.Token)
+	require.Nil(t, err)
+	err = r.AcceptOrgInvitation(ctx, res.ID, user.ID)
+	require.True(t, errors.Is(err, app.ErrOrgMemberExist))
+
+	members, total, err := r.OrgMembers(ctx, orgID, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 2, total)
+	require.Len(t, members, 2)
+	require.Equal(t, owner.ID, members[0].UserID)
+	require.Equal(t, app.OrgRoleOwner, members[0].Role)
+	require.Equal(t, *member, members[1])
+
+	members, total, err = r.OrgMembers(ctx, orgID, app.Page{Limit: 1, Offset: 1})
+	require.Nil(t, err)
+	require.Equal(t, 2, total)
+	require.Equal(t, []app.OrgMember{*member}, members)
+
+	err = r.TransferOrgOwnership(ctx, orgID, user.ID, owner.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound), "only the owner transfers ownership")
+
+	err = r.TransferOrgOwnership(ctx, orgID, owner.ID, user.ID)
+	require.Nil(t, err)
+
+	orgs, err = r.UserOrgs(ctx, owner.ID)
+	require.Nil(t, err)
+	require.Equal(t, app.OrgRoleAdmin, orgs[0].Role)
+	orgs, err = r.UserOrgs(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, app.OrgRoleOwner, orgs[0].Role)
+
+	err = r.DeleteOrgMember(ctx, orgID, owner.ID)
+	require.Nil(t, err)
+	err = r.DeleteOrgMember(ctx, orgID, owner.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	_, err = r.OrgMember(ctx, orgID, owner.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+}
+
+func testOrgRepoCascade(t *testing.T, r Repo) {
+	owner, user := createUser(t, r), createUser(t, r)
+
+	orgID, err := r.CreateOrg(ctx, app.Org{TenantID: owner.TenantID, Name: "org"}, owner.ID)
+	require.Nil(t, err)
+	userOrgID, err := r.CreateOrg(ctx, app.Org{TenantID: user.TenantID, Name: "user org"}, user.ID)
+	require.Nil(t, err)
+
+	invitation := app.OrgInvitation{
+		OrgID:     userOrgID,
+		Email:     owner.Email,
+		Role:      app.OrgRoleMember,
+		Token:     "token",
+		InvitedBy: user.ID,
+	}
+	err = r.CreateOrgInvitation(ctx, invitation, invite(owner.Email))
+	require.Nil(t, err)
+	res, err := r.OrgInvitationByToken(ctx, invitation.Token)
+	require.Nil(t, err)
+	err = r.AcceptOrgInvitation(ctx, res.ID, owner.ID)
+	require.Nil(t, err)
+
+	invitation = app.OrgInvitation{
+		OrgID:     userOrgID,
+		Email:     "other@gmail.com",
+		Role:      app.OrgRoleMember,
+		Token:     "other token",
+		InvitedBy: owner.ID,
+	}
+	err = r.CreateOrgInvitation(ctx, invitation, invite(user.Email))
+	require.Nil(t, err)
+
+	err = r.DeleteUser(ctx, owner.ID)
+	require.Nil(t, err)
+
+	_, err = r.OrgByID(ctx, orgID)
+	require.True(t, errors.Is(err, app.ErrNotFound), "organizations of the owner are removed")
+
+	members, total, err := r.OrgMembers(ctx, userOrgID, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, user.ID, members[0].UserID)
+
+	res, err = r.OrgInvitationByToken(ctx, invitation.Token)
+	require.Nil(t, err)
+	require.Zero(t, res.InvitedBy, "invitations of the user are kept")
+}
+
+func testOrgRepoGuest(t *testing.T, r Repo) {
+	owner := createUser(t, r)
+
+	orgID, err := r.CreateOrg(ctx, app.Org{TenantID: owner.TenantID, Name: "org"}, owner.ID)
+	require.Nil(t, err)
+
+	const email = "guest@gmail.com"
+	invitation := app.OrgInvitation{
+		OrgID:     orgID,
+		Email:     email,
+		Role:      app.OrgRoleMember,
+		Token:     "token",
+		InvitedBy: owner.ID,
+	}
+	err = r.CreateOrgInvitation(ctx, invitation, invite(email))
+	require.Nil(t, err, "emails without users are invited")
+
+	_, total, err := r.ListTaskNotification(ctx, app.TaskFilter{Email: email}, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+
+	err = r.DeleteUser(ctx, owner.ID)
+	require.Nil(t, err)
+
+	tasks, total, err := r.ListTaskNotification(ctx, app.TaskFilter{Email: email}, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, total, "the task isn't removed with other users")
+	require.Equal(t, app.OrgInvite, tasks[0].Kind)
+}
+
+func invite(email string) app.TaskNotification {
+	return app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    email,
+		Kind:     app.OrgInvite,
+		Payload:  &app.OrgInvitePayload{Org: "org", Link: "link"},
+	}
+}
@@ -0,0 +1,50 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func testSessionRepoSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := userGenerator()
+
+	user.ID, err = r.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.NotZero(t, user.ID)
+
+	const tokenUser = "token"
+	err = r.SaveSession(ctx, user.ID, tokenUser, origin)
+	require.Nil(t, err)
+
+	expectedSession := &app.Session{
+		Origin:  origin,
+		TokenID: tokenUser,
+	}
+
+	authUser, err := r.AuthUserByTokenID(ctx, tokenUser)
+	require.Nil(t, err)
+	expectedSession.ID = authUser.Session.ID
+	if expectedSession.IP.Equal(authUser.Session.IP) {
+		expectedSession.IP = authUser.Session.IP
+	}
+	require.Equal(t, *expectedSession, authUser.Session)
+	user.CreatedAt = authUser.CreatedAt
+	user.UpdatedAt = authUser.UpdatedAt
+	require.Equal(t, user, authUser.User)
+
+	err = r.DeleteSession(ctx, tokenUser)
+	require.Nil(t, err)
+
+	_, err = r.AuthUserByTokenID(ctx, tokenUser)
+	require.True(t, errors.Is(err, app.ErrNotFound), "the session is revoked")
+}
@@ -0,0 +1,109 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func testTenantRepoSmoke(t *testing.T, r Repo) {
+	tenants, err := r.Tenants(ctx)
+	require.Nil(t, err)
+	require.Len(t, tenants, 1)
+	require.Equal(t, app.DefaultTenant, tenants[0].ID)
+
+	id, err := r.CreateTenant(ctx, app.Tenant{Name: "second", Host: "second.example.com"})
+	require.Nil(t, err)
+	require.NotZero(t, id)
+
+	res, err := r.TenantByID(ctx, id)
+	require.Nil(t, err)
+	expected := &app.Tenant{
+		ID:        id,
+		Name:      "second",
+		Host:      "second.example.com",
+		CreatedAt: res.CreatedAt,
+	}
+	require.Equal(t, expected, res)
+
+	res, err = r.TenantByName(ctx, "second")
+	require.Nil(t, err)
+	require.Equal(t, expected, res)
+
+	res, err = r.TenantByHost(ctx, "second.example.com")
+	require.Nil(t, err)
+	require.Equal(t, expected, res)
+
+	_, err = r.TenantByHost(ctx, "")
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	_, err = r.CreateTenant(ctx, app.Tenant{Name: "second"})
+	require.True(t, errors.Is(err, app.ErrTenantExist))
+	_, err = r.CreateTenant(ctx, app.Tenant{Name: "third", Host: "second.example.com"})
+	require.True(t, errors.Is(err, app.ErrTenantExist))
+
+	_, err = r.CreateTenant(ctx, app.Tenant{Name: "third"})
+	require.Nil(t, err, "tenants without host don't conflict")
+
+	tenants, err = r.Tenants(ctx)
+	require.Nil(t, err)
+	require.Len(t, tenants, 3)
+}
+
+func testTenantRepoIsolation(t *testing.T, r Repo) {
+	user := createUser(t, r)
+
+	tenantID, err := r.CreateTenant(ctx, app.Tenant{Name: "second"})
+	require.Nil(t, err)
+
+	same := user
+	same.PublicID = userGenerator().PublicID
+	same.TenantID = tenantID
+	same.ID, err = r.CreateUser(ctx, same, app.TaskNotification{
+		TenantID: tenantID,
+		Email:    same.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err, "emails and usernames are unique per tenant")
+	require.NotEqual(t, user.ID, same.ID)
+
+	res, err := r.UserByEmail(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, user.ID, res.ID)
+
+	res, err = r.UserByUsername(ctx, tenantID, same.Name)
+	require.Nil(t, err)
+	require.Equal(t, same.ID, res.ID)
+	require.Equal(t, tenantID, res.TenantID)
+
+	users, total, err := r.ListUserByUsername(ctx, tenantID, same.Name, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, same.ID, users[0].ID)
+
+	_, err = r.UserByEmail(ctx, tenantID+1, user.Email)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	const recoveryCode = "123456"
+	err = r.SaveCode(ctx, tenantID, same.Email, recoveryCode, app.TaskNotification{
+		TenantID: tenantID,
+		Email:    same.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	})
+	require.Nil(t, err)
+
+	_, err = r.Code(ctx, user.TenantID, user.Email)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	count, err := r.CancelTaskNotifications(ctx, user.TenantID, user.Email, app.PassRecovery)
+	require.Nil(t, err)
+	require.Zero(t, count)
+
+	count, err = r.CancelTaskNotifications(ctx, tenantID, same.Email, app.PassRecovery)
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+}
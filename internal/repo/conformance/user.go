@@ -0,0 +1,188 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func testUserRepoSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := userGenerator()
+
+	user.ID, err = r.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.NotZero(t, user.ID)
+
+	res, err := r.UserByID(ctx, user.ID)
+	require.Nil(t, err)
+	user.CreatedAt = res.CreatedAt
+	user.UpdatedAt = res.UpdatedAt
+	require.Equal(t, &user, res)
+
+	res, err = r.UserByPublicID(ctx, user.PublicID)
+	require.Nil(t, err)
+	require.Equal(t, &user, res)
+
+	newUsername := "newUsername"
+	err = r.UpdateUsername(ctx, user.ID, newUsername, user.Version)
+	require.Nil(t, err)
+	user.Name = newUsername
+	user.Version++
+
+	newEmail := "newEmail@gmail.com"
+	err = r.UpdateEmail(ctx, user.ID, newEmail, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    newEmail,
+		Kind:     app.ChangeEmail,
+		Payload:  &app.ChangeEmailPayload{},
+	}, 0)
+	require.Nil(t, err)
+	user.Email = newEmail
+	user.Version++
+
+	res, err = r.UserByEmail(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	user.UpdatedAt = res.UpdatedAt
+	require.Equal(t, &user, res)
+
+	newPass := []byte(`newPassword`)
+	err = r.UpdatePassword(ctx, user.ID, newPass, user.Version-1)
+	require.True(t, errors.Is(err, app.ErrVersionMismatch))
+	err = r.UpdatePassword(ctx, user.ID, newPass, user.Version)
+	require.Nil(t, err)
+	user.PassHash = newPass
+	user.Version++
+
+	user2 := userGenerator()
+	user2.ID, err = r.CreateUser(ctx, user2, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user2.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.NotZero(t, user2.ID)
+
+	res, err = r.UserByUsername(ctx, user2.TenantID, user2.Name)
+	require.Nil(t, err)
+	user2.CreatedAt = res.CreatedAt
+	user2.UpdatedAt = res.UpdatedAt
+	require.Equal(t, &user2, res)
+
+	user3 := userGenerator()
+	user3.ID, err = r.CreateUser(ctx, user3, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user3.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.NotZero(t, user3.ID)
+
+	err = r.DeleteUser(ctx, 115)
+	require.Nil(t, err)
+
+	users, total, err := r.ListUserByUsername(ctx, app.DefaultTenant, "username", app.Page{Limit: 10})
+	require.Nil(t, err)
+	user3.CreatedAt = users[0].CreatedAt
+	user3.UpdatedAt = users[0].UpdatedAt
+	require.Equal(t, []app.User{user3, user2}, users)
+	require.Equal(t, 2, total)
+}
+
+func testUserRepoUnique(t *testing.T, r Repo) {
+	user := userGenerator()
+	var err error
+	user.ID, err = r.CreateUser(ctx, user, welcome(user.Email))
+	require.Nil(t, err)
+
+	other := userGenerator()
+	other.ID, err = r.CreateUser(ctx, other, welcome(other.Email))
+	require.Nil(t, err)
+
+	sameEmail := userGenerator()
+	sameEmail.Email = user.Email
+	_, err = r.CreateUser(ctx, sameEmail, welcome(sameEmail.Email))
+	require.True(t, errors.Is(err, app.ErrEmailExist))
+
+	sameUsername := userGenerator()
+	sameUsername.Name = user.Name
+	_, err = r.CreateUser(ctx, sameUsername, welcome(sameUsername.Email))
+	require.True(t, errors.Is(err, app.ErrUsernameExist))
+
+	err = r.UpdateUsername(ctx, other.ID, user.Name, 0)
+	require.True(t, errors.Is(err, app.ErrUsernameExist))
+
+	err = r.UpdateEmail(ctx, other.ID, user.Email, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.ChangeEmail,
+		Payload:  &app.ChangeEmailPayload{},
+	}, 0)
+	require.True(t, errors.Is(err, app.ErrEmailExist))
+
+	res, err := r.UserByID(ctx, other.ID)
+	require.Nil(t, err)
+	require.Equal(t, other.Email, res.Email)
+	require.Equal(t, other.Name, res.Name)
+
+	err = r.UpdateUsername(ctx, user.ID, user.Name, 0)
+	require.Nil(t, err, "the user doesn't conflict with itself")
+}
+
+func testUserRepoCascade(t *testing.T, r Repo) {
+	user := userGenerator()
+	var err error
+	user.ID, err = r.CreateUser(ctx, user, welcome(user.Email))
+	require.Nil(t, err)
+
+	other := userGenerator()
+	other.ID, err = r.CreateUser(ctx, other, welcome(other.Email))
+	require.Nil(t, err)
+
+	const token = "token"
+	err = r.SaveSession(ctx, user.ID, token, origin)
+	require.Nil(t, err)
+	const otherToken = "otherToken"
+	err = r.SaveSession(ctx, other.ID, otherToken, origin)
+	require.Nil(t, err)
+
+	const recoveryCode = "123456"
+	err = r.SaveCode(ctx, user.TenantID, user.Email, recoveryCode, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	})
+	require.Nil(t, err)
+
+	err = r.DeleteUser(ctx, user.ID)
+	require.Nil(t, err)
+
+	_, err = r.UserByID(ctx, user.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+	_, err = r.UserByPublicID(ctx, user.PublicID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+	_, err = r.AuthUserByTokenID(ctx, token)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+	_, err = r.Code(ctx, user.TenantID, user.Email)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+	_, total, err := r.ListTaskNotification(ctx, app.TaskFilter{Email: user.Email}, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Zero(t, total)
+
+	_, err = r.AuthUserByTokenID(ctx, otherToken)
+	require.Nil(t, err, "sessions of other users are kept")
+
+	user.ID, err = r.CreateUser(ctx, user, welcome(user.Email))
+	require.Nil(t, err, "the email and the username are free after deleting")
+}
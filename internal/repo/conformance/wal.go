@@ -0,0 +1,287 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func testWALRepoSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := userGenerator()
+	task, err := nextNotificationTask(r)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+	require.Nil(t, task)
+
+	user.ID, err = r.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.NotZero(t, user.ID)
+
+	task, err = nextNotificationTask(r)
+	require.Nil(t, err)
+	require.Equal(t, 1, task.ID)
+	require.Equal(t, app.Welcome, task.Kind)
+	require.Equal(t, &app.WelcomePayload{}, task.Payload)
+
+	err = r.DeleteTaskNotification(ctx, task.ID)
+	require.Nil(t, err)
+
+	newEmail := "newEmail@gmail.com"
+	err = r.UpdateEmail(ctx, user.ID, newEmail, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    newEmail,
+		Kind:     app.ChangeEmail,
+		Payload:  &app.ChangeEmailPayload{},
+	}, 0)
+	require.Nil(t, err)
+	user.Email = newEmail
+
+	task, err = nextNotificationTask(r)
+	require.Nil(t, err)
+	require.Equal(t, 2, task.ID)
+	require.Equal(t, app.ChangeEmail, task.Kind)
+
+	err = r.DeleteTaskNotification(ctx, task.ID)
+	require.Nil(t, err)
+
+	newPass := []byte(`newPassword`)
+	err = r.UpdatePassword(ctx, user.ID, newPass, 0)
+	require.Nil(t, err)
+	user.PassHash = newPass
+
+	const recoveryCode = "123456"
+	err = r.SaveCode(ctx, user.TenantID, user.Email, recoveryCode, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	})
+	require.Nil(t, err)
+
+	task, err = nextNotificationTask(r)
+	require.Nil(t, err)
+	require.Equal(t, 3, task.ID)
+	require.Equal(t, app.PassRecovery, task.Kind)
+	require.Equal(t, &app.PassRecoveryPayload{Code: recoveryCode}, task.Payload)
+
+	err = r.DeleteTaskNotification(ctx, task.ID)
+	require.Nil(t, err)
+
+	err = r.SaveCode(ctx, user.TenantID, user.Email, recoveryCode, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.MessageKind(0),
+	})
+	require.Nil(t, err)
+
+	task, err = nextNotificationTask(r)
+	require.True(t, errors.Is(err, app.ErrNotUnknownKindTask))
+	require.Nil(t, task)
+}
+
+func testWALRepoAdminSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := userGenerator()
+	user.ID, err = r.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+
+	welcome, err := nextNotificationTask(r)
+	require.Nil(t, err)
+
+	err = r.SaveTaskNotificationError(ctx, welcome.ID, errors.New("send failed"))
+	require.Nil(t, err)
+
+	info, err := r.TaskNotificationByID(ctx, welcome.ID)
+	require.Nil(t, err)
+	require.Equal(t, *welcome, info.TaskNotification)
+	require.Equal(t, app.TaskPending, info.Status)
+	require.Equal(t, "send failed", info.Error)
+	require.True(t, info.ExecTime.IsZero())
+
+	err = r.SaveTaskNotificationProvider(ctx, welcome.ID, "smtp")
+	require.Nil(t, err)
+	err = r.DeleteTaskNotification(ctx, welcome.ID)
+	require.Nil(t, err)
+
+	info, err = r.TaskNotificationByID(ctx, welcome.ID)
+	require.Nil(t, err)
+	require.Equal(t, app.TaskDone, info.Status)
+	require.False(t, info.ExecTime.IsZero())
+	require.Equal(t, "smtp", info.Provider)
+
+	err = r.CancelTaskNotification(ctx, welcome.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	recovery := app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: "123456"},
+	}
+	recovery.ID, err = r.CreateTaskNotification(ctx, recovery)
+	require.Nil(t, err)
+
+	tasks, total, err := r.ListTaskNotification(ctx, app.TaskFilter{Email: user.Email}, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 2, total)
+	require.False(t, tasks[0].RunAt.IsZero())
+	recovery.RunAt = tasks[0].RunAt
+	require.Equal(t, recovery, tasks[0].TaskNotification)
+	require.Equal(t, welcome.ID, tasks[1].ID)
+
+	err = r.CancelTaskNotification(ctx, recovery.ID)
+	require.Nil(t, err)
+
+	_, err = nextNotificationTask(r)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	filters := []struct {
+		filter app.TaskFilter
+		want   int
+	}{
+		{app.TaskFilter{Status: app.TaskCancelled}, recovery.ID},
+		{app.TaskFilter{Status: app.TaskDone}, welcome.ID},
+		{app.TaskFilter{Kind: app.Welcome}, welcome.ID},
+		{app.TaskFilter{Email: user.Email, Kind: app.PassRecovery}, recovery.ID},
+	}
+	for _, f := range filters {
+		tasks, total, err = r.ListTaskNotification(ctx, f.filter, app.Page{Limit: 10})
+		require.Nil(t, err)
+		require.Equal(t, 1, total)
+		require.Equal(t, f.want, tasks[0].ID)
+	}
+
+	tasks, total, err = r.ListTaskNotification(ctx, app.TaskFilter{Status: app.TaskPending}, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Zero(t, total)
+	require.Empty(t, tasks)
+}
+
+func testWALRepoScheduleSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := createUser(t, r)
+	scheduled := app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+		RunAt:    time.Now().Add(time.Hour),
+	}
+	scheduled.ID, err = r.CreateTaskNotification(ctx, scheduled)
+	require.Nil(t, err)
+
+	_, err = nextNotificationTask(r)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	overdue := scheduled
+	overdue.RunAt = time.Now().Add(-time.Hour)
+	overdue.ID, err = r.CreateTaskNotification(ctx, overdue)
+	require.Nil(t, err)
+
+	now := app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: "123456"},
+	}
+	now.ID, err = r.CreateTaskNotification(ctx, now)
+	require.Nil(t, err)
+
+	backlog, err := r.NotificationBacklog(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 2, backlog, "scheduled tasks aren't in the backlog")
+
+	task, err := nextNotificationTask(r)
+	require.Nil(t, err)
+	require.Equal(t, now.ID, task.ID, "mandatory notifications go first")
+
+	task, err = nextNotificationTask(r)
+	require.Nil(t, err)
+	require.Equal(t, overdue.ID, task.ID)
+
+	count, err := r.CancelTaskNotifications(ctx, user.TenantID, user.Email, app.Welcome)
+	require.Nil(t, err)
+	require.Equal(t, 2, count)
+
+	backlog, err = r.NotificationBacklog(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 1, backlog)
+
+	info, err := r.TaskNotificationByID(ctx, scheduled.ID)
+	require.Nil(t, err)
+	require.Equal(t, app.TaskCancelled, info.Status)
+}
+
+func testWALRepoLimitSmoke(t *testing.T, r Repo) {
+	var err error
+
+	user := createUser(t, r)
+	task := app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: "123456"},
+	}
+	ids := make([]int, 3)
+	for i := range ids {
+		ids[i], err = r.CreateTaskNotification(ctx, task)
+		require.Nil(t, err)
+	}
+	scheduled := task
+	scheduled.RunAt = time.Now().Add(time.Hour)
+	scheduled.ID, err = r.CreateTaskNotification(ctx, scheduled)
+	require.Nil(t, err)
+
+	task.ID = ids[0]
+	collapsed, err := r.CollapseTaskNotifications(ctx, task)
+	require.Nil(t, err)
+	require.ElementsMatch(t, ids[:2], collapsed)
+
+	info, err := r.TaskNotificationByID(ctx, ids[0])
+	require.Nil(t, err)
+	require.Equal(t, app.TaskCancelled, info.Status)
+	require.Equal(t, app.ErrNotificationDuplicate.Error(), info.Error)
+
+	collapsed, err = r.CollapseTaskNotifications(ctx, task)
+	require.Nil(t, err)
+	require.Empty(t, collapsed)
+
+	since := time.Now().Add(-time.Hour)
+	count, err := r.ExecutedTaskNotificationCount(ctx, user.TenantID, user.Email, app.PassRecovery, since)
+	require.Nil(t, err)
+	require.Zero(t, count)
+
+	err = r.DeleteTaskNotification(ctx, ids[2])
+	require.Nil(t, err)
+	err = r.SuppressTaskNotification(ctx, scheduled.ID, app.ErrNotificationRateLimit)
+	require.Nil(t, err)
+
+	info, err = r.TaskNotificationByID(ctx, scheduled.ID)
+	require.Nil(t, err)
+	require.Equal(t, app.TaskCancelled, info.Status)
+	require.Equal(t, app.ErrNotificationRateLimit.Error(), info.Error)
+
+	count, err = r.ExecutedTaskNotificationCount(ctx, user.TenantID, user.Email, app.PassRecovery, since)
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = r.ExecutedTaskNotificationCount(ctx, user.TenantID, user.Email, app.Welcome, since)
+	require.Nil(t, err)
+	require.Zero(t, count)
+}
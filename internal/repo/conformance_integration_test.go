@@ -0,0 +1,20 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/repo/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.Repo {
+		err := truncate()
+		require.NoError(t, err)
+
+		return Repo
+	})
+}
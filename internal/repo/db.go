@@ -2,44 +2,117 @@
 package repo
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"sync"
+	"time"
 
 	zergrepo "github.com/ZergsLaw/zerg-repo"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq" // db driver.
+	"github.com/lib/pq"
 	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/pii"
 )
 
 // Connect create new instance *zergrepo.Repo.
 func Connect(db *sqlx.DB, logger zergrepo.Logger, namespace string) *zergrepo.Repo {
+	metric := zergrepo.MustMetric(namespace, "repo")
+
+	return zergrepo.New(db, logger, metric, newMapper())
+}
+
+func newMapper() zergrepo.Mapperer {
 	// Constraint names.
 	const (
-		ConstraintEmail    = "users_email_key"
+		ConstraintEmail    = "users_email_index_key"
 		ConstraintUsername = "users_username_key"
+		ConstraintPhone    = "users_phone_index_key"
+		ConstraintTenant   = "tenants_name_key"
+		ConstraintHost     = "tenants_host_key"
+		ConstraintMember   = "org_members_pkey"
+
+		// Constraints of plaintext emails and phones are kept until they are encrypted.
+		ConstraintPlainEmail = "users_email_key"
+		ConstraintPlainPhone = "users_phone_key"
 	)
 
-	metric := zergrepo.MustMetric(namespace, "repo")
-	mapper := zergrepo.NewMapper(
+	return zergrepo.NewMapper(
 		zergrepo.NewConvert(app.ErrNotFound, sql.ErrNoRows),
-		zergrepo.PQConstraint(app.ErrEmailExist, ConstraintEmail),
-		zergrepo.PQConstraint(app.ErrUsernameExist, ConstraintUsername),
+		pqConstraint(app.ErrEmailExist, ConstraintEmail),
+		pqConstraint(app.ErrUsernameExist, ConstraintUsername),
+		pqConstraint(app.ErrPhoneExist, ConstraintPhone),
+		pqConstraint(app.ErrEmailExist, ConstraintPlainEmail),
+		pqConstraint(app.ErrPhoneExist, ConstraintPlainPhone),
+		pqConstraint(app.ErrTenantExist, ConstraintTenant),
+		pqConstraint(app.ErrTenantExist, ConstraintHost),
+		pqConstraint(app.ErrOrgMemberExist, ConstraintMember),
 	)
+}
+
+// pqConstraint is like zergrepo.PQConstraint, but also converts the wrapped errors.
+func pqConstraint(target error, constraint string) zergrepo.ErrMapFunc {
+	return func(err error) error {
+		pqErr := &pq.Error{}
+		if errors.As(err, &pqErr) && pqErr.Constraint == constraint {
+			return target
+		}
 
-	return zergrepo.New(db, logger, metric, mapper)
+		return nil
+	}
 }
 
 var _ app.SessionRepo = &Repo{}
 var _ app.UserRepo = &Repo{}
 var _ app.WAL = &Repo{}
 var _ app.CodeRepo = &Repo{}
+var _ app.NotificationSettingsRepo = &Repo{}
+var _ app.WebhookRepo = &Repo{}
+var _ app.EventRepo = &Repo{}
+var _ app.InboxRepo = &Repo{}
+var _ app.DeliverabilityRepo = &Repo{}
+var _ app.ProviderRepo = &Repo{}
+var _ app.JobRepo = &Repo{}
+var _ app.PurgeRepo = &Repo{}
+var _ app.LeaderRepo = &Repo{}
+var _ app.TenantRepo = &Repo{}
+var _ app.OrgRepo = &Repo{}
 
 // Repo is an implements app.UserRepo.
 // Responsible for working with database.
 type Repo struct {
 	db *zergrepo.Repo
+
+	mu sync.Mutex
+	// leaderConns contains connections holding advisory locks of elections won by the process.
+	leaderConns map[app.Lease]*sql.Conn
+
+	// pii encrypts emails, they are found by their blind indexes.
+	pii *pii.Keyring
+
+	log      zergrepo.Logger
+	replicas []*replica
+	maxLag   time.Duration
+	next     uint32 // The counter for taking replicas in turn.
 }
 
 // New creates and returns new app.UserRepo.
-func New(repo *zergrepo.Repo) *Repo {
-	return &Repo{db: repo}
+func New(repo *zergrepo.Repo, options ...Option) *Repo {
+	r := &Repo{
+		db:          repo,
+		leaderConns: make(map[app.Lease]*sql.Conn),
+	}
+
+	for i := range options {
+		options[i](r)
+	}
+
+	return r
+}
+
+// Ping checks the connection to the primary database.
+func (repo *Repo) Ping(ctx context.Context) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		return db.PingContext(ctx)
+	})
 }
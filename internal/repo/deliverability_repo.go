@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SaveEmailEvent need for implements app.DeliverabilityRepo.
+func (repo *Repo) SaveEmailEvent(ctx context.Context, event app.EmailEvent) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO email_events (external_id, email, email_index, kind, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, coalesce($6, now()))
+		ON CONFLICT (external_id) WHERE external_id <> '' DO NOTHING`
+
+		email, emailIndex, err := repo.encrypt(event.Email)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.ExecContext(ctx, query,
+			event.ExternalID, email, emailIndex, string(event.Kind), event.Reason, createdAt(event))
+		if err != nil {
+			return fmt.Errorf("create email event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// LastEmailEvent need for implements app.DeliverabilityRepo.
+func (repo *Repo) LastEmailEvent(ctx context.Context, email string) (event *app.EmailEvent, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT external_id, email, kind, reason, created_at FROM email_events
+		WHERE email_index = $1
+		ORDER BY created_at DESC, id DESC LIMIT 1`
+
+		emailIndex, err := repo.blindIndex(email)
+		if err != nil {
+			return err
+		}
+
+		res := &emailEventDBFormat{}
+		err = db.GetContext(ctx, res, query, emailIndex)
+		if err != nil {
+			return err
+		}
+		err = repo.decrypt(&res.Email)
+		if err != nil {
+			return err
+		}
+
+		event = res.toAppFormat()
+		return nil
+	})
+	return
+}
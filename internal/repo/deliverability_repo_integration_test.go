@@ -0,0 +1,52 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestDeliverabilityRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	user := userGenerator()
+	_, err = Repo.LastEmailEvent(ctx, user.Email)
+	require.Equal(t, app.ErrNotFound, errors.Unwrap(err))
+
+	bounce := app.EmailEvent{
+		ExternalID: "bounce",
+		Email:      user.Email,
+		Kind:       app.EmailBounce,
+		Reason:     "550 5.1.1 User unknown",
+		CreatedAt:  time.Now().Add(-time.Hour).Truncate(time.Second).UTC(),
+	}
+	err = Repo.SaveEmailEvent(ctx, bounce)
+	require.Nil(t, err)
+	// The retry of the same event is ignored.
+	err = Repo.SaveEmailEvent(ctx, bounce)
+	require.Nil(t, err)
+
+	res, err := Repo.LastEmailEvent(ctx, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, bounce, *res)
+
+	complaint := app.EmailEvent{
+		ExternalID: "complaint",
+		Email:      user.Email,
+		Kind:       app.EmailComplaint,
+	}
+	err = Repo.SaveEmailEvent(ctx, complaint)
+	require.Nil(t, err)
+
+	res, err = Repo.LastEmailEvent(ctx, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, app.EmailComplaint, res.Kind)
+	require.NotZero(t, res.CreatedAt)
+}
@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// UnpublishedEvents need for implements app.EventRepo.
+func (repo *Repo) UnpublishedEvents(ctx context.Context, limit int) (events []app.Event, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT id, type, user_id, user_public_id, email, created_at FROM events
+		WHERE published_at IS NULL
+		ORDER BY id LIMIT $1`
+
+		res := make([]eventDBFormat, 0, limit)
+		err = db.SelectContext(ctx, &res, query, limit)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		events = make([]app.Event, len(res))
+		for i := range res {
+			err = repo.decrypt(&res[i].Email)
+			if err != nil {
+				return err
+			}
+			events[i] = *res[i].toAppFormat()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// EventsPublished need for implements app.EventRepo.
+func (repo *Repo) EventsPublished(ctx context.Context, ids []int) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `UPDATE events SET published_at = now() WHERE id = ANY($1)`
+
+		_, err := db.ExecContext(ctx, query, pq.Array(ids))
+		if err != nil {
+			return fmt.Errorf("update events: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// LastUserEvent need for implements app.EventRepo.
+func (repo *Repo) LastUserEvent(ctx context.Context, userID app.UserID, eventType app.EventType) (event *app.Event, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT id, type, user_id, user_public_id, email, created_at FROM events
+		WHERE user_id = $1 AND type = $2
+		ORDER BY id DESC LIMIT 1`
+
+		res := &eventDBFormat{}
+		err = db.GetContext(ctx, res, query, userID, eventType)
+		if err != nil {
+			return err
+		}
+		err = repo.decrypt(&res.Email)
+		if err != nil {
+			return err
+		}
+
+		event = res.toAppFormat()
+		return nil
+	})
+	return
+}
@@ -0,0 +1,70 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestEventRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	user := userGenerator()
+	user.ID, err = Repo.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+
+	err = Repo.UpdateUsername(ctx, user.ID, "new"+user.Name, 0)
+	require.Nil(t, err)
+
+	err = Repo.SaveCode(ctx, user.TenantID, user.Email, "123456", app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: "123456"},
+	})
+	require.Nil(t, err)
+
+	err = Repo.UpdatePassword(ctx, user.ID, []byte("newPass"), 0)
+	require.Nil(t, err)
+
+	err = Repo.DeleteUser(ctx, user.ID)
+	require.Nil(t, err)
+
+	events, err := Repo.UnpublishedEvents(ctx, 2)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, app.EventUserCreated, events[0].Type)
+	require.Equal(t, app.EventUserUsernameChanged, events[1].Type)
+
+	err = Repo.EventsPublished(ctx, []int{events[0].ID, events[1].ID})
+	require.Nil(t, err)
+
+	events, err = Repo.UnpublishedEvents(ctx, 10)
+	require.Nil(t, err)
+	expected := []app.EventType{app.EventRecoveryCodeCreated, app.EventUserPasswordChanged, app.EventUserDeleted}
+	require.Len(t, events, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i], events[i].Type)
+		require.Equal(t, user.ID, events[i].UserID)
+		require.Equal(t, user.PublicID, events[i].UserPublicID, "the public id is kept after deleting")
+		require.Equal(t, user.Email, events[i].Email)
+	}
+
+	event, err := Repo.LastUserEvent(ctx, user.ID, app.EventUserDeleted)
+	require.Nil(t, err)
+	require.Equal(t, events[len(events)-1], *event)
+
+	_, err = Repo.LastUserEvent(ctx, user.ID, app.EventSessionRevoked)
+	require.Equal(t, app.ErrNotFound, errors.Unwrap(err))
+}
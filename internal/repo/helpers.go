@@ -2,38 +2,146 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
 	"github.com/zergslaw/boilerplate/internal/app"
 )
 
-func createTaskNotification(ctx context.Context, tx *sqlx.Tx, task app.TaskNotification) error {
-	const queryCreateTask = `INSERT INTO notifications (email, kind) VALUES (:email, :kind)`
-	type args struct {
-		Email string `db:"email"`
-		Kind  string `db:"kind"`
+// createTaskNotification saves the task with the job executing it.
+func (repo *Repo) createTaskNotification(ctx context.Context, tx *sqlx.Tx, task app.TaskNotification) (id int, err error) {
+	const queryCreateTask = `INSERT INTO notifications (tenant_id, email, email_index, kind, payload, run_at)
+	VALUES ($1, $2, $3, $4, $5, coalesce($6, now())) RETURNING id, run_at`
+
+	payload, err := json.Marshal(task.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
 	}
 
-	_, err := tx.NamedExecContext(ctx, queryCreateTask, args{
-		Email: task.Email,
-		Kind:  task.Kind.String(),
-	})
+	email, emailIndex, err := repo.encrypt(task.Email)
 	if err != nil {
-		return fmt.Errorf("create task notification: %w", err)
+		return 0, err
 	}
 
-	return nil
+	err = tx.QueryRowxContext(ctx, queryCreateTask,
+		task.TenantID, email, emailIndex, task.Kind.String(), string(payload), runAt(task)).
+		Scan(&id, &task.RunAt)
+	if err != nil {
+		return 0, fmt.Errorf("create task notification: %w", err)
+	}
+
+	_, err = createJob(ctx, tx, app.NewNotificationJob(id, task))
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// createJob adds the pending job, ErrJobExist is returned if a pending job has the same unique key.
+func createJob(ctx context.Context, tx *sqlx.Tx, job app.Job) (id int, err error) {
+	const query = `INSERT INTO jobs (kind, payload, priority, unique_key, run_at)
+	VALUES ($1, $2, $3, nullif($4, ''), coalesce($5, now()))
+	ON CONFLICT (unique_key) WHERE status = 'pending' DO NOTHING
+	RETURNING id`
+
+	payload, err := json.Marshal(job.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	var jobRunAt *time.Time
+	if !job.RunAt.IsZero() {
+		jobRunAt = &job.RunAt
+	}
+
+	err = tx.GetContext(ctx, &id, query, job.Kind, string(payload), job.Priority, job.UniqueKey, jobRunAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, app.ErrJobExist
+	case err != nil:
+		return 0, fmt.Errorf("create job: %w", err)
+	}
+
+	return id, nil
+}
+
+// runAt returns nil for the task which must be executed as soon as possible.
+func runAt(task app.TaskNotification) *time.Time {
+	if task.RunAt.IsZero() {
+		return nil
+	}
+
+	return &task.RunAt
+}
+
+func createdAt(event app.EmailEvent) *time.Time {
+	if event.CreatedAt.IsZero() {
+		return nil
+	}
+
+	return &event.CreatedAt
 }
 
-func cleanRecoveryCodes(ctx context.Context, tx *sqlx.Tx, email string) error {
-	const query = `DELETE FROM recovery_code WHERE email = $1`
+func cleanRecoveryCodes(ctx context.Context, tx *sqlx.Tx, tenantID app.TenantID, emailIndex string) error {
+	const query = `DELETE FROM recovery_code WHERE tenant_id = $1 AND email_index = $2 AND phone IS NULL`
 
-	_, err := tx.ExecContext(ctx, query, email)
+	_, err := tx.ExecContext(ctx, query, tenantID, emailIndex)
 	if err != nil {
 		return fmt.Errorf("delete recovery recoverycode: %w", err)
 	}
 
 	return nil
 }
+
+func cleanPhoneCodes(ctx context.Context, tx *sqlx.Tx, tenantID app.TenantID, emailIndex string) error {
+	const query = `DELETE FROM recovery_code WHERE tenant_id = $1 AND email_index = $2 AND phone IS NOT NULL`
+
+	_, err := tx.ExecContext(ctx, query, tenantID, emailIndex)
+	if err != nil {
+		return fmt.Errorf("delete phone codes: %w", err)
+	}
+
+	return nil
+}
+
+// createEvent saves the event with the public id of the user and the encrypted email to the outbox
+// and creates deliveries with their jobs for all webhooks subscribed to it.
+func (repo *Repo) createEvent(ctx context.Context, tx *sqlx.Tx, event app.Event) error {
+	const queryCreateEvent = `INSERT INTO events (type, user_id, user_public_id, email)
+	VALUES ($1, $2, coalesce((SELECT public_id FROM users WHERE id = $2), ''), $3) RETURNING id`
+
+	email, _, err := repo.encrypt(event.Email)
+	if err != nil {
+		return err
+	}
+
+	eventID := 0
+	err = tx.QueryRowxContext(ctx, queryCreateEvent, event.Type, event.UserID, email).Scan(&eventID)
+	if err != nil {
+		return fmt.Errorf("create event: %w", err)
+	}
+
+	const queryCreateDeliveries = `INSERT INTO webhook_deliveries (webhook_id, event_id)
+	SELECT id, $1 FROM webhooks WHERE $2 = ANY(events) RETURNING id`
+
+	var deliveryIDs []int
+	err = tx.SelectContext(ctx, &deliveryIDs, queryCreateDeliveries, eventID, event.Type)
+	if err != nil {
+		return fmt.Errorf("create webhook deliveries: %w", err)
+	}
+
+	for _, deliveryID := range deliveryIDs {
+		_, err = createJob(ctx, tx, app.NewWebhookDeliveryJob(deliveryID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateUserNotification need for implements app.InboxRepo.
+func (repo *Repo) CreateUserNotification(ctx context.Context, email string, msg app.Message) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO user_notifications (user_id, task_id, kind, content)
+		SELECT id, nullif($3, 0), $4, $5 FROM users WHERE tenant_id = $1 AND email_index = $2
+		ON CONFLICT (task_id) DO NOTHING`
+
+		emailIndex, err := repo.blindIndex(email)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.ExecContext(ctx, query, msg.TenantID, emailIndex, msg.TaskID, msg.Kind.String(), msg.Content)
+		if err != nil {
+			return fmt.Errorf("create user notification: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// UserNotifications need for implements app.InboxRepo.
+func (repo *Repo) UserNotifications(ctx context.Context, userID app.UserID, page app.Page) (notifications []app.UserNotification, total int, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT id, kind, content, read_at, created_at FROM user_notifications
+		WHERE user_id = $1
+		ORDER BY id DESC LIMIT $2 OFFSET $3`
+
+		res := make([]userNotificationDBFormat, 0, page.Limit)
+		err = db.SelectContext(ctx, &res, query, userID, page.Limit, page.Offset)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		const getTotal = `SELECT count(*) FROM user_notifications WHERE user_id = $1`
+		err = db.GetContext(ctx, &total, getTotal, userID)
+		if err != nil {
+			return fmt.Errorf("get total: %w", err)
+		}
+
+		notifications, err = userNotifications(res)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+// UserNotificationsAfter need for implements app.InboxRepo.
+func (repo *Repo) UserNotificationsAfter(ctx context.Context, userID app.UserID, afterID, limit int) (notifications []app.UserNotification, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT id, kind, content, read_at, created_at FROM user_notifications
+		WHERE user_id = $1 AND id > $2
+		ORDER BY id LIMIT $3`
+
+		res := make([]userNotificationDBFormat, 0, limit)
+		err = db.SelectContext(ctx, &res, query, userID, afterID, limit)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		notifications, err = userNotifications(res)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func userNotifications(res []userNotificationDBFormat) ([]app.UserNotification, error) {
+	notifications := make([]app.UserNotification, len(res))
+	for i := range res {
+		notification, err := res[i].toAppFormat()
+		if err != nil {
+			return nil, err
+		}
+		notifications[i] = *notification
+	}
+
+	return notifications, nil
+}
+
+// MarkUserNotificationsRead need for implements app.InboxRepo.
+func (repo *Repo) MarkUserNotificationsRead(ctx context.Context, userID app.UserID, ids []int) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `UPDATE user_notifications SET read_at = now()
+		WHERE user_id = $1 AND read_at IS NULL AND (coalesce(cardinality($2::integer[]), 0) = 0 OR id = ANY($2))`
+
+		_, err := db.ExecContext(ctx, query, userID, pq.Array(ids))
+		if err != nil {
+			return fmt.Errorf("mark read: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// UnreadUserNotificationCount need for implements app.InboxRepo.
+func (repo *Repo) UnreadUserNotificationCount(ctx context.Context, userID app.UserID) (count int, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT count(*) FROM user_notifications WHERE user_id = $1 AND read_at IS NULL`
+
+		return db.GetContext(ctx, &count, query, userID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
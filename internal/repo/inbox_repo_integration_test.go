@@ -0,0 +1,72 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestInboxRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	user := userGenerator()
+	user.ID, err = Repo.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+
+	welcome := app.Message{TenantID: app.DefaultTenant, TaskID: 1, Kind: app.Welcome, Content: "Welcome"}
+	err = Repo.CreateUserNotification(ctx, user.Email, welcome)
+	require.Nil(t, err)
+	// The retry of the same task is ignored.
+	err = Repo.CreateUserNotification(ctx, user.Email, welcome)
+	require.Nil(t, err)
+	err = Repo.CreateUserNotification(ctx, user.Email, app.Message{TenantID: app.DefaultTenant, Kind: app.ChangeEmail, Content: "Change email"})
+	require.Nil(t, err)
+	err = Repo.CreateUserNotification(ctx, "notExist@gmail.com", app.Message{TenantID: app.DefaultTenant, Kind: app.Welcome})
+	require.Nil(t, err)
+
+	notifications, total, err := Repo.UserNotifications(ctx, user.ID, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 2, total)
+	require.Len(t, notifications, 2)
+	require.Equal(t, app.ChangeEmail, notifications[0].Kind)
+	require.Equal(t, app.Welcome, notifications[1].Kind)
+	require.Equal(t, welcome.Content, notifications[1].Content)
+	require.False(t, notifications[1].Read)
+
+	after, err := Repo.UserNotificationsAfter(ctx, user.ID, notifications[1].ID, 10)
+	require.Nil(t, err)
+	require.Equal(t, notifications[:1], after)
+
+	count, err := Repo.UnreadUserNotificationCount(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, 2, count)
+
+	err = Repo.MarkUserNotificationsRead(ctx, user.ID, []int{notifications[1].ID})
+	require.Nil(t, err)
+
+	count, err = Repo.UnreadUserNotificationCount(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+
+	err = Repo.MarkUserNotificationsRead(ctx, user.ID, nil)
+	require.Nil(t, err)
+
+	count, err = Repo.UnreadUserNotificationCount(ctx, user.ID)
+	require.Nil(t, err)
+	require.Zero(t, count)
+
+	notifications, _, err = Repo.UserNotifications(ctx, user.ID, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.True(t, notifications[0].Read)
+	require.True(t, notifications[1].Read)
+}
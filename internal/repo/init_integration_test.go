@@ -1,8 +1,10 @@
+//go:build integration
 // +build integration
 
 package repo_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
@@ -12,16 +14,19 @@ import (
 	"time"
 
 	zergrepo "github.com/ZergsLaw/zerg-repo"
-	"github.com/ZergsLaw/zerg-repo/zergrepo/fs"
 	"github.com/jmoiron/sqlx"
 	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/pii"
 	"github.com/zergslaw/boilerplate/internal/repo"
+	"github.com/zergslaw/boilerplate/migrate"
 	"go.uber.org/zap"
 )
 
 var (
 	Repo     *repo.Repo
+	zergRepo *zergrepo.Repo
 	truncate func() error
+	keyring  = newKeyring(piiKey)
 
 	timeoutConnect = time.Second * 1000
 )
@@ -42,29 +47,16 @@ func TestMain(m *testing.M) {
 
 	zp := repo.Connect(dbConn, logger.Named("test").Sugar(), "test")
 
-	const dir = "../../migrate/"
-	f := fs.New()
-	migrates, err := f.Walk(dir)
+	migrations, err := repo.LoadMigrations(migrate.FS)
 	if err != nil {
-		log.Fatal(fmt.Errorf("walk migrate: %w", err))
-	}
-
-	regMigrates := make([]zergrepo.Migrate, len(migrates))
-	for i := range migrates {
-		regMigrates[i] = zergrepo.Migrate{
-			Version: migrates[i].Version,
-			Up:      zergrepo.Query(migrates[i].Query.Up),
-			Down:    zergrepo.Query(migrates[i].Query.Down),
-		}
-	}
-
-	err = zergrepo.RegisterMetric(regMigrates...)
-	if err != nil {
-		log.Fatal(fmt.Errorf("register migrate: %w", err))
+		log.Fatal(fmt.Errorf("load migrations: %w", err))
 	}
 
 	resetDB := func() {
-		err := zp.Reset(ctx)
+		// Migrations of the encryption are reverted only without encrypted data.
+		_, _ = dbConn.Exec("TRUNCATE users, notifications, recovery_code, events, email_events, orgs CASCADE")
+
+		_, err := repo.Migrate(ctx, dbConn, migrations, repo.MigrateConfig{Operation: repo.MigrateReset})
 		if err != nil {
 			log.Fatal(fmt.Errorf("migration reset: %w", err))
 		}
@@ -72,16 +64,23 @@ func TestMain(m *testing.M) {
 	// For convenient cleaning DB.
 	resetDB()
 
-	err = zp.Up(ctx)
+	_, err = repo.Migrate(ctx, dbConn, migrations, repo.MigrateConfig{Operation: repo.MigrateUp})
 	if err != nil {
 		log.Fatal(fmt.Errorf("up migration: %w", err))
 	}
 	defer resetDB()
 
-	Repo = repo.New(zp)
+	Repo = repo.New(zp, repo.Encryption(keyring))
+	zergRepo = zp
 	truncate = func() error {
 		return zp.Do(func(db *sqlx.DB) error {
-			_, err := db.Exec("TRUNCATE users, sessions, notifications, recovery_code RESTART IDENTITY CASCADE")
+			_, err := db.Exec("TRUNCATE users, sessions, notifications, recovery_code, notification_settings, webhooks, events, user_notifications, email_events, jobs, leaders, orgs RESTART IDENTITY CASCADE")
+			if err != nil {
+				return err
+			}
+
+			// The default tenant is created by migrations.
+			_, err = db.Exec("DELETE FROM tenants WHERE id <> 1; SELECT setval('tenants_id_seq', 1)")
 			return err
 		})
 	}
@@ -99,6 +98,20 @@ var (
 	}
 )
 
+var (
+	piiIndexKey = bytes.Repeat([]byte{1}, 32)
+	piiKey      = pii.Key{ID: "test", Secret: bytes.Repeat([]byte{2}, 32)}
+)
+
+func newKeyring(keys ...pii.Key) *pii.Keyring {
+	k, err := pii.New(piiIndexKey, keys...)
+	if err != nil {
+		log.Fatal(fmt.Errorf("new keyring: %w", err))
+	}
+
+	return k
+}
+
 func generatorUser() func() app.User {
 	x := 0
 
@@ -106,6 +119,8 @@ func generatorUser() func() app.User {
 		x++
 		return app.User{
 			ID:        app.UserID(x),
+			PublicID:  app.PublicID(fmt.Sprintf("public%d", x)),
+			TenantID:  app.DefaultTenant,
 			Email:     fmt.Sprintf("email%d@gmail.com", x),
 			Name:      fmt.Sprintf("username%d", x),
 			PassHash:  []byte("pass"),
@@ -114,3 +129,26 @@ func generatorUser() func() app.User {
 		}
 	}
 }
+
+// nextNotificationTask returns the pending task of the next notification job, the job is completed.
+func nextNotificationTask() (*app.TaskNotification, error) {
+	for {
+		job, err := Repo.NextJob(ctx, []app.JobKind{app.JobNotification}, time.Minute)
+		if err != nil {
+			return nil, err
+		}
+
+		err = Repo.CompleteJob(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := Repo.TaskNotificationByID(ctx, job.Payload.(*app.NotificationJobPayload).TaskID)
+		if err != nil {
+			return nil, err
+		}
+		if info.Status == app.TaskPending {
+			return &info.TaskNotification, nil
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateJob need for implements app.JobRepo.
+func (repo *Repo) CreateJob(ctx context.Context, job app.Job) (id int, err error) {
+	err = repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		id, err = createJob(ctx, tx, job)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// NextJob need for implements app.JobRepo.
+// Workers don't wait for each other thanks to SKIP LOCKED, a job locked by the dead worker
+// is picked up again after locked_until.
+func (repo *Repo) NextJob(ctx context.Context, kinds []app.JobKind, lease time.Duration) (job *app.Job, err error) {
+	err = repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `UPDATE jobs SET attempts = attempts + 1, locked_until = now() + $2 * interval '1 second'
+		WHERE id = (
+			SELECT id FROM jobs
+			WHERE status = 'pending' AND kind = ANY($1) AND run_at <= now()
+				AND (locked_until IS NULL OR locked_until < now())
+			ORDER BY priority DESC, run_at, id LIMIT 1
+			FOR UPDATE SKIP LOCKED)
+		RETURNING id, kind, payload, priority, unique_key, run_at, attempts`
+
+		names := make(pq.StringArray, len(kinds))
+		for i := range kinds {
+			names[i] = string(kinds[i])
+		}
+
+		res := &jobDBFormat{}
+		err = db.GetContext(ctx, res, query, names, lease.Seconds())
+		if err != nil {
+			return err
+		}
+
+		job, err = res.toAppFormat()
+		return err
+	})
+	return
+}
+
+// CompleteJob need for implements app.JobRepo.
+func (repo *Repo) CompleteJob(ctx context.Context, id int) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `UPDATE jobs SET status = 'done', error = '', locked_until = NULL, finished_at = now()
+		WHERE id = $1`
+
+		_, err := db.ExecContext(ctx, query, id)
+
+		return err
+	})
+}
+
+// RetryJob need for implements app.JobRepo.
+func (repo *Repo) RetryJob(ctx context.Context, id int, jobErr error, runAt time.Time) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `UPDATE jobs SET error = $1, run_at = $2, locked_until = NULL WHERE id = $3`
+
+		_, err := db.ExecContext(ctx, query, jobErr.Error(), runAt, id)
+
+		return err
+	})
+}
+
+// FailJob need for implements app.JobRepo.
+func (repo *Repo) FailJob(ctx context.Context, id int, jobErr error) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `UPDATE jobs SET status = 'failed', error = $1, locked_until = NULL, finished_at = now()
+		WHERE id = $2`
+
+		_, err := db.ExecContext(ctx, query, jobErr.Error(), id)
+
+		return err
+	})
+}
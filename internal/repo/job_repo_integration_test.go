@@ -0,0 +1,84 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestJobRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	kinds := []app.JobKind{app.JobWebhookDelivery}
+	job, err := Repo.NextJob(ctx, kinds, time.Minute)
+	require.Nil(t, job)
+	require.Equal(t, app.ErrNotFound, errors.Unwrap(err))
+
+	low := app.NewWebhookDeliveryJob(1)
+	low.ID, err = Repo.CreateJob(ctx, low)
+	require.Nil(t, err)
+	_, err = Repo.CreateJob(ctx, low)
+	require.True(t, errors.Is(err, app.ErrJobExist))
+
+	high := app.NewWebhookDeliveryJob(2)
+	high.Priority = app.JobPriorityHigh
+	high.ID, err = Repo.CreateJob(ctx, high)
+	require.Nil(t, err)
+
+	scheduled := app.NewWebhookDeliveryJob(3)
+	scheduled.RunAt = time.Now().Add(time.Hour)
+	_, err = Repo.CreateJob(ctx, scheduled)
+	require.Nil(t, err)
+
+	_, err = Repo.NextJob(ctx, []app.JobKind{app.JobNotification}, time.Minute)
+	require.Equal(t, app.ErrNotFound, errors.Unwrap(err))
+
+	job, err = Repo.NextJob(ctx, kinds, time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, high.ID, job.ID)
+	require.Equal(t, high.Payload, job.Payload)
+	require.Equal(t, high.UniqueKey, job.UniqueKey)
+	require.Equal(t, 1, job.Attempts)
+
+	err = Repo.RetryJob(ctx, job.ID, errors.New("send failed"), time.Now().Add(-time.Second))
+	require.Nil(t, err)
+
+	job, err = Repo.NextJob(ctx, kinds, -time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, high.ID, job.ID)
+	require.Equal(t, 2, job.Attempts)
+
+	job, err = Repo.NextJob(ctx, kinds, time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, high.ID, job.ID, "the lease has expired")
+	require.Equal(t, 3, job.Attempts)
+
+	err = Repo.FailJob(ctx, job.ID, errors.New("send failed"))
+	require.Nil(t, err)
+
+	job, err = Repo.NextJob(ctx, kinds, time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, low.ID, job.ID)
+
+	_, err = Repo.NextJob(ctx, kinds, time.Minute)
+	require.Equal(t, app.ErrNotFound, errors.Unwrap(err), "the job is locked")
+
+	err = Repo.CompleteJob(ctx, job.ID)
+	require.Nil(t, err)
+
+	low.ID, err = Repo.CreateJob(ctx, low)
+	require.Nil(t, err, "the job with the same key is done")
+
+	withoutKey := app.Job{Kind: app.JobWebhookDelivery, Payload: &app.WebhookDeliveryJobPayload{DeliveryID: 4}}
+	_, err = Repo.CreateJob(ctx, withoutKey)
+	require.Nil(t, err)
+	_, err = Repo.CreateJob(ctx, withoutKey)
+	require.Nil(t, err)
+}
@@ -0,0 +1,152 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// AcquireLeadership need for implements app.LeaderRepo.
+// The leader holds the advisory lock of the election on the dedicated connection, so only
+// one process may campaign at a time, and the lock is released by the database if the
+// process dies. The leaders table keeps the term and the lease, so the new leader waits
+// until the lease of the deposed one expires even if its connection is already closed.
+func (repo *Repo) AcquireLeadership(ctx context.Context, election, holder string, ttl time.Duration) (lease *app.Lease, err error) {
+	err = repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			return err
+		}
+
+		lease, err = acquireLeadership(ctx, conn, election, holder, ttl)
+		if err != nil {
+			repo.closeLeaderConn(conn)
+			return err
+		}
+
+		repo.mu.Lock()
+		defer repo.mu.Unlock()
+		repo.leaderConns[*lease] = conn
+
+		return nil
+	})
+	return
+}
+
+func acquireLeadership(ctx context.Context, conn *sql.Conn, election, holder string, ttl time.Duration) (*app.Lease, error) {
+	const lockQuery = `SELECT pg_try_advisory_lock(hashtext($1))`
+
+	locked := false
+	err := conn.QueryRowContext(ctx, lockQuery, election).Scan(&locked)
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, app.ErrNotLeader
+	}
+
+	const query = `INSERT INTO leaders (election, holder, term, expires_at)
+	VALUES ($1, $2, 1, now() + $3 * interval '1 second')
+	ON CONFLICT (election) DO UPDATE
+		SET holder = excluded.holder, term = leaders.term + 1, expires_at = excluded.expires_at
+		WHERE leaders.expires_at <= now()
+	RETURNING term`
+
+	lease := &app.Lease{Election: election, Holder: holder}
+	err = conn.QueryRowContext(ctx, query, election, holder, ttl.Seconds()).Scan(&lease.Term)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, app.ErrNotLeader
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// RenewLeadership need for implements app.LeaderRepo.
+// The lease is renewed on the connection holding the lock, so the leader whose
+// connection has been lost can't renew it.
+func (repo *Repo) RenewLeadership(ctx context.Context, lease app.Lease, ttl time.Duration) error {
+	return repo.primary(ctx).Do(func(*sqlx.DB) error {
+		repo.mu.Lock()
+		conn := repo.leaderConns[lease]
+		repo.mu.Unlock()
+		if conn == nil {
+			return app.ErrNotLeader
+		}
+
+		const query = `UPDATE leaders SET expires_at = now() + $3 * interval '1 second'
+		WHERE election = $1 AND term = $2`
+
+		res, err := conn.ExecContext(ctx, query, lease.Election, lease.Term, ttl.Seconds())
+		if err != nil {
+			return err
+		}
+
+		count, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			repo.closeLeaderConn(repo.takeLeaderConn(lease))
+			return app.ErrNotLeader
+		}
+
+		return nil
+	})
+}
+
+// ReleaseLeadership need for implements app.LeaderRepo.
+func (repo *Repo) ReleaseLeadership(ctx context.Context, lease app.Lease) error {
+	return repo.primary(ctx).Do(func(*sqlx.DB) error {
+		conn := repo.takeLeaderConn(lease)
+		if conn == nil {
+			return nil
+		}
+		defer repo.closeLeaderConn(conn)
+
+		const query = `UPDATE leaders SET expires_at = now() WHERE election = $1 AND term = $2`
+
+		_, err := conn.ExecContext(ctx, query, lease.Election, lease.Term)
+
+		return err
+	})
+}
+
+func (repo *Repo) takeLeaderConn(lease app.Lease) *sql.Conn {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	conn := repo.leaderConns[lease]
+	delete(repo.leaderConns, lease)
+
+	return conn
+}
+
+// closeLeaderConn returns the connection to the pool without the advisory lock.
+// The connection is closed if the lock can't be released, otherwise another user of the pool would hold it.
+func (repo *Repo) closeLeaderConn(conn *sql.Conn) {
+	if conn == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeLeaderConnTimeout)
+	defer cancel()
+
+	const query = `SELECT pg_advisory_unlock_all()`
+
+	_, err := conn.ExecContext(ctx, query)
+	if err != nil {
+		// The connection is discarded by the pool if driver.ErrBadConn is returned.
+		_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+	repo.db.WarnIfFail(conn.Close)
+}
+
+const closeLeaderConnTimeout = 5 * time.Second
@@ -0,0 +1,43 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestLeaderRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	const election = "election"
+
+	lease, err := Repo.AcquireLeadership(ctx, election, "first", time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, &app.Lease{Election: election, Holder: "first", Term: 1}, lease)
+
+	_, err = Repo.AcquireLeadership(ctx, election, "second", time.Minute)
+	require.True(t, errors.Is(err, app.ErrNotLeader))
+
+	err = Repo.RenewLeadership(ctx, *lease, time.Minute)
+	require.Nil(t, err)
+
+	err = Repo.ReleaseLeadership(ctx, *lease)
+	require.Nil(t, err)
+
+	next, err := Repo.AcquireLeadership(ctx, election, "second", time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, &app.Lease{Election: election, Holder: "second", Term: 2}, next)
+
+	err = Repo.RenewLeadership(ctx, *lease, time.Minute)
+	require.True(t, errors.Is(err, app.ErrNotLeader), "the lease of the deposed leader")
+
+	err = Repo.ReleaseLeadership(ctx, *next)
+	require.Nil(t, err)
+}
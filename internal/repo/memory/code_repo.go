@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SaveCode need for implements app.CodeRepo.
+func (repo *Repo) SaveCode(_ context.Context, tenantID app.TenantID, email, c string, t app.TaskNotification) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	user := repo.userByEmail(tenantID, email)
+	if user == nil {
+		return app.ErrNotFound
+	}
+
+	repo.cleanRecoveryCodes(tenantID, email)
+	repo.seq.code++
+	repo.codes = append(repo.codes, code{
+		ID:        repo.seq.code,
+		TenantID:  tenantID,
+		Code:      c,
+		Email:     email,
+		CreatedAt: time.Now(),
+	})
+
+	_, err := repo.createTaskNotification(t)
+	if err != nil {
+		return err
+	}
+
+	return repo.createEvent(app.Event{
+		Type:   app.EventRecoveryCodeCreated,
+		UserID: user.ID,
+		Email:  email,
+	})
+}
+
+// Code need for implements app.CodeRepo.
+func (repo *Repo) Code(_ context.Context, tenantID app.TenantID, email string) (*app.CodeInfo, error) {
+	return repo.code(func(c *code) bool { return c.TenantID == tenantID && c.Email == email && c.Phone == "" })
+}
+
+// SavePhoneCode need for implements app.CodeRepo.
+func (repo *Repo) SavePhoneCode(_ context.Context, tenantID app.TenantID, email, phone, c string, t app.TaskNotification) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if repo.userByEmail(tenantID, email) == nil {
+		return errNoUser
+	}
+
+	repo.cleanPhoneCodes(tenantID, email)
+	repo.seq.code++
+	repo.codes = append(repo.codes, code{
+		ID:        repo.seq.code,
+		TenantID:  tenantID,
+		Code:      c,
+		Email:     email,
+		Phone:     phone,
+		CreatedAt: time.Now(),
+	})
+
+	_, err := repo.createTaskNotification(t)
+
+	return err
+}
+
+// PhoneCode need for implements app.CodeRepo.
+func (repo *Repo) PhoneCode(_ context.Context, tenantID app.TenantID, email string) (*app.CodeInfo, error) {
+	return repo.code(func(c *code) bool { return c.TenantID == tenantID && c.Email == email && c.Phone != "" })
+}
+
+func (repo *Repo) code(match func(*code) bool) (*app.CodeInfo, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for i := range repo.codes {
+		if match(&repo.codes[i]) {
+			return repo.codes[i].toAppFormat(), nil
+		}
+	}
+
+	return nil, app.ErrNotFound
+}
@@ -0,0 +1,77 @@
+// Package memory is an implements database interface keeping data in memory.
+// It is intended for local development and tests, all data is lost when the process exits.
+package memory
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+var _ app.SessionRepo = &Repo{}
+var _ app.UserRepo = &Repo{}
+var _ app.WAL = &Repo{}
+var _ app.CodeRepo = &Repo{}
+var _ app.NotificationSettingsRepo = &Repo{}
+var _ app.WebhookRepo = &Repo{}
+var _ app.EventRepo = &Repo{}
+var _ app.InboxRepo = &Repo{}
+var _ app.DeliverabilityRepo = &Repo{}
+var _ app.ProviderRepo = &Repo{}
+var _ app.JobRepo = &Repo{}
+var _ app.PurgeRepo = &Repo{}
+var _ app.LeaderRepo = &Repo{}
+var _ app.TenantRepo = &Repo{}
+var _ app.OrgRepo = &Repo{}
+
+// Errors of constraints which have no app errors, the same constraints fail in database.
+var (
+	errTokenExist = errors.New("token exist")
+	errNoUser     = errors.New("user doesn't exist")
+)
+
+// Repo is an implements app.UserRepo.
+// Responsible for keeping data in memory with the same semantics as the database:
+// unique constraints, cascading deletes, the outbox and the job queue.
+// It is safe for concurrent use, every method is applied atomically like a transaction.
+type Repo struct {
+	mu sync.Mutex
+
+	users             []app.User
+	sessions          []session
+	codes             []code
+	tasks             []task
+	userNotifications []userNotification
+	settings          []notificationSetting
+	webhooks          []app.Webhook
+	events            []event
+	deliveries        []delivery
+	emailEvents       []app.EmailEvent
+	jobs              []job
+	leaders           map[string]leader
+	tenants           []app.Tenant
+	orgs              []app.Org
+	orgMembers        []orgMember
+	orgInvitations    []app.OrgInvitation
+
+	seq struct {
+		user, session, code, task, userNotification, webhook, event, delivery, job, tenant, org, orgInvitation int
+	}
+}
+
+// New creates and returns new repository with the default tenant like after migrations.
+func New() *Repo {
+	repo := &Repo{
+		leaders: make(map[string]leader),
+	}
+	repo.seq.tenant = int(app.DefaultTenant)
+	repo.tenants = []app.Tenant{{
+		ID:        app.DefaultTenant,
+		Name:      "default",
+		CreatedAt: time.Now(),
+	}}
+
+	return repo
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SaveEmailEvent need for implements app.DeliverabilityRepo.
+func (repo *Repo) SaveEmailEvent(_ context.Context, event app.EmailEvent) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, e := range repo.emailEvents {
+		if event.ExternalID != "" && e.ExternalID == event.ExternalID {
+			return nil
+		}
+	}
+
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	repo.emailEvents = append(repo.emailEvents, event)
+
+	return nil
+}
+
+// LastEmailEvent need for implements app.DeliverabilityRepo.
+func (repo *Repo) LastEmailEvent(_ context.Context, email string) (*app.EmailEvent, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	var last *app.EmailEvent
+	for i := range repo.emailEvents {
+		e := &repo.emailEvents[i]
+		if e.Email == email && (last == nil || !e.CreatedAt.Before(last.CreatedAt)) {
+			last = e
+		}
+	}
+	if last == nil {
+		return nil, app.ErrNotFound
+	}
+
+	res := *last
+
+	return &res, nil
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// UnpublishedEvents need for implements app.EventRepo.
+func (repo *Repo) UnpublishedEvents(_ context.Context, limit int) ([]app.Event, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	events := make([]app.Event, 0, limit)
+	for _, e := range repo.events {
+		if len(events) == limit {
+			break
+		}
+		if e.PublishedAt == nil {
+			events = append(events, e.Event)
+		}
+	}
+
+	return events, nil
+}
+
+// EventsPublished need for implements app.EventRepo.
+func (repo *Repo) EventsPublished(_ context.Context, ids []int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	now := time.Now()
+	for i := range repo.events {
+		if containsID(ids, repo.events[i].ID) {
+			repo.events[i].PublishedAt = &now
+		}
+	}
+
+	return nil
+}
+
+// LastUserEvent need for implements app.EventRepo.
+func (repo *Repo) LastUserEvent(_ context.Context, userID app.UserID, eventType app.EventType) (*app.Event, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for i := len(repo.events) - 1; i >= 0; i-- {
+		if repo.events[i].UserID == userID && repo.events[i].Type == eventType {
+			e := repo.events[i].Event
+			return &e, nil
+		}
+	}
+
+	return nil, app.ErrNotFound
+}
+
+func (repo *Repo) eventByID(id int) *app.Event {
+	for i := range repo.events {
+		if repo.events[i].ID == id {
+			return &repo.events[i].Event
+		}
+	}
+
+	return nil
+}
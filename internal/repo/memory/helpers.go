@@ -0,0 +1,188 @@
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Helpers are called with the locked mutex.
+
+// createTaskNotification saves the task with the job executing it.
+func (repo *Repo) createTaskNotification(t app.TaskNotification) (id int, err error) {
+	payload, err := json.Marshal(t.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	now := time.Now()
+	if t.RunAt.IsZero() {
+		t.RunAt = now
+	}
+
+	repo.seq.task++
+	id = repo.seq.task
+	repo.tasks = append(repo.tasks, task{
+		ID:        id,
+		TenantID:  t.TenantID,
+		Email:     t.Email,
+		Kind:      t.Kind,
+		Payload:   payload,
+		RunAt:     t.RunAt,
+		CreatedAt: now,
+	})
+
+	_, err = repo.createJob(app.NewNotificationJob(id, t))
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// createJob adds the pending job, ErrJobExist is returned if a pending job has the same unique key.
+func (repo *Repo) createJob(j app.Job) (id int, err error) {
+	if j.UniqueKey != "" {
+		for i := range repo.jobs {
+			if repo.jobs[i].UniqueKey == j.UniqueKey && repo.jobs[i].Status == jobPending {
+				return 0, app.ErrJobExist
+			}
+		}
+	}
+
+	payload, err := json.Marshal(j.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	now := time.Now()
+	if j.RunAt.IsZero() {
+		j.RunAt = now
+	}
+
+	repo.seq.job++
+	repo.jobs = append(repo.jobs, job{
+		ID:        repo.seq.job,
+		Kind:      j.Kind,
+		Payload:   payload,
+		Priority:  j.Priority,
+		UniqueKey: j.UniqueKey,
+		RunAt:     j.RunAt,
+		Status:    jobPending,
+		CreatedAt: now,
+	})
+
+	return repo.seq.job, nil
+}
+
+// createEvent saves the event with the public id of the user to the outbox
+// and creates deliveries with their jobs for all webhooks subscribed to it.
+func (repo *Repo) createEvent(e app.Event) error {
+	repo.seq.event++
+	e.ID = repo.seq.event
+	e.CreatedAt = time.Now()
+	if user := repo.userByID(e.UserID); user != nil {
+		e.UserPublicID = user.PublicID
+	}
+	repo.events = append(repo.events, event{Event: e})
+
+	for _, webhook := range repo.webhooks {
+		if !subscribed(webhook, e.Type) {
+			continue
+		}
+
+		repo.seq.delivery++
+		repo.deliveries = append(repo.deliveries, delivery{
+			ID:            repo.seq.delivery,
+			WebhookID:     webhook.ID,
+			EventID:       e.ID,
+			Status:        app.DeliveryPending,
+			NextAttemptAt: e.CreatedAt,
+		})
+
+		_, err := repo.createJob(app.NewWebhookDeliveryJob(repo.seq.delivery))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func subscribed(webhook app.Webhook, eventType app.EventType) bool {
+	for _, t := range webhook.Events {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (repo *Repo) cleanRecoveryCodes(tenantID app.TenantID, email string) {
+	repo.deleteCodes(func(c *code) bool { return c.TenantID == tenantID && c.Email == email && c.Phone == "" })
+}
+
+func (repo *Repo) cleanPhoneCodes(tenantID app.TenantID, email string) {
+	repo.deleteCodes(func(c *code) bool { return c.TenantID == tenantID && c.Email == email && c.Phone != "" })
+}
+
+func (repo *Repo) deleteCodes(match func(*code) bool) {
+	codes := repo.codes[:0]
+	for i := range repo.codes {
+		if !match(&repo.codes[i]) {
+			codes = append(codes, repo.codes[i])
+		}
+	}
+	repo.codes = codes
+}
+
+func (repo *Repo) userIndex(match func(*app.User) bool) int {
+	for i := range repo.users {
+		if match(&repo.users[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (repo *Repo) userByID(id app.UserID) *app.User {
+	i := repo.userIndex(func(u *app.User) bool { return u.ID == id })
+	if i < 0 {
+		return nil
+	}
+
+	return &repo.users[i]
+}
+
+func (repo *Repo) userByEmail(tenantID app.TenantID, email string) *app.User {
+	i := repo.userIndex(func(u *app.User) bool { return u.TenantID == tenantID && u.Email == email })
+	if i < 0 {
+		return nil
+	}
+
+	return &repo.users[i]
+}
+
+func (repo *Repo) taskByID(id int) *task {
+	for i := range repo.tasks {
+		if repo.tasks[i].ID == id {
+			return &repo.tasks[i]
+		}
+	}
+
+	return nil
+}
+
+func (repo *Repo) jobByID(id int) *job {
+	for i := range repo.jobs {
+		if repo.jobs[i].ID == id {
+			return &repo.jobs[i]
+		}
+	}
+
+	return nil
+}
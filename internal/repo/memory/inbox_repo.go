@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateUserNotification need for implements app.InboxRepo.
+func (repo *Repo) CreateUserNotification(_ context.Context, email string, msg app.Message) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	user := repo.userByEmail(msg.TenantID, email)
+	if user == nil {
+		return nil
+	}
+	if msg.TaskID != 0 {
+		for _, n := range repo.userNotifications {
+			if n.TaskID == msg.TaskID {
+				return nil
+			}
+		}
+	}
+
+	repo.seq.userNotification++
+	repo.userNotifications = append(repo.userNotifications, userNotification{
+		ID:        repo.seq.userNotification,
+		UserID:    user.ID,
+		TaskID:    msg.TaskID,
+		Kind:      msg.Kind,
+		Content:   msg.Content,
+		CreatedAt: time.Now(),
+	})
+
+	return nil
+}
+
+// UserNotifications need for implements app.InboxRepo.
+func (repo *Repo) UserNotifications(_ context.Context, userID app.UserID, p app.Page) ([]app.UserNotification, int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	notifications := make([]app.UserNotification, 0)
+	for _, n := range repo.userNotifications {
+		if n.UserID == userID {
+			notifications = append(notifications, n.toAppFormat())
+		}
+	}
+	sort.Slice(notifications, func(i, j int) bool { return notifications[i].ID > notifications[j].ID })
+
+	from, to := page(len(notifications), p)
+
+	return notifications[from:to], len(notifications), nil
+}
+
+// UserNotificationsAfter need for implements app.InboxRepo.
+func (repo *Repo) UserNotificationsAfter(_ context.Context, userID app.UserID, afterID, limit int) ([]app.UserNotification, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	notifications := make([]app.UserNotification, 0, limit)
+	for _, n := range repo.userNotifications {
+		if len(notifications) == limit {
+			break
+		}
+		if n.UserID == userID && n.ID > afterID {
+			notifications = append(notifications, n.toAppFormat())
+		}
+	}
+
+	return notifications, nil
+}
+
+// MarkUserNotificationsRead need for implements app.InboxRepo.
+func (repo *Repo) MarkUserNotificationsRead(_ context.Context, userID app.UserID, ids []int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	now := time.Now()
+	for i := range repo.userNotifications {
+		n := &repo.userNotifications[i]
+		if n.UserID == userID && n.ReadAt == nil && (len(ids) == 0 || containsID(ids, n.ID)) {
+			n.ReadAt = &now
+		}
+	}
+
+	return nil
+}
+
+// UnreadUserNotificationCount need for implements app.InboxRepo.
+func (repo *Repo) UnreadUserNotificationCount(_ context.Context, userID app.UserID) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, n := range repo.userNotifications {
+		if n.UserID == userID && n.ReadAt == nil {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func containsID(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateJob need for implements app.JobRepo.
+func (repo *Repo) CreateJob(_ context.Context, j app.Job) (id int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	return repo.createJob(j)
+}
+
+// NextJob need for implements app.JobRepo.
+func (repo *Repo) NextJob(_ context.Context, kinds []app.JobKind, lease time.Duration) (*app.Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	now := time.Now()
+	var next *job
+	for i := range repo.jobs {
+		j := &repo.jobs[i]
+		switch {
+		case j.Status != jobPending, !containsKind(kinds, j.Kind), j.RunAt.After(now):
+		case j.LockedUntil != nil && !j.LockedUntil.Before(now):
+		case next == nil, j.Priority > next.Priority,
+			j.Priority == next.Priority && j.RunAt.Before(next.RunAt):
+			next = j
+		}
+	}
+	if next == nil {
+		return nil, app.ErrNotFound
+	}
+
+	lockedUntil := now.Add(lease)
+	next.Attempts++
+	next.LockedUntil = &lockedUntil
+
+	return next.toAppFormat()
+}
+
+// CompleteJob need for implements app.JobRepo.
+func (repo *Repo) CompleteJob(_ context.Context, id int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if j := repo.jobByID(id); j != nil {
+		j.finish(jobDone, "")
+	}
+
+	return nil
+}
+
+// RetryJob need for implements app.JobRepo.
+func (repo *Repo) RetryJob(_ context.Context, id int, jobErr error, runAt time.Time) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if j := repo.jobByID(id); j != nil {
+		j.Error = jobErr.Error()
+		j.RunAt = runAt
+		j.LockedUntil = nil
+	}
+
+	return nil
+}
+
+// FailJob need for implements app.JobRepo.
+func (repo *Repo) FailJob(_ context.Context, id int, jobErr error) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if j := repo.jobByID(id); j != nil {
+		j.finish(jobFailed, jobErr.Error())
+	}
+
+	return nil
+}
+
+func (val *job) finish(status, jobErr string) {
+	now := time.Now()
+	val.Status = status
+	val.Error = jobErr
+	val.LockedUntil = nil
+	val.FinishedAt = &now
+}
+
+func containsKind(kinds []app.JobKind, kind app.JobKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// AcquireLeadership need for implements app.LeaderRepo.
+func (repo *Repo) AcquireLeadership(_ context.Context, election, holder string, ttl time.Duration) (*app.Lease, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	now := time.Now()
+	l := repo.leaders[election]
+	if now.Before(l.ExpiresAt) {
+		return nil, app.ErrNotLeader
+	}
+
+	l = leader{Holder: holder, Term: l.Term + 1, ExpiresAt: now.Add(ttl)}
+	repo.leaders[election] = l
+
+	return &app.Lease{Election: election, Holder: holder, Term: l.Term}, nil
+}
+
+// RenewLeadership need for implements app.LeaderRepo.
+func (repo *Repo) RenewLeadership(_ context.Context, lease app.Lease, ttl time.Duration) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	l, ok := repo.leaders[lease.Election]
+	if !ok || l.Term != lease.Term {
+		return app.ErrNotLeader
+	}
+
+	l.ExpiresAt = time.Now().Add(ttl)
+	repo.leaders[lease.Election] = l
+
+	return nil
+}
+
+// ReleaseLeadership need for implements app.LeaderRepo.
+func (repo *Repo) ReleaseLeadership(_ context.Context, lease app.Lease) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	l, ok := repo.leaders[lease.Election]
+	if ok && l.Term == lease.Term {
+		l.ExpiresAt = time.Now()
+		repo.leaders[lease.Election] = l
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/zergslaw/boilerplate/internal/repo/conformance"
+	"github.com/zergslaw/boilerplate/internal/repo/memory"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	conformance.Run(t, func(*testing.T) conformance.Repo {
+		return memory.New()
+	})
+}
@@ -0,0 +1,244 @@
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+type (
+	session struct {
+		ID        app.SessionID
+		UserID    app.UserID
+		TokenID   app.TokenID
+		Origin    app.Origin
+		IsLogout  bool
+		LogoutAt  time.Time
+		CreatedAt time.Time
+	}
+
+	code struct {
+		ID        int
+		TenantID  app.TenantID
+		Code      string
+		Email     string
+		Phone     string
+		CreatedAt time.Time
+	}
+
+	// task is a notification task, payload is kept as JSON like in database,
+	// so the task doesn't share the payload with the caller.
+	task struct {
+		ID          int
+		TenantID    app.TenantID
+		Email       string
+		Kind        app.MessageKind
+		Payload     []byte
+		RunAt       time.Time
+		IsDone      bool
+		Error       string
+		CreatedAt   time.Time
+		ExecTime    *time.Time
+		CancelledAt *time.Time
+		Provider    string
+	}
+
+	orgMember struct {
+		OrgID     app.OrgID
+		UserID    app.UserID
+		Role      app.OrgRole
+		CreatedAt time.Time
+	}
+
+	userNotification struct {
+		ID        int
+		UserID    app.UserID
+		TaskID    int
+		Kind      app.MessageKind
+		Content   string
+		ReadAt    *time.Time
+		CreatedAt time.Time
+	}
+
+	notificationSetting struct {
+		UserID  app.UserID
+		Kind    app.MessageKind
+		Enabled bool
+	}
+
+	event struct {
+		app.Event
+		PublishedAt *time.Time
+	}
+
+	delivery struct {
+		ID            int
+		WebhookID     app.WebhookID
+		EventID       int
+		Status        app.DeliveryStatus
+		Attempts      int
+		StatusCode    int
+		Error         string
+		NextAttemptAt time.Time
+		DeliveredAt   *time.Time
+	}
+
+	job struct {
+		ID          int
+		Kind        app.JobKind
+		Payload     []byte
+		Priority    int
+		UniqueKey   string
+		RunAt       time.Time
+		Attempts    int
+		Status      string
+		Error       string
+		LockedUntil *time.Time
+		CreatedAt   time.Time
+		FinishedAt  *time.Time
+	}
+
+	leader struct {
+		Holder    string
+		Term      int64
+		ExpiresAt time.Time
+	}
+)
+
+// Job statuses.
+const (
+	jobPending = "pending"
+	jobDone    = "done"
+	jobFailed  = "failed"
+)
+
+func copyUser(user app.User) *app.User {
+	user.PassHash = append([]byte(nil), user.PassHash...)
+
+	return &user
+}
+
+func (val *session) toAppFormat() *app.Session {
+	return &app.Session{
+		Origin: app.Origin{
+			IP:        append(net.IP(nil), val.Origin.IP...),
+			UserAgent: val.Origin.UserAgent,
+		},
+		ID:      val.ID,
+		TokenID: val.TokenID,
+	}
+}
+
+func (val *code) toAppFormat() *app.CodeInfo {
+	return &app.CodeInfo{
+		Code:      val.Code,
+		Email:     val.Email,
+		Phone:     val.Phone,
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func (val *task) toAppFormat() (*app.TaskNotification, error) {
+	payload, err := val.Kind.NewPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(val.Payload, payload)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	return &app.TaskNotification{
+		ID:       val.ID,
+		TenantID: val.TenantID,
+		Email:    val.Email,
+		Kind:     val.Kind,
+		Payload:  payload,
+		RunAt:    val.RunAt,
+	}, nil
+}
+
+func (val *task) toAppInfoFormat() (*app.TaskNotificationInfo, error) {
+	t, err := val.toAppFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &app.TaskNotificationInfo{
+		TaskNotification: *t,
+		Status:           val.status(),
+		Error:            val.Error,
+		CreatedAt:        val.CreatedAt,
+		Provider:         val.Provider,
+	}
+	if val.ExecTime != nil {
+		info.ExecTime = *val.ExecTime
+	}
+
+	return info, nil
+}
+
+func (val *task) status() app.TaskStatus {
+	switch {
+	case val.CancelledAt != nil:
+		return app.TaskCancelled
+	case val.IsDone:
+		return app.TaskDone
+	default:
+		return app.TaskPending
+	}
+}
+
+func (val *userNotification) toAppFormat() app.UserNotification {
+	return app.UserNotification{
+		ID:        val.ID,
+		Kind:      val.Kind,
+		Content:   val.Content,
+		Read:      val.ReadAt != nil,
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func copyWebhook(webhook app.Webhook) app.Webhook {
+	webhook.Events = append([]app.EventType(nil), webhook.Events...)
+
+	return webhook
+}
+
+func (val *job) toAppFormat() (*app.Job, error) {
+	payload, err := val.Kind.NewPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(val.Payload, payload)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	return &app.Job{
+		ID:        val.ID,
+		Kind:      val.Kind,
+		Payload:   payload,
+		Priority:  val.Priority,
+		RunAt:     val.RunAt,
+		UniqueKey: val.UniqueKey,
+		Attempts:  val.Attempts,
+	}, nil
+}
+
+func page(total int, p app.Page) (from, to int) {
+	from, to = p.Offset, p.Offset+p.Limit
+	if from > total {
+		from = total
+	}
+	if to > total {
+		to = total
+	}
+
+	return from, to
+}
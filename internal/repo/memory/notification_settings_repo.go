@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// NotificationSettings need for implements app.NotificationSettingsRepo.
+func (repo *Repo) NotificationSettings(_ context.Context, userID app.UserID) ([]app.NotificationSetting, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	settings := make([]app.NotificationSetting, 0)
+	for _, s := range repo.settings {
+		if s.UserID == userID {
+			settings = append(settings, app.NotificationSetting{Kind: s.Kind, Enabled: s.Enabled})
+		}
+	}
+
+	return settings, nil
+}
+
+// SaveNotificationSetting need for implements app.NotificationSettingsRepo.
+func (repo *Repo) SaveNotificationSetting(_ context.Context, userID app.UserID, setting app.NotificationSetting) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if repo.userByID(userID) == nil {
+		return errNoUser
+	}
+
+	for i := range repo.settings {
+		if repo.settings[i].UserID == userID && repo.settings[i].Kind == setting.Kind {
+			repo.settings[i].Enabled = setting.Enabled
+			return nil
+		}
+	}
+
+	repo.settings = append(repo.settings, notificationSetting{
+		UserID:  userID,
+		Kind:    setting.Kind,
+		Enabled: setting.Enabled,
+	})
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateOrg need for implements app.OrgRepo.
+func (repo *Repo) CreateOrg(_ context.Context, org app.Org, ownerID app.UserID) (app.OrgID, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if repo.userByID(ownerID) == nil {
+		return 0, errNoUser
+	}
+
+	repo.seq.org++
+	org.ID = app.OrgID(repo.seq.org)
+	org.CreatedAt = time.Now()
+	repo.orgs = append(repo.orgs, org)
+	repo.orgMembers = append(repo.orgMembers, orgMember{
+		OrgID:     org.ID,
+		UserID:    ownerID,
+		Role:      app.OrgRoleOwner,
+		CreatedAt: org.CreatedAt,
+	})
+
+	return org.ID, nil
+}
+
+// OrgByID need for implements app.OrgRepo.
+func (repo *Repo) OrgByID(_ context.Context, id app.OrgID) (*app.Org, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	org := repo.orgByID(id)
+	if org == nil {
+		return nil, app.ErrNotFound
+	}
+	res := *org
+
+	return &res, nil
+}
+
+// UserOrgs need for implements app.OrgRepo.
+func (repo *Repo) UserOrgs(_ context.Context, userID app.UserID) ([]app.OrgMembership, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	orgs := make([]app.OrgMembership, 0)
+	for _, org := range repo.orgs {
+		member := repo.orgMember(org.ID, userID)
+		if member != nil {
+			orgs = append(orgs, app.OrgMembership{Org: org, Role: member.Role})
+		}
+	}
+
+	return orgs, nil
+}
+
+// OrgMember need for implements app.OrgRepo.
+func (repo *Repo) OrgMember(_ context.Context, orgID app.OrgID, userID app.UserID) (*app.OrgMember, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	member := repo.orgMember(orgID, userID)
+	if member == nil {
+		return nil, app.ErrNotFound
+	}
+	res := repo.orgMemberAppFormat(*member)
+
+	return &res, nil
+}
+
+// OrgMembers need for implements app.OrgRepo.
+func (repo *Repo) OrgMembers(_ context.Context, orgID app.OrgID, p app.Page) ([]app.OrgMember, int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	members := make([]app.OrgMember, 0)
+	for _, m := range repo.orgMembers {
+		if m.OrgID == orgID {
+			members = append(members, repo.orgMemberAppFormat(m))
+		}
+	}
+
+	from, to := page(len(members), p)
+
+	return members[from:to], len(members), nil
+}
+
+// DeleteOrgMember need for implements app.OrgRepo.
+func (repo *Repo) DeleteOrgMember(_ context.Context, orgID app.OrgID, userID app.UserID) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if repo.orgMember(orgID, userID) == nil {
+		return app.ErrNotFound
+	}
+	repo.deleteOrgMembers(func(m *orgMember) bool { return m.OrgID == orgID && m.UserID == userID })
+
+	return nil
+}
+
+// TransferOrgOwnership need for implements app.OrgRepo.
+func (repo *Repo) TransferOrgOwnership(_ context.Context, orgID app.OrgID, ownerID, userID app.UserID) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	owner, member := repo.orgMember(orgID, ownerID), repo.orgMember(orgID, userID)
+	if owner == nil || owner.Role != app.OrgRoleOwner || member == nil {
+		return app.ErrNotFound
+	}
+	owner.Role = app.OrgRoleAdmin
+	member.Role = app.OrgRoleOwner
+
+	return nil
+}
+
+// CreateOrgInvitation need for implements app.OrgRepo.
+func (repo *Repo) CreateOrgInvitation(_ context.Context, invitation app.OrgInvitation, t app.TaskNotification) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if repo.orgByID(invitation.OrgID) == nil {
+		return app.ErrNotFound
+	}
+	for _, i := range repo.orgInvitations {
+		if i.Token == invitation.Token {
+			return errTokenExist
+		}
+	}
+
+	repo.deleteOrgInvitations(func(i *app.OrgInvitation) bool {
+		return i.OrgID == invitation.OrgID && i.Email == invitation.Email
+	})
+	repo.seq.orgInvitation++
+	invitation.ID = repo.seq.orgInvitation
+	invitation.CreatedAt = time.Now()
+	repo.orgInvitations = append(repo.orgInvitations, invitation)
+
+	_, err := repo.createTaskNotification(t)
+
+	return err
+}
+
+// OrgInvitationByToken need for implements app.OrgRepo.
+func (repo *Repo) OrgInvitationByToken(_ context.Context, token string) (*app.OrgInvitation, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, i := range repo.orgInvitations {
+		if i.Token == token {
+			return &i, nil
+		}
+	}
+
+	return nil, app.ErrNotFound
+}
+
+// AcceptOrgInvitation need for implements app.OrgRepo.
+func (repo *Repo) AcceptOrgInvitation(_ context.Context, invitationID int, userID app.UserID) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	var invitation *app.OrgInvitation
+	for i := range repo.orgInvitations {
+		if repo.orgInvitations[i].ID == invitationID {
+			invitation = &repo.orgInvitations[i]
+		}
+	}
+	switch {
+	case invitation == nil:
+		return app.ErrNotFound
+	case repo.userByID(userID) == nil:
+		return errNoUser
+	case repo.orgMember(invitation.OrgID, userID) != nil:
+		return app.ErrOrgMemberExist
+	}
+
+	repo.orgMembers = append(repo.orgMembers, orgMember{
+		OrgID:     invitation.OrgID,
+		UserID:    userID,
+		Role:      invitation.Role,
+		CreatedAt: time.Now(),
+	})
+	repo.deleteOrgInvitations(func(i *app.OrgInvitation) bool { return i.ID == invitationID })
+
+	return nil
+}
+
+func (repo *Repo) orgByID(id app.OrgID) *app.Org {
+	for i := range repo.orgs {
+		if repo.orgs[i].ID == id {
+			return &repo.orgs[i]
+		}
+	}
+
+	return nil
+}
+
+func (repo *Repo) orgMember(orgID app.OrgID, userID app.UserID) *orgMember {
+	for i := range repo.orgMembers {
+		if repo.orgMembers[i].OrgID == orgID && repo.orgMembers[i].UserID == userID {
+			return &repo.orgMembers[i]
+		}
+	}
+
+	return nil
+}
+
+// orgMemberAppFormat joins the member with the user like the database does.
+func (repo *Repo) orgMemberAppFormat(m orgMember) app.OrgMember {
+	member := app.OrgMember{
+		OrgID:     m.OrgID,
+		UserID:    m.UserID,
+		Role:      m.Role,
+		CreatedAt: m.CreatedAt,
+	}
+	if user := repo.userByID(m.UserID); user != nil {
+		member.UserPublicID = user.PublicID
+		member.Username = user.Name
+		member.Email = user.Email
+	}
+
+	return member
+}
+
+func (repo *Repo) deleteOrgMembers(match func(*orgMember) bool) {
+	members := repo.orgMembers[:0]
+	for i := range repo.orgMembers {
+		if !match(&repo.orgMembers[i]) {
+			members = append(members, repo.orgMembers[i])
+		}
+	}
+	repo.orgMembers = members
+}
+
+func (repo *Repo) deleteOrgInvitations(match func(*app.OrgInvitation) bool) {
+	invitations := repo.orgInvitations[:0]
+	for i := range repo.orgInvitations {
+		if !match(&repo.orgInvitations[i]) {
+			invitations = append(invitations, repo.orgInvitations[i])
+		}
+	}
+	repo.orgInvitations = invitations
+}
+
+// deleteUserOrgs removes organizations owned by the user and memberships of the user
+// like the cascading deletes of the database.
+func (repo *Repo) deleteUserOrgs(userID app.UserID) {
+	orgs := repo.orgs[:0]
+	for _, org := range repo.orgs {
+		owner := repo.orgMember(org.ID, userID)
+		if owner != nil && owner.Role == app.OrgRoleOwner {
+			orgID := org.ID
+			repo.deleteOrgMembers(func(m *orgMember) bool { return m.OrgID == orgID })
+			repo.deleteOrgInvitations(func(i *app.OrgInvitation) bool { return i.OrgID == orgID })
+			continue
+		}
+		orgs = append(orgs, org)
+	}
+	repo.orgs = orgs
+
+	repo.deleteOrgMembers(func(m *orgMember) bool { return m.UserID == userID })
+	for i := range repo.orgInvitations {
+		if repo.orgInvitations[i].InvitedBy == userID {
+			repo.orgInvitations[i].InvitedBy = 0
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeSessions need for implements app.PurgeRepo.
+func (repo *Repo) PurgeSessions(_ context.Context, before time.Time, limit int) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	sessions := repo.sessions[:0]
+	for _, s := range repo.sessions {
+		if count < limit && s.IsLogout && s.LogoutAt.Before(before) {
+			count++
+			continue
+		}
+		sessions = append(sessions, s)
+	}
+	repo.sessions = sessions
+
+	return count, nil
+}
+
+// PurgeRecoveryCodes need for implements app.PurgeRepo.
+func (repo *Repo) PurgeRecoveryCodes(_ context.Context, before time.Time, limit int) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	repo.deleteCodes(func(c *code) bool {
+		if count < limit && c.CreatedAt.Before(before) {
+			count++
+			return true
+		}
+		return false
+	})
+
+	return count, nil
+}
+
+// PurgeNotifications need for implements app.PurgeRepo.
+func (repo *Repo) PurgeNotifications(_ context.Context, before time.Time, limit int) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	tasks := repo.tasks[:0]
+	for _, t := range repo.tasks {
+		finishedAt := t.CreatedAt
+		switch {
+		case t.ExecTime != nil:
+			finishedAt = *t.ExecTime
+		case t.CancelledAt != nil:
+			finishedAt = *t.CancelledAt
+		}
+
+		if count < limit && t.IsDone && finishedAt.Before(before) {
+			count++
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+	repo.tasks = tasks
+
+	return count, nil
+}
+
+// PurgeJobs need for implements app.PurgeRepo.
+func (repo *Repo) PurgeJobs(_ context.Context, before time.Time, limit int) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	jobs := repo.jobs[:0]
+	for _, j := range repo.jobs {
+		if count < limit && j.FinishedAt != nil && j.FinishedAt.Before(before) {
+			count++
+			continue
+		}
+		jobs = append(jobs, j)
+	}
+	repo.jobs = jobs
+
+	return count, nil
+}
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SaveSession need for implements app.SessionRepo.
+func (repo *Repo) SaveSession(_ context.Context, userID app.UserID, tokenID app.TokenID, origin app.Origin) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if repo.userByID(userID) == nil {
+		return errNoUser
+	}
+	for _, s := range repo.sessions {
+		if s.TokenID == tokenID {
+			return errTokenExist
+		}
+	}
+
+	repo.seq.session++
+	repo.sessions = append(repo.sessions, session{
+		ID:      app.SessionID(repo.seq.session),
+		UserID:  userID,
+		TokenID: tokenID,
+		Origin: app.Origin{
+			IP:        append(net.IP(nil), origin.IP...),
+			UserAgent: origin.UserAgent,
+		},
+		CreatedAt: time.Now(),
+	})
+
+	return nil
+}
+
+// AuthUserByTokenID need for implements app.SessionRepo.
+func (repo *Repo) AuthUserByTokenID(_ context.Context, tokenID app.TokenID) (*app.AuthUser, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	s := repo.activeSession(tokenID)
+	if s == nil {
+		return nil, app.ErrNotFound
+	}
+
+	user := repo.userByID(s.UserID)
+	if user == nil {
+		return nil, app.ErrNotFound
+	}
+
+	return &app.AuthUser{User: *copyUser(*user), Session: *s.toAppFormat()}, nil
+}
+
+// DeleteSession need for implements app.SessionRepo.
+func (repo *Repo) DeleteSession(_ context.Context, tokenID app.TokenID) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	s := repo.activeSession(tokenID)
+	if s == nil {
+		return nil
+	}
+	user := repo.userByID(s.UserID)
+	if user == nil {
+		return nil
+	}
+	s.IsLogout = true
+	s.LogoutAt = time.Now()
+
+	return repo.createEvent(app.Event{
+		Type:   app.EventSessionRevoked,
+		UserID: user.ID,
+		Email:  user.Email,
+	})
+}
+
+func (repo *Repo) activeSession(tokenID app.TokenID) *session {
+	for i := range repo.sessions {
+		if repo.sessions[i].TokenID == tokenID && !repo.sessions[i].IsLogout {
+			return &repo.sessions[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateTenant need for implements app.TenantRepo.
+func (repo *Repo) CreateTenant(_ context.Context, tenant app.Tenant) (app.TenantID, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, t := range repo.tenants {
+		if t.Name == tenant.Name || (tenant.Host != "" && t.Host == tenant.Host) {
+			return 0, app.ErrTenantExist
+		}
+	}
+
+	repo.seq.tenant++
+	tenant.ID = app.TenantID(repo.seq.tenant)
+	tenant.CreatedAt = time.Now()
+	repo.tenants = append(repo.tenants, tenant)
+
+	return tenant.ID, nil
+}
+
+// TenantByID need for implements app.TenantRepo.
+func (repo *Repo) TenantByID(_ context.Context, id app.TenantID) (*app.Tenant, error) {
+	return repo.tenant(func(t *app.Tenant) bool { return t.ID == id })
+}
+
+// TenantByName need for implements app.TenantRepo.
+func (repo *Repo) TenantByName(_ context.Context, name string) (*app.Tenant, error) {
+	return repo.tenant(func(t *app.Tenant) bool { return t.Name == name })
+}
+
+// TenantByHost need for implements app.TenantRepo.
+func (repo *Repo) TenantByHost(_ context.Context, host string) (*app.Tenant, error) {
+	return repo.tenant(func(t *app.Tenant) bool { return t.Host != "" && t.Host == host })
+}
+
+func (repo *Repo) tenant(match func(*app.Tenant) bool) (*app.Tenant, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for i := range repo.tenants {
+		if match(&repo.tenants[i]) {
+			tenant := repo.tenants[i]
+			return &tenant, nil
+		}
+	}
+
+	return nil, app.ErrNotFound
+}
+
+// Tenants need for implements app.TenantRepo.
+func (repo *Repo) Tenants(_ context.Context) ([]app.Tenant, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	return append([]app.Tenant(nil), repo.tenants...), nil
+}
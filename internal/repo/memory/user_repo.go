@@ -0,0 +1,319 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateUser need for implements app.UserRepo.
+func (repo *Repo) CreateUser(_ context.Context, newUser app.User, t app.TaskNotification) (userID app.UserID, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	err = repo.checkUnique(0, newUser.TenantID, newUser.Email, newUser.Name, "")
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	repo.seq.user++
+	userID = app.UserID(repo.seq.user)
+	repo.users = append(repo.users, app.User{
+		ID:        userID,
+		PublicID:  newUser.PublicID,
+		TenantID:  newUser.TenantID,
+		Email:     newUser.Email,
+		Name:      newUser.Name,
+		PassHash:  append([]byte(nil), newUser.PassHash...),
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	_, err = repo.createTaskNotification(t)
+	if err != nil {
+		return 0, err
+	}
+
+	err = repo.createEvent(app.Event{
+		Type:   app.EventUserCreated,
+		UserID: userID,
+		Email:  newUser.Email,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// checkUnique returns the error of the unique constraint violated by other users of the tenant.
+func (repo *Repo) checkUnique(userID app.UserID, tenantID app.TenantID, email, username, phone string) error {
+	for _, u := range repo.users {
+		switch {
+		case u.ID == userID, u.TenantID != tenantID:
+		case email != "" && u.Email == email:
+			return app.ErrEmailExist
+		case username != "" && u.Name == username:
+			return app.ErrUsernameExist
+		case phone != "" && u.Phone == phone:
+			return app.ErrPhoneExist
+		}
+	}
+
+	return nil
+}
+
+// DeleteUser need for implements app.UserRepo.
+// Sessions, codes, notification tasks, inbox and settings of the user are deleted with the user.
+func (repo *Repo) DeleteUser(_ context.Context, userID app.UserID) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	i := repo.userIndex(func(u *app.User) bool { return u.ID == userID })
+	if i < 0 {
+		return nil
+	}
+	tenantID, email := repo.users[i].TenantID, repo.users[i].Email
+
+	// The event is created before the user is removed, so it gets the public id of the user.
+	err := repo.createEvent(app.Event{
+		Type:   app.EventUserDeleted,
+		UserID: userID,
+		Email:  email,
+	})
+	if err != nil {
+		return err
+	}
+	repo.users = append(repo.users[:i], repo.users[i+1:]...)
+
+	sessions := repo.sessions[:0]
+	for _, s := range repo.sessions {
+		if s.UserID != userID {
+			sessions = append(sessions, s)
+		}
+	}
+	repo.sessions = sessions
+
+	repo.deleteCodes(func(c *code) bool { return c.TenantID == tenantID && c.Email == email })
+
+	tasks := repo.tasks[:0]
+	for _, t := range repo.tasks {
+		if t.TenantID != tenantID || t.Email != email {
+			tasks = append(tasks, t)
+		}
+	}
+	repo.tasks = tasks
+
+	notifications := repo.userNotifications[:0]
+	for _, n := range repo.userNotifications {
+		if n.UserID != userID {
+			notifications = append(notifications, n)
+		}
+	}
+	repo.userNotifications = notifications
+
+	settings := repo.settings[:0]
+	for _, s := range repo.settings {
+		if s.UserID != userID {
+			settings = append(settings, s)
+		}
+	}
+	repo.settings = settings
+
+	repo.deleteUserOrgs(userID)
+
+	return nil
+}
+
+// UpdateUsername need for implements app.UserRepo.
+func (repo *Repo) UpdateUsername(_ context.Context, userID app.UserID, username string, version int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	user := repo.userByID(userID)
+	if user == nil {
+		return versionMismatch(version)
+	}
+	if version != 0 && user.Version != version {
+		return app.ErrVersionMismatch
+	}
+
+	err := repo.checkUnique(userID, user.TenantID, "", username, "")
+	if err != nil {
+		return err
+	}
+
+	user.Name = username
+	user.Version++
+	user.UpdatedAt = time.Now()
+
+	return repo.createEvent(app.Event{
+		Type:   app.EventUserUsernameChanged,
+		UserID: userID,
+		Email:  user.Email,
+	})
+}
+
+// UpdateEmail need for implements app.UserRepo.
+// Notification tasks follow the new email like in the database repositories.
+func (repo *Repo) UpdateEmail(
+	_ context.Context, userID app.UserID, email string, t app.TaskNotification, version int,
+) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	user := repo.userByID(userID)
+	if version != 0 && (user == nil || user.Version != version) {
+		return app.ErrVersionMismatch
+	}
+	if user != nil {
+		err := repo.checkUnique(userID, user.TenantID, email, "", "")
+		if err != nil {
+			return err
+		}
+
+		for i := range repo.tasks {
+			if repo.tasks[i].TenantID == user.TenantID && repo.tasks[i].Email == user.Email {
+				repo.tasks[i].Email = email
+			}
+		}
+		user.Email = email
+		user.Version++
+		user.UpdatedAt = time.Now()
+	}
+
+	_, err := repo.createTaskNotification(t)
+	if err != nil {
+		return err
+	}
+
+	return repo.createEvent(app.Event{
+		Type:   app.EventUserEmailChanged,
+		UserID: userID,
+		Email:  email,
+	})
+}
+
+// UpdatePassword need for implements app.UserRepo.
+func (repo *Repo) UpdatePassword(_ context.Context, userID app.UserID, passHash []byte, version int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	user := repo.userByID(userID)
+	if version != 0 && (user == nil || user.Version != version) {
+		return app.ErrVersionMismatch
+	}
+	if user == nil {
+		return app.ErrNotFound
+	}
+
+	user.PassHash = append([]byte(nil), passHash...)
+	user.Version++
+	user.UpdatedAt = time.Now()
+	repo.cleanRecoveryCodes(user.TenantID, user.Email)
+
+	return repo.createEvent(app.Event{
+		Type:   app.EventUserPasswordChanged,
+		UserID: userID,
+		Email:  user.Email,
+	})
+}
+
+// UpdatePhone need for implements app.UserRepo.
+func (repo *Repo) UpdatePhone(_ context.Context, userID app.UserID, phone string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	user := repo.userByID(userID)
+	if user == nil {
+		return nil
+	}
+
+	err := repo.checkUnique(userID, user.TenantID, "", "", phone)
+	if err != nil {
+		return err
+	}
+
+	user.Phone = phone
+	user.Version++
+	user.UpdatedAt = time.Now()
+	repo.cleanPhoneCodes(user.TenantID, user.Email)
+
+	return repo.createEvent(app.Event{
+		Type:   app.EventUserPhoneChanged,
+		UserID: userID,
+		Email:  user.Email,
+	})
+}
+
+// versionMismatch returns the error of the update which hasn't found the user.
+func versionMismatch(version int) error {
+	if version == 0 {
+		return nil
+	}
+
+	return app.ErrVersionMismatch
+}
+
+// UserByID need for implements app.UserRepo.
+func (repo *Repo) UserByID(_ context.Context, userID app.UserID) (*app.User, error) {
+	return repo.user(func(u *app.User) bool { return u.ID == userID })
+}
+
+// UserByPublicID need for implements app.UserRepo.
+func (repo *Repo) UserByPublicID(_ context.Context, publicID app.PublicID) (*app.User, error) {
+	return repo.user(func(u *app.User) bool { return u.PublicID == publicID })
+}
+
+// UserByEmail need for implements app.UserRepo.
+func (repo *Repo) UserByEmail(_ context.Context, tenantID app.TenantID, email string) (*app.User, error) {
+	return repo.user(func(u *app.User) bool { return u.TenantID == tenantID && u.Email == email })
+}
+
+// UserByUsername need for implements app.UserRepo.
+func (repo *Repo) UserByUsername(_ context.Context, tenantID app.TenantID, username string) (*app.User, error) {
+	return repo.user(func(u *app.User) bool { return u.TenantID == tenantID && u.Name == username })
+}
+
+func (repo *Repo) user(match func(*app.User) bool) (*app.User, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	i := repo.userIndex(match)
+	if i < 0 {
+		return nil, app.ErrNotFound
+	}
+
+	return copyUser(repo.users[i]), nil
+}
+
+// ListUserByUsername need for implements app.UserRepo.
+func (repo *Repo) ListUserByUsername(
+	_ context.Context, tenantID app.TenantID, username string, p app.Page,
+) ([]app.User, int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	users := make([]app.User, 0)
+	for _, u := range repo.users {
+		if u.TenantID == tenantID && strings.Contains(u.Name, username) {
+			users = append(users, *copyUser(u))
+		}
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		if users[i].CreatedAt.Equal(users[j].CreatedAt) {
+			return users[i].ID > users[j].ID
+		}
+
+		return users[i].CreatedAt.After(users[j].CreatedAt)
+	})
+
+	from, to := page(len(users), p)
+
+	return users[from:to], len(users), nil
+}
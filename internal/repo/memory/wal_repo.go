@@ -0,0 +1,207 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// DeleteTaskNotification need for implements app.WAL.
+func (repo *Repo) DeleteTaskNotification(_ context.Context, id int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	t := repo.taskByID(id)
+	if t != nil {
+		now := time.Now()
+		t.IsDone = true
+		t.ExecTime = &now
+	}
+
+	return nil
+}
+
+// SaveTaskNotificationError need for implements app.WAL.
+func (repo *Repo) SaveTaskNotificationError(_ context.Context, id int, taskErr error) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	t := repo.taskByID(id)
+	if t != nil {
+		t.Error = taskErr.Error()
+	}
+
+	return nil
+}
+
+// CreateTaskNotification need for implements app.WAL.
+func (repo *Repo) CreateTaskNotification(_ context.Context, t app.TaskNotification) (id int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	return repo.createTaskNotification(t)
+}
+
+// CancelTaskNotification need for implements app.WAL.
+func (repo *Repo) CancelTaskNotification(_ context.Context, id int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	t := repo.taskByID(id)
+	if t == nil || t.IsDone {
+		return app.ErrNotFound
+	}
+	t.cancel("")
+
+	return nil
+}
+
+// cancel marks the task cancelled, the error isn't changed if the reason is empty.
+func (val *task) cancel(reason string) {
+	now := time.Now()
+	val.IsDone = true
+	val.CancelledAt = &now
+	if reason != "" {
+		val.Error = reason
+	}
+}
+
+// CancelTaskNotifications need for implements app.WAL.
+func (repo *Repo) CancelTaskNotifications(
+	_ context.Context, tenantID app.TenantID, email string, kind app.MessageKind,
+) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for i := range repo.tasks {
+		t := &repo.tasks[i]
+		if t.TenantID == tenantID && t.Email == email && t.Kind == kind && !t.IsDone {
+			t.cancel("")
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// CollapseTaskNotifications need for implements app.WAL.
+func (repo *Repo) CollapseTaskNotifications(_ context.Context, task app.TaskNotification) (ids []int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	now := time.Now()
+	latest := -1
+	for i := range repo.tasks {
+		t := &repo.tasks[i]
+		if t.TenantID == task.TenantID && t.Email == task.Email && t.Kind == task.Kind && !t.IsDone && !t.RunAt.After(now) {
+			if latest >= 0 {
+				repo.tasks[latest].cancel(app.ErrNotificationDuplicate.Error())
+				ids = append(ids, repo.tasks[latest].ID)
+			}
+			latest = i
+		}
+	}
+
+	return ids, nil
+}
+
+// SuppressTaskNotification need for implements app.WAL.
+func (repo *Repo) SuppressTaskNotification(_ context.Context, id int, reason error) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	t := repo.taskByID(id)
+	if t != nil && !t.IsDone {
+		t.cancel(reason.Error())
+	}
+
+	return nil
+}
+
+// ExecutedTaskNotificationCount need for implements app.WAL.
+func (repo *Repo) ExecutedTaskNotificationCount(
+	_ context.Context, tenantID app.TenantID, email string, kind app.MessageKind, since time.Time,
+) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, t := range repo.tasks {
+		if t.TenantID == tenantID && t.Email == email && t.Kind == kind && t.status() == app.TaskDone && !t.ExecTime.Before(since) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// SaveTaskNotificationProvider need for implements app.ProviderRepo.
+func (repo *Repo) SaveTaskNotificationProvider(_ context.Context, taskID int, provider string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	t := repo.taskByID(taskID)
+	if t != nil {
+		t.Provider = provider
+	}
+
+	return nil
+}
+
+// TaskNotificationByID need for implements app.WAL.
+func (repo *Repo) TaskNotificationByID(_ context.Context, id int) (*app.TaskNotificationInfo, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	t := repo.taskByID(id)
+	if t == nil {
+		return nil, app.ErrNotFound
+	}
+
+	return t.toAppInfoFormat()
+}
+
+// ListTaskNotification need for implements app.WAL.
+func (repo *Repo) ListTaskNotification(_ context.Context, filter app.TaskFilter, p app.Page) ([]app.TaskNotificationInfo, int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	var matched []*task
+	for i := range repo.tasks {
+		t := &repo.tasks[i]
+		if (filter.Email == "" || t.Email == filter.Email) &&
+			(filter.Kind == 0 || t.Kind == filter.Kind) &&
+			(filter.Status == "" || t.status() == filter.Status) {
+			matched = append(matched, t)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID > matched[j].ID })
+
+	from, to := page(len(matched), p)
+	tasks := make([]app.TaskNotificationInfo, 0, to-from)
+	for _, t := range matched[from:to] {
+		info, err := t.toAppInfoFormat()
+		if err != nil {
+			return nil, 0, err
+		}
+		tasks = append(tasks, *info)
+	}
+
+	return tasks, len(matched), nil
+}
+
+// NotificationBacklog returns the number of pending tasks which run time has come.
+func (repo *Repo) NotificationBacklog(_ context.Context) (count int, err error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	now := time.Now()
+	for _, t := range repo.tasks {
+		if t.status() == app.TaskPending && !t.RunAt.After(now) {
+			count++
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateWebhook need for implements app.WebhookRepo.
+func (repo *Repo) CreateWebhook(_ context.Context, webhook app.Webhook) (app.WebhookID, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	repo.seq.webhook++
+	webhook = copyWebhook(webhook)
+	webhook.ID = app.WebhookID(repo.seq.webhook)
+	webhook.CreatedAt = time.Now()
+	repo.webhooks = append(repo.webhooks, webhook)
+
+	return webhook.ID, nil
+}
+
+// Webhooks need for implements app.WebhookRepo.
+func (repo *Repo) Webhooks(_ context.Context) ([]app.Webhook, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	webhooks := make([]app.Webhook, len(repo.webhooks))
+	for i := range repo.webhooks {
+		webhooks[i] = copyWebhook(repo.webhooks[i])
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook need for implements app.WebhookRepo.
+// Deliveries of the webhook are deleted by cascade.
+func (repo *Repo) DeleteWebhook(_ context.Context, id app.WebhookID) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	i := repo.webhookIndex(id)
+	if i < 0 {
+		return app.ErrNotFound
+	}
+	repo.webhooks = append(repo.webhooks[:i], repo.webhooks[i+1:]...)
+
+	deliveries := repo.deliveries[:0]
+	for _, d := range repo.deliveries {
+		if d.WebhookID != id {
+			deliveries = append(deliveries, d)
+		}
+	}
+	repo.deliveries = deliveries
+
+	return nil
+}
+
+// WebhookDeliveryByID need for implements app.WebhookRepo.
+func (repo *Repo) WebhookDeliveryByID(_ context.Context, id int) (*app.WebhookDelivery, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for i := range repo.deliveries {
+		if repo.deliveries[i].ID == id {
+			return repo.deliveryToAppFormat(&repo.deliveries[i]), nil
+		}
+	}
+
+	return nil, app.ErrNotFound
+}
+
+// WebhookDeliveries need for implements app.WebhookRepo.
+func (repo *Repo) WebhookDeliveries(_ context.Context, id app.WebhookID, p app.Page) ([]app.WebhookDelivery, int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	deliveries := make([]app.WebhookDelivery, 0)
+	for i := range repo.deliveries {
+		if repo.deliveries[i].WebhookID == id {
+			deliveries = append(deliveries, *repo.deliveryToAppFormat(&repo.deliveries[i]))
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID > deliveries[j].ID })
+
+	from, to := page(len(deliveries), p)
+
+	return deliveries[from:to], len(deliveries), nil
+}
+
+// UpdateWebhookDelivery need for implements app.WebhookRepo.
+func (repo *Repo) UpdateWebhookDelivery(_ context.Context, d app.WebhookDelivery) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for i := range repo.deliveries {
+		val := &repo.deliveries[i]
+		if val.ID != d.ID {
+			continue
+		}
+
+		val.Status = d.Status
+		val.Attempts = d.Attempts
+		val.StatusCode = d.StatusCode
+		val.Error = d.Error
+		val.NextAttemptAt = d.NextAttemptAt
+		val.DeliveredAt = nil
+		if !d.DeliveredAt.IsZero() {
+			deliveredAt := d.DeliveredAt
+			val.DeliveredAt = &deliveredAt
+		}
+	}
+
+	return nil
+}
+
+func (repo *Repo) webhookIndex(id app.WebhookID) int {
+	for i := range repo.webhooks {
+		if repo.webhooks[i].ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (repo *Repo) deliveryToAppFormat(val *delivery) *app.WebhookDelivery {
+	d := &app.WebhookDelivery{
+		ID:            val.ID,
+		Status:        val.Status,
+		Attempts:      val.Attempts,
+		StatusCode:    val.StatusCode,
+		Error:         val.Error,
+		NextAttemptAt: val.NextAttemptAt,
+	}
+	if val.DeliveredAt != nil {
+		d.DeliveredAt = *val.DeliveredAt
+	}
+	if i := repo.webhookIndex(val.WebhookID); i >= 0 {
+		d.Webhook = copyWebhook(repo.webhooks[i])
+	}
+	if e := repo.eventByID(val.EventID); e != nil {
+		d.Event = *e
+	}
+
+	return d
+}
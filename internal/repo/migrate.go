@@ -0,0 +1,434 @@
+package repo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	// Migration is the version of the database schema loaded from the file
+	// named like 1_name.sql with --up and --down sections.
+	Migration struct {
+		Version uint
+		Name    string
+		Up      string
+		Down    string
+		// Checksum is the hex encoded SHA-256 of the file, it detects files
+		// changed after the migration was applied.
+		Checksum string
+	}
+
+	// MigrateOperation selects migrations to run, operations are named like
+	// ones of the former zergrepo migrate command.
+	MigrateOperation string
+
+	// MigrateConfig is the migrate operation.
+	MigrateConfig struct {
+		Operation MigrateOperation
+		// To is the version of up-to and down-to operations.
+		To uint
+		// DryRun selects migrations without running them.
+		DryRun bool
+	}
+
+	// MigrationStatus is the state of the migration in the database.
+	MigrationStatus string
+
+	// MigrationState is the migration with its state in the database.
+	MigrationState struct {
+		Migration
+		Status MigrationStatus
+		// AppliedAt is zero for pending migrations.
+		AppliedAt time.Time
+	}
+
+	appliedMigration struct {
+		Version  uint           `db:"version"`
+		Checksum sql.NullString `db:"checksum"`
+		Time     time.Time      `db:"time"`
+	}
+)
+
+// Migrate operations.
+const (
+	MigrateUp     MigrateOperation = "up"      // Applies all pending migrations.
+	MigrateUpTo   MigrateOperation = "up-to"   // Applies pending migrations up to the version inclusive.
+	MigrateUpOne  MigrateOperation = "up-one"  // Applies the next pending migration.
+	MigrateDown   MigrateOperation = "down"    // Rolls back the last applied migration.
+	MigrateDownTo MigrateOperation = "down-to" // Rolls back applied migrations down to the version inclusive.
+	MigrateReset  MigrateOperation = "reset"   // Rolls back all applied migrations.
+)
+
+// Migration statuses.
+const (
+	MigrationPending MigrationStatus = "pending"
+	MigrationApplied MigrationStatus = "applied"
+	// MigrationDrift is applied, but the file was changed after that.
+	MigrationDrift MigrationStatus = "drift"
+	// MigrationMissing is applied, but the binary has no file of it.
+	MigrationMissing MigrationStatus = "missing"
+)
+
+// Up reports whether the operation applies migrations rather than rolls them back.
+func (op MigrateOperation) Up() bool {
+	return op == MigrateUp || op == MigrateUpTo || op == MigrateUpOne
+}
+
+var (
+	errMigrationNotFound = errors.New("migration not found")
+	errMigrationFormat   = errors.New("invalid migration")
+)
+
+// LoadMigrations reads *.sql migrations from the root of fsys ordered by version.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("glob: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(names))
+	versions := make(map[uint]string, len(names))
+	for _, name := range names {
+		buf, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+
+		m, err := parseMigration(name, buf)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", name, err)
+		}
+		if prev, ok := versions[m.Version]; ok {
+			return nil, fmt.Errorf("%s: %w: version %d is used by %s", name, errMigrationFormat, m.Version, prev)
+		}
+		versions[m.Version] = name
+
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+func parseMigration(name string, buf []byte) (*Migration, error) {
+	name = strings.TrimSuffix(path.Base(name), ".sql")
+	i := strings.Index(name, "_")
+	if i < 0 {
+		return nil, fmt.Errorf("%w: name must be like 1_name.sql", errMigrationFormat)
+	}
+	version, err := strconv.ParseUint(name[:i], 10, 32)
+	if err != nil || version == 0 {
+		return nil, fmt.Errorf("%w: version must be a positive number", errMigrationFormat)
+	}
+
+	var up, down []string
+	var section *[]string
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		switch line := scanner.Text(); strings.TrimSpace(line) {
+		case "--up":
+			section = &up
+		case "--down":
+			section = &down
+		default:
+			if section != nil {
+				*section = append(*section, line)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(up) == 0 {
+		return nil, fmt.Errorf("%w: no --up section", errMigrationFormat)
+	}
+
+	sum := sha256.Sum256(buf)
+
+	return &Migration{
+		Version:  uint(version),
+		Name:     name[i+1:],
+		Up:       strings.TrimSpace(strings.Join(up, "\n")),
+		Down:     strings.TrimSpace(strings.Join(down, "\n")),
+		Checksum: hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// Migrate runs migrations selected by the operation and returns them in order of running.
+// Migrations run in one transaction which holds the advisory lock, so concurrently
+// started instances migrate one by one and the later ones find migrations applied.
+// Checksums of migrations applied by the former zergrepo migrate command are recorded.
+// Nothing is changed in the dry run.
+func Migrate(ctx context.Context, db *sqlx.DB, migrations []Migration, cfg MigrateConfig) (selected []Migration, err error) {
+	err = migrationTx(ctx, db, !cfg.DryRun, func(tx *sqlx.Tx) error {
+		applied, err := appliedMigrations(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		err = recordChecksums(ctx, tx, migrations, applied)
+		if err != nil {
+			return err
+		}
+
+		selected, err = selectMigrations(migrations, applied, cfg)
+		if err != nil || cfg.DryRun {
+			return err
+		}
+
+		for _, m := range selected {
+			err = runMigration(ctx, tx, m, cfg.Operation.Up())
+			if err != nil {
+				return fmt.Errorf("migration %d %s: %w", m.Version, m.Name, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return selected, nil
+}
+
+// MigrationStates returns states of migrations and of applied versions
+// which have no migration, ordered by version.
+// It only reads the database, so it doesn't wait for running migrations,
+// all migrations are pending while the migration table doesn't exist.
+func MigrationStates(ctx context.Context, db *sqlx.DB, migrations []Migration) ([]MigrationState, error) {
+	applied, err := readAppliedMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var states []MigrationState
+	known := make(map[uint]bool, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = true
+		state := MigrationState{Migration: m, Status: MigrationPending}
+		if a, ok := applied[m.Version]; ok {
+			state.Status = MigrationApplied
+			state.AppliedAt = a.Time
+			// Migrations applied by the former zergrepo migrate command have no checksum yet.
+			if a.Checksum.Valid && a.Checksum.String != m.Checksum {
+				state.Status = MigrationDrift
+			}
+		}
+		states = append(states, state)
+	}
+
+	for _, a := range applied {
+		if !known[a.Version] {
+			states = append(states, MigrationState{
+				Migration: Migration{Version: a.Version, Checksum: a.Checksum.String},
+				Status:    MigrationMissing,
+				AppliedAt: a.Time,
+			})
+		}
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].Version < states[j].Version })
+
+	return states, nil
+}
+
+// MigrationStates returns states of migrations of the primary database.
+func (repo *Repo) MigrationStates(ctx context.Context, migrations []Migration) (states []MigrationState, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		states, err = MigrationStates(ctx, db, migrations)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+// migrationTx runs f in the transaction which is committed only if commit is set.
+// The transaction holds the advisory lock of migrations and creates the migration table if it doesn't exist.
+func migrationTx(ctx context.Context, db *sqlx.DB, commit bool, f func(*sqlx.Tx) error) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback() // nolint:errcheck
+
+	const queryLock = `SELECT pg_advisory_xact_lock(hashtext('migration'))`
+
+	_, err = tx.ExecContext(ctx, queryLock)
+	if err != nil {
+		return fmt.Errorf("lock: %w", err)
+	}
+
+	// The table is compatible with the former zergrepo migrate command.
+	const queryTable = `CREATE TABLE IF NOT EXISTS migration
+	(
+		id      serial,
+		version integer                 not null,
+		time    timestamp default now() not null,
+
+		unique (version),
+		primary key (id)
+	);
+	ALTER TABLE migration ADD COLUMN IF NOT EXISTS checksum text`
+
+	_, err = tx.ExecContext(ctx, queryTable)
+	if err != nil {
+		return fmt.Errorf("create migration table: %w", err)
+	}
+
+	err = f(tx)
+	if err != nil || !commit {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func appliedMigrations(ctx context.Context, tx *sqlx.Tx) (map[uint]appliedMigration, error) {
+	const query = `SELECT version, checksum, time FROM migration`
+
+	return selectAppliedMigrations(ctx, tx, query)
+}
+
+// readAppliedMigrations returns applied migrations without creating or changing the migration table.
+// The table may not exist yet or may have no checksum column of the former zergrepo migrate command.
+func readAppliedMigrations(ctx context.Context, db *sqlx.DB) (map[uint]appliedMigration, error) {
+	const queryColumns = `SELECT column_name FROM information_schema.columns
+	WHERE table_schema = current_schema() AND table_name = 'migration'`
+
+	var columns []string
+	err := db.SelectContext(ctx, &columns, queryColumns)
+	if err != nil {
+		return nil, fmt.Errorf("select migration columns: %w", err)
+	}
+
+	if len(columns) == 0 {
+		return map[uint]appliedMigration{}, nil
+	}
+
+	query := `SELECT version, NULL AS checksum, time FROM migration`
+	for _, column := range columns {
+		if column == "checksum" {
+			query = `SELECT version, checksum, time FROM migration`
+		}
+	}
+
+	return selectAppliedMigrations(ctx, db, query)
+}
+
+func selectAppliedMigrations(ctx context.Context, db sqlx.QueryerContext, query string) (map[uint]appliedMigration, error) {
+	var res []appliedMigration
+	err := sqlx.SelectContext(ctx, db, &res, query)
+	if err != nil {
+		return nil, fmt.Errorf("select migrations: %w", err)
+	}
+
+	applied := make(map[uint]appliedMigration, len(res))
+	for _, a := range res {
+		applied[a.Version] = a
+	}
+
+	return applied, nil
+}
+
+func recordChecksums(ctx context.Context, tx *sqlx.Tx, migrations []Migration, applied map[uint]appliedMigration) error {
+	const query = `UPDATE migration SET checksum = $1 WHERE version = $2`
+
+	for _, m := range migrations {
+		if a, ok := applied[m.Version]; ok && !a.Checksum.Valid {
+			_, err := tx.ExecContext(ctx, query, m.Checksum, m.Version)
+			if err != nil {
+				return fmt.Errorf("record checksum of migration %d: %w", m.Version, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// selectMigrations returns migrations to run by the operation in order of running.
+func selectMigrations(migrations []Migration, applied map[uint]appliedMigration, cfg MigrateConfig) (selected []Migration, err error) {
+	switch cfg.Operation {
+	case MigrateUp, MigrateUpTo, MigrateUpOne:
+		for _, m := range migrations {
+			_, ok := applied[m.Version]
+			switch {
+			case ok:
+			case cfg.Operation == MigrateUpTo && m.Version > cfg.To:
+			case cfg.Operation == MigrateUpOne && len(selected) == 1:
+			default:
+				selected = append(selected, m)
+			}
+		}
+
+		return selected, nil
+	case MigrateDown, MigrateDownTo, MigrateReset:
+		versions := make([]uint, 0, len(applied))
+		for v := range applied {
+			if cfg.Operation != MigrateDownTo || v >= cfg.To {
+				versions = append(versions, v)
+			}
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+		if cfg.Operation == MigrateDown && len(versions) > 1 {
+			versions = versions[:1]
+		}
+
+		byVersion := make(map[uint]Migration, len(migrations))
+		for _, m := range migrations {
+			byVersion[m.Version] = m
+		}
+		for _, v := range versions {
+			m, ok := byVersion[v]
+			if !ok {
+				return nil, fmt.Errorf("roll back version %d: %w", v, errMigrationNotFound)
+			}
+			selected = append(selected, m)
+		}
+
+		return selected, nil
+	default:
+		return nil, fmt.Errorf("unknown operation %q", cfg.Operation)
+	}
+}
+
+func runMigration(ctx context.Context, tx *sqlx.Tx, m Migration, up bool) error {
+	query, queryVersion := m.Up, `INSERT INTO migration (version, checksum) VALUES ($1, $2)`
+	args := []interface{}{m.Version, m.Checksum}
+	if !up {
+		query, queryVersion = m.Down, `DELETE FROM migration WHERE version = $1`
+		args = args[:1]
+	}
+
+	if query != "" {
+		_, err := tx.ExecContext(ctx, query)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, queryVersion, args...)
+	if err != nil {
+		return fmt.Errorf("record version: %w", err)
+	}
+
+	return nil
+}
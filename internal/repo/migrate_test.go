@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/migrate"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"10_second.sql": {Data: []byte("--up\ncreate table b (id int);\n--down\ndrop table b;\n")},
+		"2_first.sql":   {Data: []byte("--up\ncreate table a\n(\n    id int\n);\n\n--down\ndrop table a;\n")},
+		"README.md":     {Data: []byte("not a migration")},
+	}
+
+	migrations, err := LoadMigrations(fsys)
+	require.Nil(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, uint(2), migrations[0].Version)
+	assert.Equal(t, "first", migrations[0].Name)
+	assert.Equal(t, "create table a\n(\n    id int\n);", migrations[0].Up, "lines are kept")
+	assert.Equal(t, "drop table a;", migrations[0].Down)
+	assert.Equal(t, uint(10), migrations[1].Version)
+	assert.Len(t, migrations[0].Checksum, 64)
+	assert.NotEqual(t, migrations[0].Checksum, migrations[1].Checksum)
+
+	testCases := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{"no version", fstest.MapFS{"first.sql": {Data: []byte("--up\nselect 1;")}}},
+		{"zero version", fstest.MapFS{"0_first.sql": {Data: []byte("--up\nselect 1;")}}},
+		{"no up", fstest.MapFS{"1_first.sql": {Data: []byte("--down\nselect 1;")}}},
+		{"same version", fstest.MapFS{
+			"1_first.sql":  {Data: []byte("--up\nselect 1;")},
+			"01_other.sql": {Data: []byte("--up\nselect 1;")},
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := LoadMigrations(tc.fsys)
+			assert.True(t, errors.Is(err, errMigrationFormat))
+		})
+	}
+}
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	t.Parallel()
+
+	migrations, err := LoadMigrations(migrate.FS)
+	require.Nil(t, err)
+	require.NotEmpty(t, migrations)
+	for i, m := range migrations {
+		assert.Equal(t, uint(i+1), m.Version, "versions are consecutive")
+		assert.NotEmpty(t, m.Up)
+		assert.NotEmpty(t, m.Down)
+	}
+}
+
+func TestSelectMigrations(t *testing.T) {
+	t.Parallel()
+
+	migrations := []Migration{{Version: 1}, {Version: 2}, {Version: 3}, {Version: 4}}
+	applied := map[uint]appliedMigration{
+		1: {Version: 1, Checksum: sql.NullString{String: "sum", Valid: true}},
+		2: {Version: 2},
+	}
+
+	testCases := []struct {
+		name    string
+		cfg     MigrateConfig
+		applied map[uint]appliedMigration
+		want    []uint
+		wantErr error
+	}{
+		{"up", MigrateConfig{Operation: MigrateUp}, applied, []uint{3, 4}, nil},
+		{"up-to", MigrateConfig{Operation: MigrateUpTo, To: 3}, applied, []uint{3}, nil},
+		{"up-one", MigrateConfig{Operation: MigrateUpOne}, applied, []uint{3}, nil},
+		{"down", MigrateConfig{Operation: MigrateDown}, applied, []uint{2}, nil},
+		{"down-to", MigrateConfig{Operation: MigrateDownTo, To: 1}, applied, []uint{2, 1}, nil},
+		{"reset", MigrateConfig{Operation: MigrateReset}, applied, []uint{2, 1}, nil},
+		{"nothing to roll back", MigrateConfig{Operation: MigrateDown}, nil, nil, nil},
+		{"missing", MigrateConfig{Operation: MigrateReset}, map[uint]appliedMigration{5: {Version: 5}}, nil, errMigrationNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := selectMigrations(migrations, tc.applied, tc.cfg)
+			assert.True(t, errors.Is(err, tc.wantErr))
+
+			var versions []uint
+			for _, m := range res {
+				versions = append(versions, m.Version)
+			}
+			assert.Equal(t, tc.want, versions)
+		})
+	}
+
+	_, err := selectMigrations(migrations, applied, MigrateConfig{Operation: "sideways"})
+	assert.NotNil(t, err)
+}
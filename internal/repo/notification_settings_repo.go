@@ -0,0 +1,62 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// NotificationSettings need for implements app.NotificationSettingsRepo.
+func (repo *Repo) NotificationSettings(ctx context.Context, userID app.UserID) (settings []app.NotificationSetting, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT kind, enabled FROM notification_settings WHERE user_id = $1`
+
+		var res []notificationSettingDBFormat
+		err = db.SelectContext(ctx, &res, query, userID)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		settings = make([]app.NotificationSetting, 0, len(res))
+		for i := range res {
+			setting, err := res[i].toAppFormat()
+			if err != nil {
+				return err
+			}
+			settings = append(settings, *setting)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// SaveNotificationSetting need for implements app.NotificationSettingsRepo.
+func (repo *Repo) SaveNotificationSetting(ctx context.Context, userID app.UserID, setting app.NotificationSetting) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO notification_settings (user_id, kind, enabled) VALUES (:user_id, :kind, :enabled)
+		ON CONFLICT (user_id, kind) DO UPDATE SET enabled = excluded.enabled, updated_at = now()`
+		type args struct {
+			UserID  app.UserID `db:"user_id"`
+			Kind    string     `db:"kind"`
+			Enabled bool       `db:"enabled"`
+		}
+
+		_, err := db.NamedExecContext(ctx, query, args{
+			UserID:  userID,
+			Kind:    setting.Kind.String(),
+			Enabled: setting.Enabled,
+		})
+		if err != nil {
+			return fmt.Errorf("save notification setting: %w", err)
+		}
+
+		return nil
+	})
+}
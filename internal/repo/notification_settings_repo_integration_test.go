@@ -0,0 +1,46 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestNotificationSettingsRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	user := userGenerator()
+	user.ID, err = Repo.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.NotZero(t, user.ID)
+
+	settings, err := Repo.NotificationSettings(ctx, user.ID)
+	require.Nil(t, err)
+	require.Empty(t, settings)
+
+	optOut := app.NotificationSetting{Kind: app.Welcome, Enabled: false}
+	err = Repo.SaveNotificationSetting(ctx, user.ID, optOut)
+	require.Nil(t, err)
+
+	settings, err = Repo.NotificationSettings(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, []app.NotificationSetting{optOut}, settings)
+
+	optIn := app.NotificationSetting{Kind: app.Welcome, Enabled: true}
+	err = Repo.SaveNotificationSetting(ctx, user.ID, optIn)
+	require.Nil(t, err)
+
+	settings, err = Repo.NotificationSettings(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, []app.NotificationSetting{optIn}, settings)
+}
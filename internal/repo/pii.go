@@ -0,0 +1,392 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/pii"
+)
+
+var errNoKeyring = errors.New("keyring of personal data isn't set")
+
+// Encryption sets the keyring of personal data. Emails and phones of users, notification tasks,
+// recovery codes, invitations, email events and domain events are stored encrypted,
+// the ones looked up are found by their blind indexes. Without the keyring
+// they are stored in plaintext and the value is its own index.
+func Encryption(keyring *pii.Keyring) Option {
+	return func(repo *Repo) {
+		repo.pii = keyring
+	}
+}
+
+// encrypt returns the ciphertext of the value with its blind index.
+func (repo *Repo) encrypt(value string) (ciphertext, index string, err error) {
+	if repo.pii == nil {
+		return value, value, nil
+	}
+
+	ciphertext, err = repo.pii.Encrypt(value)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypt: %w", err)
+	}
+
+	return ciphertext, repo.pii.BlindIndex(value), nil
+}
+
+// blindIndex returns the blind index of the value for lookups.
+func (repo *Repo) blindIndex(value string) (string, error) {
+	if repo.pii == nil {
+		return value, nil
+	}
+
+	return repo.pii.BlindIndex(value), nil
+}
+
+// decrypt replaces ciphertexts with their plaintexts.
+func (repo *Repo) decrypt(values ...*string) error {
+	for _, value := range values {
+		if repo.pii == nil {
+			if pii.IsCiphertext(*value) {
+				return errNoKeyring
+			}
+			continue
+		}
+
+		plaintext, err := repo.pii.Decrypt(*value)
+		if err != nil {
+			return fmt.Errorf("decrypt: %w", err)
+		}
+		*value = plaintext
+	}
+
+	return nil
+}
+
+type (
+	// piiColumn is the encrypted column with its blind index,
+	// the index is empty if the column isn't looked up.
+	piiColumn struct {
+		name  string
+		index string
+	}
+	// piiTable is the table with encrypted columns. Rows of owned tables reference
+	// the user by the blind index of the email and are removed with the user.
+	// Notification tasks aren't owned, invitations are sent to emails without users.
+	piiTable struct {
+		name    string
+		columns []piiColumn
+		owned   bool
+	}
+)
+
+// piiTables are in order of re-encryption: users go first, so foreign keys of owned tables
+// find blind indexes of users. Decryption goes in the reverse order, so owned tables don't
+// reference blind indexes removed from users.
+// nolint:gochecknoglobals
+var piiTables = []piiTable{
+	{name: "users", columns: []piiColumn{{"email", "email_index"}, {"phone", "phone_index"}}},
+	{name: "notifications", columns: []piiColumn{{"email", "email_index"}}},
+	{name: "recovery_code", columns: []piiColumn{{"email", "email_index"}, {"phone", ""}}, owned: true},
+	{name: "org_invitations", columns: []piiColumn{{"email", "email_index"}}},
+	{name: "email_events", columns: []piiColumn{{"email", "email_index"}}},
+	{name: "events", columns: []piiColumn{{"email", ""}}},
+}
+
+// ReencryptPII encrypts personal data by the primary key of the keyring: ones stored
+// in plaintext before the encryption was enabled and ones encrypted by previous keys.
+// It returns the number of re-encrypted rows. Recovery codes of users deleted
+// before their emails got blind indexes are removed like the cascading delete would do.
+// Unique constraints of plaintext emails and phones are dropped once all of them are encrypted.
+func (repo *Repo) ReencryptPII(ctx context.Context, batchSize int) (count int, err error) {
+	if repo.pii == nil {
+		return 0, errNoKeyring
+	}
+
+	for _, table := range piiTables {
+		for {
+			selected, reencrypted, err := repo.reencryptBatch(ctx, table, batchSize)
+			if err != nil {
+				return count, fmt.Errorf("%s: %w", table.name, err)
+			}
+			count += reencrypted
+
+			if selected < batchSize {
+				break
+			}
+		}
+	}
+
+	// Rows of the previous version of the service keep their emails unique until it's stopped.
+	plaintext, err := repo.PlaintextPII(ctx)
+	if err != nil {
+		return count, err
+	}
+	if plaintext {
+		return count, nil
+	}
+
+	const query = `ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key, DROP CONSTRAINT IF EXISTS users_phone_key`
+
+	err = repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		_, err := db.ExecContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("drop plaintext constraints: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+
+	return count, nil
+}
+
+// PlaintextPII reports whether personal data is stored in plaintext: written before
+// the encryption was enabled, by the service without keys or by DecryptPII.
+// The service with the keyring doesn't find such rows until ReencryptPII is run.
+func (repo *Repo) PlaintextPII(ctx context.Context) (bool, error) {
+	var where []string
+	for _, table := range piiTables {
+		for _, column := range table.columns {
+			// Plaintext emails and phones have no colons.
+			where = append(where, `EXISTS (SELECT 1 FROM `+table.name+`
+			WHERE `+column.name+` IS NOT NULL AND position(':' in `+column.name+`) = 0)`)
+		}
+	}
+
+	plaintext := false
+	err := repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		err := db.GetContext(ctx, &plaintext, `SELECT `+strings.Join(where, " OR "))
+		if err != nil {
+			return fmt.Errorf("select plaintext: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return plaintext, nil
+}
+
+// DecryptPII stores personal data in plaintext indexed by itself, so the service works without keys
+// and migrations adding the encryption can be reverted. It returns the number of decrypted rows.
+func (repo *Repo) DecryptPII(ctx context.Context, batchSize int) (count int, err error) {
+	if repo.pii == nil {
+		return 0, errNoKeyring
+	}
+
+	for i := len(piiTables) - 1; i >= 0; i-- {
+		for {
+			decrypted, err := repo.decryptBatch(ctx, piiTables[i], batchSize)
+			if err != nil {
+				return count, fmt.Errorf("%s: %w", piiTables[i].name, err)
+			}
+			count += decrypted
+
+			if decrypted < batchSize {
+				break
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// reencryptBatch re-encrypts the batch of rows of the table having values in plaintext
+// or encrypted by previous keys.
+func (repo *Repo) reencryptBatch(ctx context.Context, table piiTable, batchSize int) (selected, reencrypted int, err error) {
+	err = repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		where := make([]string, len(table.columns))
+		for i, column := range table.columns {
+			where[i] = `(` + column.name + ` IS NOT NULL AND NOT starts_with(` + column.name + `, $1))`
+		}
+
+		rows, err := selectPII(ctx, tx, table, batchSize, strings.Join(where, " OR "), repo.pii.Primary()+":")
+		if err != nil {
+			return err
+		}
+		selected = len(rows)
+
+		var set []string
+		for _, column := range table.columns {
+			set = append(set, fmt.Sprintf("%s = $%d", column.name, len(set)+1))
+			if column.index != "" {
+				set = append(set, fmt.Sprintf("%s = $%d", column.index, len(set)+1))
+			}
+		}
+		update := `UPDATE ` + table.name + ` SET ` + strings.Join(set, ", ") + fmt.Sprintf(` WHERE id = $%d`, len(set)+1)
+		if table.owned {
+			// The blind index of the email is the second argument.
+			update += ` AND EXISTS (SELECT 1 FROM users
+			WHERE users.tenant_id = ` + table.name + `.tenant_id AND users.email_index = $2)`
+		}
+		remove := `DELETE FROM ` + table.name + ` WHERE id = $1`
+
+		for _, row := range rows {
+			args := make([]interface{}, 0, len(set)+1)
+			for i, column := range table.columns {
+				value, index, err := repo.reencrypt(row.values[i])
+				if err != nil {
+					return fmt.Errorf("row %d: %w", row.id, err)
+				}
+
+				args = append(args, value)
+				if column.index != "" {
+					args = append(args, index)
+				}
+			}
+
+			res, err := tx.ExecContext(ctx, update, append(args, row.id)...)
+			if err != nil {
+				return fmt.Errorf("update row %d: %w", row.id, err)
+			}
+
+			updated, err := res.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("rows affected: %w", err)
+			}
+			if updated == 1 {
+				reencrypted++
+				continue
+			}
+
+			_, err = tx.ExecContext(ctx, remove, row.id)
+			if err != nil {
+				return fmt.Errorf("delete row %d: %w", row.id, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return selected, reencrypted, nil
+}
+
+// decryptBatch decrypts the batch of rows of the table having encrypted values.
+func (repo *Repo) decryptBatch(ctx context.Context, table piiTable, batchSize int) (decrypted int, err error) {
+	err = repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		var where, set []string
+		for _, column := range table.columns {
+			// Plaintext emails and phones have no colons.
+			where = append(where, `position(':' in `+column.name+`) > 0`)
+			set = append(set, fmt.Sprintf("%s = $%d", column.name, len(set)+1))
+			if column.index != "" {
+				set = append(set, fmt.Sprintf("%s = $%d", column.index, len(set)+1))
+			}
+		}
+
+		rows, err := selectPII(ctx, tx, table, batchSize, strings.Join(where, " OR "))
+		if err != nil {
+			return err
+		}
+
+		update := `UPDATE ` + table.name + ` SET ` + strings.Join(set, ", ") +
+			fmt.Sprintf(` WHERE id = $%d`, len(set)+1)
+
+		for _, row := range rows {
+			args := make([]interface{}, 0, len(set)+1)
+			for i, value := range row.values {
+				if value.Valid && pii.IsCiphertext(value.String) {
+					err = repo.decrypt(&value.String)
+					if err != nil {
+						return fmt.Errorf("row %d: %w", row.id, err)
+					}
+				}
+				args = append(args, value)
+				if table.columns[i].index != "" {
+					args = append(args, value)
+				}
+			}
+
+			_, err = tx.ExecContext(ctx, update, append(args, row.id)...)
+			if err != nil {
+				return fmt.Errorf("update row %d: %w", row.id, err)
+			}
+			decrypted++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return decrypted, nil
+}
+
+// piiRow contains values of encrypted columns of the row in order of columns of its table.
+type piiRow struct {
+	id     int
+	values []sql.NullString
+}
+
+// selectPII locks and returns the batch of rows matching the condition with its arguments.
+func selectPII(ctx context.Context, tx *sqlx.Tx, table piiTable, limit int, where string, args ...interface{}) ([]piiRow, error) {
+	columns := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		columns[i] = column.name
+	}
+
+	query := `SELECT id, ` + strings.Join(columns, ", ") + ` FROM ` + table.name + `
+	WHERE ` + where + ` ORDER BY id LIMIT ` + strconv.Itoa(limit) + ` FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select: %w", err)
+	}
+	defer rows.Close()
+
+	var res []piiRow
+	for rows.Next() {
+		row := piiRow{values: make([]sql.NullString, len(table.columns))}
+		dest := []interface{}{&row.id}
+		for i := range row.values {
+			dest = append(dest, &row.values[i])
+		}
+
+		err = rows.Scan(dest...)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		res = append(res, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return res, nil
+}
+
+// reencrypt returns the value encrypted by the primary key with its blind index,
+// NULL stays NULL.
+func (repo *Repo) reencrypt(value sql.NullString) (ciphertext, index sql.NullString, err error) {
+	if !value.Valid {
+		return value, value, nil
+	}
+
+	if pii.IsCiphertext(value.String) {
+		err = repo.decrypt(&value.String)
+		if err != nil {
+			return ciphertext, index, err
+		}
+	}
+
+	ciphertext.String, index.String, err = repo.encrypt(value.String)
+	if err != nil {
+		return ciphertext, index, err
+	}
+	ciphertext.Valid, index.Valid = true, true
+
+	return ciphertext, index, nil
+}
@@ -0,0 +1,258 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/pii"
+	"github.com/zergslaw/boilerplate/internal/repo"
+)
+
+func TestRepo_ReencryptPII(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	user := userGenerator()
+	user.ID, err = Repo.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{piiKey.ID}, keyIDs(t, "users"), "emails are encrypted")
+
+	// Rows stored before the encryption was enabled.
+	legacy := userGenerator()
+	legacy.Phone = "+79001234567"
+	err = zergRepo.Do(func(db *sqlx.DB) error {
+		_, err := db.Exec(`INSERT INTO users (public_id, tenant_id, username, email, pass_hash, phone) VALUES ($1, $2, $3, $4, $5, $6)`,
+			legacy.PublicID, legacy.TenantID, legacy.Name, legacy.Email, legacy.PassHash, legacy.Phone)
+		if err != nil {
+			return err
+		}
+		_, err = db.Exec(`INSERT INTO events (type, user_id, email) VALUES ('user.created', 1, $1)`, legacy.Email)
+		if err != nil {
+			return err
+		}
+		_, err = db.Exec(`INSERT INTO email_events (external_id, email, kind, reason) VALUES ('bounce', $1, 'bounce', '')`, legacy.Email)
+		if err != nil {
+			return err
+		}
+		_, err = db.Exec(`INSERT INTO recovery_code (tenant_id, email, code) VALUES ($1, $2, 'code')`, legacy.TenantID, legacy.Email)
+		if err != nil {
+			return err
+		}
+		_, err = db.Exec(`INSERT INTO notifications (tenant_id, email, kind, payload) VALUES ($1, $2, 'Welcome', '{}'), ($1, 'guest@gmail.com', 'OrgInvite', '{}')`,
+			legacy.TenantID, legacy.Email)
+		return err
+	})
+	require.Nil(t, err)
+
+	_, err = Repo.UserByEmail(ctx, legacy.TenantID, legacy.Email)
+	require.True(t, errors.Is(err, app.ErrNotFound), "plaintext emails have no blind index")
+	plaintext, err := Repo.PlaintextPII(ctx)
+	require.Nil(t, err)
+	require.True(t, plaintext)
+
+	count, err := Repo.ReencryptPII(ctx, 1)
+	require.Nil(t, err)
+	require.Equal(t, 6, count)
+	plaintext, err = Repo.PlaintextPII(ctx)
+	require.Nil(t, err)
+	require.False(t, plaintext)
+
+	res, err := Repo.UserByEmail(ctx, legacy.TenantID, legacy.Email)
+	require.Nil(t, err)
+	require.Equal(t, legacy.PublicID, res.PublicID)
+	require.Equal(t, legacy.Email, res.Email)
+	require.Equal(t, legacy.Phone, res.Phone)
+	emailEvent, err := Repo.LastEmailEvent(ctx, legacy.Email)
+	require.Nil(t, err)
+	require.Equal(t, legacy.Email, emailEvent.Email)
+	code, err := Repo.Code(ctx, legacy.TenantID, legacy.Email)
+	require.Nil(t, err)
+	require.Equal(t, legacy.Email, code.Email)
+	tasks, total, err := Repo.ListTaskNotification(ctx, app.TaskFilter{Email: legacy.Email}, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, legacy.Email, tasks[0].Email)
+	_, total, err = Repo.ListTaskNotification(ctx, app.TaskFilter{}, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 3, total, "the task of the email without the user is kept")
+
+	// The rotation.
+	rotated := repo.New(zergRepo, repo.Encryption(newKeyring(pii.Key{ID: "rotated", Secret: bytes.Repeat([]byte{3}, 32)}, piiKey)))
+
+	res, err = rotated.UserByEmail(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, user.Email, res.Email, "previous keys decrypt emails")
+
+	count, err = rotated.ReencryptPII(ctx, 10)
+	require.Nil(t, err)
+	require.Equal(t, 9, count)
+	for _, table := range []string{"users", "notifications", "recovery_code", "events", "email_events"} {
+		require.Equal(t, []string{"rotated"}, keyIDs(t, table))
+	}
+
+	count, err = rotated.ReencryptPII(ctx, 10)
+	require.Nil(t, err)
+	require.Zero(t, count)
+
+	res, err = rotated.UserByEmail(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, user.ID, res.ID)
+	_, err = Repo.UserByEmail(ctx, user.TenantID, user.Email)
+	require.NotNil(t, err, "the previous keyring doesn't know the new key")
+}
+
+func TestRepo_PlaintextPII(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	// The service without keys.
+	plain := repo.New(zergRepo)
+
+	user := userGenerator()
+	user.Phone = "+79001234567"
+	task := app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	}
+	user.ID, err = plain.CreateUser(ctx, user, task)
+	require.Nil(t, err)
+	err = plain.UpdatePhone(ctx, user.ID, user.Phone)
+	require.Nil(t, err)
+	require.Equal(t, []string{user.Email}, keyIDs(t, "users"), "emails are stored in plaintext")
+
+	res, err := plain.UserByEmail(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, user.Phone, res.Phone)
+	duplicate := userGenerator()
+	duplicate.Email = user.Email
+	_, err = plain.CreateUser(ctx, duplicate, task)
+	require.True(t, errors.Is(err, app.ErrEmailExist))
+
+	plaintext, err := Repo.PlaintextPII(ctx)
+	require.Nil(t, err)
+	require.True(t, plaintext)
+	_, err = Repo.UserByEmail(ctx, user.TenantID, user.Email)
+	require.True(t, errors.Is(err, app.ErrNotFound), "the service with keys doesn't find plaintext emails")
+
+	count, err := Repo.ReencryptPII(ctx, 10)
+	require.Nil(t, err)
+	require.Equal(t, 4, count, "the user, the task and two events")
+	plaintext, err = Repo.PlaintextPII(ctx)
+	require.Nil(t, err)
+	require.False(t, plaintext)
+
+	res, err = Repo.UserByEmail(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, user.Phone, res.Phone)
+	_, err = plain.UserByEmail(ctx, user.TenantID, user.Email)
+	require.NotNil(t, err, "ciphertexts aren't read without keys")
+
+	err = zergRepo.Do(func(db *sqlx.DB) error {
+		var constraints []string
+		err := db.Select(&constraints, `SELECT conname FROM pg_constraint
+		WHERE conname IN ('users_email_key', 'users_phone_key')`)
+		require.Nil(t, err)
+		require.Empty(t, constraints, "constraints of plaintext values are dropped")
+
+		return nil
+	})
+	require.Nil(t, err)
+}
+
+// keyIDs returns ids of keys encrypting emails of the table.
+func keyIDs(t *testing.T, table string) (ids []string) {
+	t.Helper()
+
+	err := zergRepo.Do(func(db *sqlx.DB) error {
+		var emails []string
+		err := db.Select(&emails, `SELECT email FROM `+table)
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[string]bool)
+		for _, email := range emails {
+			id := strings.SplitN(email, ":", 2)[0]
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+
+		return nil
+	})
+	require.Nil(t, err)
+
+	return ids
+}
+
+func TestRepo_DecryptPII(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	user := userGenerator()
+	user.ID, err = Repo.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+	err = Repo.UpdatePhone(ctx, user.ID, "+79001234567")
+	require.Nil(t, err)
+
+	// The user, the task and two events.
+	count, err := Repo.DecryptPII(ctx, 1)
+	require.Nil(t, err)
+	require.Equal(t, 4, count)
+
+	err = zergRepo.Do(func(db *sqlx.DB) error {
+		var res struct {
+			Email      string  `db:"email"`
+			EmailIndex *string `db:"email_index"`
+			Phone      string  `db:"phone"`
+			PhoneIndex *string `db:"phone_index"`
+		}
+		err := db.Get(&res, `SELECT email, email_index, phone, phone_index FROM users`)
+		require.Nil(t, err)
+		require.Equal(t, user.Email, res.Email)
+		require.Equal(t, "+79001234567", res.Phone)
+		require.Equal(t, user.Email, *res.EmailIndex, "plaintext values are their own indexes")
+		require.Equal(t, "+79001234567", *res.PhoneIndex)
+
+		var emails []string
+		err = db.Select(&emails, `SELECT email FROM events UNION ALL SELECT email FROM notifications`)
+		require.Nil(t, err)
+		require.Equal(t, []string{user.Email, user.Email, user.Email}, emails)
+
+		return nil
+	})
+	require.Nil(t, err)
+
+	count, err = Repo.DecryptPII(ctx, 1)
+	require.Nil(t, err)
+	require.Zero(t, count)
+
+	count, err = Repo.ReencryptPII(ctx, 10)
+	require.Nil(t, err)
+	require.Equal(t, 4, count)
+
+	res, err := Repo.UserByEmail(ctx, user.TenantID, user.Email)
+	require.Nil(t, err)
+	require.Equal(t, "+79001234567", res.Phone)
+}
@@ -0,0 +1,57 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PurgeSessions need for implements app.PurgeRepo.
+func (repo *Repo) PurgeSessions(ctx context.Context, before time.Time, limit int) (count int, err error) {
+	const query = `DELETE FROM sessions WHERE id IN (
+		SELECT id FROM sessions WHERE is_logout = true AND logout_at < $1 LIMIT $2)`
+
+	return repo.purge(ctx, query, before, limit)
+}
+
+// PurgeRecoveryCodes need for implements app.PurgeRepo.
+func (repo *Repo) PurgeRecoveryCodes(ctx context.Context, before time.Time, limit int) (count int, err error) {
+	const query = `DELETE FROM recovery_code WHERE id IN (
+		SELECT id FROM recovery_code WHERE created_at < $1 LIMIT $2)`
+
+	return repo.purge(ctx, query, before, limit)
+}
+
+// PurgeNotifications need for implements app.PurgeRepo.
+func (repo *Repo) PurgeNotifications(ctx context.Context, before time.Time, limit int) (count int, err error) {
+	const query = `DELETE FROM notifications WHERE id IN (
+		SELECT id FROM notifications
+		WHERE is_done = true AND coalesce(exec_time, cancelled_at, created_at) < $1 LIMIT $2)`
+
+	return repo.purge(ctx, query, before, limit)
+}
+
+// PurgeJobs need for implements app.PurgeRepo.
+func (repo *Repo) PurgeJobs(ctx context.Context, before time.Time, limit int) (count int, err error) {
+	const query = `DELETE FROM jobs WHERE id IN (
+		SELECT id FROM jobs WHERE status IN ('done', 'failed') AND finished_at < $1 LIMIT $2)`
+
+	return repo.purge(ctx, query, before, limit)
+}
+
+// purge executes the query removing at most limit rows older than before, returns the number of removed rows.
+func (repo *Repo) purge(ctx context.Context, query string, before time.Time, limit int) (count int, err error) {
+	err = repo.primary(ctx).Do(func(db *sqlx.DB) error {
+		res, err := db.ExecContext(ctx, query, before, limit)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		count = int(n)
+
+		return err
+	})
+	return
+}
@@ -0,0 +1,88 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestPurgeRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	user := userGenerator()
+	user.ID, err = Repo.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+
+	tokens := []app.TokenID{"token1", "token2", "token3"}
+	for _, token := range tokens {
+		err = Repo.SaveSession(ctx, user.ID, token, origin)
+		require.Nil(t, err)
+	}
+	for _, token := range tokens[:2] {
+		err = Repo.DeleteSession(ctx, token)
+		require.Nil(t, err)
+	}
+	// The retention counts from the logout, not from the sign in.
+	err = zergRepo.Do(func(db *sqlx.DB) error {
+		_, err := db.Exec("UPDATE sessions SET created_at = now() - interval '1 day'")
+		return err
+	})
+	require.Nil(t, err)
+
+	const recoveryCode = "123456"
+	err = Repo.SaveCode(ctx, user.TenantID, user.Email, recoveryCode, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.PassRecovery,
+		Payload:  &app.PassRecoveryPayload{Code: recoveryCode},
+	})
+	require.Nil(t, err)
+
+	welcome, err := nextNotificationTask()
+	require.Nil(t, err)
+	err = Repo.DeleteTaskNotification(ctx, welcome.ID)
+	require.Nil(t, err)
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	count, err := Repo.PurgeSessions(ctx, past, 10)
+	require.Nil(t, err)
+	require.Zero(t, count)
+	count, err = Repo.PurgeSessions(ctx, future, 1)
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+	count, err = Repo.PurgeSessions(ctx, future, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+
+	_, err = Repo.AuthUserByTokenID(ctx, tokens[2])
+	require.Nil(t, err, "active session is kept")
+
+	count, err = Repo.PurgeRecoveryCodes(ctx, future, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = Repo.PurgeNotifications(ctx, future, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, count, "pending recovery task is kept")
+
+	_, err = Repo.TaskNotificationByID(ctx, welcome.ID)
+	require.NotNil(t, err)
+
+	count, err = Repo.PurgeJobs(ctx, future, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, count, "pending recovery job is kept")
+}
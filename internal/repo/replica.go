@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	zergrepo "github.com/ZergsLaw/zerg-repo"
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/consistency"
+)
+
+type (
+	// Option for building repo struct.
+	Option func(*Repo)
+
+	replica struct {
+		db      *zergrepo.Repo
+		healthy int32
+	}
+)
+
+// Replicas sets read replicas of the database and the max replication lag at which they serve reads.
+// Replicas are unused until MonitorReplicas checks them, changes of their health are logged.
+func Replicas(logger zergrepo.Logger, maxLag time.Duration, replicas ...*zergrepo.Repo) Option {
+	return func(repo *Repo) {
+		repo.log = logger
+		repo.maxLag = maxLag
+		for i := range replicas {
+			repo.replicas = append(repo.replicas, &replica{db: replicas[i]})
+		}
+	}
+}
+
+// ConnectReplicas create new instances *zergrepo.Repo for read replicas.
+// Queries of all replicas are collected by the single metric apart from the primary.
+func ConnectReplicas(dbs []*sqlx.DB, logger zergrepo.Logger, namespace string) []*zergrepo.Repo {
+	metric := zergrepo.MustMetric(namespace, "repo_replica")
+
+	replicas := make([]*zergrepo.Repo, len(dbs))
+	for i := range dbs {
+		replicas[i] = zergrepo.New(dbs[i], logger, metric, newMapper())
+	}
+
+	return replicas
+}
+
+// primary returns the primary database for writes, the following reads of the request stay on it.
+func (repo *Repo) primary(ctx context.Context) *zergrepo.Repo {
+	consistency.MarkWritten(ctx)
+
+	return repo.db
+}
+
+// replica returns the healthy replica in turn for the read-only query of the request.
+// The primary is returned if all replicas are unhealthy or the request has written to the primary,
+// so the request always reads its own writes. Queries out of requests like jobs always read
+// the primary, they may follow writes of other processes which replicas haven't replayed yet.
+func (repo *Repo) replica(ctx context.Context) *zergrepo.Repo {
+	if len(repo.replicas) == 0 || !consistency.Tracked(ctx) || consistency.Written(ctx) {
+		return repo.db
+	}
+
+	next := int(atomic.AddUint32(&repo.next, 1))
+	for i := range repo.replicas {
+		r := repo.replicas[(next+i)%len(repo.replicas)]
+		if atomic.LoadInt32(&r.healthy) == 1 {
+			return r.db
+		}
+	}
+
+	return repo.db
+}
+
+// MonitorReplicas checks replicas every interval until ctx is done.
+// The replica is healthy if it responds and its replication lag doesn't exceed the max lag.
+func (repo *Repo) MonitorReplicas(ctx context.Context, interval time.Duration) error {
+	if len(repo.replicas) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for i, r := range repo.replicas {
+			repo.checkReplica(ctx, i, r, interval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (repo *Repo) checkReplica(ctx context.Context, i int, r *replica, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lag, err := replicationLag(ctx, r.db)
+	switch {
+	case err != nil:
+		if atomic.SwapInt32(&r.healthy, 0) == 1 {
+			repo.log.Warnf("replica %d is unhealthy: %s", i, err)
+		}
+	case lag > repo.maxLag:
+		if atomic.SwapInt32(&r.healthy, 0) == 1 {
+			repo.log.Warnf("replica %d is unhealthy: lag %s", i, lag)
+		}
+	default:
+		if atomic.SwapInt32(&r.healthy, 1) == 0 {
+			repo.log.Infof("replica %d is healthy: lag %s", i, lag)
+		}
+	}
+}
+
+// replicationLag returns the age of the last replayed transaction,
+// the lag is zero if the replica has replayed everything received from the primary.
+func replicationLag(ctx context.Context, db *zergrepo.Repo) (lag time.Duration, err error) {
+	err = db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT CASE
+			WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE coalesce(extract(epoch FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END`
+
+		seconds := 0.0
+		err := db.GetContext(ctx, &seconds, query)
+		lag = time.Duration(seconds * float64(time.Second))
+
+		return err
+	})
+	return
+}
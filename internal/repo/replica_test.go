@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	zergrepo "github.com/ZergsLaw/zerg-repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/zergslaw/boilerplate/internal/consistency"
+)
+
+func TestRepo_Replica(t *testing.T) {
+	t.Parallel()
+
+	primary, first, second := &zergrepo.Repo{}, &zergrepo.Repo{}, &zergrepo.Repo{}
+	ctx := consistency.NewContext(context.Background())
+
+	r := New(primary)
+	assert.Same(t, primary, r.replica(ctx), "no replicas")
+
+	r = New(primary, Replicas(nil, 0, first, second))
+	assert.Same(t, primary, r.replica(ctx), "replicas aren't checked")
+
+	atomic.StoreInt32(&r.replicas[0].healthy, 1)
+	atomic.StoreInt32(&r.replicas[1].healthy, 1)
+	used := map[*zergrepo.Repo]bool{r.replica(ctx): true, r.replica(ctx): true}
+	assert.Equal(t, map[*zergrepo.Repo]bool{first: true, second: true}, used, "replicas are taken in turn")
+
+	atomic.StoreInt32(&r.replicas[0].healthy, 0)
+	assert.Same(t, second, r.replica(ctx))
+	assert.Same(t, second, r.replica(ctx), "the unhealthy replica is skipped")
+
+	assert.Same(t, primary, r.primary(ctx))
+	assert.Same(t, primary, r.replica(ctx), "reads follow the write")
+	assert.Same(t, second, r.replica(consistency.NewContext(context.Background())), "another request")
+	assert.Same(t, primary, r.replica(context.Background()), "jobs read the primary")
+}
@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgtype"
@@ -13,7 +15,7 @@ import (
 
 // SaveSession need for implements app.SessionRepo.
 func (repo *Repo) SaveSession(ctx context.Context, userID app.UserID, tokenID app.TokenID, origin app.Origin) error {
-	return repo.db.Do(func(db *sqlx.DB) error {
+	return repo.primary(ctx).Do(func(db *sqlx.DB) error {
 		const query = `INSERT INTO sessions (user_id, token_id, ip, user_agent) VALUES (:user_id,:token_id,:ip,:user_agent)`
 		type args struct {
 			UserID    app.UserID   `db:"user_id"`
@@ -41,37 +43,25 @@ func (repo *Repo) SaveSession(ctx context.Context, userID app.UserID, tokenID ap
 	})
 }
 
-// SessionByTokenID need for implements app.SessionRepo.
-func (repo *Repo) SessionByTokenID(ctx context.Context, tokenID app.TokenID) (session *app.Session, err error) {
-	err = repo.db.Do(func(db *sqlx.DB) error {
-		const query = `SELECT * FROM sessions WHERE token_id = $1 AND is_logout = false`
+// AuthUserByTokenID need for implements app.SessionRepo.
+func (repo *Repo) AuthUserByTokenID(ctx context.Context, tokenID app.TokenID) (authUser *app.AuthUser, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT users.id, users.public_id, users.tenant_id, users.email, users.username, users.pass_hash, users.phone,
+		users.version, users.created_at, users.updated_at, sessions.id AS session_id, sessions.token_id, sessions.ip, sessions.user_agent
+		FROM sessions JOIN users ON users.id = sessions.user_id
+		WHERE sessions.token_id = $1 AND sessions.is_logout = false`
 
-		s := &sessionDBFormat{}
-		err = db.GetContext(ctx, s, query, tokenID)
+		u := &authUserDBFormat{}
+		err = db.GetContext(ctx, u, query, tokenID)
 		if err != nil {
 			return err
 		}
-
-		session = s.toAppFormat()
-		return nil
-	})
-	return
-}
-
-// UserByTokenID need for implements app.UserRepo.
-func (repo *Repo) UserByTokenID(ctx context.Context, tokenID app.TokenID) (user *app.User, err error) {
-	err = repo.db.Do(func(db *sqlx.DB) error {
-		const query = `SELECT users.id, users.email, users.username, users.pass_hash, users.created_at, users.updated_at
-		FROM users LEFT JOIN sessions ON sessions.user_id = users.id WHERE sessions.token_id = $1
-		AND sessions.is_logout = false`
-
-		u := &userDBFormat{}
-		err = db.GetContext(ctx, u, query, tokenID)
+		err = repo.decryptUser(&u.userDBFormat)
 		if err != nil {
 			return err
 		}
 
-		user = u.toAppFormat()
+		authUser = u.toAppFormat()
 		return nil
 	})
 	return
@@ -79,13 +69,31 @@ func (repo *Repo) UserByTokenID(ctx context.Context, tokenID app.TokenID) (user
 
 // DeleteSession need for implements app.SessionRepo.
 func (repo *Repo) DeleteSession(ctx context.Context, tokenID app.TokenID) error {
-	return repo.db.Do(func(db *sqlx.DB) error {
-		const query = `UPDATE sessions SET is_logout = true WHERE token_id = :token_id`
-		type args struct {
-			TokenID app.TokenID `db:"token_id"`
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE sessions SET is_logout = true, logout_at = now() FROM users
+		WHERE sessions.token_id = $1 AND sessions.is_logout = false AND users.id = sessions.user_id
+		RETURNING users.id, users.email`
+
+		var (
+			userID    app.UserID
+			userEmail string
+		)
+		err := tx.QueryRowContext(ctx, query, tokenID).Scan(&userID, &userEmail)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("delete session: %w", err)
+		}
+		err = repo.decrypt(&userEmail)
+		if err != nil {
+			return err
 		}
 
-		_, err := db.NamedExecContext(ctx, query, args{TokenID: tokenID})
-		return err
+		return repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventSessionRevoked,
+			UserID: userID,
+			Email:  userEmail,
+		})
 	})
 }
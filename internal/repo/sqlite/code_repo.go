@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SaveCode need for implements app.CodeRepo.
+func (repo *Repo) SaveCode(ctx context.Context, tenantID app.TenantID, email, code string, task app.TaskNotification) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		err := cleanRecoveryCodes(ctx, tx, tenantID, email)
+		if err != nil {
+			return err
+		}
+
+		const query = `INSERT INTO recovery_code(tenant_id, email, code) VALUES (:tenant_id, :email, :code)`
+		type args struct {
+			TenantID app.TenantID `db:"tenant_id"`
+			Email    string       `db:"email"`
+			Code     string       `db:"code"`
+		}
+
+		_, err = tx.NamedExecContext(ctx, query, args{
+			TenantID: tenantID,
+			Email:    email,
+			Code:     code,
+		})
+		if err != nil {
+			return fmt.Errorf("insert code: %w", err)
+		}
+
+		_, err = createTaskNotification(ctx, tx, task)
+		if err != nil {
+			return err
+		}
+
+		const queryUserID = `SELECT id FROM users WHERE tenant_id = ? AND email = ?`
+		userID := app.UserID(0)
+		err = tx.GetContext(ctx, &userID, queryUserID, tenantID, email)
+		if err != nil {
+			return fmt.Errorf("get user id: %w", err)
+		}
+
+		return createEvent(ctx, tx, app.Event{
+			Type:   app.EventRecoveryCodeCreated,
+			UserID: userID,
+			Email:  email,
+		})
+	})
+}
+
+// Code need for implements app.CodeRepo.
+func (repo *Repo) Code(ctx context.Context, tenantID app.TenantID, email string) (codeInfo *app.CodeInfo, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM recovery_code WHERE tenant_id = ? AND email = ? AND phone IS NULL`
+
+		c := &codeInfoDBFormat{}
+		err = db.GetContext(ctx, c, query, tenantID, email)
+		if err != nil {
+			return err
+		}
+
+		codeInfo = c.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// SavePhoneCode need for implements app.CodeRepo.
+func (repo *Repo) SavePhoneCode(ctx context.Context, tenantID app.TenantID, email, phone, code string, task app.TaskNotification) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		err := cleanPhoneCodes(ctx, tx, tenantID, email)
+		if err != nil {
+			return err
+		}
+
+		const query = `INSERT INTO recovery_code(tenant_id, email, phone, code) VALUES (?, ?, ?, ?)`
+		_, err = tx.ExecContext(ctx, query, tenantID, email, phone, code)
+		if err != nil {
+			return fmt.Errorf("insert code: %w", err)
+		}
+
+		_, err = createTaskNotification(ctx, tx, task)
+
+		return err
+	})
+}
+
+// PhoneCode need for implements app.CodeRepo.
+func (repo *Repo) PhoneCode(ctx context.Context, tenantID app.TenantID, email string) (codeInfo *app.CodeInfo, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM recovery_code WHERE tenant_id = ? AND email = ? AND phone IS NOT NULL`
+
+		c := &codeInfoDBFormat{}
+		err = db.GetContext(ctx, c, query, tenantID, email)
+		if err != nil {
+			return err
+		}
+
+		codeInfo = c.toAppFormat()
+		return nil
+	})
+	return
+}
@@ -0,0 +1,114 @@
+// Package sqlite is an implements database interface keeping data in SQLite.
+// It is intended for small deployments and demos which don't need a Postgres server.
+// The driver requires cgo, the binary built with CGO_ENABLED=0 fails to open the database.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	zergrepo "github.com/ZergsLaw/zerg-repo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // db driver.
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Open opens the database file, it is created if it doesn't exist.
+// Writers wait for each other instead of failing, transactions take the write lock at start,
+// so the transaction never fails upgrading the read lock.
+func Open(ctx context.Context, path string) (*sqlx.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=%d&_txlock=immediate",
+		path, busyTimeout.Milliseconds())
+
+	db, err := sqlx.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.PingContext(ctx)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+const busyTimeout = 5 * time.Second
+
+// Connect create new instance *zergrepo.Repo.
+func Connect(db *sqlx.DB, logger zergrepo.Logger, namespace string) *zergrepo.Repo {
+	// Unique columns, SQLite reports them instead of constraint names.
+	const (
+		ColumnEmail    = "users.tenant_id, users.email"
+		ColumnUsername = "users.tenant_id, users.username"
+		ColumnPhone    = "users.tenant_id, users.phone"
+		ColumnTenant   = "tenants.name"
+		ColumnHost     = "tenants.host"
+		ColumnMember   = "org_members.org_id, org_members.user_id"
+	)
+
+	metric := zergrepo.MustMetric(namespace, "repo")
+	mapper := zergrepo.NewMapper(
+		zergrepo.NewConvert(app.ErrNotFound, sql.ErrNoRows),
+		uniqueConstraint(app.ErrEmailExist, ColumnEmail),
+		uniqueConstraint(app.ErrUsernameExist, ColumnUsername),
+		uniqueConstraint(app.ErrPhoneExist, ColumnPhone),
+		uniqueConstraint(app.ErrTenantExist, ColumnTenant),
+		uniqueConstraint(app.ErrTenantExist, ColumnHost),
+		uniqueConstraint(app.ErrOrgMemberExist, ColumnMember),
+	)
+
+	return zergrepo.New(db, logger, metric, mapper)
+}
+
+// uniqueConstraint converts the violation of the unique column.
+// The error is recognized by the message, the error type of the driver isn't available without cgo.
+func uniqueConstraint(target error, column string) zergrepo.ErrMapFunc {
+	return func(err error) error {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed: "+column) {
+			return target
+		}
+
+		return nil
+	}
+}
+
+var _ app.SessionRepo = &Repo{}
+var _ app.UserRepo = &Repo{}
+var _ app.WAL = &Repo{}
+var _ app.CodeRepo = &Repo{}
+var _ app.NotificationSettingsRepo = &Repo{}
+var _ app.WebhookRepo = &Repo{}
+var _ app.EventRepo = &Repo{}
+var _ app.InboxRepo = &Repo{}
+var _ app.DeliverabilityRepo = &Repo{}
+var _ app.ProviderRepo = &Repo{}
+var _ app.JobRepo = &Repo{}
+var _ app.PurgeRepo = &Repo{}
+var _ app.LeaderRepo = &Repo{}
+var _ app.TenantRepo = &Repo{}
+var _ app.OrgRepo = &Repo{}
+
+// Repo is an implements app.UserRepo.
+// Responsible for working with SQLite database.
+type Repo struct {
+	db *zergrepo.Repo
+}
+
+// New creates and returns new app.UserRepo.
+func New(repo *zergrepo.Repo) *Repo {
+	return &Repo{
+		db: repo,
+	}
+}
+
+// Ping checks the connection to the database.
+func (repo *Repo) Ping(ctx context.Context) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		return db.PingContext(ctx)
+	})
+}
@@ -0,0 +1,44 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SaveEmailEvent need for implements app.DeliverabilityRepo.
+func (repo *Repo) SaveEmailEvent(ctx context.Context, event app.EmailEvent) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO email_events (external_id, email, kind, reason, created_at)
+		VALUES (?, ?, ?, ?, coalesce(?, ` + now + `))
+		ON CONFLICT (external_id) WHERE external_id <> '' DO NOTHING`
+
+		_, err := db.ExecContext(ctx, query, event.ExternalID, event.Email, string(event.Kind), event.Reason, nullTimestamp(event.CreatedAt))
+		if err != nil {
+			return fmt.Errorf("create email event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// LastEmailEvent need for implements app.DeliverabilityRepo.
+func (repo *Repo) LastEmailEvent(ctx context.Context, email string) (event *app.EmailEvent, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT external_id, email, kind, reason, created_at FROM email_events
+		WHERE email = ?
+		ORDER BY created_at DESC, id DESC LIMIT 1`
+
+		res := &emailEventDBFormat{}
+		err = db.GetContext(ctx, res, query, email)
+		if err != nil {
+			return err
+		}
+
+		event = res.toAppFormat()
+		return nil
+	})
+	return
+}
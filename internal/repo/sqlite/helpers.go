@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// createTaskNotification saves the task with the job executing it.
+func createTaskNotification(ctx context.Context, tx *sqlx.Tx, task app.TaskNotification) (id int, err error) {
+	const queryCreateTask = `INSERT INTO notifications (tenant_id, email, kind, payload, run_at)
+	VALUES (?, ?, ?, ?, coalesce(?, ` + now + `)) RETURNING id, run_at`
+
+	payload, err := json.Marshal(task.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	err = tx.QueryRowxContext(ctx, queryCreateTask, task.TenantID, task.Email, task.Kind.String(), string(payload), nullTimestamp(task.RunAt)).
+		Scan(&id, &task.RunAt)
+	if err != nil {
+		return 0, fmt.Errorf("create task notification: %w", err)
+	}
+
+	_, err = createJob(ctx, tx, app.NewNotificationJob(id, task))
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// createJob adds the pending job, ErrJobExist is returned if a pending job has the same unique key.
+func createJob(ctx context.Context, tx *sqlx.Tx, job app.Job) (id int, err error) {
+	const query = `INSERT INTO jobs (kind, payload, priority, unique_key, run_at)
+	VALUES (?, ?, ?, nullif(?, ''), coalesce(?, ` + now + `))
+	ON CONFLICT (unique_key) WHERE status = 'pending' DO NOTHING
+	RETURNING id`
+
+	payload, err := json.Marshal(job.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	err = tx.GetContext(ctx, &id, query, job.Kind, string(payload), job.Priority, job.UniqueKey, nullTimestamp(job.RunAt))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, app.ErrJobExist
+	case err != nil:
+		return 0, fmt.Errorf("create job: %w", err)
+	}
+
+	return id, nil
+}
+
+func cleanRecoveryCodes(ctx context.Context, tx *sqlx.Tx, tenantID app.TenantID, email string) error {
+	const query = `DELETE FROM recovery_code WHERE tenant_id = ? AND email = ? AND phone IS NULL`
+
+	_, err := tx.ExecContext(ctx, query, tenantID, email)
+	if err != nil {
+		return fmt.Errorf("delete recovery recoverycode: %w", err)
+	}
+
+	return nil
+}
+
+func cleanPhoneCodes(ctx context.Context, tx *sqlx.Tx, tenantID app.TenantID, email string) error {
+	const query = `DELETE FROM recovery_code WHERE tenant_id = ? AND email = ? AND phone IS NOT NULL`
+
+	_, err := tx.ExecContext(ctx, query, tenantID, email)
+	if err != nil {
+		return fmt.Errorf("delete phone codes: %w", err)
+	}
+
+	return nil
+}
+
+// createEvent saves the event with the public id of the user to the outbox
+// and creates deliveries with their jobs for all webhooks subscribed to it.
+func createEvent(ctx context.Context, tx *sqlx.Tx, event app.Event) error {
+	const queryCreateEvent = `INSERT INTO events (type, user_id, user_public_id, email)
+	VALUES (?1, ?2, coalesce((SELECT public_id FROM users WHERE id = ?2), ''), ?3) RETURNING id`
+
+	eventID := 0
+	err := tx.QueryRowxContext(ctx, queryCreateEvent, event.Type, event.UserID, event.Email).Scan(&eventID)
+	if err != nil {
+		return fmt.Errorf("create event: %w", err)
+	}
+
+	const queryCreateDeliveries = `INSERT INTO webhook_deliveries (webhook_id, event_id)
+	SELECT id, ?1 FROM webhooks WHERE EXISTS (SELECT 1 FROM json_each(webhooks.events) WHERE value = ?2)
+	RETURNING id`
+
+	var deliveryIDs []int
+	err = tx.SelectContext(ctx, &deliveryIDs, queryCreateDeliveries, eventID, event.Type)
+	if err != nil {
+		return fmt.Errorf("create webhook deliveries: %w", err)
+	}
+
+	for _, deliveryID := range deliveryIDs {
+		_, err = createJob(ctx, tx, app.NewWebhookDeliveryJob(deliveryID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// AcquireLeadership need for implements app.LeaderRepo.
+// The database file is shared only by processes of one host and SQLite serializes writes,
+// so the leader is defined by the lease alone: the new leader waits until the lease
+// of the deposed one expires.
+func (repo *Repo) AcquireLeadership(ctx context.Context, election, holder string, ttl time.Duration) (lease *app.Lease, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO leaders (election, holder, term, expires_at)
+		VALUES (?1, ?2, 1, ?3)
+		ON CONFLICT (election) DO UPDATE
+			SET holder = excluded.holder, term = leaders.term + 1, expires_at = excluded.expires_at
+			WHERE leaders.expires_at <= ` + now + `
+		RETURNING term`
+
+		res := &app.Lease{Election: election, Holder: holder}
+		err = db.QueryRowxContext(ctx, query, election, holder, timestamp(time.Now().Add(ttl))).Scan(&res.Term)
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.ErrNotLeader
+		}
+		if err != nil {
+			return err
+		}
+
+		lease = res
+		return nil
+	})
+	return
+}
+
+// RenewLeadership need for implements app.LeaderRepo.
+func (repo *Repo) RenewLeadership(ctx context.Context, lease app.Lease, ttl time.Duration) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE leaders SET expires_at = ?3
+		WHERE election = ?1 AND term = ?2 AND expires_at > ` + now
+
+		res, err := db.ExecContext(ctx, query, lease.Election, lease.Term, timestamp(time.Now().Add(ttl)))
+		if err != nil {
+			return err
+		}
+
+		count, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return app.ErrNotLeader
+		}
+
+		return nil
+	})
+}
+
+// ReleaseLeadership need for implements app.LeaderRepo.
+func (repo *Repo) ReleaseLeadership(ctx context.Context, lease app.Lease) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE leaders SET expires_at = ` + now + ` WHERE election = ? AND term = ?`
+
+		_, err := db.ExecContext(ctx, query, lease.Election, lease.Term)
+
+		return err
+	})
+}
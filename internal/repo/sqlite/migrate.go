@@ -0,0 +1,588 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	zergrepo "github.com/ZergsLaw/zerg-repo"
+	"github.com/jmoiron/sqlx"
+)
+
+var errForeignKey = errors.New("foreign key violation")
+
+// Timestamp column with the default value in the format of now.
+const timestampNow = `timestamp not null default (` + now + `)`
+
+// migrations mirror the versions of migrate/*.sql in SQLite dialect:
+// serial is integer primary key, arrays and jsonb are JSON text, inet is text.
+// The migrator of zergrepo can't be used, its migration table is Postgres specific.
+// nolint:gochecknoglobals
+var migrations = []zergrepo.Migrate{
+	{
+		Version: 1,
+		Up: zergrepo.Query(`create table users
+(
+    id         integer primary key autoincrement,
+    email      text not null unique,
+    username   text not null unique,
+    pass_hash  blob,
+    created_at ` + timestampNow + `,
+    updated_at ` + timestampNow + `
+)`),
+	},
+	{
+		Version: 2,
+		Up: zergrepo.Query(`create table sessions
+(
+    id         integer primary key autoincrement,
+    user_id    integer not null references users on delete cascade,
+    token_id   text    not null unique,
+    ip         text    not null,
+    user_agent text    not null default '',
+    created_at ` + timestampNow + `,
+    is_logout  boolean not null default false
+)`),
+	},
+	{
+		Version: 3,
+		Up: zergrepo.Query(`create table notifications
+(
+    id         integer primary key autoincrement,
+    email      text    not null references users (email) on delete cascade on update cascade,
+    kind       text    not null,
+    is_done    boolean not null default false,
+    created_at ` + timestampNow + `,
+    exec_time  timestamp
+)`),
+	},
+	{
+		Version: 4,
+		Up: zergrepo.Query(`create table recovery_code
+(
+    id         integer primary key autoincrement,
+    email      text not null references users (email) on delete cascade,
+    code       text not null unique,
+    created_at ` + timestampNow + `
+)`),
+	},
+	{
+		// Pending recovery tasks read the code by the email before, it's copied to the payload
+		// or the task is removed, so it doesn't send the empty code.
+		Version: 5,
+		Up: zergrepo.Query(`alter table notifications add column payload text not null default '{}';
+
+update notifications
+set payload = json_object('code', (select code from recovery_code where recovery_code.email = notifications.email
+                                   order by created_at desc, id desc limit 1))
+where kind = 'PassRecovery' and is_done = false
+  and exists(select 1 from recovery_code where recovery_code.email = notifications.email);
+
+delete from notifications where kind = 'PassRecovery' and is_done = false and payload = '{}';`),
+	},
+	{
+		Version: 6,
+		Up: zergrepo.Query(`create table notification_settings
+(
+    user_id    integer not null references users on delete cascade,
+    kind       text    not null,
+    enabled    boolean not null,
+    updated_at ` + timestampNow + `,
+
+    primary key (user_id, kind)
+)`),
+	},
+	{
+		Version: 7,
+		Up: zergrepo.Query(`create table webhooks
+(
+    id         integer primary key autoincrement,
+    url        text not null,
+    secret     text not null,
+    events     text not null,
+    created_at ` + timestampNow + `
+);
+
+create table webhook_events
+(
+    id         integer primary key autoincrement,
+    type       text    not null,
+    user_id    integer not null,
+    email      text    not null,
+    created_at ` + timestampNow + `
+);
+
+create table webhook_deliveries
+(
+    id              integer primary key autoincrement,
+    webhook_id      integer not null references webhooks on delete cascade,
+    event_id        integer not null references webhook_events on delete cascade,
+    status          text    not null default 'pending',
+    attempts        integer not null default 0,
+    status_code     integer not null default 0,
+    error           text    not null default '',
+    next_attempt_at ` + timestampNow + `,
+    delivered_at    timestamp,
+    created_at      ` + timestampNow + `
+);
+
+create index webhook_deliveries_pending_idx on webhook_deliveries (next_attempt_at) where status = 'pending';`),
+	},
+	{
+		// SQLite can't change the foreign key, so webhook_deliveries is rebuilt.
+		Version: 8,
+		Up: zergrepo.Query(`create table events
+(
+    id           integer primary key autoincrement,
+    type         text    not null,
+    user_id      integer not null,
+    email        text    not null,
+    created_at   ` + timestampNow + `,
+    published_at timestamp
+);
+
+create index events_unpublished_idx on events (id) where published_at is null;
+
+insert into events (id, type, user_id, email, created_at, published_at)
+select id, type, user_id, email, created_at, ` + now + ` from webhook_events;
+
+create table webhook_deliveries_new
+(
+    id              integer primary key autoincrement,
+    webhook_id      integer not null references webhooks on delete cascade,
+    event_id        integer not null references events on delete cascade,
+    status          text    not null default 'pending',
+    attempts        integer not null default 0,
+    status_code     integer not null default 0,
+    error           text    not null default '',
+    next_attempt_at ` + timestampNow + `,
+    delivered_at    timestamp,
+    created_at      ` + timestampNow + `
+);
+
+insert into webhook_deliveries_new select * from webhook_deliveries;
+drop table webhook_deliveries;
+alter table webhook_deliveries_new rename to webhook_deliveries;
+create index webhook_deliveries_pending_idx on webhook_deliveries (next_attempt_at) where status = 'pending';
+
+drop table webhook_events;`),
+	},
+	{
+		Version: 9,
+		Up: zergrepo.Query(`alter table notifications add column error text not null default '';
+alter table notifications add column cancelled_at timestamp;
+
+create index notifications_email_idx on notifications (email);`),
+	},
+	{
+		// The default of the added column must be constant, run_at is set by the update.
+		Version: 10,
+		Up: zergrepo.Query(`alter table notifications add column run_at timestamp not null default '';
+
+update notifications set run_at = created_at;
+
+create index notifications_pending_idx on notifications (run_at) where is_done = false;
+create index events_user_type_idx on events (user_id, type);`),
+	},
+	{
+		Version: 11,
+		Up: zergrepo.Query(`create table user_notifications
+(
+    id         integer primary key autoincrement,
+    user_id    integer not null references users on delete cascade,
+    task_id    integer unique,
+    kind       text    not null,
+    content    text    not null,
+    read_at    timestamp,
+    created_at ` + timestampNow + `
+);
+
+create index user_notifications_user_idx on user_notifications (user_id, id);
+create index user_notifications_unread_idx on user_notifications (user_id) where read_at is null;`),
+	},
+	{
+		Version: 12,
+		Up: zergrepo.Query(`create table email_events
+(
+    id          integer primary key autoincrement,
+    external_id text not null unique,
+    email       text not null,
+    kind        text not null,
+    reason      text not null,
+    created_at  ` + timestampNow + `
+);
+
+create index email_events_email_idx on email_events (email, created_at);`),
+	},
+	{
+		Version: 13,
+		Up:      zergrepo.Query(`alter table notifications add column provider text not null default ''`),
+	},
+	{
+		// The added column can't be unique, the constraint is the unique index.
+		Version: 14,
+		Up: zergrepo.Query(`alter table users add column phone text;
+create unique index users_phone_key on users (phone);
+
+alter table recovery_code add column phone text;`),
+	},
+	{
+		Version: 15,
+		Up: zergrepo.Query(`create table jobs
+(
+    id           integer primary key autoincrement,
+    kind         text    not null,
+    payload      text    not null,
+    priority     integer not null default 0,
+    unique_key   text,
+    run_at       ` + timestampNow + `,
+    attempts     integer not null default 0,
+    status       text    not null default 'pending',
+    error        text    not null default '',
+    locked_until timestamp,
+    created_at   ` + timestampNow + `,
+    finished_at  timestamp
+);
+
+create unique index jobs_unique_key_idx on jobs (unique_key) where status = 'pending';
+create index jobs_pending_idx on jobs (priority desc, run_at) where status = 'pending';
+
+insert into jobs (kind, payload, priority, unique_key, run_at)
+select 'notification',
+       json_object('task_id', id),
+       case when kind in ('ChangeEmail', 'PassRecovery', 'PassRecoverySMS', 'PhoneVerification') then 10 else 0 end,
+       'notification:' || id,
+       run_at
+from notifications
+where is_done = false;
+
+insert into jobs (kind, payload, priority, unique_key, run_at)
+select 'webhook_delivery', json_object('delivery_id', id), 0, 'webhook_delivery:' || id, next_attempt_at
+from webhook_deliveries
+where status = 'pending';`),
+	},
+	{
+		Version: 16,
+		Up: zergrepo.Query(`create index sessions_logout_idx on sessions (created_at) where is_logout = true;
+create index recovery_code_created_at_idx on recovery_code (created_at);
+create index notifications_done_idx on notifications (coalesce(exec_time, cancelled_at, created_at)) where is_done = true;
+create index jobs_finished_idx on jobs (finished_at) where status <> 'pending';`),
+	},
+	{
+		Version: 17,
+		Up: zergrepo.Query(`create table leaders
+(
+    election   text    not null primary key,
+    holder     text    not null,
+    term       integer not null,
+    expires_at timestamp not null
+)`),
+	},
+	{
+		Version: 18,
+		Up:      zergrepo.Query(`alter table users add column version integer not null default 1`),
+	},
+	{
+		// SQLite can't drop the unique constraint and change the foreign key,
+		// so users, notifications and recovery_code are rebuilt.
+		Version: 19,
+		Up: zergrepo.Query(`create table tenants
+(
+    id         integer primary key autoincrement,
+    name       text not null unique,
+    host       text unique,
+    created_at ` + timestampNow + `
+);
+
+insert into tenants (name) values ('default');
+
+create table users_new
+(
+    id         integer primary key autoincrement,
+    tenant_id  integer not null references tenants,
+    email      text    not null,
+    username   text    not null,
+    pass_hash  blob,
+    created_at ` + timestampNow + `,
+    updated_at ` + timestampNow + `,
+    phone      text,
+    version    integer not null default 1
+);
+
+insert into users_new (id, tenant_id, email, username, pass_hash, created_at, updated_at, phone, version)
+select id, 1, email, username, pass_hash, created_at, updated_at, phone, version from users;
+drop table users;
+alter table users_new rename to users;
+
+create unique index users_email_key on users (tenant_id, email);
+create unique index users_username_key on users (tenant_id, username);
+create unique index users_phone_key on users (tenant_id, phone);
+
+create table notifications_new
+(
+    id           integer primary key autoincrement,
+    tenant_id    integer   not null,
+    email        text      not null,
+    kind         text      not null,
+    is_done      boolean   not null default false,
+    created_at   ` + timestampNow + `,
+    exec_time    timestamp,
+    payload      text      not null default '{}',
+    error        text      not null default '',
+    cancelled_at timestamp,
+    run_at       timestamp not null,
+    provider     text      not null default '',
+
+    foreign key (tenant_id, email) references users (tenant_id, email) on delete cascade on update cascade
+);
+
+insert into notifications_new (id, tenant_id, email, kind, is_done, created_at, exec_time, payload, error,
+                               cancelled_at, run_at, provider)
+select id, 1, email, kind, is_done, created_at, exec_time, payload, error, cancelled_at, run_at, provider
+from notifications;
+drop table notifications;
+alter table notifications_new rename to notifications;
+
+create index notifications_email_idx on notifications (tenant_id, email);
+create index notifications_pending_idx on notifications (run_at) where is_done = false;
+create index notifications_done_idx on notifications (coalesce(exec_time, cancelled_at, created_at)) where is_done = true;
+
+create table recovery_code_new
+(
+    id         integer primary key autoincrement,
+    tenant_id  integer not null,
+    email      text    not null,
+    code       text    not null unique,
+    created_at ` + timestampNow + `,
+    phone      text,
+
+    foreign key (tenant_id, email) references users (tenant_id, email) on delete cascade
+);
+
+insert into recovery_code_new (id, tenant_id, email, code, created_at, phone)
+select id, 1, email, code, created_at, phone from recovery_code;
+drop table recovery_code;
+alter table recovery_code_new rename to recovery_code;
+
+create index recovery_code_created_at_idx on recovery_code (created_at);`),
+	},
+	{
+		Version: 20,
+		Up: zergrepo.Query(`create table orgs
+(
+    id         integer primary key autoincrement,
+    tenant_id  integer not null references tenants,
+    name       text    not null,
+    created_at ` + timestampNow + `
+);
+
+create table org_members
+(
+    org_id     integer not null references orgs on delete cascade,
+    user_id    integer not null references users on delete cascade,
+    role       text    not null,
+    created_at ` + timestampNow + `,
+
+    primary key (org_id, user_id)
+);
+
+create unique index org_members_owner_idx on org_members (org_id) where role = 'owner';
+create index org_members_user_id_idx on org_members (user_id);
+
+create table org_invitations
+(
+    id         integer primary key autoincrement,
+    org_id     integer not null references orgs on delete cascade,
+    email      text    not null,
+    role       text    not null,
+    token      text    not null unique,
+    invited_by integer references users on delete set null,
+    created_at ` + timestampNow + `
+);
+
+create index org_invitations_org_id_email_idx on org_invitations (org_id, email);`),
+	},
+	{
+		// SQLite can't add the not null column without the default, existing users get random UUIDs of version 4.
+		Version: 21,
+		Up: zergrepo.Query(`alter table users add column public_id text not null default '';
+
+update users set public_id = lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' ||
+    substr(hex(randomblob(2)), 2) || '-' || substr('89ab', 1 + abs(random()) % 4, 1) ||
+    substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)));
+
+create unique index users_public_id_key on users (public_id);
+
+alter table events add column user_public_id text not null default '';
+
+update events set user_public_id = coalesce((select public_id from users where users.id = events.user_id), '');`),
+	},
+	{
+		// SQLite can't drop the unique constraint, so email_events is rebuilt.
+		Version: 23,
+		Up: zergrepo.Query(`create table email_events_new
+(
+    id          integer primary key autoincrement,
+    external_id text not null,
+    email       text not null,
+    kind        text not null,
+    reason      text not null,
+    created_at  ` + timestampNow + `
+);
+
+insert into email_events_new (id, external_id, email, kind, reason, created_at)
+select id, external_id, email, kind, reason, created_at from email_events;
+
+drop table email_events;
+alter table email_events_new rename to email_events;
+
+create index email_events_email_idx on email_events (email, created_at);
+create unique index email_events_external_id_idx on email_events (external_id) where external_id <> '';`),
+	},
+	{
+		Version: 25,
+		Up: zergrepo.Query(`alter table sessions add column logout_at timestamp;
+
+update sessions set logout_at = ` + now + ` where is_logout = true;
+
+drop index sessions_logout_idx;
+create index sessions_logout_at_idx on sessions (logout_at) where is_logout = true;`),
+	},
+	{
+		// SQLite can't drop the foreign key, so notifications are rebuilt.
+		// Invitations are sent to emails without users, so tasks don't reference users.
+		Version: 26,
+		Up: zergrepo.Query(`create table notifications_new
+(
+    id           integer primary key autoincrement,
+    tenant_id    integer   not null,
+    email        text      not null,
+    kind         text      not null,
+    is_done      boolean   not null default false,
+    created_at   ` + timestampNow + `,
+    exec_time    timestamp,
+    payload      text      not null default '{}',
+    error        text      not null default '',
+    cancelled_at timestamp,
+    run_at       timestamp not null,
+    provider     text      not null default ''
+);
+
+insert into notifications_new (id, tenant_id, email, kind, is_done, created_at, exec_time, payload, error,
+                               cancelled_at, run_at, provider)
+select id, tenant_id, email, kind, is_done, created_at, exec_time, payload, error, cancelled_at, run_at, provider
+from notifications;
+drop table notifications;
+alter table notifications_new rename to notifications;
+
+create index notifications_email_idx on notifications (tenant_id, email);
+create index notifications_pending_idx on notifications (run_at) where is_done = false;
+create index notifications_done_idx on notifications (coalesce(exec_time, cancelled_at, created_at)) where is_done = true;`),
+	},
+}
+
+// Migrate applies migrations which haven't been applied yet, every version in its own transaction.
+// Foreign keys are disabled during migrations, so tables can be rebuilt,
+// and are checked before every version is committed.
+func Migrate(ctx context.Context, db *sqlx.DB) (err error) {
+	const queryInit = `create table if not exists migration
+(
+    version integer primary key,
+    time    ` + timestampNow + `
+)`
+
+	_, err = db.ExecContext(ctx, queryInit)
+	if err != nil {
+		return fmt.Errorf("init table: %w", err)
+	}
+
+	// The pragma is ignored inside transactions and applies only to the connection.
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close() // nolint:errcheck
+
+	_, err = conn.ExecContext(ctx, `PRAGMA foreign_keys = off`)
+	if err != nil {
+		return fmt.Errorf("disable foreign keys: %w", err)
+	}
+	defer func() {
+		_, errEnable := conn.ExecContext(ctx, `PRAGMA foreign_keys = on`)
+		if errEnable != nil && err == nil {
+			err = fmt.Errorf("enable foreign keys: %w", errEnable)
+		}
+	}()
+
+	for _, migrate := range migrations {
+		err = up(ctx, db, conn, migrate)
+		if err != nil {
+			return fmt.Errorf("up %d: %w", migrate.Version, err)
+		}
+	}
+
+	return nil
+}
+
+func up(ctx context.Context, db *sqlx.DB, conn *sql.Conn, migrate zergrepo.Migrate) error {
+	sqlTx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlTx.Rollback() // nolint:errcheck
+
+	// sqlx can't begin the transaction on the connection, queries of migrations don't need the driver name.
+	tx := &sqlx.Tx{Tx: sqlTx, Mapper: db.Mapper}
+
+	const queryApplied = `SELECT count(*) FROM migration WHERE version = ?`
+
+	applied := 0
+	err = tx.GetContext(ctx, &applied, queryApplied, migrate.Version)
+	if err != nil {
+		return fmt.Errorf("get version: %w", err)
+	}
+	if applied > 0 {
+		return nil
+	}
+
+	err = migrate.Up(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	err = foreignKeyCheck(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	const queryVersion = `INSERT INTO migration (version) VALUES (?)`
+
+	_, err = tx.ExecContext(ctx, queryVersion, migrate.Version)
+	if err != nil {
+		return fmt.Errorf("insert new version: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// foreignKeyCheck returns the error if any row violates foreign keys.
+func foreignKeyCheck(ctx context.Context, tx *sqlx.Tx) error {
+	var violations []struct {
+		Table  string        `db:"table"`
+		RowID  sql.NullInt64 `db:"rowid"`
+		Parent string        `db:"parent"`
+		FKID   int           `db:"fkid"`
+	}
+
+	err := tx.SelectContext(ctx, &violations, `PRAGMA foreign_key_check`)
+	if err != nil {
+		return fmt.Errorf("foreign key check: %w", err)
+	}
+	if len(violations) > 0 {
+		v := violations[0]
+		return fmt.Errorf("%w: %s row %d references %s", errForeignKey, v.Table, v.RowID.Int64, v.Parent)
+	}
+
+	return nil
+}
@@ -0,0 +1,528 @@
+package sqlite
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SQLite has no time type, timestamps are kept as UTC text in the format of now,
+// so they are compared as strings. The expression has no colons, so it can be used in named queries.
+const (
+	now        = `datetime('now', 'subsec')`
+	timeFormat = "2006-01-02 15:04:05.000"
+)
+
+// timestamp returns the time in the format of timestamps.
+func timestamp(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
+
+// nullTimestamp returns nil for the zero time.
+func nullTimestamp(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamp(t)
+}
+
+// stringArray is kept as JSON array, it replaces the Postgres array.
+type stringArray []string
+
+// Scan implements sql.Scanner.
+func (a *stringArray) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(src), a)
+	case []byte:
+		return json.Unmarshal(src, a)
+	default:
+		return fmt.Errorf("unsupported type %T", src)
+	}
+}
+
+// Value implements driver.Valuer.
+func (a stringArray) Value() (driver.Value, error) {
+	if a == nil {
+		return "[]", nil
+	}
+
+	buf, err := json.Marshal([]string(a))
+	if err != nil {
+		return nil, err
+	}
+
+	return string(buf), nil
+}
+
+// intArray returns ids as JSON array for json_each.
+func intArray(ids []int) (string, error) {
+	if ids == nil {
+		ids = []int{}
+	}
+
+	buf, err := json.Marshal(ids)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+type (
+	userDBFormat struct {
+		ID        app.UserID     `db:"id"`
+		PublicID  app.PublicID   `db:"public_id"`
+		TenantID  app.TenantID   `db:"tenant_id"`
+		Email     string         `db:"email"`
+		Username  string         `db:"username"`
+		PassHash  []byte         `db:"pass_hash"`
+		Phone     sql.NullString `db:"phone"`
+		Version   int            `db:"version"`
+		CreatedAt time.Time      `db:"created_at"`
+		UpdatedAt time.Time      `db:"updated_at"`
+	}
+
+	// sessionDBFormat keeps IP as text instead of inet.
+	sessionDBFormat struct {
+		ID        app.SessionID `db:"id"`
+		UserID    app.UserID    `db:"user_id"`
+		TokenID   app.AuthToken `db:"token_id"`
+		IP        string        `db:"ip"`
+		UserAgent string        `db:"user_agent"`
+		IsLogout  bool          `db:"is_logout"`
+		CreatedAt time.Time     `db:"created_at"`
+	}
+
+	// authUserDBFormat is the user joined with the session.
+	authUserDBFormat struct {
+		userDBFormat
+		SessionID app.SessionID `db:"session_id"`
+		TokenID   app.AuthToken `db:"token_id"`
+		IP        string        `db:"ip"`
+		UserAgent string        `db:"user_agent"`
+	}
+
+	codeInfoDBFormat struct {
+		ID        int            `db:"id"`
+		TenantID  app.TenantID   `db:"tenant_id"`
+		Code      string         `db:"code"`
+		Email     string         `db:"email"`
+		Phone     sql.NullString `db:"phone"`
+		CreatedAt time.Time      `db:"created_at"`
+	}
+
+	taskNotificationDBFormat struct {
+		ID       int          `db:"id"`
+		TenantID app.TenantID `db:"tenant_id"`
+		Email    string       `db:"email"`
+		Kind     string       `db:"kind"`
+		Payload  []byte       `db:"payload"`
+		RunAt    time.Time    `db:"run_at"`
+	}
+
+	taskNotificationInfoDBFormat struct {
+		taskNotificationDBFormat
+		IsDone      bool       `db:"is_done"`
+		Error       string     `db:"error"`
+		CreatedAt   time.Time  `db:"created_at"`
+		ExecTime    *time.Time `db:"exec_time"`
+		CancelledAt *time.Time `db:"cancelled_at"`
+		Provider    string     `db:"provider"`
+	}
+
+	userNotificationDBFormat struct {
+		ID        int        `db:"id"`
+		Kind      string     `db:"kind"`
+		Content   string     `db:"content"`
+		ReadAt    *time.Time `db:"read_at"`
+		CreatedAt time.Time  `db:"created_at"`
+	}
+
+	notificationSettingDBFormat struct {
+		Kind    string `db:"kind"`
+		Enabled bool   `db:"enabled"`
+	}
+
+	tenantDBFormat struct {
+		ID        app.TenantID   `db:"id"`
+		Name      string         `db:"name"`
+		Host      sql.NullString `db:"host"`
+		CreatedAt time.Time      `db:"created_at"`
+	}
+
+	orgDBFormat struct {
+		ID        app.OrgID    `db:"id"`
+		TenantID  app.TenantID `db:"tenant_id"`
+		Name      string       `db:"name"`
+		CreatedAt time.Time    `db:"created_at"`
+	}
+
+	orgMembershipDBFormat struct {
+		orgDBFormat
+		Role string `db:"role"`
+	}
+
+	orgMemberDBFormat struct {
+		OrgID        app.OrgID    `db:"org_id"`
+		UserID       app.UserID   `db:"user_id"`
+		UserPublicID app.PublicID `db:"public_id"`
+		Username     string       `db:"username"`
+		Email        string       `db:"email"`
+		Role         string       `db:"role"`
+		CreatedAt    time.Time    `db:"created_at"`
+	}
+
+	orgInvitationDBFormat struct {
+		ID        int           `db:"id"`
+		OrgID     app.OrgID     `db:"org_id"`
+		Email     string        `db:"email"`
+		Role      string        `db:"role"`
+		Token     string        `db:"token"`
+		InvitedBy sql.NullInt64 `db:"invited_by"`
+		CreatedAt time.Time     `db:"created_at"`
+	}
+
+	webhookDBFormat struct {
+		ID        app.WebhookID `db:"id"`
+		URL       string        `db:"url"`
+		Secret    string        `db:"secret"`
+		Events    stringArray   `db:"events"`
+		CreatedAt time.Time     `db:"created_at"`
+	}
+
+	eventDBFormat struct {
+		ID           int          `db:"id"`
+		Type         string       `db:"type"`
+		UserID       app.UserID   `db:"user_id"`
+		UserPublicID app.PublicID `db:"user_public_id"`
+		Email        string       `db:"email"`
+		CreatedAt    time.Time    `db:"created_at"`
+	}
+
+	emailEventDBFormat struct {
+		ExternalID string    `db:"external_id"`
+		Email      string    `db:"email"`
+		Kind       string    `db:"kind"`
+		Reason     string    `db:"reason"`
+		CreatedAt  time.Time `db:"created_at"`
+	}
+
+	jobDBFormat struct {
+		ID        int            `db:"id"`
+		Kind      string         `db:"kind"`
+		Payload   []byte         `db:"payload"`
+		Priority  int            `db:"priority"`
+		UniqueKey sql.NullString `db:"unique_key"`
+		RunAt     time.Time      `db:"run_at"`
+		Attempts  int            `db:"attempts"`
+	}
+
+	webhookDeliveryDBFormat struct {
+		ID                int           `db:"id"`
+		Status            string        `db:"status"`
+		Attempts          int           `db:"attempts"`
+		StatusCode        int           `db:"status_code"`
+		Error             string        `db:"error"`
+		NextAttemptAt     time.Time     `db:"next_attempt_at"`
+		DeliveredAt       *time.Time    `db:"delivered_at"`
+		WebhookID         app.WebhookID `db:"webhook_id"`
+		WebhookURL        string        `db:"webhook_url"`
+		WebhookSecret     string        `db:"webhook_secret"`
+		WebhookEvents     stringArray   `db:"webhook_events"`
+		WebhookCreatedAt  time.Time     `db:"webhook_created_at"`
+		EventID           int           `db:"event_id"`
+		EventType         string        `db:"event_type"`
+		EventUserID       app.UserID    `db:"event_user_id"`
+		EventUserPublicID app.PublicID  `db:"event_user_public_id"`
+		EventEmail        string        `db:"event_email"`
+		EventCreatedAt    time.Time     `db:"event_created_at"`
+	}
+)
+
+func (val *userDBFormat) toAppFormat() *app.User {
+	return &app.User{
+		ID:        val.ID,
+		PublicID:  val.PublicID,
+		TenantID:  val.TenantID,
+		Email:     val.Email,
+		Name:      val.Username,
+		PassHash:  val.PassHash,
+		Phone:     val.Phone.String,
+		Version:   val.Version,
+		CreatedAt: val.CreatedAt,
+		UpdatedAt: val.UpdatedAt,
+	}
+}
+
+func (val *authUserDBFormat) toAppFormat() *app.AuthUser {
+	session := sessionDBFormat{
+		ID:        val.SessionID,
+		UserID:    val.ID,
+		TokenID:   val.TokenID,
+		IP:        val.IP,
+		UserAgent: val.UserAgent,
+	}
+
+	return &app.AuthUser{
+		User:    *val.userDBFormat.toAppFormat(),
+		Session: *session.toAppFormat(),
+	}
+}
+
+func (val *sessionDBFormat) toAppFormat() *app.Session {
+	return &app.Session{
+		Origin: app.Origin{
+			IP:        net.ParseIP(val.IP),
+			UserAgent: val.UserAgent,
+		},
+		ID:      val.ID,
+		TokenID: app.TokenID(val.TokenID),
+	}
+}
+
+// inet returns the text form of IP, the unspecified IP is kept as empty string.
+func inet(ip net.IP) string {
+	if ip == nil || ip.IsUnspecified() {
+		return ""
+	}
+
+	return ip.String()
+}
+
+func (val *taskNotificationDBFormat) toAppFormat() (*app.TaskNotification, error) {
+	kind, err := app.ParseMessageKind(val.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := kind.NewPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(val.Payload, payload)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	return &app.TaskNotification{
+		ID:       val.ID,
+		TenantID: val.TenantID,
+		Email:    val.Email,
+		Kind:     kind,
+		Payload:  payload,
+		RunAt:    val.RunAt,
+	}, nil
+}
+
+func (val *orgDBFormat) toAppFormat() *app.Org {
+	return &app.Org{
+		ID:        val.ID,
+		TenantID:  val.TenantID,
+		Name:      val.Name,
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func (val *orgMembershipDBFormat) toAppFormat() *app.OrgMembership {
+	return &app.OrgMembership{
+		Org:  *val.orgDBFormat.toAppFormat(),
+		Role: app.OrgRole(val.Role),
+	}
+}
+
+func (val *orgMemberDBFormat) toAppFormat() *app.OrgMember {
+	return &app.OrgMember{
+		OrgID:        val.OrgID,
+		UserID:       val.UserID,
+		UserPublicID: val.UserPublicID,
+		Username:     val.Username,
+		Email:        val.Email,
+		Role:         app.OrgRole(val.Role),
+		CreatedAt:    val.CreatedAt,
+	}
+}
+
+func (val *orgInvitationDBFormat) toAppFormat() *app.OrgInvitation {
+	return &app.OrgInvitation{
+		ID:        val.ID,
+		OrgID:     val.OrgID,
+		Email:     val.Email,
+		Role:      app.OrgRole(val.Role),
+		Token:     val.Token,
+		InvitedBy: app.UserID(val.InvitedBy.Int64),
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func (val *tenantDBFormat) toAppFormat() *app.Tenant {
+	return &app.Tenant{
+		ID:        val.ID,
+		Name:      val.Name,
+		Host:      val.Host.String,
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func (val *taskNotificationInfoDBFormat) toAppFormat() (*app.TaskNotificationInfo, error) {
+	task, err := val.taskNotificationDBFormat.toAppFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &app.TaskNotificationInfo{
+		TaskNotification: *task,
+		Status:           app.TaskPending,
+		Error:            val.Error,
+		CreatedAt:        val.CreatedAt,
+		Provider:         val.Provider,
+	}
+	switch {
+	case val.CancelledAt != nil:
+		info.Status = app.TaskCancelled
+	case val.IsDone:
+		info.Status = app.TaskDone
+	}
+	if val.ExecTime != nil {
+		info.ExecTime = *val.ExecTime
+	}
+
+	return info, nil
+}
+
+func (val *userNotificationDBFormat) toAppFormat() (*app.UserNotification, error) {
+	kind, err := app.ParseMessageKind(val.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &app.UserNotification{
+		ID:        val.ID,
+		Kind:      kind,
+		Content:   val.Content,
+		Read:      val.ReadAt != nil,
+		CreatedAt: val.CreatedAt,
+	}, nil
+}
+
+func (val *notificationSettingDBFormat) toAppFormat() (*app.NotificationSetting, error) {
+	kind, err := app.ParseMessageKind(val.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &app.NotificationSetting{
+		Kind:    kind,
+		Enabled: val.Enabled,
+	}, nil
+}
+
+func (val *codeInfoDBFormat) toAppFormat() *app.CodeInfo {
+	return &app.CodeInfo{
+		Code:      val.Code,
+		Email:     val.Email,
+		Phone:     val.Phone.String,
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func eventTypes(events stringArray) []app.EventType {
+	res := make([]app.EventType, len(events))
+	for i := range events {
+		res[i] = app.EventType(events[i])
+	}
+
+	return res
+}
+
+func (val *webhookDBFormat) toAppFormat() *app.Webhook {
+	return &app.Webhook{
+		ID:        val.ID,
+		URL:       val.URL,
+		Secret:    val.Secret,
+		Events:    eventTypes(val.Events),
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func (val *eventDBFormat) toAppFormat() *app.Event {
+	return &app.Event{
+		ID:           val.ID,
+		Type:         app.EventType(val.Type),
+		UserID:       val.UserID,
+		UserPublicID: val.UserPublicID,
+		Email:        val.Email,
+		CreatedAt:    val.CreatedAt,
+	}
+}
+
+func (val *webhookDeliveryDBFormat) toAppFormat() *app.WebhookDelivery {
+	delivery := &app.WebhookDelivery{
+		ID: val.ID,
+		Webhook: app.Webhook{
+			ID:        val.WebhookID,
+			URL:       val.WebhookURL,
+			Secret:    val.WebhookSecret,
+			Events:    eventTypes(val.WebhookEvents),
+			CreatedAt: val.WebhookCreatedAt,
+		},
+		Event: app.Event{
+			ID:           val.EventID,
+			Type:         app.EventType(val.EventType),
+			UserID:       val.EventUserID,
+			UserPublicID: val.EventUserPublicID,
+			Email:        val.EventEmail,
+			CreatedAt:    val.EventCreatedAt,
+		},
+		Status:        app.DeliveryStatus(val.Status),
+		Attempts:      val.Attempts,
+		StatusCode:    val.StatusCode,
+		Error:         val.Error,
+		NextAttemptAt: val.NextAttemptAt,
+	}
+	if val.DeliveredAt != nil {
+		delivery.DeliveredAt = *val.DeliveredAt
+	}
+
+	return delivery
+}
+
+func (val *emailEventDBFormat) toAppFormat() *app.EmailEvent {
+	return &app.EmailEvent{
+		ExternalID: val.ExternalID,
+		Email:      val.Email,
+		Kind:       app.EmailEventKind(val.Kind),
+		Reason:     val.Reason,
+		CreatedAt:  val.CreatedAt,
+	}
+}
+
+func (val *jobDBFormat) toAppFormat() (*app.Job, error) {
+	kind := app.JobKind(val.Kind)
+	payload, err := kind.NewPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(val.Payload, payload)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	return &app.Job{
+		ID:        val.ID,
+		Kind:      kind,
+		Payload:   payload,
+		Priority:  val.Priority,
+		RunAt:     val.RunAt,
+		UniqueKey: val.UniqueKey.String,
+		Attempts:  val.Attempts,
+	}, nil
+}
@@ -0,0 +1,250 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateOrg need for implements app.OrgRepo.
+func (repo *Repo) CreateOrg(ctx context.Context, org app.Org, ownerID app.UserID) (id app.OrgID, err error) {
+	err = repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `INSERT INTO orgs (tenant_id, name) VALUES (?1, ?2) RETURNING id`
+
+		err := tx.QueryRowxContext(ctx, query, org.TenantID, org.Name).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("create org: %w", err)
+		}
+
+		return createOrgMember(ctx, tx, id, ownerID, app.OrgRoleOwner)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func createOrgMember(ctx context.Context, tx *sqlx.Tx, orgID app.OrgID, userID app.UserID, role app.OrgRole) error {
+	const query = `INSERT INTO org_members (org_id, user_id, role) VALUES (?1, ?2, ?3)`
+
+	_, err := tx.ExecContext(ctx, query, orgID, userID, role)
+	if err != nil {
+		return fmt.Errorf("create org member: %w", err)
+	}
+
+	return nil
+}
+
+// OrgByID need for implements app.OrgRepo.
+func (repo *Repo) OrgByID(ctx context.Context, id app.OrgID) (org *app.Org, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM orgs WHERE id = ?`
+
+		res := &orgDBFormat{}
+		err = db.GetContext(ctx, res, query, id)
+		if err != nil {
+			return err
+		}
+
+		org = res.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// UserOrgs need for implements app.OrgRepo.
+func (repo *Repo) UserOrgs(ctx context.Context, userID app.UserID) (orgs []app.OrgMembership, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT orgs.*, org_members.role FROM orgs
+		JOIN org_members ON org_members.org_id = orgs.id
+		WHERE org_members.user_id = ?
+		ORDER BY orgs.id`
+
+		var res []orgMembershipDBFormat
+		err = db.SelectContext(ctx, &res, query, userID)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		orgs = make([]app.OrgMembership, len(res))
+		for i := range res {
+			orgs[i] = *res[i].toAppFormat()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+// OrgMember need for implements app.OrgRepo.
+func (repo *Repo) OrgMember(ctx context.Context, orgID app.OrgID, userID app.UserID) (member *app.OrgMember, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT org_members.*, users.public_id, users.username, users.email FROM org_members
+		JOIN users ON users.id = org_members.user_id
+		WHERE org_members.org_id = ?1 AND org_members.user_id = ?2`
+
+		res := &orgMemberDBFormat{}
+		err = db.GetContext(ctx, res, query, orgID, userID)
+		if err != nil {
+			return err
+		}
+
+		member = res.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// OrgMembers need for implements app.OrgRepo.
+func (repo *Repo) OrgMembers(ctx context.Context, orgID app.OrgID, page app.Page) (members []app.OrgMember, total int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT org_members.*, users.public_id, users.username, users.email FROM org_members
+		JOIN users ON users.id = org_members.user_id
+		WHERE org_members.org_id = ?1
+		ORDER BY org_members.created_at, org_members.user_id LIMIT ?2 OFFSET ?3`
+
+		res := make([]orgMemberDBFormat, 0, page.Limit)
+		err = db.SelectContext(ctx, &res, query, orgID, page.Limit, page.Offset)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		const getTotal = `SELECT count(*) FROM org_members WHERE org_id = ?1`
+		err = db.GetContext(ctx, &total, getTotal, orgID)
+		if err != nil {
+			return fmt.Errorf("get total: %w", err)
+		}
+
+		members = make([]app.OrgMember, len(res))
+		for i := range res {
+			members[i] = *res[i].toAppFormat()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return members, total, nil
+}
+
+// DeleteOrgMember need for implements app.OrgRepo.
+func (repo *Repo) DeleteOrgMember(ctx context.Context, orgID app.OrgID, userID app.UserID) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `DELETE FROM org_members WHERE org_id = ?1 AND user_id = ?2`
+
+		res, err := db.ExecContext(ctx, query, orgID, userID)
+		if err != nil {
+			return fmt.Errorf("delete org member: %w", err)
+		}
+
+		count, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %w", err)
+		}
+		if count == 0 {
+			return app.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// TransferOrgOwnership need for implements app.OrgRepo.
+func (repo *Repo) TransferOrgOwnership(ctx context.Context, orgID app.OrgID, ownerID, userID app.UserID) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		// The owner is demoted first, because the organization can't have two owners at once.
+		err := updateOrgMemberRole(ctx, tx, orgID, ownerID, app.OrgRoleOwner, app.OrgRoleAdmin)
+		if err != nil {
+			return err
+		}
+
+		return updateOrgMemberRole(ctx, tx, orgID, userID, "", app.OrgRoleOwner)
+	})
+}
+
+// updateOrgMemberRole changes the role of the member, the current role is checked if it isn't empty.
+func updateOrgMemberRole(ctx context.Context, tx *sqlx.Tx, orgID app.OrgID, userID app.UserID, from, to app.OrgRole) error {
+	const query = `UPDATE org_members SET role = ?1 WHERE org_id = ?2 AND user_id = ?3 AND (?4 = '' OR role = ?4)`
+
+	res, err := tx.ExecContext(ctx, query, to, orgID, userID, from)
+	if err != nil {
+		return fmt.Errorf("update org member role: %w", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if count == 0 {
+		return app.ErrNotFound
+	}
+
+	return nil
+}
+
+// CreateOrgInvitation need for implements app.OrgRepo.
+func (repo *Repo) CreateOrgInvitation(ctx context.Context, invitation app.OrgInvitation, task app.TaskNotification) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const queryClean = `DELETE FROM org_invitations WHERE org_id = ?1 AND email = ?2`
+
+		_, err := tx.ExecContext(ctx, queryClean, invitation.OrgID, invitation.Email)
+		if err != nil {
+			return fmt.Errorf("delete org invitations: %w", err)
+		}
+
+		const query = `INSERT INTO org_invitations (org_id, email, role, token, invited_by)
+		VALUES (?1, ?2, ?3, ?4, nullif(?5, 0))`
+
+		_, err = tx.ExecContext(ctx, query,
+			invitation.OrgID, invitation.Email, invitation.Role, invitation.Token, invitation.InvitedBy)
+		if err != nil {
+			return fmt.Errorf("create org invitation: %w", err)
+		}
+
+		_, err = createTaskNotification(ctx, tx, task)
+
+		return err
+	})
+}
+
+// OrgInvitationByToken need for implements app.OrgRepo.
+func (repo *Repo) OrgInvitationByToken(ctx context.Context, token string) (invitation *app.OrgInvitation, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM org_invitations WHERE token = ?`
+
+		res := &orgInvitationDBFormat{}
+		err = db.GetContext(ctx, res, query, token)
+		if err != nil {
+			return err
+		}
+
+		invitation = res.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// AcceptOrgInvitation need for implements app.OrgRepo.
+func (repo *Repo) AcceptOrgInvitation(ctx context.Context, invitationID int, userID app.UserID) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `DELETE FROM org_invitations WHERE id = ? RETURNING org_id, role`
+
+		orgID, role := app.OrgID(0), app.OrgRole("")
+		err := tx.QueryRowxContext(ctx, query, invitationID).Scan(&orgID, &role)
+		if err != nil {
+			return fmt.Errorf("delete org invitation: %w", err)
+		}
+
+		// The mapper converts the violation of the primary key to app.ErrOrgMemberExist.
+		return createOrgMember(ctx, tx, orgID, userID, role)
+	})
+}
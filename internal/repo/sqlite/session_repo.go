@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// SaveSession need for implements app.SessionRepo.
+func (repo *Repo) SaveSession(ctx context.Context, userID app.UserID, tokenID app.TokenID, origin app.Origin) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO sessions (user_id, token_id, ip, user_agent) VALUES (:user_id,:token_id,:ip,:user_agent)`
+		type args struct {
+			UserID    app.UserID  `db:"user_id"`
+			TokenID   app.TokenID `db:"token_id"`
+			IP        string      `db:"ip"`
+			UserAgent string      `db:"user_agent"`
+		}
+
+		_, err := db.NamedExecContext(ctx, query, args{
+			UserID:    userID,
+			TokenID:   tokenID,
+			IP:        inet(origin.IP),
+			UserAgent: origin.UserAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("create session: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// AuthUserByTokenID need for implements app.SessionRepo.
+func (repo *Repo) AuthUserByTokenID(ctx context.Context, tokenID app.TokenID) (authUser *app.AuthUser, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT users.id, users.public_id, users.tenant_id, users.email, users.username, users.pass_hash, users.phone,
+		users.version, users.created_at, users.updated_at, sessions.id AS session_id, sessions.token_id, sessions.ip, sessions.user_agent
+		FROM sessions JOIN users ON users.id = sessions.user_id
+		WHERE sessions.token_id = ? AND sessions.is_logout = false`
+
+		u := &authUserDBFormat{}
+		err = db.GetContext(ctx, u, query, tokenID)
+		if err != nil {
+			return err
+		}
+
+		authUser = u.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// DeleteSession need for implements app.SessionRepo.
+// SQLite doesn't return columns of joined tables, so the user is selected after the update.
+func (repo *Repo) DeleteSession(ctx context.Context, tokenID app.TokenID) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE sessions SET is_logout = true, logout_at = ` + now + `
+		WHERE token_id = ? AND is_logout = false
+		RETURNING user_id`
+
+		var userID app.UserID
+		err := tx.QueryRowContext(ctx, query, tokenID).Scan(&userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("delete session: %w", err)
+		}
+
+		const queryEmail = `SELECT email FROM users WHERE id = ?`
+
+		userEmail := ""
+		err = tx.GetContext(ctx, &userEmail, queryEmail, userID)
+		if err != nil {
+			return fmt.Errorf("get user email: %w", err)
+		}
+
+		return createEvent(ctx, tx, app.Event{
+			Type:   app.EventSessionRevoked,
+			UserID: userID,
+			Email:  userEmail,
+		})
+	})
+}
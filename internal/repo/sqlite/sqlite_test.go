@@ -0,0 +1,57 @@
+//go:build cgo
+// +build cgo
+
+package sqlite_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/repo/conformance"
+	"github.com/zergslaw/boilerplate/internal/repo/sqlite"
+	"go.uber.org/zap"
+)
+
+// Tables in the order of deletion, children before parents.
+var tables = []string{
+	"org_invitations", "org_members", "orgs", "leaders", "jobs", "email_events", "user_notifications",
+	"webhook_deliveries", "events", "webhooks", "notification_settings", "recovery_code", "notifications",
+	"sessions", "users",
+}
+
+func TestConformance(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "sqlite")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := sqlite.Open(ctx, filepath.Join(dir, "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = sqlite.Migrate(ctx, db)
+	require.NoError(t, err)
+	err = sqlite.Migrate(ctx, db)
+	require.NoError(t, err, "applied migrations are skipped")
+
+	r := sqlite.New(sqlite.Connect(db, zap.NewNop().Sugar(), "test"))
+
+	conformance.Run(t, func(t *testing.T) conformance.Repo {
+		for _, table := range tables {
+			_, err := db.Exec(`DELETE FROM ` + table)
+			require.NoError(t, err)
+		}
+		// The default tenant is created by migrations.
+		_, err := db.Exec(`DELETE FROM tenants WHERE id <> 1`)
+		require.NoError(t, err)
+		_, err = db.Exec(`DELETE FROM sqlite_sequence`)
+		require.NoError(t, err)
+
+		return r
+	})
+}
@@ -0,0 +1,100 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateTenant need for implements app.TenantRepo.
+func (repo *Repo) CreateTenant(ctx context.Context, tenant app.Tenant) (id app.TenantID, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO tenants (name, host) VALUES (?, nullif(?, '')) RETURNING id`
+
+		// Not wrapped, so the mapper converts the violation of the unique name or host to app.ErrTenantExist.
+		return db.QueryRowxContext(ctx, query, tenant.Name, tenant.Host).Scan(&id)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// TenantByID need for implements app.TenantRepo.
+func (repo *Repo) TenantByID(ctx context.Context, id app.TenantID) (tenant *app.Tenant, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM tenants WHERE id = ?`
+
+		res := &tenantDBFormat{}
+		err = db.GetContext(ctx, res, query, id)
+		if err != nil {
+			return err
+		}
+
+		tenant = res.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// TenantByName need for implements app.TenantRepo.
+func (repo *Repo) TenantByName(ctx context.Context, name string) (tenant *app.Tenant, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM tenants WHERE name = ?`
+
+		res := &tenantDBFormat{}
+		err = db.GetContext(ctx, res, query, name)
+		if err != nil {
+			return err
+		}
+
+		tenant = res.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// TenantByHost need for implements app.TenantRepo.
+func (repo *Repo) TenantByHost(ctx context.Context, host string) (tenant *app.Tenant, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM tenants WHERE host = ?`
+
+		res := &tenantDBFormat{}
+		err = db.GetContext(ctx, res, query, host)
+		if err != nil {
+			return err
+		}
+
+		tenant = res.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// Tenants need for implements app.TenantRepo.
+func (repo *Repo) Tenants(ctx context.Context) (tenants []app.Tenant, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM tenants ORDER BY id`
+
+		var res []tenantDBFormat
+		err = db.SelectContext(ctx, &res, query)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		tenants = make([]app.Tenant, len(res))
+		for i := range res {
+			tenants[i] = *res[i].toAppFormat()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
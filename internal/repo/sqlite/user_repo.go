@@ -0,0 +1,334 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// CreateUser need for implements app.UserRepo.
+func (repo *Repo) CreateUser(ctx context.Context, newUser app.User, task app.TaskNotification) (userID app.UserID, err error) {
+	err = repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `INSERT INTO users (public_id, tenant_id, username, email, pass_hash)
+		VALUES (?, ?, ?, ?, ?) RETURNING id`
+
+		err = tx.QueryRowxContext(ctx, query,
+			newUser.PublicID, newUser.TenantID, newUser.Name, newUser.Email, newUser.PassHash).Scan(&userID)
+		if err != nil {
+			return fmt.Errorf("create user: %w", err)
+		}
+
+		_, err = createTaskNotification(ctx, tx, task)
+		if err != nil {
+			return err
+		}
+
+		return createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserCreated,
+			UserID: userID,
+			Email:  newUser.Email,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// DeleteUser need for implements app.UserRepo.
+func (repo *Repo) DeleteUser(ctx context.Context, userID app.UserID) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const queryDeleteOrgs = `DELETE FROM orgs WHERE id IN
+		(SELECT org_id FROM org_members WHERE user_id = ? AND role = 'owner')`
+
+		_, err := tx.ExecContext(ctx, queryDeleteOrgs, userID)
+		if err != nil {
+			return fmt.Errorf("delete orgs: %w", err)
+		}
+
+		const queryEmail = `SELECT email FROM users WHERE id = ?`
+
+		userEmail := ""
+		err = tx.GetContext(ctx, &userEmail, queryEmail, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get user email: %w", err)
+		}
+
+		// The event is created before the user is removed, so it gets the public id of the user.
+		err = createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserDeleted,
+			UserID: userID,
+			Email:  userEmail,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Tasks don't reference users, because invitations are sent to emails without users.
+		const queryTasks = `DELETE FROM notifications
+		WHERE email = ?1 AND tenant_id = (SELECT tenant_id FROM users WHERE id = ?2)`
+
+		_, err = tx.ExecContext(ctx, queryTasks, userEmail, userID)
+		if err != nil {
+			return fmt.Errorf("delete notifications: %w", err)
+		}
+
+		const query = `DELETE FROM users WHERE id = ?`
+
+		_, err = tx.ExecContext(ctx, query, userID)
+		if err != nil {
+			return fmt.Errorf("delete user: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// UpdateUsername need for implements app.UserRepo.
+func (repo *Repo) UpdateUsername(ctx context.Context, userID app.UserID, username string, version int) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET username = ?1, version = version + 1, updated_at = ` + now + `
+		WHERE id = ?2 AND (?3 = 0 OR version = ?3) RETURNING email`
+
+		userEmail := ""
+		err := tx.QueryRowContext(ctx, query, username, userID, version).Scan(&userEmail)
+		if errors.Is(err, sql.ErrNoRows) {
+			return versionMismatch(version)
+		}
+		if err != nil {
+			return fmt.Errorf("update username: %w", err)
+		}
+
+		return createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserUsernameChanged,
+			UserID: userID,
+			Email:  userEmail,
+		})
+	})
+}
+
+// UpdateEmail need for implements app.UserRepo.
+func (repo *Repo) UpdateEmail(
+	ctx context.Context, userID app.UserID, email string, task app.TaskNotification, version int,
+) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET email = :email, version = version + 1, updated_at = ` + now + `
+		WHERE id = :id AND (:version = 0 OR version = :version)`
+		type args struct {
+			Email   string     `db:"email"`
+			ID      app.UserID `db:"id"`
+			Version int        `db:"version"`
+		}
+
+		// Tasks are moved to the new email before the email of the user is changed.
+		const queryTasks = `UPDATE notifications SET email = ?1
+		WHERE (tenant_id, email) = (SELECT tenant_id, email FROM users WHERE id = ?2)`
+
+		_, err := tx.ExecContext(ctx, queryTasks, email, userID)
+		if err != nil {
+			return fmt.Errorf("update notification emails: %w", err)
+		}
+
+		res, err := tx.NamedExecContext(ctx, query, args{
+			Email:   email,
+			ID:      userID,
+			Version: version,
+		})
+		if err != nil {
+			return fmt.Errorf("update email: %w", err)
+		}
+
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %w", err)
+		}
+		if updated == 0 && version != 0 {
+			return app.ErrVersionMismatch
+		}
+
+		_, err = createTaskNotification(ctx, tx, task)
+		if err != nil {
+			return err
+		}
+
+		return createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserEmailChanged,
+			UserID: userID,
+			Email:  email,
+		})
+	})
+}
+
+// UpdatePassword need for implements app.UserRepo.
+func (repo *Repo) UpdatePassword(ctx context.Context, userID app.UserID, passHash []byte, version int) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET pass_hash = ?1, version = version + 1, updated_at = ` + now + `
+		WHERE id = ?2 AND (?3 = 0 OR version = ?3) RETURNING tenant_id, email`
+
+		tenantID, userEmail := app.TenantID(0), ""
+		err := tx.QueryRowContext(ctx, query, passHash, userID, version).Scan(&tenantID, &userEmail)
+		if errors.Is(err, sql.ErrNoRows) && version != 0 {
+			return app.ErrVersionMismatch
+		}
+		if err != nil {
+			return fmt.Errorf("update pass: %w", err)
+		}
+
+		err = cleanRecoveryCodes(ctx, tx, tenantID, userEmail)
+		if err != nil {
+			return err
+		}
+
+		return createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserPasswordChanged,
+			UserID: userID,
+			Email:  userEmail,
+		})
+	})
+}
+
+// UpdatePhone need for implements app.UserRepo.
+func (repo *Repo) UpdatePhone(ctx context.Context, userID app.UserID, phone string) error {
+	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET phone = nullif(?, ''), version = version + 1, updated_at = ` + now + `
+		WHERE id = ? RETURNING tenant_id, email`
+
+		tenantID, userEmail := app.TenantID(0), ""
+		err := tx.QueryRowContext(ctx, query, phone, userID).Scan(&tenantID, &userEmail)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("update phone: %w", err)
+		}
+
+		err = cleanPhoneCodes(ctx, tx, tenantID, userEmail)
+		if err != nil {
+			return err
+		}
+
+		return createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserPhoneChanged,
+			UserID: userID,
+			Email:  userEmail,
+		})
+	})
+}
+
+// versionMismatch returns the error of the update which hasn't found the user,
+// the user having another version isn't distinguished from the deleted one.
+func versionMismatch(version int) error {
+	if version == 0 {
+		return nil
+	}
+
+	return app.ErrVersionMismatch
+}
+
+// UserByID need for implements app.UserRepo.
+func (repo *Repo) UserByID(ctx context.Context, userID app.UserID) (user *app.User, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE id = ?`
+
+		u := &userDBFormat{}
+		err = db.GetContext(ctx, u, query, userID)
+		if err != nil {
+			return err
+		}
+
+		user = u.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// UserByPublicID need for implements app.UserRepo.
+func (repo *Repo) UserByPublicID(ctx context.Context, publicID app.PublicID) (user *app.User, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE public_id = ?`
+
+		u := &userDBFormat{}
+		err = db.GetContext(ctx, u, query, publicID)
+		if err != nil {
+			return err
+		}
+
+		user = u.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// UserByEmail need for implements app.UserRepo.
+func (repo *Repo) UserByEmail(ctx context.Context, tenantID app.TenantID, email string) (user *app.User, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE tenant_id = ? AND email = ?`
+
+		u := &userDBFormat{}
+		err = db.GetContext(ctx, u, query, tenantID, email)
+		if err != nil {
+			return err
+		}
+
+		user = u.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// UserByUsername need for implements app.UserRepo.
+func (repo *Repo) UserByUsername(ctx context.Context, tenantID app.TenantID, username string) (user *app.User, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE tenant_id = ? AND username = ?`
+
+		u := &userDBFormat{}
+		err = db.GetContext(ctx, u, query, tenantID, username)
+		if err != nil {
+			return err
+		}
+
+		user = u.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// ListUserByUsername need for implements app.UserRepo.
+// instr is used instead of LIKE, which ignores case in SQLite.
+func (repo *Repo) ListUserByUsername(ctx context.Context, tenantID app.TenantID, username string, page app.Page) (users []app.User, total int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE tenant_id = ? AND instr(username, ?) > 0 ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+
+		res := make([]userDBFormat, 0, page.Limit)
+		err = db.SelectContext(ctx, &res, query, tenantID, username, page.Limit, page.Offset)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		const getTotal = `SELECT count(*) FROM users WHERE tenant_id = ? AND instr(username, ?) > 0`
+		err = db.GetContext(ctx, &total, getTotal, tenantID, username)
+		if err != nil {
+			return fmt.Errorf("get total: %w", err)
+		}
+
+		users = make([]app.User, len(res))
+		for i := range res {
+			users[i] = *res[i].toAppFormat()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
@@ -0,0 +1,235 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// DeleteTaskNotification need for implements app.WAL.
+func (repo *Repo) DeleteTaskNotification(ctx context.Context, id int) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE notifications SET is_done = true, exec_time = ` + now + ` WHERE id = ?`
+
+		_, err := db.ExecContext(ctx, query, id)
+
+		return err
+	})
+}
+
+// SaveTaskNotificationError need for implements app.WAL.
+func (repo *Repo) SaveTaskNotificationError(ctx context.Context, id int, taskErr error) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE notifications SET error = ? WHERE id = ?`
+
+		_, err := db.ExecContext(ctx, query, taskErr.Error(), id)
+
+		return err
+	})
+}
+
+// CreateTaskNotification need for implements app.WAL.
+func (repo *Repo) CreateTaskNotification(ctx context.Context, task app.TaskNotification) (id int, err error) {
+	err = repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
+		id, err = createTaskNotification(ctx, tx, task)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// CancelTaskNotification need for implements app.WAL.
+func (repo *Repo) CancelTaskNotification(ctx context.Context, id int) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE notifications SET is_done = true, cancelled_at = ` + now + `
+		WHERE id = ? AND is_done = false`
+
+		res, err := db.ExecContext(ctx, query, id)
+		if err != nil {
+			return fmt.Errorf("cancel task: %w", err)
+		}
+
+		count, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %w", err)
+		}
+		if count == 0 {
+			return app.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// CancelTaskNotifications need for implements app.WAL.
+func (repo *Repo) CancelTaskNotifications(
+	ctx context.Context, tenantID app.TenantID, email string, kind app.MessageKind,
+) (count int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE notifications SET is_done = true, cancelled_at = ` + now + `
+		WHERE tenant_id = ? AND email = ? AND kind = ? AND is_done = false`
+
+		res, err := db.ExecContext(ctx, query, tenantID, email, kind.String())
+		if err != nil {
+			return fmt.Errorf("cancel tasks: %w", err)
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %w", err)
+		}
+		count = int(affected)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CollapseTaskNotifications need for implements app.WAL.
+func (repo *Repo) CollapseTaskNotifications(ctx context.Context, task app.TaskNotification) (ids []int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE notifications SET is_done = true, cancelled_at = ` + now + `, error = ?4
+		WHERE tenant_id = ?1 AND email = ?2 AND kind = ?3 AND is_done = false AND run_at <= ` + now + `
+		AND id <> (SELECT max(id) FROM notifications
+			WHERE tenant_id = ?1 AND email = ?2 AND kind = ?3 AND is_done = false AND run_at <= ` + now + `)
+		RETURNING id`
+
+		return db.SelectContext(ctx, &ids, query,
+			task.TenantID, task.Email, task.Kind.String(), app.ErrNotificationDuplicate.Error())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// SuppressTaskNotification need for implements app.WAL.
+func (repo *Repo) SuppressTaskNotification(ctx context.Context, id int, reason error) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE notifications SET is_done = true, cancelled_at = ` + now + `, error = ?2
+		WHERE id = ?1 AND is_done = false`
+
+		_, err := db.ExecContext(ctx, query, id, reason.Error())
+
+		return err
+	})
+}
+
+// ExecutedTaskNotificationCount need for implements app.WAL.
+func (repo *Repo) ExecutedTaskNotificationCount(
+	ctx context.Context, tenantID app.TenantID, email string, kind app.MessageKind, since time.Time,
+) (count int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT count(*) FROM notifications
+		WHERE tenant_id = ? AND email = ? AND kind = ? AND is_done = true AND cancelled_at IS NULL AND exec_time >= ?`
+
+		return db.GetContext(ctx, &count, query, tenantID, email, kind.String(), timestamp(since))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SaveTaskNotificationProvider need for implements app.ProviderRepo.
+func (repo *Repo) SaveTaskNotificationProvider(ctx context.Context, taskID int, provider string) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE notifications SET provider = ?2 WHERE id = ?1`
+
+		_, err := db.ExecContext(ctx, query, taskID, provider)
+
+		return err
+	})
+}
+
+// TaskNotificationByID need for implements app.WAL.
+func (repo *Repo) TaskNotificationByID(ctx context.Context, id int) (task *app.TaskNotificationInfo, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT id, tenant_id, kind, email, payload, run_at, is_done, error, created_at, exec_time, cancelled_at, provider
+		FROM notifications WHERE id = ?`
+
+		res := &taskNotificationInfoDBFormat{}
+		err = db.GetContext(ctx, res, query, id)
+		if err != nil {
+			return err
+		}
+
+		task, err = res.toAppFormat()
+		return err
+	})
+	return
+}
+
+// ListTaskNotification need for implements app.WAL.
+func (repo *Repo) ListTaskNotification(ctx context.Context, filter app.TaskFilter, page app.Page) (tasks []app.TaskNotificationInfo, total int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const where = `
+		WHERE (?1 = '' OR email = ?1) AND (?2 = '' OR kind = ?2) AND (
+			?3 = '' OR
+			(?3 = 'pending' AND is_done = false) OR
+			(?3 = 'done' AND is_done = true AND cancelled_at IS NULL) OR
+			(?3 = 'cancelled' AND cancelled_at IS NOT NULL)
+		)`
+		const query = `SELECT id, tenant_id, kind, email, payload, run_at, is_done, error, created_at, exec_time, cancelled_at, provider
+		FROM notifications` + where + ` ORDER BY id DESC LIMIT ?4 OFFSET ?5`
+
+		kind := ""
+		if filter.Kind != 0 {
+			kind = filter.Kind.String()
+		}
+
+		res := make([]taskNotificationInfoDBFormat, 0, page.Limit)
+		err = db.SelectContext(ctx, &res, query, filter.Email, kind, string(filter.Status), page.Limit, page.Offset)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		const getTotal = `SELECT count(*) FROM notifications` + where
+		err = db.GetContext(ctx, &total, getTotal, filter.Email, kind, string(filter.Status))
+		if err != nil {
+			return fmt.Errorf("get total: %w", err)
+		}
+
+		tasks = make([]app.TaskNotificationInfo, len(res))
+		for i := range res {
+			task, err := res[i].toAppFormat()
+			if err != nil {
+				return err
+			}
+			tasks[i] = *task
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
+
+// NotificationBacklog returns the number of pending tasks which run time has come.
+func (repo *Repo) NotificationBacklog(ctx context.Context) (count int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT count(*) FROM notifications WHERE is_done = false AND run_at <= ` + now
+
+		return db.GetContext(ctx, &count, query)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
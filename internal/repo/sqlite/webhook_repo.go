@@ -0,0 +1,177 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+const selectWebhookDelivery = `SELECT
+	webhook_deliveries.id, webhook_deliveries.status, webhook_deliveries.attempts,
+	webhook_deliveries.status_code, webhook_deliveries.error,
+	webhook_deliveries.next_attempt_at, webhook_deliveries.delivered_at,
+	webhooks.id AS webhook_id, webhooks.url AS webhook_url, webhooks.secret AS webhook_secret,
+	webhooks.events AS webhook_events, webhooks.created_at AS webhook_created_at,
+	events.id AS event_id, events.type AS event_type, events.user_id AS event_user_id,
+	events.user_public_id AS event_user_public_id, events.email AS event_email, events.created_at AS event_created_at
+	FROM webhook_deliveries
+	JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id
+	JOIN events ON events.id = webhook_deliveries.event_id`
+
+// CreateWebhook need for implements app.WebhookRepo.
+func (repo *Repo) CreateWebhook(ctx context.Context, webhook app.Webhook) (id app.WebhookID, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `INSERT INTO webhooks (url, secret, events) VALUES (?, ?, ?) RETURNING id`
+
+		events := make(stringArray, len(webhook.Events))
+		for i := range webhook.Events {
+			events[i] = string(webhook.Events[i])
+		}
+
+		err = db.QueryRowxContext(ctx, query, webhook.URL, webhook.Secret, events).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("create webhook: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Webhooks need for implements app.WebhookRepo.
+func (repo *Repo) Webhooks(ctx context.Context) (webhooks []app.Webhook, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM webhooks ORDER BY id`
+
+		var res []webhookDBFormat
+		err = db.SelectContext(ctx, &res, query)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		webhooks = make([]app.Webhook, len(res))
+		for i := range res {
+			webhooks[i] = *res[i].toAppFormat()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook need for implements app.WebhookRepo.
+func (repo *Repo) DeleteWebhook(ctx context.Context, id app.WebhookID) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `DELETE FROM webhooks WHERE id = ?`
+
+		res, err := db.ExecContext(ctx, query, id)
+		if err != nil {
+			return fmt.Errorf("delete webhook: %w", err)
+		}
+
+		count, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %w", err)
+		}
+		if count == 0 {
+			return app.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// WebhookDeliveryByID need for implements app.WebhookRepo.
+func (repo *Repo) WebhookDeliveryByID(ctx context.Context, id int) (delivery *app.WebhookDelivery, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = selectWebhookDelivery + `
+		WHERE webhook_deliveries.id = ?`
+
+		res := &webhookDeliveryDBFormat{}
+		err = db.GetContext(ctx, res, query, id)
+		if err != nil {
+			return err
+		}
+
+		delivery = res.toAppFormat()
+		return nil
+	})
+	return
+}
+
+// WebhookDeliveries need for implements app.WebhookRepo.
+func (repo *Repo) WebhookDeliveries(ctx context.Context, id app.WebhookID, page app.Page) (deliveries []app.WebhookDelivery, total int, err error) {
+	err = repo.db.Do(func(db *sqlx.DB) error {
+		const query = selectWebhookDelivery + `
+		WHERE webhook_deliveries.webhook_id = ?
+		ORDER BY webhook_deliveries.id DESC LIMIT ? OFFSET ?`
+
+		res := make([]webhookDeliveryDBFormat, 0, page.Limit)
+		err = db.SelectContext(ctx, &res, query, id, page.Limit, page.Offset)
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+
+		const getTotal = `SELECT count(*) FROM webhook_deliveries WHERE webhook_id = ?`
+		err = db.GetContext(ctx, &total, getTotal, id)
+		if err != nil {
+			return fmt.Errorf("get total: %w", err)
+		}
+
+		deliveries = make([]app.WebhookDelivery, len(res))
+		for i := range res {
+			deliveries[i] = *res[i].toAppFormat()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// UpdateWebhookDelivery need for implements app.WebhookRepo.
+func (repo *Repo) UpdateWebhookDelivery(ctx context.Context, delivery app.WebhookDelivery) error {
+	return repo.db.Do(func(db *sqlx.DB) error {
+		const query = `UPDATE webhook_deliveries SET
+		status = :status, attempts = :attempts, status_code = :status_code, error = :error,
+		next_attempt_at = :next_attempt_at, delivered_at = :delivered_at
+		WHERE id = :id`
+		type args struct {
+			ID            int         `db:"id"`
+			Status        string      `db:"status"`
+			Attempts      int         `db:"attempts"`
+			StatusCode    int         `db:"status_code"`
+			Error         string      `db:"error"`
+			NextAttemptAt string      `db:"next_attempt_at"`
+			DeliveredAt   interface{} `db:"delivered_at"`
+		}
+
+		_, err := db.NamedExecContext(ctx, query, args{
+			ID:            delivery.ID,
+			Status:        string(delivery.Status),
+			Attempts:      delivery.Attempts,
+			StatusCode:    delivery.StatusCode,
+			Error:         delivery.Error,
+			NextAttemptAt: timestamp(delivery.NextAttemptAt),
+			DeliveredAt:   nullTimestamp(delivery.DeliveredAt),
+		})
+		if err != nil {
+			return fmt.Errorf("update webhook delivery: %w", err)
+		}
+
+		return nil
+	})
+}
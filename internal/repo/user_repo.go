@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgtype"
@@ -11,20 +13,36 @@ import (
 
 // CreateUser need for implements app.UserRepo.
 func (repo *Repo) CreateUser(ctx context.Context, newUser app.User, task app.TaskNotification) (userID app.UserID, err error) {
-	err = repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
-		const query = `INSERT INTO users (username, email, pass_hash) VALUES ($1, $2, $3) RETURNING id`
+	err = repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `INSERT INTO users (public_id, tenant_id, username, email, email_index, pass_hash)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
 
 		hash := pgtype.Bytea{
 			Bytes:  newUser.PassHash,
 			Status: pgtype.Present,
 		}
 
-		err = tx.QueryRowxContext(ctx, query, newUser.Name, newUser.Email, hash).Scan(&userID)
+		email, emailIndex, err := repo.encrypt(newUser.Email)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowxContext(ctx, query, newUser.PublicID, newUser.TenantID, newUser.Name, email, emailIndex, hash).
+			Scan(&userID)
 		if err != nil {
 			return fmt.Errorf("create user: %w", err)
 		}
 
-		return createTaskNotification(ctx, tx, task)
+		_, err = repo.createTaskNotification(ctx, tx, task)
+		if err != nil {
+			return err
+		}
+
+		return repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserCreated,
+			UserID: userID,
+			Email:  newUser.Email,
+		})
 	})
 	if err != nil {
 		return 0, err
@@ -35,81 +53,243 @@ func (repo *Repo) CreateUser(ctx context.Context, newUser app.User, task app.Tas
 
 // DeleteUser need for implements app.UserRepo.
 func (repo *Repo) DeleteUser(ctx context.Context, userID app.UserID) error {
-	return repo.db.Do(func(db *sqlx.DB) error {
-		const query = `DELETE FROM users WHERE id = :id`
-		type args struct {
-			ID app.UserID `db:"id"`
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		const queryDeleteOrgs = `DELETE FROM orgs WHERE id IN
+		(SELECT org_id FROM org_members WHERE user_id = $1 AND role = 'owner')`
+
+		_, err := tx.ExecContext(ctx, queryDeleteOrgs, userID)
+		if err != nil {
+			return fmt.Errorf("delete orgs: %w", err)
 		}
 
-		_, err := db.NamedExecContext(ctx, query, args{
-			ID: userID,
+		const queryEmail = `SELECT email FROM users WHERE id = $1`
+
+		userEmail := ""
+		err = tx.GetContext(ctx, &userEmail, queryEmail, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get user email: %w", err)
+		}
+		err = repo.decrypt(&userEmail)
+		if err != nil {
+			return err
+		}
+
+		// The event is created before the user is removed, so it gets the public id of the user.
+		err = repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserDeleted,
+			UserID: userID,
+			Email:  userEmail,
 		})
+		if err != nil {
+			return err
+		}
 
-		return err
+		// Tasks don't reference users, because invitations are sent to emails without users.
+		const queryTasks = `DELETE FROM notifications USING users
+		WHERE users.id = $1 AND notifications.tenant_id = users.tenant_id AND
+		(notifications.email_index = users.email_index OR
+		users.email_index IS NULL AND notifications.email = users.email)`
+
+		_, err = tx.ExecContext(ctx, queryTasks, userID)
+		if err != nil {
+			return fmt.Errorf("delete notifications: %w", err)
+		}
+
+		const query = `DELETE FROM users WHERE id = $1`
+
+		_, err = tx.ExecContext(ctx, query, userID)
+		if err != nil {
+			return fmt.Errorf("delete user: %w", err)
+		}
+
+		return nil
 	})
 }
 
 // UpdateUsername need for implements app.UserRepo.
-func (repo *Repo) UpdateUsername(ctx context.Context, userID app.UserID, username string) error {
-	return repo.db.Do(func(db *sqlx.DB) error {
-		const query = `UPDATE users SET username = :username, updated_at = now() WHERE id = :id`
-		type args struct {
-			Username string     `db:"username"`
-			ID       app.UserID `db:"id"`
+func (repo *Repo) UpdateUsername(ctx context.Context, userID app.UserID, username string, version int) error {
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET username = $1, version = version + 1, updated_at = now()
+		WHERE id = $2 AND ($3 = 0 OR version = $3) RETURNING email`
+
+		userEmail := ""
+		err := tx.QueryRowContext(ctx, query, username, userID, version).Scan(&userEmail)
+		if errors.Is(err, sql.ErrNoRows) {
+			return versionMismatch(version)
+		}
+		if err != nil {
+			return fmt.Errorf("update username: %w", err)
+		}
+		err = repo.decrypt(&userEmail)
+		if err != nil {
+			return err
 		}
 
-		_, err := db.NamedExecContext(ctx, query, args{
-			Username: username,
-			ID:       userID,
+		return repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserUsernameChanged,
+			UserID: userID,
+			Email:  userEmail,
 		})
-
-		return err
 	})
 }
 
 // UpdateEmail need for implements app.UserRepo.
-func (repo *Repo) UpdateEmail(ctx context.Context, userID app.UserID, email string, task app.TaskNotification) error {
-	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
-		const query = `UPDATE users SET email = :email, updated_at = now() WHERE id = :id`
+func (repo *Repo) UpdateEmail(
+	ctx context.Context, userID app.UserID, email string, task app.TaskNotification, version int,
+) error {
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET email = :email, email_index = :email_index, version = version + 1, updated_at = now()
+		WHERE id = :id AND (:version = 0 OR version = :version)`
 		type args struct {
-			Email string     `db:"email"`
-			ID    app.UserID `db:"id"`
+			Email      string     `db:"email"`
+			EmailIndex string     `db:"email_index"`
+			ID         app.UserID `db:"id"`
+			Version    int        `db:"version"`
 		}
 
-		_, err := tx.NamedExecContext(ctx, query, args{
-			Email: email,
-			ID:    userID,
+		ciphertext, emailIndex, err := repo.encrypt(email)
+		if err != nil {
+			return err
+		}
+
+		// Tasks are moved to the new email before the blind index of the user is changed,
+		// so they are sent to the new email like before the encryption.
+		const queryTasks = `UPDATE notifications SET email = $1, email_index = $2 FROM users
+		WHERE users.id = $3 AND notifications.tenant_id = users.tenant_id AND
+		(notifications.email_index = users.email_index OR
+		users.email_index IS NULL AND notifications.email = users.email)`
+
+		_, err = tx.ExecContext(ctx, queryTasks, ciphertext, emailIndex, userID)
+		if err != nil {
+			return fmt.Errorf("update notification emails: %w", err)
+		}
+
+		res, err := tx.NamedExecContext(ctx, query, args{
+			Email:      ciphertext,
+			EmailIndex: emailIndex,
+			ID:         userID,
+			Version:    version,
 		})
 		if err != nil {
 			return fmt.Errorf("update email: %w", err)
 		}
 
-		return createTaskNotification(ctx, tx, task)
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %w", err)
+		}
+		if updated == 0 && version != 0 {
+			return app.ErrVersionMismatch
+		}
+
+		_, err = repo.createTaskNotification(ctx, tx, task)
+		if err != nil {
+			return err
+		}
+
+		return repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserEmailChanged,
+			UserID: userID,
+			Email:  email,
+		})
 	})
 }
 
 // UpdatePassword need for implements app.UserRepo.
-func (repo *Repo) UpdatePassword(ctx context.Context, userID app.UserID, passHash []byte) error {
-	return repo.db.Tx(ctx, func(tx *sqlx.Tx) error {
-		const query = `UPDATE users SET pass_hash = $1, updated_at = now() WHERE id = $2 RETURNING email`
+func (repo *Repo) UpdatePassword(ctx context.Context, userID app.UserID, passHash []byte, version int) error {
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET pass_hash = $1, version = version + 1, updated_at = now()
+		WHERE id = $2 AND ($3 = 0 OR version = $3) RETURNING tenant_id, email, email_index`
 		hash := pgtype.Bytea{
 			Bytes:  passHash,
 			Status: pgtype.Present,
 		}
 
-		userEmail := ""
-		err := tx.QueryRowContext(ctx, query, hash, userID).Scan(&userEmail)
+		tenantID, userEmail, emailIndex := app.TenantID(0), "", ""
+		err := tx.QueryRowContext(ctx, query, hash, userID, version).Scan(&tenantID, &userEmail, &emailIndex)
+		if errors.Is(err, sql.ErrNoRows) && version != 0 {
+			return app.ErrVersionMismatch
+		}
 		if err != nil {
 			return fmt.Errorf("update pass: %w", err)
 		}
+		err = repo.decrypt(&userEmail)
+		if err != nil {
+			return err
+		}
+
+		err = cleanRecoveryCodes(ctx, tx, tenantID, emailIndex)
+		if err != nil {
+			return err
+		}
 
-		return cleanRecoveryCodes(ctx, tx, userEmail)
+		return repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserPasswordChanged,
+			UserID: userID,
+			Email:  userEmail,
+		})
 	})
 }
 
+// UpdatePhone need for implements app.UserRepo.
+func (repo *Repo) UpdatePhone(ctx context.Context, userID app.UserID, phone string) error {
+	return repo.primary(ctx).Tx(ctx, func(tx *sqlx.Tx) error {
+		const query = `UPDATE users SET phone = $1, phone_index = $2, version = version + 1, updated_at = now()
+		WHERE id = $3 RETURNING tenant_id, email, email_index`
+
+		var ciphertext, phoneIndex sql.NullString
+		if phone != "" {
+			var err error
+			ciphertext.String, phoneIndex.String, err = repo.encrypt(phone)
+			if err != nil {
+				return err
+			}
+			ciphertext.Valid, phoneIndex.Valid = true, true
+		}
+
+		tenantID, userEmail, emailIndex := app.TenantID(0), "", ""
+		err := tx.QueryRowContext(ctx, query, ciphertext, phoneIndex, userID).Scan(&tenantID, &userEmail, &emailIndex)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			// Not wrapped, so the mapper converts the violation of the unique phone to app.ErrPhoneExist.
+			return err
+		}
+		err = repo.decrypt(&userEmail)
+		if err != nil {
+			return err
+		}
+
+		err = cleanPhoneCodes(ctx, tx, tenantID, emailIndex)
+		if err != nil {
+			return err
+		}
+
+		return repo.createEvent(ctx, tx, app.Event{
+			Type:   app.EventUserPhoneChanged,
+			UserID: userID,
+			Email:  userEmail,
+		})
+	})
+}
+
+// versionMismatch returns the error of the update which hasn't found the user,
+// the user having another version isn't distinguished from the deleted one.
+func versionMismatch(version int) error {
+	if version == 0 {
+		return nil
+	}
+
+	return app.ErrVersionMismatch
+}
+
 // UserByID need for implements app.UserRepo.
 func (repo *Repo) UserByID(ctx context.Context, userID app.UserID) (user *app.User, err error) {
-	err = repo.db.Do(func(db *sqlx.DB) error {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
 		const query = `SELECT * FROM users WHERE id = $1`
 
 		u := &userDBFormat{}
@@ -118,66 +298,95 @@ func (repo *Repo) UserByID(ctx context.Context, userID app.UserID) (user *app.Us
 			return err
 		}
 
-		user = u.toAppFormat()
-		return nil
+		user, err = repo.userAppFormat(u)
+		return err
+	})
+	return
+}
+
+// UserByPublicID need for implements app.UserRepo.
+func (repo *Repo) UserByPublicID(ctx context.Context, publicID app.PublicID) (user *app.User, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE public_id = $1`
+
+		u := &userDBFormat{}
+		err = db.GetContext(ctx, u, query, publicID)
+		if err != nil {
+			return err
+		}
+
+		user, err = repo.userAppFormat(u)
+		return err
 	})
 	return
 }
 
 // UserByEmail need for implements app.UserRepo.
-func (repo *Repo) UserByEmail(ctx context.Context, email string) (user *app.User, err error) {
-	err = repo.db.Do(func(db *sqlx.DB) error {
-		const query = `SELECT * FROM users WHERE email = $1`
+func (repo *Repo) UserByEmail(ctx context.Context, tenantID app.TenantID, email string) (user *app.User, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE tenant_id = $1 AND email_index = $2`
+
+		emailIndex, err := repo.blindIndex(email)
+		if err != nil {
+			return err
+		}
 
 		u := &userDBFormat{}
-		err = db.GetContext(ctx, u, query, email)
+		err = db.GetContext(ctx, u, query, tenantID, emailIndex)
 		if err != nil {
 			return err
 		}
 
-		user = u.toAppFormat()
-		return nil
+		user, err = repo.userAppFormat(u)
+		return err
 	})
 	return
 }
 
 // UserByUsername need for implements app.UserRepo.
-func (repo *Repo) UserByUsername(ctx context.Context, username string) (user *app.User, err error) {
-	err = repo.db.Do(func(db *sqlx.DB) error {
-		const query = `SELECT * FROM users WHERE username = $1`
+func (repo *Repo) UserByUsername(ctx context.Context, tenantID app.TenantID, username string) (user *app.User, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE tenant_id = $1 AND username = $2`
 
 		u := &userDBFormat{}
-		err = db.GetContext(ctx, u, query, username)
+		err = db.GetContext(ctx, u, query, tenantID, username)
 		if err != nil {
 			return err
 		}
 
-		user = u.toAppFormat()
-		return nil
+		user, err = repo.userAppFormat(u)
+		return err
 	})
 	return
 }
 
 // ListUserByUsername need for implements app.UserRepo.
-func (repo *Repo) ListUserByUsername(ctx context.Context, username string, page app.Page) (users []app.User, total int, err error) {
-	err = repo.db.Do(func(db *sqlx.DB) error {
-		const query = `SELECT * FROM users WHERE username LIKE $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+func (repo *Repo) ListUserByUsername(
+	ctx context.Context, tenantID app.TenantID, username string, page app.Page,
+) (users []app.User, total int, err error) {
+	err = repo.replica(ctx).Do(func(db *sqlx.DB) error {
+		const query = `SELECT * FROM users WHERE tenant_id = $1 AND username LIKE $2
+		ORDER BY created_at DESC LIMIT $3 OFFSET $4`
 
 		res := make([]userDBFormat, 0, page.Limit)
-		err = db.SelectContext(ctx, &res, query, "%"+username+"%", page.Limit, page.Offset)
+		err = db.SelectContext(ctx, &res, query, tenantID, "%"+username+"%", page.Limit, page.Offset)
 		if err != nil {
 			return fmt.Errorf("select: %w", err)
 		}
 
-		const getTotal = `SELECT count(*) OVER() AS total FROM users WHERE username LIKE $1`
-		err = db.GetContext(ctx, &total, getTotal, "%"+username+"%")
+		const getTotal = `SELECT count(*) OVER() AS total FROM users WHERE tenant_id = $1 AND username LIKE $2`
+		err = db.GetContext(ctx, &total, getTotal, tenantID, "%"+username+"%")
 		if err != nil {
 			return fmt.Errorf("get total: %w", err)
 		}
 
 		users = make([]app.User, len(res))
 		for i := range res {
-			users[i] = *res[i].toAppFormat()
+			user, err := repo.userAppFormat(&res[i])
+			if err != nil {
+				return err
+			}
+			users[i] = *user
 		}
 
 		return nil
@@ -188,3 +397,22 @@ func (repo *Repo) ListUserByUsername(ctx context.Context, username string, page
 
 	return users, total, nil
 }
+
+// userAppFormat returns the user with the decrypted email and phone.
+func (repo *Repo) userAppFormat(u *userDBFormat) (*app.User, error) {
+	err := repo.decryptUser(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.toAppFormat(), nil
+}
+
+// decryptUser replaces the ciphertexts of the email and phone of the user with their plaintexts.
+func (repo *Repo) decryptUser(u *userDBFormat) error {
+	if u.Phone.Valid {
+		return repo.decrypt(&u.Email, &u.Phone.String)
+	}
+
+	return repo.decrypt(&u.Email)
+}
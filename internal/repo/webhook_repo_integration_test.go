@@ -0,0 +1,124 @@
+//go:build integration
+// +build integration
+
+package repo_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+func TestWebhookRepoSmoke(t *testing.T) {
+	err := truncate()
+	require.NoError(t, err)
+
+	webhook := app.Webhook{
+		URL:    "https://example.com/hook",
+		Secret: "secret",
+		Events: []app.EventType{app.EventUserCreated, app.EventSessionRevoked},
+	}
+	webhook.ID, err = Repo.CreateWebhook(ctx, webhook)
+	require.Nil(t, err)
+	require.NotZero(t, webhook.ID)
+
+	webhooks, err := Repo.Webhooks(ctx)
+	require.Nil(t, err)
+	require.Len(t, webhooks, 1)
+	webhook.CreatedAt = webhooks[0].CreatedAt
+	require.Equal(t, webhook, webhooks[0])
+
+	jobKinds := []app.JobKind{app.JobWebhookDelivery}
+	job, err := Repo.NextJob(ctx, jobKinds, time.Minute)
+	require.Nil(t, job)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	user := userGenerator()
+	user.ID, err = Repo.CreateUser(ctx, user, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    user.Email,
+		Kind:     app.Welcome,
+		Payload:  &app.WelcomePayload{},
+	})
+	require.Nil(t, err)
+
+	// Not subscribed to the event.
+	err = Repo.UpdateEmail(ctx, user.ID, "new"+user.Email, app.TaskNotification{
+		TenantID: app.DefaultTenant,
+		Email:    "new" + user.Email,
+		Kind:     app.ChangeEmail,
+		Payload:  &app.ChangeEmailPayload{},
+	}, 0)
+	require.Nil(t, err)
+
+	job, err = Repo.NextJob(ctx, jobKinds, time.Minute)
+	require.Nil(t, err)
+	delivery, err := Repo.WebhookDeliveryByID(ctx, job.Payload.(*app.WebhookDeliveryJobPayload).DeliveryID)
+	require.Nil(t, err)
+	require.Equal(t, webhook, delivery.Webhook)
+	require.Equal(t, app.EventUserCreated, delivery.Event.Type)
+	require.Equal(t, user.ID, delivery.Event.UserID)
+	require.Equal(t, user.Email, delivery.Event.Email)
+	require.Equal(t, app.DeliveryPending, delivery.Status)
+
+	delivery.Attempts = 1
+	delivery.StatusCode = http.StatusInternalServerError
+	delivery.Error = "unexpected status code"
+	delivery.NextAttemptAt = time.Now().Add(time.Hour)
+	err = Repo.UpdateWebhookDelivery(ctx, *delivery)
+	require.Nil(t, err)
+	err = Repo.RetryJob(ctx, job.ID, errors.New(delivery.Error), delivery.NextAttemptAt)
+	require.Nil(t, err)
+
+	_, err = Repo.NextJob(ctx, jobKinds, time.Minute)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	deliveries, total, err := Repo.WebhookDeliveries(ctx, webhook.ID, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, 1, deliveries[0].Attempts)
+	require.Equal(t, http.StatusInternalServerError, deliveries[0].StatusCode)
+	require.Equal(t, delivery.Error, deliveries[0].Error)
+
+	const tokenUser = "token"
+	err = Repo.SaveSession(ctx, user.ID, tokenUser, origin)
+	require.Nil(t, err)
+	err = Repo.DeleteSession(ctx, tokenUser)
+	require.Nil(t, err)
+
+	job, err = Repo.NextJob(ctx, jobKinds, time.Minute)
+	require.Nil(t, err)
+	delivery, err = Repo.WebhookDeliveryByID(ctx, job.Payload.(*app.WebhookDeliveryJobPayload).DeliveryID)
+	require.Nil(t, err)
+	require.Equal(t, app.EventSessionRevoked, delivery.Event.Type)
+
+	delivery.Attempts = 1
+	delivery.Status = app.DeliveryDelivered
+	delivery.StatusCode = http.StatusOK
+	delivery.DeliveredAt = time.Now()
+	err = Repo.UpdateWebhookDelivery(ctx, *delivery)
+	require.Nil(t, err)
+	err = Repo.CompleteJob(ctx, job.ID)
+	require.Nil(t, err)
+
+	_, err = Repo.NextJob(ctx, jobKinds, time.Minute)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	delivery, err = Repo.WebhookDeliveryByID(ctx, delivery.ID)
+	require.Nil(t, err)
+	require.Equal(t, app.DeliveryDelivered, delivery.Status)
+
+	err = Repo.DeleteWebhook(ctx, webhook.ID)
+	require.Nil(t, err)
+	err = Repo.DeleteWebhook(ctx, webhook.ID)
+	require.True(t, errors.Is(err, app.ErrNotFound))
+
+	deliveries, total, err = Repo.WebhookDeliveries(ctx, webhook.ID, app.Page{Limit: 10})
+	require.Nil(t, err)
+	require.Zero(t, total)
+	require.Empty(t, deliveries)
+}
@@ -0,0 +1,48 @@
+package sms
+
+import (
+	"sync"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+type (
+	// Fake keeps sent SMS in memory instead of sending them, it is used in tests and local runs.
+	Fake struct {
+		mu       sync.Mutex
+		messages []Message
+	}
+	// Message contains the SMS kept by Fake.
+	Message struct {
+		To   string
+		Text string
+	}
+)
+
+// NewFake creates a new instance of the app.Notification object keeping SMS in memory.
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// Notification need for implemented app.Notification.
+func (f *Fake) Notification(contact string, msg app.Message) error {
+	text, err := Text(msg)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.messages = append(f.messages, Message{To: contact, Text: text})
+
+	return nil
+}
+
+// Messages returns all kept SMS in order of sending.
+func (f *Fake) Messages() []Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Message(nil), f.messages...)
+}
@@ -0,0 +1,118 @@
+// Package sms contains an implementation of sending text messages to phones.
+package sms
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// DefaultURL is the address of the Twilio API, other providers often implement the same API.
+const DefaultURL = "https://api.twilio.com"
+
+const (
+	defaultTimeout = 10 * time.Second
+	// Limits the part of the response body read to release the connection.
+	maxResponseBody = 64 << 10
+)
+
+type (
+	// Option for building client struct.
+	Option func(*client)
+
+	client struct {
+		http       *http.Client
+		url        string
+		accountSID string
+		authToken  string
+		from       string
+	}
+)
+
+// New creates a new instance of the app.Notification object sending SMS by Twilio compatible Messages API.
+// The from is the phone or the alphanumeric sender ID the messages are sent from.
+func New(accountSID, authToken, from string, options ...Option) app.Notification {
+	c := &client{
+		http:       &http.Client{Timeout: defaultTimeout},
+		url:        DefaultURL,
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+	}
+
+	for i := range options {
+		options[i](c)
+	}
+
+	return c
+}
+
+// SetHTTPClient sets the client used for sending requests.
+func SetHTTPClient(httpClient *http.Client) Option {
+	return func(c *client) {
+		c.http = httpClient
+	}
+}
+
+// SetURL sets the address of the API.
+func SetURL(apiURL string) Option {
+	return func(c *client) {
+		c.url = strings.TrimSuffix(apiURL, "/")
+	}
+}
+
+// Notification need for implemented app.Notification.
+func (c *client) Notification(contact string, msg app.Message) error {
+	text, err := Text(msg)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{
+		"To":   {contact},
+		"From": {c.from},
+		"Body": {text},
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", c.url, url.PathEscape(c.accountSID))
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+	req.SetBasicAuth(c.accountSID, c.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("sms send: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, _ := ioutil.ReadAll(io.LimitReader(res.Body, maxResponseBody))
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("sms send: status %d: %s", res.StatusCode, body)
+	}
+
+	return nil
+}
+
+// Text returns the text of the SMS for the message.
+// Errors: app.ErrNotUnknownKindTask.
+func Text(msg app.Message) (string, error) {
+	switch msg.Kind {
+	case app.ChangeEmail:
+		return "Boilerplate: the email of your account has been changed.", nil
+	case app.PassRecoverySMS:
+		return "Boilerplate: your password recovery code is " + msg.Content, nil
+	case app.PhoneVerification:
+		return "Boilerplate: your phone verification code is " + msg.Content, nil
+	default:
+		return "", fmt.Errorf("%w: %s", app.ErrNotUnknownKindTask, msg.Kind)
+	}
+}
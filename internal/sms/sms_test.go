@@ -0,0 +1,69 @@
+package sms_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/sms"
+)
+
+const (
+	accountSID = "AC123"
+	authToken  = "token"
+	from       = "+15550000000"
+	phone      = "+15551234567"
+)
+
+func TestClient_Notification(t *testing.T) {
+	t.Parallel()
+
+	msg := app.Message{TaskID: 1, Kind: app.PassRecoverySMS, Content: "123456"}
+	text, err := sms.Text(msg)
+	require.NoError(t, err)
+
+	statusCode := http.StatusCreated
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/"+accountSID+"/Messages.json", r.URL.Path)
+
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, accountSID, user)
+		assert.Equal(t, authToken, pass)
+
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, phone, r.PostForm.Get("To"))
+		assert.Equal(t, from, r.PostForm.Get("From"))
+		assert.Equal(t, text, r.PostForm.Get("Body"))
+
+		w.WriteHeader(statusCode)
+	}))
+	defer srv.Close()
+
+	client := sms.New(accountSID, authToken, from, sms.SetURL(srv.URL+"/"))
+
+	assert.NoError(t, client.Notification(phone, msg))
+
+	statusCode = http.StatusBadRequest
+	assert.Error(t, client.Notification(phone, msg))
+
+	err = client.Notification(phone, app.Message{Kind: app.Welcome})
+	assert.True(t, errors.Is(err, app.ErrNotUnknownKindTask), "kinds without SMS text aren't sent")
+}
+
+func TestFake_Notification(t *testing.T) {
+	t.Parallel()
+
+	fake := sms.NewFake()
+	msg := app.Message{Kind: app.PhoneVerification, Content: "123456"}
+
+	text, err := sms.Text(msg)
+	require.NoError(t, err)
+
+	assert.NoError(t, fake.Notification(phone, msg))
+	assert.Equal(t, []sms.Message{{To: phone, Text: text}}, fake.Messages())
+}
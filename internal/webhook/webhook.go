@@ -0,0 +1,136 @@
+// Package webhook contains an implementation of delivering events to webhook endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zergslaw/boilerplate/internal/app"
+)
+
+// Request headers.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderEventID   = "X-Webhook-Event-Id"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+const (
+	signaturePrefix = "sha256="
+	defaultTimeout  = 10 * time.Second
+	// Limits the part of the response body read to release the connection.
+	maxResponseBody = 64 << 10
+)
+
+type (
+	// Option for building sender struct.
+	Option func(*sender)
+
+	sender struct {
+		client *http.Client
+		now    func() time.Time
+	}
+
+	payload struct {
+		ID        int       `json:"id"`
+		Type      string    `json:"type"`
+		CreatedAt time.Time `json:"created_at"`
+		Data      data      `json:"data"`
+	}
+
+	data struct {
+		UserID string `json:"user_id"`
+		Email  string `json:"email"`
+	}
+)
+
+// New creates a new instance of the app.WebhookSender object.
+func New(options ...Option) app.WebhookSender {
+	s := &sender{
+		client: &http.Client{Timeout: defaultTimeout},
+		now:    time.Now,
+	}
+
+	for i := range options {
+		options[i](s)
+	}
+
+	return s
+}
+
+// SetHTTPClient sets the client used for sending requests.
+func SetHTTPClient(client *http.Client) Option {
+	return func(s *sender) {
+		s.client = client
+	}
+}
+
+// SetClock sets func for getting the signature timestamp.
+func SetClock(now func() time.Time) Option {
+	return func(s *sender) {
+		s.now = now
+	}
+}
+
+// Sign returns the signature of the request body,
+// receivers verify it by computing HMAC-SHA256 of "timestamp.body" with the webhook secret.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send need for implemented app.WebhookSender.
+func (s *sender) Send(ctx context.Context, webhook app.Webhook, event app.Event) (int, error) {
+	body, err := json.Marshal(payload{
+		ID:        event.ID,
+		Type:      string(event.Type),
+		CreatedAt: event.CreatedAt,
+		Data: data{
+			UserID: string(event.UserPublicID),
+			Email:  event.Email,
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("new request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(event.Type))
+	req.Header.Set(HeaderEventID, strconv.Itoa(event.ID))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,81 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zergslaw/boilerplate/internal/app"
+	"github.com/zergslaw/boilerplate/internal/webhook"
+)
+
+func TestSender_Send(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1600000000, 0)
+	event := app.Event{
+		ID:           1,
+		Type:         app.EventUserCreated,
+		UserID:       2,
+		UserPublicID: "public id",
+		Email:        "email@mail.com",
+		CreatedAt:    now.UTC(),
+	}
+	const secret = "secret"
+
+	statusCode := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		timestamp := r.Header.Get(webhook.HeaderTimestamp)
+		assert.Equal(t, "1600000000", timestamp)
+		assert.Equal(t, webhook.Sign(secret, timestamp, body), r.Header.Get(webhook.HeaderSignature))
+		assert.Equal(t, string(app.EventUserCreated), r.Header.Get(webhook.HeaderEvent))
+		assert.Equal(t, "1", r.Header.Get(webhook.HeaderEventID))
+
+		var payload struct {
+			ID   int    `json:"id"`
+			Type string `json:"type"`
+			Data struct {
+				UserID string `json:"user_id"`
+				Email  string `json:"email"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, event.ID, payload.ID)
+		assert.Equal(t, string(event.Type), payload.Type)
+		assert.Equal(t, string(event.UserPublicID), payload.Data.UserID)
+		assert.Equal(t, event.Email, payload.Data.Email)
+
+		w.WriteHeader(statusCode)
+	}))
+	defer srv.Close()
+
+	sender := webhook.New(webhook.SetClock(func() time.Time { return now }))
+	hook := app.Webhook{URL: srv.URL, Secret: secret}
+
+	code, err := sender.Send(context.Background(), hook, event)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	statusCode = http.StatusInternalServerError
+	code, err = sender.Send(context.Background(), hook, event)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	sign := webhook.Sign("secret", "1600000000", []byte(`{}`))
+	assert.Equal(t, sign, webhook.Sign("secret", "1600000000", []byte(`{}`)))
+	assert.NotEqual(t, sign, webhook.Sign("other", "1600000000", []byte(`{}`)))
+	assert.NotEqual(t, sign, webhook.Sign("secret", "1600000001", []byte(`{}`)))
+}
@@ -10,12 +10,13 @@ import (
 	"syscall"
 	"time"
 
-	migrate "github.com/ZergsLaw/zerg-repo/zergrepo/cmd"
 	"github.com/urfave/cli/v2"
 	"github.com/zergslaw/boilerplate/cmd"
 	"github.com/zergslaw/boilerplate/internal/api/web"
 	"github.com/zergslaw/boilerplate/internal/api/web/generated/restapi"
 	"github.com/zergslaw/boilerplate/internal/log"
+	"github.com/zergslaw/boilerplate/internal/metrics"
+	"github.com/zergslaw/boilerplate/internal/notification"
 	"go.uber.org/zap"
 )
 
@@ -28,13 +29,15 @@ var (
 		Usage:        "Boilerplate application.",
 		BashComplete: cli.DefaultAppComplete,
 		Writer:       os.Stdout,
-		Commands:     []*cli.Command{cmd.Version, migrate.Migrate, cmd.Serve},
+		Commands:     []*cli.Command{cmd.Version, cmd.Migrate, cmd.Serve, cmd.Worker, cmd.Webhook, cmd.Tenant, cmd.Notification, cmd.PII},
 	}
 )
 
 func main() {
 	namespace := regexp.MustCompile(`[^a-zA-Z0-9]+`).ReplaceAllString(app.Name, "_")
+	metrics.InitMetrics()
 	web.InitMetrics(namespace, restapi.FlatSwaggerJSON)
+	notification.InitMetrics(namespace)
 
 	var err error
 	logger, err = zap.NewProduction()
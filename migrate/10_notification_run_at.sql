@@ -0,0 +1,17 @@
+--up
+alter table notifications
+    add column run_at timestamp not null default now();
+
+update notifications
+    set run_at = created_at;
+
+create index notifications_pending_idx on notifications (run_at) where is_done = false;
+create index events_user_type_idx on events (user_id, type);
+
+
+--down
+drop index events_user_type_idx;
+drop index notifications_pending_idx;
+
+alter table notifications
+    drop column run_at;
@@ -0,0 +1,22 @@
+--up
+create table user_notifications
+(
+    id         serial,
+    user_id    integer                 not null,
+    task_id    integer,
+    kind       text                    not null,
+    content    text                    not null,
+    read_at    timestamp,
+    created_at timestamp default now() not null,
+
+    foreign key (user_id) references users on delete cascade,
+    unique (task_id),
+    primary key (id)
+);
+
+create index user_notifications_user_idx on user_notifications (user_id, id);
+create index user_notifications_unread_idx on user_notifications (user_id) where read_at is null;
+
+
+--down
+drop table user_notifications;
@@ -0,0 +1,19 @@
+--up
+create table email_events
+(
+    id          serial,
+    external_id text                    not null,
+    email       text                    not null,
+    kind        text                    not null,
+    reason      text                    not null,
+    created_at  timestamp default now() not null,
+
+    unique (external_id),
+    primary key (id)
+);
+
+create index email_events_email_idx on email_events (email, created_at);
+
+
+--down
+drop table email_events;
@@ -0,0 +1,8 @@
+--up
+alter table notifications
+    add column provider text not null default '';
+
+
+--down
+alter table notifications
+    drop column provider;
@@ -0,0 +1,15 @@
+--up
+alter table users
+    add column phone text,
+    add constraint users_phone_key unique (phone);
+
+alter table recovery_code
+    add column phone text;
+
+
+--down
+alter table recovery_code
+    drop column phone;
+
+alter table users
+    drop column phone;
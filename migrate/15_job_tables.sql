@@ -0,0 +1,39 @@
+--up
+create table jobs
+(
+    id           serial,
+    kind         text                      not null,
+    payload      jsonb                     not null,
+    priority     integer   default 0       not null,
+    unique_key   text,
+    run_at       timestamp default now()   not null,
+    attempts     integer   default 0       not null,
+    status       text      default 'pending' not null,
+    error        text      default ''      not null,
+    locked_until timestamp,
+    created_at   timestamp default now()   not null,
+    finished_at  timestamp,
+
+    primary key (id)
+);
+
+create unique index jobs_unique_key_idx on jobs (unique_key) where status = 'pending';
+create index jobs_pending_idx on jobs (priority desc, run_at) where status = 'pending';
+
+insert into jobs (kind, payload, priority, unique_key, run_at)
+select 'notification',
+       json_build_object('task_id', id),
+       case when kind in ('ChangeEmail', 'PassRecovery', 'PassRecoverySMS', 'PhoneVerification') then 10 else 0 end,
+       'notification:' || id,
+       run_at
+    from notifications
+    where is_done = false;
+
+insert into jobs (kind, payload, priority, unique_key, run_at)
+select 'webhook_delivery', json_build_object('delivery_id', id), 0, 'webhook_delivery:' || id, next_attempt_at
+    from webhook_deliveries
+    where status = 'pending';
+
+
+--down
+drop table jobs;
@@ -0,0 +1,12 @@
+--up
+create index sessions_logout_idx on sessions (created_at) where is_logout = true;
+create index recovery_code_created_at_idx on recovery_code (created_at);
+create index notifications_done_idx on notifications (coalesce(exec_time, cancelled_at, created_at)) where is_done = true;
+create index jobs_finished_idx on jobs (finished_at) where status <> 'pending';
+
+
+--down
+drop index jobs_finished_idx;
+drop index notifications_done_idx;
+drop index recovery_code_created_at_idx;
+drop index sessions_logout_idx;
@@ -0,0 +1,12 @@
+--up
+create table leaders
+(
+    election   text        not null primary key,
+    holder     text        not null,
+    term       bigint      not null,
+    expires_at timestamp not null
+);
+
+
+--down
+drop table leaders;
@@ -0,0 +1,8 @@
+--up
+alter table users
+    add column version integer not null default 1;
+
+
+--down
+alter table users
+    drop column version;
@@ -0,0 +1,77 @@
+--up
+create table tenants
+(
+    id         serial,
+    name       text      not null,
+    host       text,
+    created_at timestamp not null default now(),
+
+    unique (name),
+    unique (host),
+    primary key (id)
+);
+
+insert into tenants (name) values ('default');
+
+alter table notifications
+    drop constraint notifications_email_fkey;
+
+alter table recovery_code
+    drop constraint recovery_code_email_fkey;
+
+alter table users
+    add column tenant_id integer not null default 1 references tenants,
+    drop constraint users_email_key,
+    drop constraint users_username_key,
+    drop constraint users_phone_key,
+    add constraint users_email_key unique (tenant_id, email),
+    add constraint users_username_key unique (tenant_id, username),
+    add constraint users_phone_key unique (tenant_id, phone);
+
+alter table users
+    alter column tenant_id drop default;
+
+alter table notifications
+    add column tenant_id integer not null default 1,
+    add foreign key (tenant_id, email) references users (tenant_id, email) on delete cascade on update cascade;
+
+alter table notifications
+    alter column tenant_id drop default;
+
+alter table recovery_code
+    add column tenant_id integer not null default 1,
+    add foreign key (tenant_id, email) references users (tenant_id, email) on delete cascade;
+
+alter table recovery_code
+    alter column tenant_id drop default;
+
+drop index notifications_email_idx;
+create index notifications_email_idx on notifications (tenant_id, email);
+
+
+--down
+drop index notifications_email_idx;
+create index notifications_email_idx on notifications (email);
+
+alter table recovery_code
+    drop column tenant_id;
+
+alter table notifications
+    drop column tenant_id;
+
+alter table users
+    drop constraint users_email_key,
+    drop constraint users_username_key,
+    drop constraint users_phone_key,
+    drop column tenant_id,
+    add constraint users_email_key unique (email),
+    add constraint users_username_key unique (username),
+    add constraint users_phone_key unique (phone);
+
+alter table recovery_code
+    add foreign key (email) references users (email) on delete cascade;
+
+alter table notifications
+    add foreign key (email) references users (email) on delete cascade on update cascade;
+
+drop table tenants;
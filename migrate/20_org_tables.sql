@@ -0,0 +1,45 @@
+--up
+create table orgs
+(
+    id         serial,
+    tenant_id  integer   not null references tenants,
+    name       text      not null,
+    created_at timestamp not null default now(),
+
+    primary key (id)
+);
+
+create table org_members
+(
+    org_id     integer   not null references orgs on delete cascade,
+    user_id    integer   not null references users on delete cascade,
+    role       text      not null,
+    created_at timestamp not null default now(),
+
+    primary key (org_id, user_id)
+);
+
+create unique index org_members_owner_idx on org_members (org_id) where role = 'owner';
+create index org_members_user_id_idx on org_members (user_id);
+
+create table org_invitations
+(
+    id         serial,
+    org_id     integer   not null references orgs on delete cascade,
+    email      text      not null,
+    role       text      not null,
+    token      text      not null,
+    invited_by integer references users on delete set null,
+    created_at timestamp not null default now(),
+
+    unique (token),
+    primary key (id)
+);
+
+create index org_invitations_org_id_email_idx on org_invitations (org_id, email);
+
+
+--down
+drop table org_invitations;
+drop table org_members;
+drop table orgs;
@@ -0,0 +1,24 @@
+--up
+alter table users
+    add column public_id text;
+
+update users set public_id = gen_random_uuid()::text;
+
+alter table users
+    alter column public_id set not null,
+    add constraint users_public_id_key unique (public_id);
+
+alter table events
+    add column user_public_id text not null default '';
+
+update events set user_public_id = users.public_id
+    from users
+    where users.id = events.user_id;
+
+
+--down
+alter table events
+    drop column user_public_id;
+
+alter table users
+    drop column public_id;
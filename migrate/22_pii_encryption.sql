@@ -0,0 +1,70 @@
+--up
+alter table notifications
+    drop constraint notifications_tenant_id_email_fkey,
+    add column email_index text;
+
+alter table recovery_code
+    drop constraint recovery_code_tenant_id_email_fkey,
+    add column email_index text;
+
+/* users_email_key keeps plaintext emails unique until `pii reencrypt` has encrypted them and dropped it. */
+alter table users
+    add column email_index text,
+    add constraint users_email_index_key unique (tenant_id, email_index);
+
+/* Plaintext emails are their own blind indexes, so they are found until `pii reencrypt` encrypts them. */
+update users set email_index = email;
+update notifications set email_index = email;
+update recovery_code set email_index = email;
+
+alter table notifications
+    add foreign key (tenant_id, email_index) references users (tenant_id, email_index) on delete cascade on update cascade;
+
+/* Codes follow blind indexes of users changed by `pii reencrypt`. */
+alter table recovery_code
+    add foreign key (tenant_id, email_index) references users (tenant_id, email_index) on delete cascade on update cascade;
+
+drop index notifications_email_idx;
+create index notifications_email_index_idx on notifications (tenant_id, email_index);
+
+
+--down
+/* Ciphertexts can't be decrypted without keys, `pii decrypt` must be run before. */
+do
+$$
+    begin
+        if exists(select 1 from users where position(':' in email) > 0) or
+           exists(select 1 from notifications where position(':' in email) > 0) or
+           exists(select 1 from recovery_code where position(':' in email) > 0) then
+            raise exception 'emails are encrypted, run pii decrypt before the migration';
+        end if;
+    end
+$$;
+
+drop index notifications_email_index_idx;
+create index notifications_email_idx on notifications (tenant_id, email);
+
+alter table recovery_code
+    drop column email_index;
+
+alter table notifications
+    drop column email_index;
+
+alter table users
+    drop column email_index;
+
+do
+$$
+    begin
+        if not exists(select 1 from pg_constraint where conname = 'users_email_key') then
+            alter table users
+                add constraint users_email_key unique (tenant_id, email);
+        end if;
+    end
+$$;
+
+alter table notifications
+    add foreign key (tenant_id, email) references users (tenant_id, email) on delete cascade on update cascade;
+
+alter table recovery_code
+    add foreign key (tenant_id, email) references users (tenant_id, email) on delete cascade;
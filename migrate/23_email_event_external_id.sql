@@ -0,0 +1,14 @@
+--up
+alter table email_events
+    drop constraint email_events_external_id_key;
+
+create unique index email_events_external_id_idx on email_events (external_id) where external_id <> '';
+
+
+--down
+drop index email_events_external_id_idx;
+
+update email_events set external_id = 'local-' || id where external_id = '';
+
+alter table email_events
+    add constraint email_events_external_id_key unique (external_id);
@@ -0,0 +1,62 @@
+--up
+/* users_phone_key keeps plaintext phones unique until `pii reencrypt` has encrypted them and dropped it. */
+alter table users
+    add column phone_index text,
+    add constraint users_phone_index_key unique (tenant_id, phone_index);
+
+alter table org_invitations
+    add column email_index text;
+
+drop index org_invitations_org_id_email_idx;
+create index org_invitations_org_id_email_index_idx on org_invitations (org_id, email_index);
+
+alter table email_events
+    add column email_index text;
+
+drop index email_events_email_idx;
+create index email_events_email_index_idx on email_events (email_index, created_at);
+
+/* Plaintext values are their own blind indexes, so they are found until `pii reencrypt` encrypts them. */
+update users set phone_index = phone;
+update org_invitations set email_index = email;
+update email_events set email_index = email;
+
+
+--down
+/* Ciphertexts can't be decrypted without keys, `pii decrypt` must be run before. */
+do
+$$
+    begin
+        if exists(select 1 from users where position(':' in phone) > 0) or
+           exists(select 1 from org_invitations where position(':' in email) > 0) or
+           exists(select 1 from email_events where position(':' in email) > 0) then
+            raise exception 'personal data is encrypted, run pii decrypt before the migration';
+        end if;
+    end
+$$;
+
+drop index email_events_email_index_idx;
+create index email_events_email_idx on email_events (email, created_at);
+
+alter table email_events
+    drop column email_index;
+
+drop index org_invitations_org_id_email_index_idx;
+create index org_invitations_org_id_email_idx on org_invitations (org_id, email);
+
+alter table org_invitations
+    drop column email_index;
+
+alter table users
+    drop constraint users_phone_index_key,
+    drop column phone_index;
+
+do
+$$
+    begin
+        if not exists(select 1 from pg_constraint where conname = 'users_phone_key') then
+            alter table users
+                add constraint users_phone_key unique (tenant_id, phone);
+        end if;
+    end
+$$;
@@ -0,0 +1,17 @@
+--up
+alter table sessions
+    add column logout_at timestamp;
+
+-- The time of earlier logouts is unknown, their retention counts from the upgrade.
+update sessions set logout_at = now() where is_logout = true;
+
+drop index sessions_logout_idx;
+create index sessions_logout_at_idx on sessions (logout_at) where is_logout = true;
+
+
+--down
+drop index sessions_logout_at_idx;
+create index sessions_logout_idx on sessions (created_at) where is_logout = true;
+
+alter table sessions
+    drop column logout_at;
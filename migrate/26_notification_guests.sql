@@ -0,0 +1,18 @@
+--up
+/* Invitations are sent to emails without users, so tasks don't reference users anymore.
+   Tasks of users are removed and moved by the repository. */
+alter table notifications
+    drop constraint notifications_tenant_id_email_index_fkey;
+
+
+--down
+delete
+from notifications
+where not exists(select 1
+                 from users
+                 where users.tenant_id = notifications.tenant_id
+                   and (users.email_index = notifications.email_index or
+                        notifications.email_index is null and users.email = notifications.email));
+
+alter table notifications
+    add foreign key (tenant_id, email_index) references users (tenant_id, email_index) on delete cascade on update cascade;
@@ -0,0 +1,26 @@
+--up
+alter table notifications
+    add column payload jsonb not null default '{}';
+
+/* Pending recovery tasks read the code by the email before, it's copied to the payload. */
+update notifications
+set payload = jsonb_build_object('code', (select code
+                                          from recovery_code
+                                          where recovery_code.email = notifications.email
+                                          order by created_at desc, id desc
+                                          limit 1))
+where kind = 'PassRecovery'
+  and is_done = false
+  and exists(select 1 from recovery_code where recovery_code.email = notifications.email);
+
+/* The code has expired or been used, the task would send the empty code. */
+delete
+from notifications
+where kind = 'PassRecovery'
+  and is_done = false
+  and payload = '{}';
+
+
+--down
+alter table notifications
+    drop column payload;
@@ -0,0 +1,15 @@
+--up
+create table notification_settings
+(
+    user_id    integer                 not null,
+    kind       text                    not null,
+    enabled    bool                    not null,
+    updated_at timestamp default now() not null,
+
+    foreign key (user_id) references users on delete cascade,
+    primary key (user_id, kind)
+);
+
+
+--down
+drop table notification_settings;
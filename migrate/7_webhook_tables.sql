@@ -0,0 +1,48 @@
+--up
+create table webhooks
+(
+    id         serial,
+    url        text                    not null,
+    secret     text                    not null,
+    events     text[]                  not null,
+    created_at timestamp default now() not null,
+
+    primary key (id)
+);
+
+create table webhook_events
+(
+    id         serial,
+    type       text                    not null,
+    user_id    integer                 not null,
+    email      text                    not null,
+    created_at timestamp default now() not null,
+
+    primary key (id)
+);
+
+create table webhook_deliveries
+(
+    id              serial,
+    webhook_id      integer                   not null,
+    event_id        integer                   not null,
+    status          text      default 'pending' not null,
+    attempts        integer   default 0       not null,
+    status_code     integer   default 0       not null,
+    error           text      default ''      not null,
+    next_attempt_at timestamp default now()   not null,
+    delivered_at    timestamp,
+    created_at      timestamp default now()   not null,
+
+    foreign key (webhook_id) references webhooks on delete cascade,
+    foreign key (event_id) references webhook_events on delete cascade,
+    primary key (id)
+);
+
+create index webhook_deliveries_pending_idx on webhook_deliveries (next_attempt_at) where status = 'pending';
+
+
+--down
+drop table webhook_deliveries;
+drop table webhook_events;
+drop table webhooks;
@@ -0,0 +1,50 @@
+--up
+create table events
+(
+    id           serial,
+    type         text                    not null,
+    user_id      integer                 not null,
+    email        text                    not null,
+    created_at   timestamp default now() not null,
+    published_at timestamp,
+
+    primary key (id)
+);
+
+create index events_unpublished_idx on events (id) where published_at is null;
+
+/* Events created before the outbox are considered published. */
+insert into events (id, type, user_id, email, created_at, published_at)
+select id, type, user_id, email, created_at, now()
+    from webhook_events;
+select setval('events_id_seq', coalesce((select max(id) from events), 0) + 1, false);
+
+alter table webhook_deliveries
+    drop constraint webhook_deliveries_event_id_fkey,
+    add foreign key (event_id) references events on delete cascade;
+
+drop table webhook_events;
+
+
+--down
+create table webhook_events
+(
+    id         serial,
+    type       text                    not null,
+    user_id    integer                 not null,
+    email      text                    not null,
+    created_at timestamp default now() not null,
+
+    primary key (id)
+);
+
+insert into webhook_events (id, type, user_id, email, created_at)
+select id, type, user_id, email, created_at
+    from events;
+select setval('webhook_events_id_seq', coalesce((select max(id) from webhook_events), 0) + 1, false);
+
+alter table webhook_deliveries
+    drop constraint webhook_deliveries_event_id_fkey,
+    add foreign key (event_id) references webhook_events on delete cascade;
+
+drop table events;
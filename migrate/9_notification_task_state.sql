@@ -0,0 +1,14 @@
+--up
+alter table notifications
+    add column error        text not null default '',
+    add column cancelled_at timestamp;
+
+create index notifications_email_idx on notifications (email);
+
+
+--down
+drop index notifications_email_idx;
+
+alter table notifications
+    drop column error,
+    drop column cancelled_at;
@@ -0,0 +1,9 @@
+// Package migrate contains migrations of the postgres database embedded in the binary.
+package migrate
+
+import "embed"
+
+// FS contains *.sql migrations, they are loaded by repo.LoadMigrations.
+//
+//go:embed *.sql
+var FS embed.FS